@@ -58,6 +58,7 @@ func NewAgentRequest(req utils.DataProvider,
 		Vars:       vars,
 		CGRRequest: utils.NewOrderedNavigableMap(),
 		diamreq:    utils.NewOrderedNavigableMap(), // special case when CGRateS is building the request
+		radDAReq:   utils.NewOrderedNavigableMap(), // special case when CGRateS is building a RADIUS DA request
 		CGRReply:   cgrRply,
 		Reply:      rply,
 		Timezone:   timezone,
@@ -66,6 +67,12 @@ func NewAgentRequest(req utils.DataProvider,
 		Cfg:        config.CgrConfig().GetDataProvider(),
 		ExtraDP:    extraDP,
 	}
+	if _, has := ar.ExtraDP[utils.MetaHTTP]; !has { // ~*http lookups in templates
+		ar.ExtraDP[utils.MetaHTTP] = engine.NewHTTPLookupDP(ar)
+	}
+	if _, has := ar.ExtraDP[utils.MetaGeoIP]; !has { // ~*geoip lookups in templates
+		ar.ExtraDP[utils.MetaGeoIP] = engine.NewGeoIPDP()
+	}
 	if tnt, err := tntTpl.ParseDataProvider(ar); err == nil && tnt != utils.EmptyString {
 		ar.Tenant = tnt
 	}
@@ -85,6 +92,7 @@ type AgentRequest struct {
 	Timezone   string
 	filterS    *engine.FilterS
 	diamreq    *utils.OrderedNavigableMap // used in case of building requests (ie. DisconnectSession)
+	radDAReq   *utils.OrderedNavigableMap // used in case of building RADIUS Dynamic Authorization requests
 	tmp        *utils.DataNode            // used in case you want to store temporary items and access them later
 	Opts       utils.MapStorage
 	Cfg        utils.DataProvider
@@ -135,6 +143,12 @@ func (ar *AgentRequest) FieldAsInterface(fldPath []string) (val any, err error)
 		} else {
 			val = ar.diamreq
 		}
+	case utils.MetaRadDAReq:
+		if len(fldPath) != 1 {
+			val, err = ar.radDAReq.FieldAsInterface(fldPath[1:])
+		} else {
+			val = ar.radDAReq
+		}
 	case utils.MetaRep:
 		if len(fldPath) != 1 {
 			val, err = ar.Reply.FieldAsInterface(fldPath[1:])
@@ -281,6 +295,11 @@ func (ar *AgentRequest) SetAsSlice(fullPath *utils.FullPath, nm *utils.DataLeaf)
 			PathSlice: fullPath.PathSlice[1:],
 			Path:      fullPath.Path[9:],
 		}, []*utils.DataNode{{Type: utils.NMDataType, Value: nm}})
+	case utils.MetaRadDAReq:
+		return ar.radDAReq.SetAsSlice(&utils.FullPath{
+			PathSlice: fullPath.PathSlice[1:],
+			Path:      fullPath.Path[10:],
+		}, []*utils.DataNode{{Type: utils.NMDataType, Value: nm}})
 	case utils.MetaTmp:
 		_, err = ar.tmp.Set(fullPath.PathSlice[1:], []*utils.DataNode{{Type: utils.NMDataType, Value: nm}})
 		return
@@ -306,6 +325,8 @@ func (ar *AgentRequest) RemoveAll(prefix string) error {
 		ar.Reply.RemoveAll()
 	case utils.MetaDiamreq:
 		ar.diamreq.RemoveAll()
+	case utils.MetaRadDAReq:
+		ar.radDAReq.RemoveAll()
 	case utils.MetaTmp:
 		ar.tmp = &utils.DataNode{Type: utils.NMMapType, Map: make(map[string]*utils.DataNode)}
 	case utils.MetaUCH:
@@ -340,6 +361,11 @@ func (ar *AgentRequest) Remove(fullPath *utils.FullPath) error {
 			PathSlice: fullPath.PathSlice[1:],
 			Path:      fullPath.Path[9:],
 		})
+	case utils.MetaRadDAReq:
+		return ar.radDAReq.Remove(&utils.FullPath{
+			PathSlice: fullPath.PathSlice[1:],
+			Path:      fullPath.Path[10:],
+		})
 	case utils.MetaTmp:
 		return ar.tmp.Remove(slices.Clone(fullPath.PathSlice[1:]))
 	case utils.MetaOpts:
@@ -420,6 +446,11 @@ func (ar *AgentRequest) Append(fullPath *utils.FullPath, val *utils.DataLeaf) (e
 			PathSlice: fullPath.PathSlice[1:],
 			Path:      fullPath.Path[9:],
 		}, val)
+	case utils.MetaRadDAReq:
+		return ar.radDAReq.Append(&utils.FullPath{
+			PathSlice: fullPath.PathSlice[1:],
+			Path:      fullPath.Path[10:],
+		}, val)
 	case utils.MetaTmp:
 		_, err = ar.tmp.Append(fullPath.PathSlice[1:], val)
 		return
@@ -455,6 +486,11 @@ func (ar *AgentRequest) Compose(fullPath *utils.FullPath, val *utils.DataLeaf) (
 			PathSlice: fullPath.PathSlice[1:],
 			Path:      fullPath.Path[9:],
 		}, val)
+	case utils.MetaRadDAReq:
+		return ar.radDAReq.Compose(&utils.FullPath{
+			PathSlice: fullPath.PathSlice[1:],
+			Path:      fullPath.Path[10:],
+		}, val)
 	case utils.MetaTmp:
 		return ar.tmp.Compose(fullPath.PathSlice[1:], val)
 	case utils.MetaOpts:
@@ -23,6 +23,7 @@ import (
 	"bytes"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"strings"
 	"testing"
@@ -2816,3 +2817,39 @@ func TestAgentRequestRemove(t *testing.T) {
 // 		}
 // 	}
 // }
+
+func TestAgReqSetFieldsHTTPLookup(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(fmt.Sprintf(`{"Tier":"gold","Account":%q}`, r.URL.Query().Get("account"))))
+	}))
+	defer ts.Close()
+	tmpCfg := config.CgrConfig()
+	defer config.SetCgrConfig(tmpCfg)
+	cfg := config.NewDefaultCGRConfig()
+	cfg.FilterSCfg().HTTPProfiles = map[string]*config.HTTPProfile{
+		"CRM": {URL: config.NewRSRParsersMustCompile(ts.URL+"/tier?account=;~*cgreq.Account", utils.InfieldSep)},
+	}
+	config.SetCgrConfig(cfg)
+	data := engine.NewInternalDB(nil, nil, true, cfg.DataDbCfg().Items)
+	dm := engine.NewDataManager(data, cfg.CacheCfg(), nil)
+	filterS := engine.NewFilterS(cfg, nil, dm)
+	agReq := NewAgentRequest(nil, nil, nil, nil, nil, nil, "cgrates.org", "", filterS, nil)
+	agReq.CGRRequest.Set(&utils.FullPath{Path: utils.AccountField, PathSlice: []string{utils.AccountField}}, "1001")
+	tplFlds := []*config.FCTemplate{
+		{Tag: "Tier",
+			Path: utils.MetaRep + utils.NestingSep + "Tier", Type: utils.MetaVariable,
+			Filters: []string{"*string:~*http.CRM.Account:1001"},
+			Value:   config.NewRSRParsersMustCompile("~*http.CRM.Tier", utils.InfieldSep)},
+	}
+	for _, v := range tplFlds {
+		v.ComputePath()
+	}
+	if err := agReq.SetFields(tplFlds); err != nil {
+		t.Fatal(err)
+	}
+	if val, err := agReq.FieldAsString([]string{utils.MetaRep, "Tier"}); err != nil {
+		t.Error(err)
+	} else if val != "gold" {
+		t.Errorf("Expected gold, received %q", val)
+	}
+}
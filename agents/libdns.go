@@ -617,6 +617,16 @@ func updateDnsAnswer(q []dns.RR, qType uint16, qName string, path []string, valu
 		default:
 			err = utils.ErrWrongPath
 		}
+	case *dns.AAAA:
+		err = updateDnsAAAAAnswer(v, path, value)
+	case *dns.TXT:
+		err = updateDnsTXTAnswer(v, path, value)
+	case *dns.PTR:
+		err = updateDnsPTRAnswer(v, path, value)
+	case *dns.CNAME:
+		err = updateDnsCNAMEAnswer(v, path, value)
+	case *dns.MX:
+		err = updateDnsMXAnswer(v, path, value)
 	case nil:
 		err = fmt.Errorf("unsupported dns option type <%T>", v)
 	default:
@@ -641,6 +651,16 @@ func newDNSAnswer(qType uint16, qName string) (a dns.RR, err error) {
 		a = &dns.NAPTR{Hdr: hdr}
 	case dns.TypeSRV:
 		a = &dns.SRV{Hdr: hdr}
+	case dns.TypeAAAA:
+		a = &dns.AAAA{Hdr: hdr}
+	case dns.TypeTXT:
+		a = &dns.TXT{Hdr: hdr}
+	case dns.TypePTR:
+		a = &dns.PTR{Hdr: hdr}
+	case dns.TypeCNAME:
+		a = &dns.CNAME{Hdr: hdr}
+	case dns.TypeMX:
+		a = &dns.MX{Hdr: hdr}
 	default:
 		err = fmt.Errorf("unsupported DNS type: <%v>", dns.TypeToString[qType])
 	}
@@ -718,6 +738,104 @@ func updateDnsNAPTRAnswer(v *dns.NAPTR, path []string, value any) (err error) {
 	return
 }
 
+func updateDnsAAAAAnswer(v *dns.AAAA, path []string, value any) (err error) {
+	if len(path) < 1 ||
+		(path[0] != utils.DNSHdr && len(path) != 1) ||
+		(path[0] == utils.DNSHdr && len(path) != 2) {
+		return utils.ErrWrongPath
+	}
+	switch path[0] {
+	case utils.DNSHdr:
+		return updateDnsRRHeader(&v.Hdr, path[1:], value)
+	case utils.DNSAAAA:
+		ip := net.ParseIP(utils.IfaceAsString(value))
+		if ip == nil || ip.To4() != nil {
+			return fmt.Errorf("invalid IPv6 address <%v>",
+				utils.IfaceAsString(value))
+		}
+		v.AAAA = ip
+	default:
+		return utils.ErrWrongPath
+	}
+	return
+}
+
+// updateDnsTXTAnswer will append the value to the TXT strings
+// so multiple fields with the same path build a multi-string record
+func updateDnsTXTAnswer(v *dns.TXT, path []string, value any) (err error) {
+	if len(path) < 1 ||
+		(path[0] != utils.DNSHdr && len(path) != 1) ||
+		(path[0] == utils.DNSHdr && len(path) != 2) {
+		return utils.ErrWrongPath
+	}
+	switch path[0] {
+	case utils.DNSHdr:
+		return updateDnsRRHeader(&v.Hdr, path[1:], value)
+	case utils.DNSTxt:
+		v.Txt = append(v.Txt, utils.IfaceAsString(value))
+	default:
+		return utils.ErrWrongPath
+	}
+	return
+}
+
+func updateDnsPTRAnswer(v *dns.PTR, path []string, value any) (err error) {
+	if len(path) < 1 ||
+		(path[0] != utils.DNSHdr && len(path) != 1) ||
+		(path[0] == utils.DNSHdr && len(path) != 2) {
+		return utils.ErrWrongPath
+	}
+	switch path[0] {
+	case utils.DNSHdr:
+		return updateDnsRRHeader(&v.Hdr, path[1:], value)
+	case utils.DNSPtr:
+		v.Ptr = utils.IfaceAsString(value)
+	default:
+		return utils.ErrWrongPath
+	}
+	return
+}
+
+func updateDnsCNAMEAnswer(v *dns.CNAME, path []string, value any) (err error) {
+	if len(path) < 1 ||
+		(path[0] != utils.DNSHdr && len(path) != 1) ||
+		(path[0] == utils.DNSHdr && len(path) != 2) {
+		return utils.ErrWrongPath
+	}
+	switch path[0] {
+	case utils.DNSHdr:
+		return updateDnsRRHeader(&v.Hdr, path[1:], value)
+	case utils.DNSTarget:
+		v.Target = utils.IfaceAsString(value)
+	default:
+		return utils.ErrWrongPath
+	}
+	return
+}
+
+func updateDnsMXAnswer(v *dns.MX, path []string, value any) (err error) {
+	if len(path) < 1 ||
+		(path[0] != utils.DNSHdr && len(path) != 1) ||
+		(path[0] == utils.DNSHdr && len(path) != 2) {
+		return utils.ErrWrongPath
+	}
+	switch path[0] {
+	case utils.DNSHdr:
+		return updateDnsRRHeader(&v.Hdr, path[1:], value)
+	case utils.Preference:
+		var vItm int64
+		if vItm, err = utils.IfaceAsTInt64(value); err != nil {
+			return
+		}
+		v.Preference = uint16(vItm)
+	case utils.DNSMx:
+		v.Mx = utils.IfaceAsString(value)
+	default:
+		return utils.ErrWrongPath
+	}
+	return
+}
+
 func updateDnsRRHeader(v *dns.RR_Header, path []string, value any) (err error) {
 	if len(path) != 1 {
 		return utils.ErrWrongPath
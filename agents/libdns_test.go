@@ -19,6 +19,8 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>
 package agents
 
 import (
+	"net"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -209,3 +211,141 @@ func TestUpdateDNSMsgFromNM(t *testing.T) {
 	}
 
 }
+
+func TestAppendDNSAnswerNewTypes(t *testing.T) {
+	for qType, exp := range map[uint16]dns.RR{
+		dns.TypeAAAA:  &dns.AAAA{},
+		dns.TypeTXT:   &dns.TXT{},
+		dns.TypePTR:   &dns.PTR{},
+		dns.TypeCNAME: &dns.CNAME{},
+		dns.TypeMX:    &dns.MX{},
+	} {
+		a, err := newDNSAnswer(qType, "cgrates.org.")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if reflect.TypeOf(a) != reflect.TypeOf(exp) {
+			t.Errorf("expecting: <%T>, received: <%T>", exp, a)
+		} else if a.Header().Rrtype != qType {
+			t.Errorf("expecting: <%+v>, received: <%+v>", qType, a.Header().Rrtype)
+		} else if a.Header().Name != "cgrates.org." {
+			t.Errorf("expecting: <cgrates.org.>, received: <%+v>", a.Header().Name)
+		}
+	}
+}
+
+func TestUpdateDnsAnswerAAAA(t *testing.T) {
+	rr, err := updateDnsAnswer(nil, dns.TypeAAAA, "cgrates.org.", []string{utils.DNSAAAA}, "2001:db8::1", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rr, err = updateDnsAnswer(rr, dns.TypeAAAA, "cgrates.org.", []string{utils.DNSHdr, utils.DNSTtl}, 3600, false); err != nil {
+		t.Fatal(err)
+	}
+	exp := []dns.RR{&dns.AAAA{
+		Hdr:  dns.RR_Header{Name: "cgrates.org.", Rrtype: dns.TypeAAAA, Class: dns.ClassINET, Ttl: 3600},
+		AAAA: net.ParseIP("2001:db8::1"),
+	}}
+	if !reflect.DeepEqual(exp, rr) {
+		t.Errorf("expecting: %s, received: %s", utils.ToJSON(exp), utils.ToJSON(rr))
+	}
+	if _, err = updateDnsAnswer(nil, dns.TypeAAAA, "cgrates.org.", []string{utils.DNSAAAA}, "192.168.0.1", false); err == nil ||
+		err.Error() != "invalid IPv6 address <192.168.0.1>" {
+		t.Errorf("Expected error, received: %v", err)
+	}
+	if _, err = updateDnsAnswer(nil, dns.TypeAAAA, "cgrates.org.", []string{utils.DNSA}, "2001:db8::1", false); err != utils.ErrWrongPath {
+		t.Errorf("Expected %v, received: %v", utils.ErrWrongPath, err)
+	}
+}
+
+func TestUpdateDnsAnswerTXT(t *testing.T) {
+	rr, err := updateDnsAnswer(nil, dns.TypeTXT, "3.6.9.4.e164.arpa.", []string{utils.DNSTxt}, "carrier=CGR", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rr, err = updateDnsAnswer(rr, dns.TypeTXT, "3.6.9.4.e164.arpa.", []string{utils.DNSTxt}, "cnam=ITsysCOM", false); err != nil {
+		t.Fatal(err)
+	}
+	exp := []dns.RR{&dns.TXT{
+		Hdr: dns.RR_Header{Name: "3.6.9.4.e164.arpa.", Rrtype: dns.TypeTXT, Class: dns.ClassINET, Ttl: 60},
+		Txt: []string{"carrier=CGR", "cnam=ITsysCOM"},
+	}}
+	if !reflect.DeepEqual(exp, rr) {
+		t.Errorf("expecting: %s, received: %s", utils.ToJSON(exp), utils.ToJSON(rr))
+	}
+	if _, err = updateDnsAnswer(nil, dns.TypeTXT, "cgrates.org.", []string{utils.DNSTxt, "1"}, "txt", false); err != utils.ErrWrongPath {
+		t.Errorf("Expected %v, received: %v", utils.ErrWrongPath, err)
+	}
+}
+
+func TestUpdateDnsAnswerPTR(t *testing.T) {
+	rr, err := updateDnsAnswer(nil, dns.TypePTR, "1.0.168.192.in-addr.arpa.", []string{utils.DNSPtr}, "cgrates.org.", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := []dns.RR{&dns.PTR{
+		Hdr: dns.RR_Header{Name: "1.0.168.192.in-addr.arpa.", Rrtype: dns.TypePTR, Class: dns.ClassINET, Ttl: 60},
+		Ptr: "cgrates.org.",
+	}}
+	if !reflect.DeepEqual(exp, rr) {
+		t.Errorf("expecting: %s, received: %s", utils.ToJSON(exp), utils.ToJSON(rr))
+	}
+	if _, err = updateDnsAnswer(nil, dns.TypePTR, "cgrates.org.", []string{utils.DNSTarget}, "cgrates.org.", false); err != utils.ErrWrongPath {
+		t.Errorf("Expected %v, received: %v", utils.ErrWrongPath, err)
+	}
+}
+
+func TestUpdateDnsAnswerCNAME(t *testing.T) {
+	rr, err := updateDnsAnswer(nil, dns.TypeCNAME, "www.cgrates.org.", []string{utils.DNSTarget}, "cgrates.org.", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rr, err = updateDnsAnswer(rr, dns.TypeCNAME, "www.cgrates.org.", []string{utils.DNSHdr, utils.DNSName}, "web.cgrates.org.", false); err != nil {
+		t.Fatal(err)
+	}
+	exp := []dns.RR{&dns.CNAME{
+		Hdr:    dns.RR_Header{Name: "web.cgrates.org.", Rrtype: dns.TypeCNAME, Class: dns.ClassINET, Ttl: 60},
+		Target: "cgrates.org.",
+	}}
+	if !reflect.DeepEqual(exp, rr) {
+		t.Errorf("expecting: %s, received: %s", utils.ToJSON(exp), utils.ToJSON(rr))
+	}
+	if _, err = updateDnsAnswer(nil, dns.TypeCNAME, "cgrates.org.", []string{utils.DNSPtr}, "cgrates.org.", false); err != utils.ErrWrongPath {
+		t.Errorf("Expected %v, received: %v", utils.ErrWrongPath, err)
+	}
+}
+
+func TestUpdateDnsAnswerMX(t *testing.T) {
+	rr, err := updateDnsAnswer(nil, dns.TypeMX, "cgrates.org.", []string{utils.Preference}, 10, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rr, err = updateDnsAnswer(rr, dns.TypeMX, "cgrates.org.", []string{utils.DNSMx}, "mail.cgrates.org.", false); err != nil {
+		t.Fatal(err)
+	}
+	if rr, err = updateDnsAnswer(rr, dns.TypeMX, "cgrates.org.", []string{"1", utils.Preference}, 20, false); err != nil {
+		t.Fatal(err)
+	}
+	if rr, err = updateDnsAnswer(rr, dns.TypeMX, "cgrates.org.", []string{utils.DNSMx}, "backup.cgrates.org.", false); err != nil {
+		t.Fatal(err)
+	}
+	exp := []dns.RR{
+		&dns.MX{
+			Hdr:        dns.RR_Header{Name: "cgrates.org.", Rrtype: dns.TypeMX, Class: dns.ClassINET, Ttl: 60},
+			Preference: 10,
+			Mx:         "mail.cgrates.org.",
+		},
+		&dns.MX{
+			Hdr:        dns.RR_Header{Name: "cgrates.org.", Rrtype: dns.TypeMX, Class: dns.ClassINET, Ttl: 60},
+			Preference: 20,
+			Mx:         "backup.cgrates.org.",
+		},
+	}
+	if !reflect.DeepEqual(exp, rr) {
+		t.Errorf("expecting: %s, received: %s", utils.ToJSON(exp), utils.ToJSON(rr))
+	}
+	if _, err = updateDnsAnswer(nil, dns.TypeMX, "cgrates.org.", []string{utils.Preference}, "RandomValue", false); err == nil ||
+		err.Error() != `strconv.ParseInt: parsing "RandomValue": invalid syntax` {
+		t.Errorf("Expected error, received: %v", err)
+	}
+}
@@ -20,11 +20,29 @@ package agents
 
 import (
 	"bytes"
+	"crypto/md5"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"time"
 
 	"github.com/cgrates/cgrates/utils"
 	"github.com/cgrates/radigo"
 )
 
+// Dynamic Authorization packet codes and attributes as defined in RFC 5176
+const (
+	radDisconnectRequest radigo.PacketCode = 40
+	radDisconnectACK     radigo.PacketCode = 41
+	radDisconnectNAK     radigo.PacketCode = 42
+	radCoARequest        radigo.PacketCode = 43
+	radCoAACK            radigo.PacketCode = 44
+	radCoANAK            radigo.PacketCode = 45
+
+	radErrorCauseAVP = 101
+)
+
 // radReplyAppendAttributes appends attributes to a RADIUS reply based on predefined template
 func radReplyAppendAttributes(reply *radigo.Packet, rplNM *utils.OrderedNavigableMap) (err error) {
 	for el := rplNM.GetFirstElement(); el != nil; el = el.Next() {
@@ -164,3 +182,84 @@ func radauthReq(flags utils.FlagsWithParams, req *radigo.Packet, aReq *AgentRequ
 	}
 
 }
+
+// radDAReqName returns the name of the Dynamic Authorization request, used for logging
+func radDAReqName(code radigo.PacketCode) string {
+	switch code {
+	case radDisconnectRequest:
+		return "Disconnect-Request"
+	case radCoARequest:
+		return "CoA-Request"
+	}
+	return code.String()
+}
+
+// sendRadDARequest sends the Dynamic Authorization request to the NAS and waits for its reply
+// radigo computes authenticators only for RFC 2865/2866 packets so we compute them here as per RFC 5176
+func sendRadDARequest(network, address, secret string, req *radigo.Packet,
+	timeout time.Duration) (rpl *radigo.Packet, err error) {
+	var buf [4096]byte
+	var n int
+	if n, err = req.Encode(buf[:]); err != nil {
+		return
+	}
+	copy(buf[4:20], make([]byte, 16)) // Request Authenticator is computed over null bytes
+	reqAuth := md5.Sum(append(buf[:n:n], secret...))
+	copy(buf[4:20], reqAuth[:])
+	var conn net.Conn
+	if conn, err = net.DialTimeout(network, address, timeout); err != nil {
+		return
+	}
+	defer conn.Close()
+	if err = conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		return
+	}
+	if _, err = conn.Write(buf[:n]); err != nil {
+		return
+	}
+	var rplBuf [4096]byte
+	if n, err = conn.Read(rplBuf[:]); err != nil {
+		if nErr, canCast := err.(net.Error); canCast && nErr.Timeout() {
+			err = utils.ErrTimedOut
+		}
+		return
+	}
+	if n < 20 || int(binary.BigEndian.Uint16(rplBuf[2:4])) != n {
+		return nil, errors.New("unexpected packet length received")
+	}
+	if rplBuf[1] != buf[1] {
+		return nil, fmt.Errorf("unexpected reply identifier: <%d>", rplBuf[1])
+	}
+	var rplAuth [16]byte
+	copy(rplAuth[:], rplBuf[4:20])
+	copy(rplBuf[4:20], reqAuth[:]) // Response Authenticator is computed over the Request Authenticator
+	if md5.Sum(append(rplBuf[:n:n], secret...)) != rplAuth {
+		return nil, errors.New("invalid reply authenticator")
+	}
+	copy(rplBuf[4:20], rplAuth[:])
+	rpl = radigo.NewPacket(0, 0, nil, radigo.NewCoder(), secret)
+	if err = rpl.Decode(rplBuf[:n]); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// radDAReplyErr checks the NAS reply to a Dynamic Authorization request
+func radDAReplyErr(reqCode radigo.PacketCode, rpl *radigo.Packet) error {
+	switch {
+	case reqCode == radDisconnectRequest && rpl.Code == radDisconnectACK,
+		reqCode == radCoARequest && rpl.Code == radCoAACK:
+		return nil
+	case reqCode == radDisconnectRequest && rpl.Code == radDisconnectNAK,
+		reqCode == radCoARequest && rpl.Code == radCoANAK:
+		for _, avp := range rpl.AVPs {
+			if avp.Number == radErrorCauseAVP && len(avp.RawValue) == 4 {
+				return fmt.Errorf("%s rejected with Error-Cause: <%d>",
+					radDAReqName(reqCode), binary.BigEndian.Uint32(avp.RawValue))
+			}
+		}
+		return fmt.Errorf("%s rejected", radDAReqName(reqCode))
+	default:
+		return fmt.Errorf("unexpected reply code: <%d>", rpl.Code)
+	}
+}
@@ -19,6 +19,9 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>
 package agents
 
 import (
+	"crypto/md5"
+	"encoding/binary"
+	"net"
 	"reflect"
 	"strings"
 	"testing"
@@ -141,3 +144,82 @@ func TestRadiusDPFieldAsString(t *testing.T) {
 		t.Errorf("Expecting: flopsy, received: <%s>", data)
 	}
 }
+
+// fakeNAS answers the first Dynamic Authorization request received with the given reply code
+func fakeNAS(t *testing.T, secret string, rplCode radigo.PacketCode, errCause uint32) (addr string) {
+	conn, err := net.ListenPacket(utils.UDP, "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		defer conn.Close()
+		var buf [4096]byte
+		n, from, err := conn.ReadFrom(buf[:])
+		if err != nil {
+			return
+		}
+		var reqAuth [16]byte
+		copy(reqAuth[:], buf[4:20])
+		copy(buf[4:20], make([]byte, 16))
+		if md5.Sum(append(buf[:n:n], secret...)) != reqAuth {
+			return // do not reply to unauthentic requests
+		}
+		rpl := []byte{byte(rplCode), buf[1], 0, 20}
+		rpl = append(rpl, reqAuth[:]...)
+		if errCause != 0 {
+			rpl = append(rpl, radErrorCauseAVP, 6, 0, 0, 0, 0)
+			binary.BigEndian.PutUint32(rpl[22:], errCause)
+		}
+		binary.BigEndian.PutUint16(rpl[2:4], uint16(len(rpl)))
+		rplAuth := md5.Sum(append(rpl, secret...))
+		copy(rpl[4:20], rplAuth[:])
+		conn.WriteTo(rpl, from)
+	}()
+	return conn.LocalAddr().String()
+}
+
+func TestSendRadDARequest(t *testing.T) {
+	req := radigo.NewPacket(radDisconnectRequest, 1, dictRad, coder, "CGRateS.org")
+	if err := req.AddAVPWithName("User-Name", "flopsy", ""); err != nil {
+		t.Fatal(err)
+	}
+	addr := fakeNAS(t, "CGRateS.org", radDisconnectACK, 0)
+	if rpl, err := sendRadDARequest(utils.UDP, addr, "CGRateS.org", req, time.Second); err != nil {
+		t.Fatal(err)
+	} else if err = radDAReplyErr(radDisconnectRequest, rpl); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSendRadDARequestWrongSecret(t *testing.T) {
+	req := radigo.NewPacket(radCoARequest, 2, dictRad, coder, "WrongSecret")
+	if err := req.AddAVPWithName("User-Name", "flopsy", ""); err != nil {
+		t.Fatal(err)
+	}
+	addr := fakeNAS(t, "CGRateS.org", radCoAACK, 0)
+	start := time.Now()
+	if _, err := sendRadDARequest(utils.UDP, addr, "WrongSecret", req, 50*time.Millisecond); err != utils.ErrTimedOut {
+		t.Errorf("Expected %v, received: %v", utils.ErrTimedOut, err)
+	} else if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("Expected the request to time out after 50ms, took: %v", elapsed)
+	}
+}
+
+func TestRadDAReplyErr(t *testing.T) {
+	rpl := radigo.NewPacket(radDisconnectNAK, 1, dictRad, coder, "CGRateS.org")
+	rpl.AVPs = append(rpl.AVPs, &radigo.AVP{Number: radErrorCauseAVP, RawValue: []byte{0, 0, 1, 147}})
+	expErr := "Disconnect-Request rejected with Error-Cause: <403>"
+	if err := radDAReplyErr(radDisconnectRequest, rpl); err == nil || err.Error() != expErr {
+		t.Errorf("Expected %q, received: %v", expErr, err)
+	}
+	rpl = radigo.NewPacket(radCoANAK, 1, dictRad, coder, "CGRateS.org")
+	expErr = "CoA-Request rejected"
+	if err := radDAReplyErr(radCoARequest, rpl); err == nil || err.Error() != expErr {
+		t.Errorf("Expected %q, received: %v", expErr, err)
+	}
+	rpl = radigo.NewPacket(radDisconnectACK, 1, dictRad, coder, "CGRateS.org")
+	expErr = "unexpected reply code: <41>"
+	if err := radDAReplyErr(radCoARequest, rpl); err == nil || err.Error() != expErr {
+		t.Errorf("Expected %q, received: %v", expErr, err)
+	}
+}
@@ -20,7 +20,12 @@ package agents
 
 import (
 	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync/atomic"
 
+	"github.com/cgrates/birpc"
 	"github.com/cgrates/birpc/context"
 	"github.com/cgrates/cgrates/config"
 	"github.com/cgrates/cgrates/engine"
@@ -52,15 +57,26 @@ func NewRadiusAgent(cgrCfg *config.CGRConfig, filterS *engine.FilterS,
 			return
 		}
 	}
-	dicts := radigo.NewDictionaries(dts)
-	ra = &RadiusAgent{cgrCfg: cgrCfg, filterS: filterS, connMgr: connMgr}
-	secrets := radigo.NewSecrets(cgrCfg.RadiusAgentCfg().ClientSecrets)
+	ra = &RadiusAgent{
+		cgrCfg:  cgrCfg,
+		filterS: filterS,
+		connMgr: connMgr,
+		dicts:   radigo.NewDictionaries(dts),
+		secrets: radigo.NewSecrets(cgrCfg.RadiusAgentCfg().ClientSecrets),
+	}
+	var srv *birpc.Service
+	if srv, err = birpc.NewServiceWithMethodsRename(ra, utils.SessionSv1, true, func(oldFn string) (newFn string) {
+		return strings.TrimPrefix(oldFn, "V1")
+	}); err != nil {
+		return
+	}
+	ra.ctx = context.WithClient(context.TODO(), srv)
 	ra.rsAuth = radigo.NewServer(cgrCfg.RadiusAgentCfg().ListenNet,
-		cgrCfg.RadiusAgentCfg().ListenAuth, secrets, dicts,
+		cgrCfg.RadiusAgentCfg().ListenAuth, ra.secrets, ra.dicts,
 		map[radigo.PacketCode]func(*radigo.Packet) (*radigo.Packet, error){
 			radigo.AccessRequest: ra.handleAuth}, nil)
 	ra.rsAcct = radigo.NewServer(cgrCfg.RadiusAgentCfg().ListenNet,
-		cgrCfg.RadiusAgentCfg().ListenAcct, secrets, dicts,
+		cgrCfg.RadiusAgentCfg().ListenAcct, ra.secrets, ra.dicts,
 		map[radigo.PacketCode]func(*radigo.Packet) (*radigo.Packet, error){
 			radigo.AccountingRequest: ra.handleAcct}, nil)
 	return
@@ -72,6 +88,11 @@ type RadiusAgent struct {
 	filterS *engine.FilterS
 	rsAuth  *radigo.Server
 	rsAcct  *radigo.Server
+	dicts   *radigo.Dictionaries
+	secrets *radigo.Secrets
+	daReqID uint32 // identifier of the last Dynamic Authorization request sent
+
+	ctx *context.Context
 }
 
 // handleAuth handles RADIUS Authorization request
@@ -85,6 +106,7 @@ func (ra *RadiusAgent) handleAuth(req *radigo.Packet) (rpl *radigo.Packet, err e
 	opts := utils.MapStorage{}
 	var processed bool
 	reqVars := &utils.DataNode{Type: utils.NMMapType, Map: map[string]*utils.DataNode{utils.RemoteHost: utils.NewLeafNode(req.RemoteAddr().String())}}
+	ra.cacheRadiusPacket(req, dcdr, reqVars)
 	for _, reqProcessor := range ra.cgrCfg.RadiusAgentCfg().RequestProcessors {
 		agReq := NewAgentRequest(dcdr, reqVars, cgrRplyNM, rplyNM, opts,
 			reqProcessor.Tenant, ra.cgrCfg.GeneralCfg().DefaultTenant,
@@ -130,6 +152,7 @@ func (ra *RadiusAgent) handleAcct(req *radigo.Packet) (rpl *radigo.Packet, err e
 	opts := utils.MapStorage{}
 	var processed bool
 	reqVars := &utils.DataNode{Type: utils.NMMapType, Map: map[string]*utils.DataNode{utils.RemoteHost: utils.NewLeafNode(req.RemoteAddr().String())}}
+	ra.cacheRadiusPacket(req, dcdr, reqVars)
 	for _, reqProcessor := range ra.cgrCfg.RadiusAgentCfg().RequestProcessors {
 		agReq := NewAgentRequest(dcdr, reqVars, cgrRplyNM, rplyNM, opts,
 			reqProcessor.Tenant, ra.cgrCfg.GeneralCfg().DefaultTenant,
@@ -223,7 +246,7 @@ func (ra *RadiusAgent) processRequest(req *radigo.Packet, reqProcessor *config.R
 			reqProcessor.Flags.ParamValue(utils.MetaRoutesMaxCost),
 		)
 		rply := new(sessions.V1AuthorizeReply)
-		err = ra.connMgr.Call(context.TODO(), ra.cgrCfg.RadiusAgentCfg().SessionSConns, utils.SessionSv1AuthorizeEvent,
+		err = ra.connMgr.Call(ra.ctx, ra.cgrCfg.RadiusAgentCfg().SessionSConns, utils.SessionSv1AuthorizeEvent,
 			authArgs, rply)
 		rply.SetMaxUsageNeeded(authArgs.GetMaxUsage)
 		agReq.setCGRReply(rply, err)
@@ -239,7 +262,7 @@ func (ra *RadiusAgent) processRequest(req *radigo.Packet, reqProcessor *config.R
 			reqProcessor.Flags.Has(utils.MetaAccounts),
 			cgrEv, reqProcessor.Flags.Has(utils.MetaFD))
 		rply := new(sessions.V1InitSessionReply)
-		err = ra.connMgr.Call(context.TODO(), ra.cgrCfg.RadiusAgentCfg().SessionSConns, utils.SessionSv1InitiateSession,
+		err = ra.connMgr.Call(ra.ctx, ra.cgrCfg.RadiusAgentCfg().SessionSConns, utils.SessionSv1InitiateSession,
 			initArgs, rply)
 		rply.SetMaxUsageNeeded(initArgs.InitSession)
 		agReq.setCGRReply(rply, err)
@@ -250,7 +273,7 @@ func (ra *RadiusAgent) processRequest(req *radigo.Packet, reqProcessor *config.R
 			reqProcessor.Flags.Has(utils.MetaAccounts),
 			cgrEv, reqProcessor.Flags.Has(utils.MetaFD))
 		rply := new(sessions.V1UpdateSessionReply)
-		err = ra.connMgr.Call(context.TODO(), ra.cgrCfg.RadiusAgentCfg().SessionSConns, utils.SessionSv1UpdateSession,
+		err = ra.connMgr.Call(ra.ctx, ra.cgrCfg.RadiusAgentCfg().SessionSConns, utils.SessionSv1UpdateSession,
 			updateArgs, rply)
 		rply.SetMaxUsageNeeded(updateArgs.UpdateSession)
 		agReq.setCGRReply(rply, err)
@@ -264,7 +287,7 @@ func (ra *RadiusAgent) processRequest(req *radigo.Packet, reqProcessor *config.R
 			reqProcessor.Flags.ParamsSlice(utils.MetaStats, utils.MetaIDs),
 			cgrEv, reqProcessor.Flags.Has(utils.MetaFD))
 		var rply string
-		err = ra.connMgr.Call(context.TODO(), ra.cgrCfg.RadiusAgentCfg().SessionSConns, utils.SessionSv1TerminateSession,
+		err = ra.connMgr.Call(ra.ctx, ra.cgrCfg.RadiusAgentCfg().SessionSConns, utils.SessionSv1TerminateSession,
 			terminateArgs, &rply)
 		agReq.setCGRReply(nil, err)
 	case utils.MetaMessage:
@@ -284,7 +307,7 @@ func (ra *RadiusAgent) processRequest(req *radigo.Packet, reqProcessor *config.R
 			reqProcessor.Flags.ParamValue(utils.MetaRoutesMaxCost),
 		)
 		rply := new(sessions.V1ProcessMessageReply)
-		err = ra.connMgr.Call(context.TODO(), ra.cgrCfg.RadiusAgentCfg().SessionSConns, utils.SessionSv1ProcessMessage, evArgs, rply)
+		err = ra.connMgr.Call(ra.ctx, ra.cgrCfg.RadiusAgentCfg().SessionSConns, utils.SessionSv1ProcessMessage, evArgs, rply)
 		if utils.ErrHasPrefix(err, utils.RalsErrorPrfx) {
 			cgrEv.Event[utils.Usage] = 0 // avoid further debits
 		} else if evArgs.Debit {
@@ -299,7 +322,7 @@ func (ra *RadiusAgent) processRequest(req *radigo.Packet, reqProcessor *config.R
 			Paginator: cgrArgs,
 		}
 		rply := new(sessions.V1ProcessEventReply)
-		err = ra.connMgr.Call(context.TODO(), ra.cgrCfg.RadiusAgentCfg().SessionSConns, utils.SessionSv1ProcessEvent,
+		err = ra.connMgr.Call(ra.ctx, ra.cgrCfg.RadiusAgentCfg().SessionSConns, utils.SessionSv1ProcessEvent,
 			evArgs, rply)
 		if utils.ErrHasPrefix(err, utils.RalsErrorPrfx) {
 			cgrEv.Event[utils.Usage] = 0 // avoid further debits
@@ -318,7 +341,7 @@ func (ra *RadiusAgent) processRequest(req *radigo.Packet, reqProcessor *config.R
 	// separate request so we can capture the Terminate/Event also here
 	if reqProcessor.Flags.GetBool(utils.MetaCDRs) {
 		var rplyCDRs string
-		if err = ra.connMgr.Call(context.TODO(), ra.cgrCfg.RadiusAgentCfg().SessionSConns, utils.SessionSv1ProcessCDR,
+		if err = ra.connMgr.Call(ra.ctx, ra.cgrCfg.RadiusAgentCfg().SessionSConns, utils.SessionSv1ProcessCDR,
 			cgrEv, &rplyCDRs); err != nil {
 			agReq.CGRReply.Map[utils.Error] = utils.NewLeafNode(err.Error())
 		}
@@ -358,3 +381,158 @@ func (ra *RadiusAgent) ListenAndServe(stopChan <-chan struct{}) (err error) {
 	err = <-errListen
 	return
 }
+
+// cacheRadiusPacket caches the request so it can be used later to build the Dynamic Authorization requests
+func (ra *RadiusAgent) cacheRadiusPacket(req *radigo.Packet, dcdr utils.DataProvider, reqVars *utils.DataNode) {
+	if len(ra.cgrCfg.RadiusAgentCfg().RequestsCacheKey) == 0 {
+		return
+	}
+	cacheKey, err := ra.cgrCfg.RadiusAgentCfg().RequestsCacheKey.ParseDataProvider(
+		utils.MapStorage{utils.MetaReq: dcdr, utils.MetaVars: reqVars})
+	if err != nil || cacheKey == utils.EmptyString {
+		utils.Logger.Warning(
+			fmt.Sprintf("<%s> failed retrieving the cache key for request: %s, err: %v",
+				utils.RadiusAgent, utils.ToJSON(req), err))
+		return
+	}
+	if err = engine.Cache.Set(utils.CacheRadiusPackets, cacheKey, req,
+		nil, true, utils.NonTransactional); err != nil {
+		utils.Logger.Warning(
+			fmt.Sprintf("<%s> failed caching request: %s, err: %s",
+				utils.RadiusAgent, utils.ToJSON(req), err.Error()))
+	}
+}
+
+// Call implements birpc.ClientConnector interface
+func (ra *RadiusAgent) Call(ctx *context.Context, serviceMethod string, args any, reply any) error {
+	return utils.RPCCall(ra, serviceMethod, args, reply)
+}
+
+// V1DisconnectSession is part of the sessions.BiRPClient
+// sends a Disconnect-Request to the NAS which originated the session
+func (ra *RadiusAgent) V1DisconnectSession(ctx *context.Context, args utils.AttrDisconnectSession, reply *string) (err error) {
+	if ra.cgrCfg.RadiusAgentCfg().DMRTemplate == utils.EmptyString {
+		return utils.ErrNotImplemented
+	}
+	originID, has := args.EventStart[utils.OriginID]
+	if !has {
+		utils.Logger.Info(
+			fmt.Sprintf("<%s> cannot disconnect session, missing OriginID in event: %s",
+				utils.RadiusAgent, utils.ToJSON(args.EventStart)))
+		return utils.ErrMandatoryIeMissing
+	}
+	if err = ra.sendRadDAReq(radDisconnectRequest, ra.cgrCfg.RadiusAgentCfg().DMRTemplate,
+		utils.IfaceAsString(originID), map[string]string{utils.DisconnectCause: args.Reason}); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
+// V1GetActiveSessionIDs is part of the sessions.BiRPClient
+func (ra *RadiusAgent) V1GetActiveSessionIDs(ctx *context.Context, ignParam string,
+	sessionIDs *[]*sessions.SessionID) error {
+	return utils.ErrNotImplemented
+}
+
+// V1ReAuthorize is part of the sessions.BiRPClient
+// sends a CoA-Request to the NAS which originated the session
+func (ra *RadiusAgent) V1ReAuthorize(ctx *context.Context, originID string, reply *string) (err error) {
+	if ra.cgrCfg.RadiusAgentCfg().CoATemplate == utils.EmptyString {
+		return utils.ErrNotImplemented
+	}
+	if originID == utils.EmptyString {
+		utils.Logger.Info(
+			fmt.Sprintf("<%s> cannot send CoA, missing session ID",
+				utils.RadiusAgent))
+		return utils.ErrMandatoryIeMissing
+	}
+	if err = ra.sendRadDAReq(radCoARequest, ra.cgrCfg.RadiusAgentCfg().CoATemplate,
+		originID, nil); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
+// V1DisconnectPeer is part of the sessions.BiRPClient
+func (ra *RadiusAgent) V1DisconnectPeer(ctx *context.Context, args *utils.DPRArgs, reply *string) (err error) {
+	return utils.ErrNotImplemented
+}
+
+// V1WarnDisconnect is part of the sessions.BiRPClient
+func (ra *RadiusAgent) V1WarnDisconnect(ctx *context.Context, args map[string]any, reply *string) (err error) {
+	return utils.ErrNotImplemented
+}
+
+// sendRadDAReq builds a Dynamic Authorization request out of the cached request
+// and sends it to the NAS, returning error if the NAS did not acknowledge it
+func (ra *RadiusAgent) sendRadDAReq(reqCode radigo.PacketCode, tplID, originID string,
+	vars map[string]string) (err error) {
+	cachedPkt, has := engine.Cache.Get(utils.CacheRadiusPackets, originID)
+	if !has {
+		utils.Logger.Warning(
+			fmt.Sprintf("<%s> cannot retrieve packet from cache with OriginID: <%s>",
+				utils.RadiusAgent, originID))
+		return utils.ErrMandatoryIeMissing
+	}
+	pkt := cachedPkt.(*radigo.Packet)
+	if pkt.RemoteAddr() == nil {
+		return utils.ErrMandatoryIeMissing
+	}
+	var clntHost string
+	if clntHost, _, err = net.SplitHostPort(pkt.RemoteAddr().String()); err != nil {
+		return
+	}
+	dac, has := ra.cgrCfg.RadiusAgentCfg().ClientDaAddresses[clntHost]
+	if !has {
+		utils.Logger.Warning(
+			fmt.Sprintf("<%s> no DA client configured for <%s>, OriginID: <%s>",
+				utils.RadiusAgent, clntHost, originID))
+		return utils.ErrNotFound
+	}
+	reqVars := &utils.DataNode{Type: utils.NMMapType, Map: map[string]*utils.DataNode{
+		utils.RemoteHost: utils.NewLeafNode(pkt.RemoteAddr().String())}}
+	for k, v := range vars {
+		reqVars.Map[k] = utils.NewLeafNode(v)
+	}
+	aReq := NewAgentRequest(newRADataProvider(pkt), reqVars,
+		nil, nil, nil, nil,
+		ra.cgrCfg.GeneralCfg().DefaultTenant,
+		ra.cgrCfg.GeneralCfg().DefaultTimezone, ra.filterS, nil)
+	if err = aReq.SetFields(ra.cgrCfg.TemplatesCfg()[tplID]); err != nil {
+		utils.Logger.Warning(
+			fmt.Sprintf("<%s> cannot build %s with OriginID: <%s>, err: %s",
+				utils.RadiusAgent, radDAReqName(reqCode), originID, err.Error()))
+		return utils.ErrServerError
+	}
+	secret := ra.secrets.GetSecret(clntHost)
+	daReq := radigo.NewPacket(reqCode, uint8(atomic.AddUint32(&ra.daReqID, 1)),
+		ra.dicts.GetInstance(clntHost), radigo.NewCoder(), secret)
+	if err = radReplyAppendAttributes(daReq, aReq.radDAReq); err != nil {
+		utils.Logger.Warning(
+			fmt.Sprintf("<%s> cannot build %s with OriginID: <%s>, err: %s",
+				utils.RadiusAgent, radDAReqName(reqCode), originID, err.Error()))
+		return utils.ErrServerError
+	}
+	daAddr := net.JoinHostPort(utils.FirstNonEmpty(dac.Host, clntHost), strconv.Itoa(dac.Port))
+	if dac.Flags.Has(utils.MetaLog) {
+		utils.Logger.Info(
+			fmt.Sprintf("<%s> LOG, sending %s to <%s>: %s",
+				utils.RadiusAgent, radDAReqName(reqCode), daAddr, utils.ToJSON(daReq)))
+	}
+	var rpl *radigo.Packet
+	if rpl, err = sendRadDARequest(dac.Transport, daAddr, secret, daReq,
+		ra.cgrCfg.RadiusAgentCfg().DATimeout); err != nil {
+		utils.Logger.Warning(
+			fmt.Sprintf("<%s> failed sending %s to <%s>, OriginID: <%s>, err: %s",
+				utils.RadiusAgent, radDAReqName(reqCode), daAddr, originID, err.Error()))
+		return
+	}
+	if dac.Flags.Has(utils.MetaLog) {
+		utils.Logger.Info(
+			fmt.Sprintf("<%s> LOG, received reply from <%s>: %s",
+				utils.RadiusAgent, daAddr, utils.ToJSON(rpl)))
+	}
+	return radDAReplyErr(reqCode, rpl)
+}
@@ -0,0 +1,43 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package agents
+
+import (
+	"testing"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/sessions"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestRAsSessionSClientIface(t *testing.T) {
+	_ = sessions.BiRPCClient(new(RadiusAgent))
+}
+
+func TestRadiusAgentDisconnectSessionNoTemplate(t *testing.T) {
+	ra := &RadiusAgent{cgrCfg: config.NewDefaultCGRConfig()}
+	var rply string
+	if err := ra.V1DisconnectSession(nil, utils.AttrDisconnectSession{
+		EventStart: map[string]any{utils.OriginID: "sess1"}}, &rply); err != utils.ErrNotImplemented {
+		t.Errorf("Expected %v, received: %v", utils.ErrNotImplemented, err)
+	}
+	if err := ra.V1ReAuthorize(nil, "sess1", &rply); err != utils.ErrNotImplemented {
+		t.Errorf("Expected %v, received: %v", utils.ErrNotImplemented, err)
+	}
+}
@@ -20,6 +20,7 @@ package v1
 
 import (
 	"errors"
+	"fmt"
 	"math"
 	"slices"
 	"strings"
@@ -463,6 +464,11 @@ func (apierSv1 *APIerSv1) modifyBalance(aType string, attr *AttrAddBalance, repl
 		}
 	}
 	at := &engine.ActionTiming{}
+	if aType == utils.MetaDebit {
+		at.SetAPIMethod(utils.APIerSv1DebitBalance)
+	} else {
+		at.SetAPIMethod(utils.APIerSv1AddBalance)
+	}
 	//check if we have extra data
 	if attr.ActionExtraData != nil && len(*attr.ActionExtraData) != 0 {
 		at.ExtraData = *attr.ActionExtraData
@@ -545,6 +551,7 @@ func (apierSv1 *APIerSv1) SetBalance(ctx *context.Context, attr *utils.AttrSetBa
 		}
 	}
 	at := &engine.ActionTiming{}
+	at.SetAPIMethod(utils.APIerSv1SetBalance)
 	//check if we have extra data
 	if attr.ActionExtraData != nil && len(*attr.ActionExtraData) != 0 {
 		at.ExtraData = *attr.ActionExtraData
@@ -612,6 +619,7 @@ func (apierSv1 *APIerSv1) SetBalances(ctx *context.Context, attr *utils.AttrSetB
 	}
 	for _, bal := range attr.Balances {
 		at := &engine.ActionTiming{}
+		at.SetAPIMethod(utils.APIerSv1SetBalances)
 
 		var balFltr *engine.BalanceFilter
 		if balFltr, err = engine.NewBalanceFilter(bal.Balance, apierSv1.Config.GeneralCfg().DefaultTimezone); err != nil {
@@ -694,6 +702,7 @@ func (apierSv1 *APIerSv1) RemoveBalances(ctx *context.Context, attr *utils.AttrS
 	}
 
 	at := &engine.ActionTiming{}
+	at.SetAPIMethod(utils.APIerSv1RemoveBalances)
 	//check if we have extra data
 	if attr.ActionExtraData != nil && len(*attr.ActionExtraData) != 0 {
 		at.ExtraData = *attr.ActionExtraData
@@ -724,3 +733,130 @@ func (apierSv1 *APIerSv1) GetAccountsCount(ctx *context.Context, attr *utils.Ten
 	*reply = len(accountKeys)
 	return
 }
+
+// HoldBalance reserves an amount on the account balances, excluding it from the credit
+// available for debits until it is captured, released or the TTL expires
+func (apierSv1 *APIerSv1) HoldBalance(ctx *context.Context, attr *engine.ArgsBalanceHold, reply *engine.BalanceHold) (err error) {
+	if missing := utils.MissingStructFields(attr, []string{utils.AccountField, utils.Amount}); len(missing) != 0 {
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	if attr.Tenant == utils.EmptyString {
+		attr.Tenant = apierSv1.Config.GeneralCfg().DefaultTenant
+	}
+	var hold *engine.BalanceHold
+	if hold, err = engine.HoldBalance(attr); err != nil {
+		return
+	}
+	*reply = *hold
+	return
+}
+
+// CaptureBalanceHold consumes the amount from the hold (all of it if not specified), releasing the rest
+func (apierSv1 *APIerSv1) CaptureBalanceHold(ctx *context.Context, attr *engine.ArgsBalanceHold, reply *float64) (err error) {
+	if missing := utils.MissingStructFields(attr, []string{utils.AccountField, utils.HoldID}); len(missing) != 0 {
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	if attr.Tenant == utils.EmptyString {
+		attr.Tenant = apierSv1.Config.GeneralCfg().DefaultTenant
+	}
+	var captured float64
+	if captured, err = engine.CaptureBalanceHold(attr); err != nil {
+		return
+	}
+	*reply = captured
+	return
+}
+
+// ReleaseBalanceHold adds the amount held back on the account balances
+func (apierSv1 *APIerSv1) ReleaseBalanceHold(ctx *context.Context, attr *engine.ArgsBalanceHold, reply *string) (err error) {
+	if missing := utils.MissingStructFields(attr, []string{utils.AccountField, utils.HoldID}); len(missing) != 0 {
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	if attr.Tenant == utils.EmptyString {
+		attr.Tenant = apierSv1.Config.GeneralCfg().DefaultTenant
+	}
+	if err = engine.ReleaseBalanceHold(attr); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
+// TransferBalance moves value between the balances of two accounts, taking the
+// configured fee out of the source balances on top of the amount
+func (apierSv1 *APIerSv1) TransferBalance(ctx *context.Context, attr *engine.ArgsTransferBalance, reply *engine.BalanceTransfer) (err error) {
+	if missing := utils.MissingStructFields(attr, []string{utils.FromAccount, utils.ToAccount, utils.Amount}); len(missing) != 0 {
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	if attr.Tenant == utils.EmptyString {
+		attr.Tenant = apierSv1.Config.GeneralCfg().DefaultTenant
+	}
+	var tr *engine.BalanceTransfer
+	if tr, err = engine.TransferBalance(attr, apierSv1.FilterS); err != nil {
+		return
+	}
+	*reply = *tr
+	return
+}
+
+// GetBalanceLedger returns the balance changes recorded in StorDB, ordered by time
+func (apierSv1 *APIerSv1) GetBalanceLedger(ctx *context.Context, attr *engine.BalanceLedgerFilter, reply *[]*engine.BalanceLedgerEntry) (err error) {
+	var entries []*engine.BalanceLedgerEntry
+	if entries, err = apierSv1.CdrDb.GetBalanceLedgerEntries(attr); err != nil {
+		if err != utils.ErrNotFound {
+			err = utils.NewErrServerError(err)
+		}
+		return
+	}
+	*reply = entries
+	return
+}
+
+// ArgExportBalanceLedger selects the ledger entries to be exported and the exporters used
+type ArgExportBalanceLedger struct {
+	engine.BalanceLedgerFilter
+	ExporterIDs []string
+	Verbose     bool
+}
+
+// ExportBalanceLedger sends the balance ledger entries to EEs
+func (apierSv1 *APIerSv1) ExportBalanceLedger(ctx *context.Context, args *ArgExportBalanceLedger, reply *map[string]any) (err error) {
+	if len(apierSv1.Config.ApierCfg().EEsConns) == 0 {
+		return utils.NewErrNotConnected(utils.EEs)
+	}
+	entries, err := apierSv1.CdrDb.GetBalanceLedgerEntries(&args.BalanceLedgerFilter)
+	if err != nil {
+		if err != utils.ErrNotFound {
+			err = utils.NewErrServerError(err)
+		}
+		return
+	}
+	withErrors := false
+	var rplyEv map[string]map[string]any
+	for _, le := range entries {
+		argEv := &engine.CGREventWithEeIDs{
+			EeIDs:    args.ExporterIDs,
+			CGREvent: le.AsCGREvent(),
+		}
+		if args.Verbose {
+			argEv.CGREvent.APIOpts[utils.OptsEEsVerbose] = struct{}{}
+		}
+		if err := apierSv1.ConnMgr.Call(context.TODO(), apierSv1.Config.ApierCfg().EEsConns,
+			utils.EeSv1ProcessEvent, argEv, &rplyEv); err != nil {
+			utils.Logger.Warning(fmt.Sprintf("<%s> error: <%s> processing event: <%s> with <%s>",
+				utils.ApierS, err.Error(), utils.ToJSON(argEv.CGREvent), utils.EEs))
+			withErrors = true
+		}
+	}
+	if withErrors {
+		return utils.ErrPartiallyExecuted
+	}
+	if *reply == nil {
+		*reply = make(map[string]any)
+	}
+	// we consider only the last reply because it should have the metrics updated
+	for exporterID, metrics := range rplyEv {
+		(*reply)[exporterID] = metrics
+	}
+	return
+}
@@ -98,6 +98,8 @@ type SessionSv1Interface interface {
 	SetPassiveSession(ctx *context.Context, args *sessions.Session, reply *string) error
 	ActivateSessions(ctx *context.Context, args *utils.SessionIDsWithArgsDispatcher, reply *string) error
 	DeactivateSessions(ctx *context.Context, args *utils.SessionIDsWithArgsDispatcher, reply *string) error
+	BackupActiveSessions(ctx *context.Context, args *utils.TenantWithAPIOpts, reply *int) error
+	GetBackupSessions(ctx *context.Context, args *utils.SessionFilter, rply *[]*sessions.ExternalSession) error
 
 	STIRAuthenticate(ctx *context.Context, args *sessions.V1STIRAuthenticateArgs, reply *string) error
 	STIRIdentity(ctx *context.Context, args *sessions.V1STIRIdentityArgs, reply *string) error
@@ -211,6 +213,9 @@ type ReplicatorSv1Interface interface {
 	GetChargerProfile(ctx *context.Context, tntID *utils.TenantIDWithAPIOpts, reply *engine.ChargerProfile) error
 	GetDispatcherProfile(ctx *context.Context, tntID *utils.TenantIDWithAPIOpts, reply *engine.DispatcherProfile) error
 	GetDispatcherHost(ctx *context.Context, tntID *utils.TenantIDWithAPIOpts, reply *engine.DispatcherHost) error
+	GetExchangeRate(ctx *context.Context, tntID *utils.TenantIDWithAPIOpts, reply *engine.ExchangeRate) error
+	GetCalendar(ctx *context.Context, tntID *utils.TenantIDWithAPIOpts, reply *engine.Calendar) error
+	GetTaxProfile(ctx *context.Context, tntID *utils.TenantIDWithAPIOpts, reply *engine.TaxProfile) error
 	GetItemLoadIDs(ctx *context.Context, itemID *utils.StringWithAPIOpts, reply *map[string]int64) error
 	SetThresholdProfile(ctx *context.Context, th *engine.ThresholdProfileWithAPIOpts, reply *string) error
 	SetThreshold(ctx *context.Context, th *engine.ThresholdWithAPIOpts, reply *string) error
@@ -235,6 +240,9 @@ type ReplicatorSv1Interface interface {
 	SetActionPlan(ctx *context.Context, args *engine.SetActionPlanArgWithAPIOpts, reply *string) error
 	SetAccountActionPlans(ctx *context.Context, args *engine.SetAccountActionPlansArgWithAPIOpts, reply *string) error
 	SetDispatcherHost(ctx *context.Context, dpp *engine.DispatcherHostWithAPIOpts, reply *string) error
+	SetExchangeRate(ctx *context.Context, er *engine.ExchangeRateWithAPIOpts, reply *string) error
+	SetCalendar(ctx *context.Context, cal *engine.CalendarWithAPIOpts, reply *string) error
+	SetTaxProfile(ctx *context.Context, tp *engine.TaxProfileWithAPIOpts, reply *string) error
 	RemoveThreshold(ctx *context.Context, args *utils.TenantIDWithAPIOpts, reply *string) error
 	SetLoadIDs(ctx *context.Context, args *utils.LoadIDsWithAPIOpts, reply *string) error
 	RemoveDestination(ctx *context.Context, id *utils.StringWithAPIOpts, reply *string) error
@@ -258,6 +266,9 @@ type ReplicatorSv1Interface interface {
 	RemoveChargerProfile(ctx *context.Context, args *utils.TenantIDWithAPIOpts, reply *string) error
 	RemoveDispatcherProfile(ctx *context.Context, args *utils.TenantIDWithAPIOpts, reply *string) error
 	RemoveDispatcherHost(ctx *context.Context, args *utils.TenantIDWithAPIOpts, reply *string) error
+	RemoveExchangeRate(ctx *context.Context, args *utils.TenantIDWithAPIOpts, reply *string) error
+	RemoveCalendar(ctx *context.Context, args *utils.TenantIDWithAPIOpts, reply *string) error
+	RemoveTaxProfile(ctx *context.Context, args *utils.TenantIDWithAPIOpts, reply *string) error
 
 	GetIndexes(ctx *context.Context, args *utils.GetIndexesArg, reply *map[string]utils.StringSet) error
 	SetIndexes(ctx *context.Context, args *utils.SetIndexesArg, reply *string) error
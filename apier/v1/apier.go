@@ -1475,6 +1475,62 @@ func (apierSv1 *APIerSv1) ComputeActionPlanIndexes(ctx *context.Context, _ strin
 	return nil
 }
 
+// DumpDataDB writes the content of the internal DataDB on disk
+func (apierSv1 *APIerSv1) DumpDataDB(ctx *context.Context, _ string, reply *string) (err error) {
+	iDB, canCast := apierSv1.DataManager.DataDB().(*engine.InternalDB)
+	if !canCast {
+		return fmt.Errorf("dump not supported for DataDB type <%s>",
+			apierSv1.Config.DataDbCfg().Type)
+	}
+	if err = iDB.Dump(); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
+// RestoreDataDB replaces the content of the internal DataDB with the one from disk
+func (apierSv1 *APIerSv1) RestoreDataDB(ctx *context.Context, _ string, reply *string) (err error) {
+	iDB, canCast := apierSv1.DataManager.DataDB().(*engine.InternalDB)
+	if !canCast {
+		return fmt.Errorf("restore not supported for DataDB type <%s>",
+			apierSv1.Config.DataDbCfg().Type)
+	}
+	if err = iDB.Restore(); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
+// DumpStorDB writes the content of the internal StorDB on disk
+func (apierSv1 *APIerSv1) DumpStorDB(ctx *context.Context, _ string, reply *string) (err error) {
+	iDB, canCast := apierSv1.StorDb.(*engine.InternalDB)
+	if !canCast {
+		return fmt.Errorf("dump not supported for StorDB type <%s>",
+			apierSv1.Config.StorDbCfg().Type)
+	}
+	if err = iDB.Dump(); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
+// RestoreStorDB replaces the content of the internal StorDB with the one from disk
+func (apierSv1 *APIerSv1) RestoreStorDB(ctx *context.Context, _ string, reply *string) (err error) {
+	iDB, canCast := apierSv1.StorDb.(*engine.InternalDB)
+	if !canCast {
+		return fmt.Errorf("restore not supported for StorDB type <%s>",
+			apierSv1.Config.StorDbCfg().Type)
+	}
+	if err = iDB.Restore(); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
 // GetActionPlanIDs returns list of ActionPlan IDs registered for a tenant
 func (apierSv1 *APIerSv1) GetActionPlanIDs(ctx *context.Context, args *utils.PaginatorWithTenant, attrPrfIDs *[]string) error {
 	prfx := utils.ActionPlanPrefix
@@ -0,0 +1,118 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package v1
+
+import (
+	"time"
+
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// GetCalendar returns the calendar used by the *calendar filters
+func (apierSv1 *APIerSv1) GetCalendar(ctx *context.Context, arg *utils.TenantID, reply *engine.Calendar) error {
+	if missing := utils.MissingStructFields(arg, []string{utils.ID}); len(missing) != 0 { //Params missing
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	tnt := arg.Tenant
+	if tnt == utils.EmptyString {
+		tnt = apierSv1.Config.GeneralCfg().DefaultTenant
+	}
+	cal, err := apierSv1.DataManager.GetCalendar(tnt, arg.ID, true, false, utils.NonTransactional)
+	if err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	*reply = *cal
+	return nil
+}
+
+// GetCalendarIDs returns the list of calendar IDs registered for a tenant
+func (apierSv1 *APIerSv1) GetCalendarIDs(ctx *context.Context, tenantArg *utils.PaginatorWithTenant, calIDs *[]string) error {
+	tenant := tenantArg.Tenant
+	if tenant == utils.EmptyString {
+		tenant = apierSv1.Config.GeneralCfg().DefaultTenant
+	}
+	prfx := utils.CalendarPrefix + tenant + utils.ConcatenatedKeySep
+	keys, err := apierSv1.DataManager.DataDB().GetKeysForPrefix(prfx)
+	if err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		return utils.ErrNotFound
+	}
+	retIDs := make([]string, len(keys))
+	for i, key := range keys {
+		retIDs[i] = key[len(prfx):]
+	}
+	*calIDs = tenantArg.PaginateStringSlice(retIDs)
+	return nil
+}
+
+// SetCalendar adds or updates a calendar
+func (apierSv1 *APIerSv1) SetCalendar(ctx *context.Context, args *engine.CalendarWithAPIOpts, reply *string) error {
+	if args.Calendar == nil {
+		return utils.NewErrMandatoryIeMissing(utils.ID)
+	}
+	if err := args.Validate(); err != nil {
+		return utils.NewErrServerError(err)
+	}
+	if args.Tenant == utils.EmptyString {
+		args.Tenant = apierSv1.Config.GeneralCfg().DefaultTenant
+	}
+	if err := apierSv1.DataManager.SetCalendar(args.Calendar); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	//generate a loadID for CacheCalendars and store it in database
+	if err := apierSv1.DataManager.SetLoadIDs(map[string]int64{utils.CacheCalendars: time.Now().UnixNano()}); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	//handle caching for Calendar
+	if err := apierSv1.CallCache(utils.IfaceAsString(args.APIOpts[utils.CacheOpt]), args.Tenant, utils.CacheCalendars,
+		args.TenantID(), utils.EmptyString, nil, nil, args.APIOpts); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	*reply = utils.OK
+	return nil
+}
+
+// RemoveCalendar removes a calendar
+func (apierSv1 *APIerSv1) RemoveCalendar(ctx *context.Context, arg *utils.TenantIDWithAPIOpts, reply *string) error {
+	if missing := utils.MissingStructFields(arg, []string{utils.ID}); len(missing) != 0 { //Params missing
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	tnt := arg.Tenant
+	if tnt == utils.EmptyString {
+		tnt = apierSv1.Config.GeneralCfg().DefaultTenant
+	}
+	if err := apierSv1.DataManager.RemoveCalendar(tnt, arg.ID); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	//generate a loadID for CacheCalendars and store it in database
+	if err := apierSv1.DataManager.SetLoadIDs(map[string]int64{utils.CacheCalendars: time.Now().UnixNano()}); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	//handle caching for Calendar
+	if err := apierSv1.CallCache(utils.IfaceAsString(arg.APIOpts[utils.CacheOpt]), tnt, utils.CacheCalendars,
+		utils.ConcatenatedKey(tnt, arg.ID), utils.EmptyString, nil, nil, arg.APIOpts); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	*reply = utils.OK
+	return nil
+}
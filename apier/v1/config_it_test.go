@@ -172,6 +172,7 @@ func testConfigSSetConfigSessionS(t *testing.T) {
 		},
 		"store_session_costs": false,
 		"terminate_attempts":  5.,
+		"backup_interval":     "0",
 		utils.DefaultUsageCfg: map[string]any{
 			utils.MetaAny:   "3h0m0s",
 			utils.MetaVoice: "3h0m0s",
@@ -198,6 +199,7 @@ func testConfigSSetConfigSessionS(t *testing.T) {
 			"session_indexes":       []string{"OriginID"},
 			"client_protocol":       1.,
 			"terminate_attempts":    5,
+			"backup_interval":       "0",
 			"channel_sync_interval": "0",
 			"debit_interval":        "0",
 			"session_ttl":           "0",
@@ -251,6 +253,7 @@ func testConfigSv1GetJSONSectionWithoutTenant(t *testing.T) {
 		"session_indexes":            []any{"OriginID"},
 		"client_protocol":            1.,
 		"terminate_attempts":         5.,
+		"backup_interval":            "0",
 		"channel_sync_interval":      "0",
 		"debit_interval":             "0",
 		"session_ttl":                "0",
@@ -290,6 +293,7 @@ func testConfigSv1GetJSONSectionWithoutTenant(t *testing.T) {
 			"session_indexes":            []string{"OriginID"},
 			"client_protocol":            1.,
 			"terminate_attempts":         5,
+			"backup_interval":            "0",
 			"channel_sync_interval":      "0",
 			"debit_interval":             "0",
 			"session_ttl":                "0",
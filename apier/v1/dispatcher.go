@@ -545,6 +545,15 @@ func (dS *DispatcherSessionSv1) SyncSessions(ctx *context.Context, args *utils.T
 	return dS.dS.SessionSv1SyncSessions(ctx, args, rply)
 }
 
+func (dS *DispatcherSessionSv1) BackupActiveSessions(ctx *context.Context, args *utils.TenantWithAPIOpts, reply *int) error {
+	return dS.dS.SessionSv1BackupActiveSessions(ctx, args, reply)
+}
+
+func (dS *DispatcherSessionSv1) GetBackupSessions(ctx *context.Context, args *utils.SessionFilter,
+	reply *[]*sessions.ExternalSession) error {
+	return dS.dS.SessionSv1GetBackupSessions(ctx, args, reply)
+}
+
 func (dS *DispatcherSessionSv1) STIRAuthenticate(ctx *context.Context, args *sessions.V1STIRAuthenticateArgs, reply *string) error {
 	return dS.dS.SessionSv1STIRAuthenticate(ctx, args, reply)
 }
@@ -971,6 +980,18 @@ func (dS *DispatcherEeSv1) ProcessEvent(ctx *context.Context, args *engine.CGREv
 	return dS.dS.EeSv1ProcessEvent(ctx, args, reply)
 }
 
+func (dS *DispatcherEeSv1) GetDeadLetters(ctx *context.Context, args *engine.ArgsDeadLetters, reply *[]*engine.DeadLetter) error {
+	return dS.dS.EeSv1GetDeadLetters(ctx, args, reply)
+}
+
+func (dS *DispatcherEeSv1) ReplayDeadLetters(ctx *context.Context, args *engine.ArgsDeadLetters, reply *string) error {
+	return dS.dS.EeSv1ReplayDeadLetters(ctx, args, reply)
+}
+
+func (dS *DispatcherEeSv1) PurgeDeadLetters(ctx *context.Context, args *engine.ArgsDeadLetters, reply *string) error {
+	return dS.dS.EeSv1PurgeDeadLetters(ctx, args, reply)
+}
+
 type DispatcherReplicatorSv1 struct {
 	dS *dispatchers.DispatcherService
 }
@@ -1104,6 +1125,21 @@ func (dS *DispatcherReplicatorSv1) GetDispatcherHost(ctx *context.Context, tntID
 	return dS.dS.ReplicatorSv1GetDispatcherHost(ctx, tntID, reply)
 }
 
+// GetExchangeRate
+func (dS *DispatcherReplicatorSv1) GetExchangeRate(ctx *context.Context, tntID *utils.TenantIDWithAPIOpts, reply *engine.ExchangeRate) error {
+	return dS.dS.ReplicatorSv1GetExchangeRate(ctx, tntID, reply)
+}
+
+// GetCalendar
+func (dS *DispatcherReplicatorSv1) GetCalendar(ctx *context.Context, tntID *utils.TenantIDWithAPIOpts, reply *engine.Calendar) error {
+	return dS.dS.ReplicatorSv1GetCalendar(ctx, tntID, reply)
+}
+
+// GetTaxProfile
+func (dS *DispatcherReplicatorSv1) GetTaxProfile(ctx *context.Context, tntID *utils.TenantIDWithAPIOpts, reply *engine.TaxProfile) error {
+	return dS.dS.ReplicatorSv1GetTaxProfile(ctx, tntID, reply)
+}
+
 // GetItemLoadIDs
 func (dS *DispatcherReplicatorSv1) GetItemLoadIDs(ctx *context.Context, itemID *utils.StringWithAPIOpts, reply *map[string]int64) error {
 	return dS.dS.ReplicatorSv1GetItemLoadIDs(ctx, itemID, reply)
@@ -1226,6 +1262,21 @@ func (dS *DispatcherReplicatorSv1) SetDispatcherHost(ctx *context.Context, args
 	return dS.dS.ReplicatorSv1SetDispatcherHost(ctx, args, reply)
 }
 
+// SetExchangeRate
+func (dS *DispatcherReplicatorSv1) SetExchangeRate(ctx *context.Context, args *engine.ExchangeRateWithAPIOpts, reply *string) error {
+	return dS.dS.ReplicatorSv1SetExchangeRate(ctx, args, reply)
+}
+
+// SetCalendar
+func (dS *DispatcherReplicatorSv1) SetCalendar(ctx *context.Context, args *engine.CalendarWithAPIOpts, reply *string) error {
+	return dS.dS.ReplicatorSv1SetCalendar(ctx, args, reply)
+}
+
+// SetTaxProfile
+func (dS *DispatcherReplicatorSv1) SetTaxProfile(ctx *context.Context, args *engine.TaxProfileWithAPIOpts, reply *string) error {
+	return dS.dS.ReplicatorSv1SetTaxProfile(ctx, args, reply)
+}
+
 // RemoveThreshold
 func (dS *DispatcherReplicatorSv1) RemoveThreshold(ctx *context.Context, args *utils.TenantIDWithAPIOpts, reply *string) error {
 	return dS.dS.ReplicatorSv1RemoveThreshold(ctx, args, reply)
@@ -1341,6 +1392,21 @@ func (dS *DispatcherReplicatorSv1) RemoveDispatcherHost(ctx *context.Context, ar
 	return dS.dS.ReplicatorSv1RemoveDispatcherHost(ctx, args, reply)
 }
 
+// RemoveExchangeRate
+func (dS *DispatcherReplicatorSv1) RemoveExchangeRate(ctx *context.Context, args *utils.TenantIDWithAPIOpts, reply *string) error {
+	return dS.dS.ReplicatorSv1RemoveExchangeRate(ctx, args, reply)
+}
+
+// RemoveCalendar
+func (dS *DispatcherReplicatorSv1) RemoveCalendar(ctx *context.Context, args *utils.TenantIDWithAPIOpts, reply *string) error {
+	return dS.dS.ReplicatorSv1RemoveCalendar(ctx, args, reply)
+}
+
+// RemoveTaxProfile
+func (dS *DispatcherReplicatorSv1) RemoveTaxProfile(ctx *context.Context, args *utils.TenantIDWithAPIOpts, reply *string) error {
+	return dS.dS.ReplicatorSv1RemoveTaxProfile(ctx, args, reply)
+}
+
 // GetIndexes .
 func (dS *DispatcherReplicatorSv1) GetIndexes(ctx *context.Context, args *utils.GetIndexesArg, reply *map[string]utils.StringSet) error {
 	return dS.dS.ReplicatorSv1GetIndexes(ctx, args, reply)
@@ -43,3 +43,21 @@ func (eeSv1 *EeSv1) ProcessEvent(ctx *context.Context, args *engine.CGREventWith
 	reply *map[string]map[string]any) error {
 	return eeSv1.eeS.V1ProcessEvent(ctx, args, reply)
 }
+
+// GetDeadLetters returns the events that failed the export
+func (eeSv1 *EeSv1) GetDeadLetters(ctx *context.Context, args *engine.ArgsDeadLetters,
+	reply *[]*engine.DeadLetter) error {
+	return eeSv1.eeS.V1GetDeadLetters(ctx, args, reply)
+}
+
+// ReplayDeadLetters exports again the events that failed the export
+func (eeSv1 *EeSv1) ReplayDeadLetters(ctx *context.Context, args *engine.ArgsDeadLetters,
+	reply *string) error {
+	return eeSv1.eeS.V1ReplayDeadLetters(ctx, args, reply)
+}
+
+// PurgeDeadLetters removes the events that failed the export
+func (eeSv1 *EeSv1) PurgeDeadLetters(ctx *context.Context, args *engine.ArgsDeadLetters,
+	reply *string) error {
+	return eeSv1.eeS.V1PurgeDeadLetters(ctx, args, reply)
+}
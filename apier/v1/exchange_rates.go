@@ -0,0 +1,118 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package v1
+
+import (
+	"time"
+
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// GetExchangeRate returns the exchange rate with the ID FromCurrency:ToCurrency
+func (apierSv1 *APIerSv1) GetExchangeRate(ctx *context.Context, arg *utils.TenantID, reply *engine.ExchangeRate) error {
+	if missing := utils.MissingStructFields(arg, []string{utils.ID}); len(missing) != 0 { //Params missing
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	tnt := arg.Tenant
+	if tnt == utils.EmptyString {
+		tnt = apierSv1.Config.GeneralCfg().DefaultTenant
+	}
+	er, err := apierSv1.DataManager.GetExchangeRate(tnt, arg.ID, true, false, utils.NonTransactional)
+	if err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	*reply = *er
+	return nil
+}
+
+// GetExchangeRateIDs returns the list of exchange rate IDs registered for a tenant
+func (apierSv1 *APIerSv1) GetExchangeRateIDs(ctx *context.Context, tenantArg *utils.PaginatorWithTenant, erIDs *[]string) error {
+	tenant := tenantArg.Tenant
+	if tenant == utils.EmptyString {
+		tenant = apierSv1.Config.GeneralCfg().DefaultTenant
+	}
+	prfx := utils.ExchangeRatePrefix + tenant + utils.ConcatenatedKeySep
+	keys, err := apierSv1.DataManager.DataDB().GetKeysForPrefix(prfx)
+	if err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		return utils.ErrNotFound
+	}
+	retIDs := make([]string, len(keys))
+	for i, key := range keys {
+		retIDs[i] = key[len(prfx):]
+	}
+	*erIDs = tenantArg.PaginateStringSlice(retIDs)
+	return nil
+}
+
+// SetExchangeRate adds or updates an exchange rate
+func (apierSv1 *APIerSv1) SetExchangeRate(ctx *context.Context, args *engine.ExchangeRateWithAPIOpts, reply *string) error {
+	if args.ExchangeRate == nil {
+		return utils.NewErrMandatoryIeMissing(utils.FromCurrency, utils.ToCurrency)
+	}
+	if err := args.Validate(); err != nil {
+		return utils.NewErrServerError(err)
+	}
+	if args.Tenant == utils.EmptyString {
+		args.Tenant = apierSv1.Config.GeneralCfg().DefaultTenant
+	}
+	if err := apierSv1.DataManager.SetExchangeRate(args.ExchangeRate); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	//generate a loadID for CacheExchangeRates and store it in database
+	if err := apierSv1.DataManager.SetLoadIDs(map[string]int64{utils.CacheExchangeRates: time.Now().UnixNano()}); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	//handle caching for ExchangeRate
+	if err := apierSv1.CallCache(utils.IfaceAsString(args.APIOpts[utils.CacheOpt]), args.Tenant, utils.CacheExchangeRates,
+		args.TenantID(), utils.EmptyString, nil, nil, args.APIOpts); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	*reply = utils.OK
+	return nil
+}
+
+// RemoveExchangeRate removes the exchange rate with the ID FromCurrency:ToCurrency
+func (apierSv1 *APIerSv1) RemoveExchangeRate(ctx *context.Context, arg *utils.TenantIDWithAPIOpts, reply *string) error {
+	if missing := utils.MissingStructFields(arg, []string{utils.ID}); len(missing) != 0 { //Params missing
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	tnt := arg.Tenant
+	if tnt == utils.EmptyString {
+		tnt = apierSv1.Config.GeneralCfg().DefaultTenant
+	}
+	if err := apierSv1.DataManager.RemoveExchangeRate(tnt, arg.ID); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	//generate a loadID for CacheExchangeRates and store it in database
+	if err := apierSv1.DataManager.SetLoadIDs(map[string]int64{utils.CacheExchangeRates: time.Now().UnixNano()}); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	//handle caching for ExchangeRate
+	if err := apierSv1.CallCache(utils.IfaceAsString(arg.APIOpts[utils.CacheOpt]), tnt, utils.CacheExchangeRates,
+		utils.ConcatenatedKey(tnt, arg.ID), utils.EmptyString, nil, nil, arg.APIOpts); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	*reply = utils.OK
+	return nil
+}
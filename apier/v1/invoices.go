@@ -0,0 +1,61 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package v1
+
+import (
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// NewInvoiceSv1 initializes InvoiceSv1
+func NewInvoiceSv1(invS *engine.InvoiceService) *InvoiceSv1 {
+	return &InvoiceSv1{invS: invS}
+}
+
+// InvoiceSv1 exports RPC from InvoiceS
+type InvoiceSv1 struct {
+	invS *engine.InvoiceService
+}
+
+// Call implements birpc.ClientConnector interface for internal RPC
+func (invSv1 *InvoiceSv1) Call(ctx *context.Context, serviceMethod string, args any, reply any) error {
+	return utils.APIerRPCCall(invSv1, serviceMethod, args, reply)
+}
+
+// BillRun builds and stores the invoices of the accounts for the billing period
+func (invSv1 *InvoiceSv1) BillRun(ctx *context.Context, args *engine.ArgsBillRun, reply *[]*engine.Invoice) error {
+	return invSv1.invS.V1BillRun(ctx, args, reply)
+}
+
+// GetInvoices returns the stored invoices matching the filter
+func (invSv1 *InvoiceSv1) GetInvoices(ctx *context.Context, args *engine.InvoiceFilter, reply *[]*engine.Invoice) error {
+	return invSv1.invS.V1GetInvoices(ctx, args, reply)
+}
+
+// ExportInvoices sends the stored invoices to EEs
+func (invSv1 *InvoiceSv1) ExportInvoices(ctx *context.Context, args *engine.ArgExportInvoices, reply *map[string]any) error {
+	return invSv1.invS.V1ExportInvoices(ctx, args, reply)
+}
+
+// Ping return pong if the service is active
+func (invSv1 *InvoiceSv1) Ping(ctx *context.Context, ign *utils.CGREvent, reply *string) error {
+	*reply = utils.Pong
+	return nil
+}
@@ -49,6 +49,11 @@ func (ldrSv1 *LoaderSv1) Remove(ctx *context.Context, args *loaders.ArgsProcessF
 	return ldrSv1.ldrS.V1Remove(ctx, args, rply)
 }
 
+func (ldrSv1 *LoaderSv1) DryRun(ctx *context.Context, args *loaders.ArgsDryRun,
+	rply *loaders.LoaderDiff) error {
+	return ldrSv1.ldrS.V1DryRun(ctx, args, rply)
+}
+
 func (rsv1 *LoaderSv1) Ping(ctx *context.Context, ign *utils.CGREvent, reply *string) error {
 	*reply = utils.Pong
 	return nil
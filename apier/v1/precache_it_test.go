@@ -195,6 +195,7 @@ func testPrecacheGetCacheStatsAfterRestart(t *testing.T) {
 		utils.CacheReverseDestinations: {Items: 7},
 		utils.CacheRPCResponses:        {},
 		utils.MetaSentryPeer:           {},
+		utils.CacheHTTPLookups:         {},
 		utils.CacheSharedGroups:        {Items: 1},
 		utils.CacheStatFilterIndexes: {
 			Items:  2,
@@ -311,6 +311,39 @@ func (rplSv1 *ReplicatorSv1) GetDispatcherHost(ctx *context.Context, tntID *util
 	return nil
 }
 
+// GetExchangeRate is the remote method coresponding to the dataDb driver method
+func (rplSv1 *ReplicatorSv1) GetExchangeRate(ctx *context.Context, tntID *utils.TenantIDWithAPIOpts, reply *engine.ExchangeRate) error {
+	engine.UpdateReplicationFilters(utils.ExchangeRatePrefix, tntID.TenantID.TenantID(), utils.IfaceAsString(tntID.APIOpts[utils.RemoteHostOpt]))
+	rcv, err := rplSv1.dm.DataDB().GetExchangeRateDrv(tntID.Tenant, tntID.ID)
+	if err != nil {
+		return err
+	}
+	*reply = *rcv
+	return nil
+}
+
+// GetCalendar is the remote method coresponding to the dataDb driver method
+func (rplSv1 *ReplicatorSv1) GetCalendar(ctx *context.Context, tntID *utils.TenantIDWithAPIOpts, reply *engine.Calendar) error {
+	engine.UpdateReplicationFilters(utils.CalendarPrefix, tntID.TenantID.TenantID(), utils.IfaceAsString(tntID.APIOpts[utils.RemoteHostOpt]))
+	rcv, err := rplSv1.dm.DataDB().GetCalendarDrv(tntID.Tenant, tntID.ID)
+	if err != nil {
+		return err
+	}
+	*reply = *rcv
+	return nil
+}
+
+// GetTaxProfile is the remote method coresponding to the dataDb driver method
+func (rplSv1 *ReplicatorSv1) GetTaxProfile(ctx *context.Context, tntID *utils.TenantIDWithAPIOpts, reply *engine.TaxProfile) error {
+	engine.UpdateReplicationFilters(utils.TaxProfilePrefix, tntID.TenantID.TenantID(), utils.IfaceAsString(tntID.APIOpts[utils.RemoteHostOpt]))
+	rcv, err := rplSv1.dm.DataDB().GetTaxProfileDrv(tntID.Tenant, tntID.ID)
+	if err != nil {
+		return err
+	}
+	*reply = *rcv
+	return nil
+}
+
 // GetItemLoadIDs is the remote method coresponding to the dataDb driver method
 func (rplSv1 *ReplicatorSv1) GetItemLoadIDs(ctx *context.Context, itemID *utils.StringWithAPIOpts, reply *map[string]int64) error {
 	engine.UpdateReplicationFilters(utils.LoadIDPrefix, itemID.Arg, utils.IfaceAsString(itemID.APIOpts[utils.RemoteHostOpt]))
@@ -629,6 +662,45 @@ func (rplSv1 *ReplicatorSv1) SetDispatcherHost(ctx *context.Context, dpp *engine
 	return
 }
 
+// SetExchangeRate is the replication method coresponding to the dataDb driver method
+func (rplSv1 *ReplicatorSv1) SetExchangeRate(ctx *context.Context, er *engine.ExchangeRateWithAPIOpts, reply *string) (err error) {
+	if err = rplSv1.dm.DataDB().SetExchangeRateDrv(er.ExchangeRate); err != nil {
+		return
+	}
+	if err = rplSv1.v1.CallCache(utils.IfaceAsString(er.APIOpts[utils.CacheOpt]),
+		er.Tenant, utils.CacheExchangeRates, er.TenantID(), utils.EmptyString, nil, nil, er.APIOpts); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
+// SetCalendar is the replication method coresponding to the dataDb driver method
+func (rplSv1 *ReplicatorSv1) SetCalendar(ctx *context.Context, cal *engine.CalendarWithAPIOpts, reply *string) (err error) {
+	if err = rplSv1.dm.DataDB().SetCalendarDrv(cal.Calendar); err != nil {
+		return
+	}
+	if err = rplSv1.v1.CallCache(utils.IfaceAsString(cal.APIOpts[utils.CacheOpt]),
+		cal.Tenant, utils.CacheCalendars, cal.TenantID(), utils.EmptyString, nil, nil, cal.APIOpts); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
+// SetTaxProfile is the replication method coresponding to the dataDb driver method
+func (rplSv1 *ReplicatorSv1) SetTaxProfile(ctx *context.Context, tp *engine.TaxProfileWithAPIOpts, reply *string) (err error) {
+	if err = rplSv1.dm.DataDB().SetTaxProfileDrv(tp.TaxProfile); err != nil {
+		return
+	}
+	if err = rplSv1.v1.CallCache(utils.IfaceAsString(tp.APIOpts[utils.CacheOpt]),
+		tp.Tenant, utils.CacheTaxProfiles, tp.TenantID(), utils.EmptyString, &tp.FilterIDs, nil, tp.APIOpts); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
 // SetLoadIDs is the replication method coresponding to the dataDb driver method
 func (rplSv1 *ReplicatorSv1) SetLoadIDs(ctx *context.Context, args *utils.LoadIDsWithAPIOpts, reply *string) (err error) {
 	if err = rplSv1.dm.DataDB().SetLoadIDsDrv(args.LoadIDs); err != nil {
@@ -946,6 +1018,45 @@ func (rplSv1 *ReplicatorSv1) RemoveDispatcherHost(ctx *context.Context, args *ut
 	return
 }
 
+// RemoveExchangeRate is the replication method coresponding to the dataDb driver method
+func (rplSv1 *ReplicatorSv1) RemoveExchangeRate(ctx *context.Context, args *utils.TenantIDWithAPIOpts, reply *string) (err error) {
+	if err = rplSv1.dm.DataDB().RemoveExchangeRateDrv(args.Tenant, args.ID); err != nil {
+		return
+	}
+	if err = rplSv1.v1.CallCache(utils.IfaceAsString(args.APIOpts[utils.CacheOpt]),
+		args.Tenant, utils.CacheExchangeRates, args.TenantID.TenantID(), utils.EmptyString, nil, nil, args.APIOpts); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
+// RemoveCalendar is the replication method coresponding to the dataDb driver method
+func (rplSv1 *ReplicatorSv1) RemoveCalendar(ctx *context.Context, args *utils.TenantIDWithAPIOpts, reply *string) (err error) {
+	if err = rplSv1.dm.DataDB().RemoveCalendarDrv(args.Tenant, args.ID); err != nil {
+		return
+	}
+	if err = rplSv1.v1.CallCache(utils.IfaceAsString(args.APIOpts[utils.CacheOpt]),
+		args.Tenant, utils.CacheCalendars, args.TenantID.TenantID(), utils.EmptyString, nil, nil, args.APIOpts); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
+// RemoveTaxProfile is the replication method coresponding to the dataDb driver method
+func (rplSv1 *ReplicatorSv1) RemoveTaxProfile(ctx *context.Context, args *utils.TenantIDWithAPIOpts, reply *string) (err error) {
+	if err = rplSv1.dm.DataDB().RemoveTaxProfileDrv(args.Tenant, args.ID); err != nil {
+		return
+	}
+	if err = rplSv1.v1.CallCache(utils.IfaceAsString(args.APIOpts[utils.CacheOpt]),
+		args.Tenant, utils.CacheTaxProfiles, args.TenantID.TenantID(), utils.EmptyString, nil, nil, args.APIOpts); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
 // RemoveIndexes  is the replication method coresponding to the dataDb driver method
 func (rplSv1 *ReplicatorSv1) RemoveIndexes(ctx *context.Context, args *utils.GetIndexesArg, reply *string) (err error) {
 	if err = rplSv1.dm.DataDB().RemoveIndexesDrv(args.IdxItmType, args.TntCtx, args.IdxKey); err != nil {
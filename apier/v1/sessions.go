@@ -139,6 +139,17 @@ func (ssv1 *SessionSv1) DeactivateSessions(ctx *context.Context, args *utils.Ses
 	return ssv1.sS.BiRPCv1DeactivateSessions(ctx, args, reply)
 }
 
+// BackupActiveSessions stores the active sessions into DataDB
+func (ssv1 *SessionSv1) BackupActiveSessions(ctx *context.Context, args *utils.TenantWithAPIOpts, reply *int) error {
+	return ssv1.sS.BiRPCv1BackupActiveSessions(ctx, args, reply)
+}
+
+// GetBackupSessions returns the sessions backed up into DataDB
+func (ssv1 *SessionSv1) GetBackupSessions(ctx *context.Context, args *utils.SessionFilter,
+	rply *[]*sessions.ExternalSession) error {
+	return ssv1.sS.BiRPCv1GetBackupSessions(ctx, args, rply)
+}
+
 // ReAuthorize sends the RAR for filterd sessions
 func (ssv1 *SessionSv1) ReAuthorize(ctx *context.Context, args *utils.SessionFilter, reply *string) error {
 	return ssv1.sS.BiRPCv1ReAuthorize(ctx, args, reply)
@@ -0,0 +1,96 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package v1
+
+import (
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// SetTPTaxProfile creates a new TaxProfile within a tariff plan
+func (apierSv1 *APIerSv1) SetTPTaxProfile(ctx *context.Context, attr *utils.TPTaxProfile, reply *string) error {
+	if missing := utils.MissingStructFields(attr, []string{utils.TPid, utils.ID}); len(missing) != 0 {
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	if attr.Tenant == utils.EmptyString {
+		attr.Tenant = apierSv1.Config.GeneralCfg().DefaultTenant
+	}
+	if err := apierSv1.StorDb.SetTPTaxProfiles([]*utils.TPTaxProfile{attr}); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	*reply = utils.OK
+	return nil
+}
+
+// GetTPTaxProfile queries specific TaxProfile on Tariff plan
+func (apierSv1 *APIerSv1) GetTPTaxProfile(ctx *context.Context, attr *utils.TPTntID, reply *utils.TPTaxProfile) error {
+	if missing := utils.MissingStructFields(attr, []string{utils.TPid, utils.ID}); len(missing) != 0 { //Params missing
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	if attr.Tenant == utils.EmptyString {
+		attr.Tenant = apierSv1.Config.GeneralCfg().DefaultTenant
+	}
+	rls, err := apierSv1.StorDb.GetTPTaxProfiles(attr.TPid, attr.Tenant, attr.ID)
+	if err != nil {
+		if err.Error() != utils.ErrNotFound.Error() {
+			err = utils.NewErrServerError(err)
+		}
+		return err
+	}
+	*reply = *rls[0]
+	return nil
+}
+
+type AttrGetTPTaxProfileIds struct {
+	TPid string // Tariff plan id
+	utils.PaginatorWithSearch
+}
+
+// GetTPTaxProfileIDs queries TaxProfile identities on specific tariff plan.
+func (apierSv1 *APIerSv1) GetTPTaxProfileIDs(ctx *context.Context, attrs *AttrGetTPTaxProfileIds, reply *[]string) error {
+	if missing := utils.MissingStructFields(attrs, []string{utils.TPid}); len(missing) != 0 { //Params missing
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	ids, err := apierSv1.StorDb.GetTpTableIds(attrs.TPid, utils.TBLTPTaxProfiles, utils.TPDistinctIds{utils.TenantCfg, utils.IDCfg},
+		nil, &attrs.PaginatorWithSearch)
+	if err != nil {
+		if err.Error() != utils.ErrNotFound.Error() {
+			err = utils.NewErrServerError(err)
+		}
+		return err
+	}
+	*reply = ids
+	return nil
+}
+
+// RemoveTPTaxProfile removes specific TaxProfile on Tariff plan
+func (apierSv1 *APIerSv1) RemoveTPTaxProfile(ctx *context.Context, attrs *utils.TPTntID, reply *string) error {
+	if missing := utils.MissingStructFields(attrs, []string{utils.TPid, utils.ID}); len(missing) != 0 { //Params missing
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	if attrs.Tenant == utils.EmptyString {
+		attrs.Tenant = apierSv1.Config.GeneralCfg().DefaultTenant
+	}
+	if err := apierSv1.StorDb.RemTpData(utils.TBLTPTaxProfiles, attrs.TPid,
+		map[string]string{"tenant": attrs.Tenant, "id": attrs.ID}); err != nil {
+		return utils.NewErrServerError(err)
+	}
+	*reply = utils.OK
+	return nil
+}
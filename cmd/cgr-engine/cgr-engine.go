@@ -444,6 +444,9 @@ func main() {
 		cncReqsStrategy = utils.ConcurrentReqsStrategy
 	}
 	caps := engine.NewCaps(cncReqsLimit, cncReqsStrategy)
+	if len(cfg.CoreSCfg().CapsLimits) != 0 {
+		caps.SetLimiter(engine.NewCapsLimiter(cfg.CoreSCfg().CapsLimits))
+	}
 	utils.Logger.Info(fmt.Sprintf("<CoreS> starting version <%s><%s>", vers, goVers))
 	cfg.LazySanityCheck()
 
@@ -470,6 +473,7 @@ func main() {
 	internalAPIerSv2Chan := make(chan birpc.ClientConnector, 1)
 	internalLoaderSChan := make(chan birpc.ClientConnector, 1)
 	internalEEsChan := make(chan birpc.ClientConnector, 1)
+	internalInvoiceSChan := make(chan birpc.ClientConnector, 1)
 
 	// initialize the connManager before creating the DMService
 	// because we need to pass the connection to it
@@ -495,6 +499,7 @@ func main() {
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaRALs):           internalRALsChan,
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaEEs):            internalEEsChan,
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaDispatchers):    internalDispatcherSChan,
+		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaInvoices):       internalInvoiceSChan,
 
 		utils.ConcatenatedKey(rpcclient.BiRPCInternal, utils.MetaSessionS): internalSessionSChan,
 	})
@@ -531,6 +536,7 @@ func main() {
 		utils.StorDB:          new(sync.WaitGroup),
 		utils.ThresholdS:      new(sync.WaitGroup),
 		utils.AccountS:        new(sync.WaitGroup),
+		utils.InvoiceS:        new(sync.WaitGroup),
 	}
 	gvService := services.NewGlobalVarS(cfg, srvDep)
 	shdWg.Add(1)
@@ -561,6 +567,10 @@ func main() {
 	if cfg.ConfigSCfg().Enabled {
 		server.RegisterHttpFunc(cfg.ConfigSCfg().URL, config.HandlerConfigS)
 	}
+	if cfg.HTTPCfg().HTTPMetricsURL != utils.EmptyString {
+		engine.Metrics.Register(utils.CoreS, caps)
+		server.RegisterHttpFunc(cfg.HTTPCfg().HTTPMetricsURL, engine.Metrics.ServeHTTP)
+	}
 	if *httpPprofPath != utils.EmptyString {
 		server.RegisterProfiler(*httpPprofPath)
 	}
@@ -592,6 +602,7 @@ func main() {
 		log.Fatal(err)
 	}
 	engine.Cache = cacheS
+	engine.Metrics.Register(utils.CacheS, cacheS)
 
 	// init GuardianSv1
 	err = initGuardianSv1(internalGuardianSChan, server, anz)
@@ -636,7 +647,7 @@ func main() {
 
 	srvManager.AddServices(gvService, attrS, chrS, tS, stS, reS, routeS, schS, rals,
 		apiSv1, apiSv2, cdrS, smg, coreS,
-		services.NewEventReaderService(cfg, filterSChan, shdChan, connManager, srvDep),
+		services.NewEventReaderService(cfg, filterSChan, shdChan, server, connManager, srvDep),
 		services.NewDNSAgent(cfg, filterSChan, shdChan, connManager, srvDep),
 		services.NewFreeswitchAgent(cfg, shdChan, connManager, srvDep),
 		services.NewKamailioAgent(cfg, shdChan, connManager, srvDep),
@@ -648,6 +659,8 @@ func main() {
 		services.NewEventExporterService(cfg, filterSChan,
 			connManager, server, internalEEsChan, anz, srvDep),
 		services.NewSIPAgent(cfg, filterSChan, shdChan, connManager, srvDep),
+		services.NewInvoiceService(cfg, dmService, storDBService, server,
+			internalInvoiceSChan, connManager, anz, srvDep),
 	)
 	srvManager.StartServices()
 	// Start FilterS
@@ -684,6 +697,7 @@ func main() {
 	engine.IntRPC.AddInternalRPCClient(utils.RALsV1, internalRALsChan)
 	engine.IntRPC.AddInternalRPCClient(utils.EeSv1, internalEEsChan)
 	engine.IntRPC.AddInternalRPCClient(utils.DispatcherSv1, internalDispatcherSChan)
+	engine.IntRPC.AddInternalRPCClient(utils.InvoiceSv1, internalInvoiceSChan)
 
 	err = initConfigSv1(internalConfigChan, server, anz)
 	if err != nil {
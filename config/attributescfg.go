@@ -38,6 +38,8 @@ type AttributeSCfg struct {
 	ResourceSConns      []string
 	StatSConns          []string
 	ApierSConns         []string
+	ThresholdSConns     []string
+	RouteSConns         []string
 	IndexedSelects      bool
 	StringIndexedFields *[]string
 	PrefixIndexedFields *[]string
@@ -105,6 +107,26 @@ func (alS *AttributeSCfg) loadFromJSONCfg(jsnCfg *AttributeSJsonCfg) (err error)
 			}
 		}
 	}
+	if jsnCfg.Thresholds_conns != nil {
+		alS.ThresholdSConns = make([]string, len(*jsnCfg.Thresholds_conns))
+		for idx, connID := range *jsnCfg.Thresholds_conns {
+			// if we have the connection internal we change the name so we can have internal rpc for each subsystem
+			alS.ThresholdSConns[idx] = connID
+			if connID == utils.MetaInternal {
+				alS.ThresholdSConns[idx] = utils.ConcatenatedKey(utils.MetaInternal, utils.MetaThresholds)
+			}
+		}
+	}
+	if jsnCfg.Routes_conns != nil {
+		alS.RouteSConns = make([]string, len(*jsnCfg.Routes_conns))
+		for idx, connID := range *jsnCfg.Routes_conns {
+			// if we have the connection internal we change the name so we can have internal rpc for each subsystem
+			alS.RouteSConns[idx] = connID
+			if connID == utils.MetaInternal {
+				alS.RouteSConns[idx] = utils.ConcatenatedKey(utils.MetaInternal, utils.MetaRoutes)
+			}
+		}
+	}
 	if jsnCfg.Indexed_selects != nil {
 		alS.IndexedSelects = *jsnCfg.Indexed_selects
 	}
@@ -211,6 +233,26 @@ func (alS *AttributeSCfg) AsMapInterface() (initialMP map[string]any) {
 		}
 		initialMP[utils.ApierSConnsCfg] = apierSConns
 	}
+	if alS.ThresholdSConns != nil {
+		thresholdSConns := make([]string, len(alS.ThresholdSConns))
+		for i, item := range alS.ThresholdSConns {
+			thresholdSConns[i] = item
+			if item == utils.ConcatenatedKey(utils.MetaInternal, utils.MetaThresholds) {
+				thresholdSConns[i] = utils.MetaInternal
+			}
+		}
+		initialMP[utils.ThresholdSConnsCfg] = thresholdSConns
+	}
+	if alS.RouteSConns != nil {
+		routeSConns := make([]string, len(alS.RouteSConns))
+		for i, item := range alS.RouteSConns {
+			routeSConns[i] = item
+			if item == utils.ConcatenatedKey(utils.MetaInternal, utils.MetaRoutes) {
+				routeSConns[i] = utils.MetaInternal
+			}
+		}
+		initialMP[utils.RouteSConnsCfg] = routeSConns
+	}
 	return
 }
 
@@ -255,6 +297,18 @@ func (alS AttributeSCfg) Clone() (cln *AttributeSCfg) {
 			cln.ApierSConns[i] = con
 		}
 	}
+	if alS.ThresholdSConns != nil {
+		cln.ThresholdSConns = make([]string, len(alS.ThresholdSConns))
+		for i, con := range alS.ThresholdSConns {
+			cln.ThresholdSConns[i] = con
+		}
+	}
+	if alS.RouteSConns != nil {
+		cln.RouteSConns = make([]string, len(alS.RouteSConns))
+		for i, con := range alS.RouteSConns {
+			cln.RouteSConns[i] = con
+		}
+	}
 
 	if alS.StringIndexedFields != nil {
 		idx := make([]string, len(*alS.StringIndexedFields))
@@ -32,6 +32,8 @@ func TestAttributeSCfgloadFromJsonCfg(t *testing.T) {
 		Resources_conns:       &[]string{"*internal", "*conn1"},
 		Stats_conns:           &[]string{"*internal", "*conn1"},
 		Apiers_conns:          &[]string{"*internal", "*conn1"},
+		Thresholds_conns:      &[]string{"*internal", "*conn1"},
+		Routes_conns:          &[]string{"*internal", "*conn1"},
 		String_indexed_fields: &[]string{"*req.index1"},
 		Prefix_indexed_fields: &[]string{"*req.index1", "*req.index2"},
 		Suffix_indexed_fields: &[]string{"*req.index1"},
@@ -47,6 +49,8 @@ func TestAttributeSCfgloadFromJsonCfg(t *testing.T) {
 		ApierSConns:         []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaApier), "*conn1"},
 		StatSConns:          []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaStats), "*conn1"},
 		ResourceSConns:      []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaResources), "*conn1"},
+		ThresholdSConns:     []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaThresholds), "*conn1"},
+		RouteSConns:         []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaRoutes), "*conn1"},
 		IndexedSelects:      false,
 		StringIndexedFields: &[]string{"*req.index1"},
 		PrefixIndexedFields: &[]string{"*req.index1", "*req.index2"},
@@ -80,6 +84,8 @@ func TestAttributeSCfgAsMapInterface(t *testing.T) {
 	"stats_conns": ["*internal"],			
 	"resources_conns": ["*internal"],		
 	"apiers_conns": ["*internal"],			
+	"thresholds_conns": ["*internal"],
+	"routes_conns": ["*internal"],
 	"prefix_indexed_fields": ["*req.index1","*req.index2"],		
     "string_indexed_fields": ["*req.index1"],
 	"opts": {
@@ -93,6 +99,8 @@ func TestAttributeSCfgAsMapInterface(t *testing.T) {
 		utils.StatSConnsCfg:          []string{utils.MetaInternal},
 		utils.ResourceSConnsCfg:      []string{utils.MetaInternal},
 		utils.ApierSConnsCfg:         []string{utils.MetaInternal},
+		utils.ThresholdSConnsCfg:     []string{utils.MetaInternal},
+		utils.RouteSConnsCfg:         []string{utils.MetaInternal},
 		utils.StringIndexedFieldsCfg: []string{"*req.index1"},
 		utils.PrefixIndexedFieldsCfg: []string{"*req.index1", "*req.index2"},
 		utils.IndexedSelectsCfg:      true,
@@ -130,6 +138,8 @@ func TestAttributeSCfgAsMapInterface2(t *testing.T) {
 		utils.StatSConnsCfg:          []string{},
 		utils.ResourceSConnsCfg:      []string{},
 		utils.ApierSConnsCfg:         []string{},
+		utils.ThresholdSConnsCfg:     []string{},
+		utils.RouteSConnsCfg:         []string{},
 		utils.IndexedSelectsCfg:      true,
 		utils.PrefixIndexedFieldsCfg: []string{},
 		utils.SuffixIndexedFieldsCfg: []string{"*req.index1", "*req.index2"},
@@ -160,6 +170,8 @@ func TestAttributeSCfgAsMapInterface3(t *testing.T) {
 		utils.StatSConnsCfg:          []string{},
 		utils.ResourceSConnsCfg:      []string{},
 		utils.ApierSConnsCfg:         []string{},
+		utils.ThresholdSConnsCfg:     []string{},
+		utils.RouteSConnsCfg:         []string{},
 		utils.IndexedSelectsCfg:      true,
 		utils.PrefixIndexedFieldsCfg: []string{},
 		utils.SuffixIndexedFieldsCfg: []string{},
@@ -36,6 +36,7 @@ type CdrsCfg struct {
 	OnlineCDRExports []string // list of CDRE templates to use for real-time CDR exports
 	SchedulerConns   []string
 	EEsConns         []string
+	Taxes            bool // apply the matching TaxProfiles on the rated CDRs
 }
 
 // loadFromJSONCfg loads Cdrs config from JsonCfg
@@ -131,6 +132,9 @@ func (cdrscfg *CdrsCfg) loadFromJSONCfg(jsnCdrsCfg *CdrsJsonCfg) (err error) {
 			}
 		}
 	}
+	if jsnCdrsCfg.Taxes != nil {
+		cdrscfg.Taxes = *jsnCdrsCfg.Taxes
+	}
 	return nil
 }
 
@@ -140,6 +144,7 @@ func (cdrscfg *CdrsCfg) AsMapInterface() (initialMP map[string]any) {
 		utils.EnabledCfg:       cdrscfg.Enabled,
 		utils.StoreCdrsCfg:     cdrscfg.StoreCdrs,
 		utils.SMCostRetriesCfg: cdrscfg.SMCostRetries,
+		utils.TaxesCfg:         cdrscfg.Taxes,
 	}
 
 	extraFields := make([]string, len(cdrscfg.ExtraFields))
@@ -234,6 +239,7 @@ func (cdrscfg CdrsCfg) Clone() (cln *CdrsCfg) {
 		ExtraFields:   cdrscfg.ExtraFields.Clone(),
 		StoreCdrs:     cdrscfg.StoreCdrs,
 		SMCostRetries: cdrscfg.SMCostRetries,
+		Taxes:         cdrscfg.Taxes,
 	}
 	if cdrscfg.ChargerSConns != nil {
 		cln.ChargerSConns = make([]string, len(cdrscfg.ChargerSConns))
@@ -101,6 +101,7 @@ func TestCdrsCfgAsMapInterface(t *testing.T) {
 		utils.OnlineCDRExportsCfg: []string{"http_localhost", "amqp_localhost", "http_test_file"},
 		utils.SchedulerConnsCfg:   []string{utils.MetaInternal, "*conn1"},
 		utils.EEsConnsCfg:         []string{utils.MetaInternal, "*conn1"},
+		utils.TaxesCfg:            false,
 	}
 	if cgrCfg, err := NewCGRConfigFromJSONStringWithDefaults(cfgJSONStr); err != nil {
 		t.Error(err)
@@ -131,6 +132,7 @@ func TestCdrsCfgAsMapInterface2(t *testing.T) {
 		utils.OnlineCDRExportsCfg: []string{},
 		utils.SchedulerConnsCfg:   []string{},
 		utils.EEsConnsCfg:         []string{"conn1"},
+		utils.TaxesCfg:            false,
 	}
 	if cgrCfg, err := NewCGRConfigFromJSONStringWithDefaults(cfgJSONStr); err != nil {
 		t.Error(err)
@@ -138,6 +138,8 @@ func newCGRConfig(config []byte) (cfg *CGRConfig, err error) {
 	cfg.ralsCfg = new(RalsCfg)
 	cfg.ralsCfg.MaxComputedUsage = make(map[string]time.Duration)
 	cfg.ralsCfg.BalanceRatingSubject = make(map[string]string)
+	cfg.ralsCfg.MaxTransfer = make(map[string]float64)
+	cfg.ralsCfg.TransferFee = make(map[string]string)
 	cfg.schedulerCfg = new(SchedulerCfg)
 	cfg.cdrsCfg = new(CdrsCfg)
 	cfg.analyzerSCfg = new(AnalyzerSCfg)
@@ -178,6 +180,8 @@ func newCGRConfig(config []byte) (cfg *CGRConfig, err error) {
 	cfg.apiBanCfg = new(APIBanCfg)
 	cfg.sentryPeerCfg = new(SentryPeerCfg)
 	cfg.coreSCfg = new(CoreSCfg)
+	cfg.invoiceSCfg = new(InvoiceSCfg)
+	cfg.taxSCfg = new(TaxSCfg)
 	cfg.dfltEvExp = &EventExporterCfg{Opts: &EventExporterOpts{
 		Els:   new(ElsOpts),
 		SQL:   new(SQLOpts),
@@ -194,6 +198,7 @@ func newCGRConfig(config []byte) (cfg *CGRConfig, err error) {
 		AMQP:  new(AMQPROpts),
 		Kafka: new(KafkaROpts),
 		NATS:  new(NATSROpts),
+		HTTP:  new(HTTPROpts),
 	}}
 
 	cfg.cacheDP = make(map[string]utils.MapStorage)
@@ -324,6 +329,8 @@ type CGRConfig struct {
 	apier            *ApierCfg         // APIer config
 	ersCfg           *ERsCfg           // EventReader config
 	eesCfg           *EEsCfg           // EventExporter config
+	invoiceSCfg      *InvoiceSCfg      // InvoiceS config
+	taxSCfg          *TaxSCfg          // TaxS config
 	sipAgentCfg      *SIPAgentCfg      // SIPAgent config
 	configSCfg       *ConfigSCfg       // ConfigS config
 	apiBanCfg        *APIBanCfg        // APIBan config
@@ -337,17 +344,27 @@ type CGRConfig struct {
 var posibleLoaderTypes = utils.NewStringSet([]string{utils.MetaAttributes,
 	utils.MetaResources, utils.MetaFilters, utils.MetaStats,
 	utils.MetaRoutes, utils.MetaThresholds, utils.MetaChargers,
-	utils.MetaDispatchers, utils.MetaDispatcherHosts})
+	utils.MetaDispatchers, utils.MetaDispatcherHosts, utils.MetaTimings,
+	utils.MetaDestinations, utils.MetaRates, utils.MetaDestinationRates,
+	utils.MetaRatingPlans, utils.MetaRatingProfiles, utils.MetaSharedGroups,
+	utils.MetaActions, utils.MetaActionPlans, utils.MetaActionTriggers,
+	utils.MetaAccountActions, utils.MetaExchangeRates, utils.MetaCalendars, utils.MetaTaxProfiles})
 
 var possibleReaderTypes = utils.NewStringSet([]string{utils.MetaFileCSV,
 	utils.MetaKafkajsonMap, utils.MetaFileXML, utils.MetaSQL, utils.MetaFileFWV,
 	utils.MetaFileJSON, utils.MetaNone, utils.MetaAMQPjsonMap, utils.MetaS3jsonMap,
-	utils.MetaSQSjsonMap, utils.MetaAMQPV1jsonMap, utils.MetaNatsjsonMap})
+	utils.MetaSQSjsonMap, utils.MetaAMQPV1jsonMap, utils.MetaNatsjsonMap,
+	utils.MetaHTTPjson, utils.MetaHTTPPost, utils.MetaFileParquet, utils.MetaFileAvro})
+
+var possibleParquetCompressions = utils.NewStringSet([]string{utils.ParquetUncompressed,
+	utils.ParquetSnappy, utils.ParquetGzip, utils.ParquetZstd})
+
+var possibleAvroCodecs = utils.NewStringSet([]string{utils.AvroNull, utils.AvroDeflate, utils.AvroSnappy})
 
 var possibleExporterTypes = utils.NewStringSet([]string{utils.MetaFileCSV, utils.MetaNone, utils.MetaFileFWV,
 	utils.MetaHTTPPost, utils.MetaHTTPjsonMap, utils.MetaAMQPjsonMap, utils.MetaAMQPV1jsonMap, utils.MetaSQSjsonMap,
 	utils.MetaKafkajsonMap, utils.MetaS3jsonMap, utils.MetaElastic, utils.MetaVirt, utils.MetaSQL, utils.MetaNatsjsonMap,
-	utils.MetaLog, utils.MetaRPC})
+	utils.MetaLog, utils.MetaRPC, utils.MetaFileParquet, utils.MetaFileAvro})
 
 // LazySanityCheck used after check config sanity to display warnings related to the config
 func (cfg *CGRConfig) LazySanityCheck() {
@@ -401,7 +418,8 @@ func (cfg *CGRConfig) loadFromJSONCfg(jsnCfg *CgrJsonCfg) (err error) {
 		cfg.loadLoaderCgrCfg, cfg.loadMigratorCgrCfg, cfg.loadTLSCgrCfg,
 		cfg.loadAnalyzerCgrCfg, cfg.loadApierCfg, cfg.loadErsCfg, cfg.loadEesCfg,
 		cfg.loadSIPAgentCfg, cfg.loadRegistrarCCfg,
-		cfg.loadConfigSCfg, cfg.loadAPIBanCgrCfg, cfg.loadSentryPeerCgrCfg, cfg.loadCoreSCfg} {
+		cfg.loadConfigSCfg, cfg.loadAPIBanCgrCfg, cfg.loadSentryPeerCgrCfg, cfg.loadCoreSCfg,
+		cfg.loadInvoiceSCfg, cfg.loadTaxSCfg} {
 		if err = loadFunc(jsnCfg); err != nil {
 			return
 		}
@@ -527,7 +545,7 @@ func (cfg *CGRConfig) loadFilterSCfg(jsnCfg *CgrJsonCfg) (err error) {
 	if jsnFilterSCfg, err = jsnCfg.FilterSJsonCfg(); err != nil {
 		return
 	}
-	return cfg.filterSCfg.loadFromJSONCfg(jsnFilterSCfg)
+	return cfg.filterSCfg.loadFromJSONCfg(jsnFilterSCfg, cfg.generalCfg.RSRSep)
 }
 
 // loadRalSCfg loads the RalS section of the configuration
@@ -797,6 +815,24 @@ func (cfg *CGRConfig) loadApierCfg(jsnCfg *CgrJsonCfg) (err error) {
 	return cfg.apier.loadFromJSONCfg(jsnApierCfg)
 }
 
+// loadInvoiceSCfg loads the InvoiceS section of the configuration
+func (cfg *CGRConfig) loadInvoiceSCfg(jsnCfg *CgrJsonCfg) (err error) {
+	var jsnInvoiceSCfg *InvoiceSJsonCfg
+	if jsnInvoiceSCfg, err = jsnCfg.InvoiceSCfgJson(); err != nil {
+		return
+	}
+	return cfg.invoiceSCfg.loadFromJSONCfg(jsnInvoiceSCfg)
+}
+
+// loadTaxSCfg loads the TaxS section of the configuration
+func (cfg *CGRConfig) loadTaxSCfg(jsnCfg *CgrJsonCfg) (err error) {
+	var jsnTaxSCfg *TaxSJsonCfg
+	if jsnTaxSCfg, err = jsnCfg.TaxSCfgJson(); err != nil {
+		return
+	}
+	return cfg.taxSCfg.loadFromJSONCfg(jsnTaxSCfg)
+}
+
 // loadCoreSCfg loads the CoreS section of the configuration
 func (cfg *CGRConfig) loadCoreSCfg(jsnCfg *CgrJsonCfg) (err error) {
 	var jsnCoreCfg *CoreSJsonCfg
@@ -1093,6 +1129,20 @@ func (cfg *CGRConfig) ApierCfg() *ApierCfg {
 	return cfg.apier
 }
 
+// InvoiceSCfg returns the config for InvoiceS
+func (cfg *CGRConfig) InvoiceSCfg() *InvoiceSCfg {
+	cfg.lks[InvoiceSJson].Lock()
+	defer cfg.lks[InvoiceSJson].Unlock()
+	return cfg.invoiceSCfg
+}
+
+// TaxSCfg returns the config for the tax profiles matching
+func (cfg *CGRConfig) TaxSCfg() *TaxSCfg {
+	cfg.lks[TaxSJson].Lock()
+	defer cfg.lks[TaxSJson].Unlock()
+	return cfg.taxSCfg
+}
+
 // ERsCfg reads the EventReader configuration
 func (cfg *CGRConfig) ERsCfg() *ERsCfg {
 	cfg.lks[ERsJson].RLock()
@@ -1271,6 +1321,8 @@ func (cfg *CGRConfig) getLoadFunctions() map[string]func(*CgrJsonCfg) error {
 		APIBanCfgJson:      cfg.loadAPIBanCgrCfg,
 		SentryPeerCfgJson:  cfg.loadSentryPeerCgrCfg,
 		CoreSCfgJson:       cfg.loadCoreSCfg,
+		InvoiceSJson:       cfg.loadInvoiceSCfg,
+		TaxSJson:           cfg.loadTaxSCfg,
 	}
 }
 
@@ -1431,7 +1483,7 @@ func (cfg *CGRConfig) reloadSections(sections ...string) {
 		ChargerSCfgJson, RESOURCES_JSON, STATS_JSON, THRESHOLDS_JSON,
 		RouteSJson, LoaderJson, DispatcherSJson, ApierS,
 	})
-	subsystemsThatNeedStorDB := utils.NewStringSet([]string{STORDB_JSN, RALS_JSN, CDRS_JSN, ApierS})
+	subsystemsThatNeedStorDB := utils.NewStringSet([]string{STORDB_JSN, RALS_JSN, CDRS_JSN, ApierS, InvoiceSJson})
 	needsDataDB := false
 	needsStorDB := false
 	for _, section := range sections {
@@ -1520,6 +1572,8 @@ func (cfg *CGRConfig) reloadSections(sections ...string) {
 			cfg.rldChans[SIPAgentJson] <- struct{}{}
 		case RegistrarCJson:
 			cfg.rldChans[RegistrarCJson] <- struct{}{}
+		case InvoiceSJson:
+			cfg.rldChans[InvoiceSJson] <- struct{}{}
 		}
 	}
 }
@@ -1537,7 +1591,7 @@ func (cfg *CGRConfig) AsMapInterface(separator string) (mp map[string]any) {
 		CACHE_JSN:          cfg.cacheCfg.AsMapInterface(),
 		LISTEN_JSN:         cfg.listenCfg.AsMapInterface(),
 		HTTP_JSN:           cfg.httpCfg.AsMapInterface(),
-		FilterSjsn:         cfg.filterSCfg.AsMapInterface(),
+		FilterSjsn:         cfg.filterSCfg.AsMapInterface(separator),
 		RALS_JSN:           cfg.ralsCfg.AsMapInterface(),
 		SCHEDULER_JSN:      cfg.schedulerCfg.AsMapInterface(),
 		CDRS_JSN:           cfg.cdrsCfg.AsMapInterface(),
@@ -1570,6 +1624,8 @@ func (cfg *CGRConfig) AsMapInterface(separator string) (mp map[string]any) {
 		TemplatesJson:      cfg.templates.AsMapInterface(separator),
 		ConfigSJson:        cfg.configSCfg.AsMapInterface(),
 		CoreSCfgJson:       cfg.coreSCfg.AsMapInterface(),
+		InvoiceSJson:       cfg.invoiceSCfg.AsMapInterface(),
+		TaxSJson:           cfg.taxSCfg.AsMapInterface(),
 	}
 }
 
@@ -1661,7 +1717,7 @@ func (cfg *CGRConfig) V1GetConfig(ctx *context.Context, args *SectionWithAPIOpts
 	case HTTP_JSN:
 		mp = cfg.HTTPCfg().AsMapInterface()
 	case FilterSjsn:
-		mp = cfg.FilterSCfg().AsMapInterface()
+		mp = cfg.FilterSCfg().AsMapInterface(cfg.GeneralCfg().RSRSep)
 	case RALS_JSN:
 		mp = cfg.RalsCfg().AsMapInterface()
 	case SCHEDULER_JSN:
@@ -1732,6 +1788,10 @@ func (cfg *CGRConfig) V1GetConfig(ctx *context.Context, args *SectionWithAPIOpts
 		mp = cfg.AnalyzerSCfg().AsMapInterface()
 	case CoreSCfgJson:
 		mp = cfg.CoreSCfg().AsMapInterface()
+	case InvoiceSJson:
+		mp = cfg.InvoiceSCfg().AsMapInterface()
+	case TaxSJson:
+		mp = cfg.TaxSCfg().AsMapInterface()
 	default:
 		return errors.New("Invalid section")
 	}
@@ -1827,7 +1887,7 @@ func (cfg *CGRConfig) V1GetConfigAsJSON(ctx *context.Context, args *SectionWithA
 	case HTTP_JSN:
 		mp = cfg.HTTPCfg().AsMapInterface()
 	case FilterSjsn:
-		mp = cfg.FilterSCfg().AsMapInterface()
+		mp = cfg.FilterSCfg().AsMapInterface(cfg.GeneralCfg().RSRSep)
 	case RALS_JSN:
 		mp = cfg.RalsCfg().AsMapInterface()
 	case SCHEDULER_JSN:
@@ -1898,6 +1958,10 @@ func (cfg *CGRConfig) V1GetConfigAsJSON(ctx *context.Context, args *SectionWithA
 		mp = cfg.AnalyzerSCfg().AsMapInterface()
 	case CoreSCfgJson:
 		mp = cfg.CoreSCfg().AsMapInterface()
+	case InvoiceSJson:
+		mp = cfg.InvoiceSCfg().AsMapInterface()
+	case TaxSJson:
+		mp = cfg.TaxSCfg().AsMapInterface()
 	default:
 		return errors.New("Invalid section")
 	}
@@ -1995,6 +2059,8 @@ func (cfg *CGRConfig) Clone() (cln *CGRConfig) {
 		apiBanCfg:        cfg.apiBanCfg.Clone(),
 		sentryPeerCfg:    cfg.sentryPeerCfg.Clone(),
 		coreSCfg:         cfg.coreSCfg.Clone(),
+		invoiceSCfg:      cfg.invoiceSCfg.Clone(),
+		taxSCfg:          cfg.taxSCfg.Clone(),
 
 		cacheDP: make(map[string]utils.MapStorage),
 	}
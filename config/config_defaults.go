@@ -61,6 +61,17 @@ const CGRATES_CFG_JSON = `
 	"caps": 0,							// maximum concurrent request allowed ( 0 to disabled )
 	"caps_strategy": "*busy",			// strategy in case in case of concurrent requests reached	
 	"caps_stats_interval": "0",			// the interval we sample for caps stats ( 0 to disabled )
+	"caps_limits": [					// limits applied on top of caps, counted per key of the request
+		// {
+		// 	"id": "",						// identifier of the limit
+		// 	"tenants": [],					// tenants the limit applies to, empty for all
+		// 	"api_methods": [],				// API methods the limit applies to, <APIerSv1.*> for all the methods of a service, empty for all
+		// 	"keys": [],						// the requests are counted separately for each <*tenant|*api_method|*connection>, empty to count them together
+		// 	"concurrent_requests": 0,		// maximum concurrent requests for each key ( 0 to disabled )
+		// 	"requests_per_second": 0,		// maximum requests per second for each key ( 0 to disabled )
+		// 	"burst": 0,						// maximum requests allowed at once by the requests_per_second ( 0 to use the requests_per_second )
+		// },
+	],
 	"shutdown_timeout": "1s"			// the duration to wait until all services are stopped
 },
 
@@ -110,6 +121,8 @@ const CGRATES_CFG_JSON = `
 		"*action_triggers": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false}, 
 		"*shared_groups": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false}, 
 		"*timings": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false}, 
+		"*exchange_rates": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false}, 
+		"*calendars": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false}, 
 		"*resource_profiles": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false}, 
 		"*resources": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false}, 
 		"*statqueue_profiles": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false}, 
@@ -120,16 +133,19 @@ const CGRATES_CFG_JSON = `
 		"*route_profiles": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false}, 
 		"*attribute_profiles": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false}, 
 		"*charger_profiles": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false}, 
+		"*tax_profiles": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false}, 
 		"*dispatcher_profiles": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false}, 
 		"*dispatcher_hosts": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false}, 
 		"*load_ids": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false}, 
 		"*versions": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false}, 
+		"*sessions_backup": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false}, 
 		"*resource_filter_indexes" : {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
 		"*stat_filter_indexes" : {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
 		"*threshold_filter_indexes" : {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
 		"*route_filter_indexes" : {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
 		"*attribute_filter_indexes" : {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
 		"*charger_filter_indexes" : {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
+		"*tax_filter_indexes" : {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
 		"*dispatcher_filter_indexes" : {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
 		"*reverse_filter_indexes" : {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
 	},
@@ -148,6 +164,11 @@ const CGRATES_CFG_JSON = `
 		"redisClientCertificate":"",			// path to client certificate
 		"redisClientKey":"",					// path to client key
 		"redisCACertificate":"",				// path to CA certificate (populate for self-signed certificate otherwise let it empty)
+		"redisReadPolicy": "*primary",			// where the profiles and filters are read from, the indexes always on primary <*primary|*replica|*replica_preferred>
+		"redisReadReplicas": [],				// addresses of the replicas used for reads, discovered automatically with sentinel or cluster
+		"internalDBDumpPath": "",				// folder where the *internal DB is dumped and restored from on start, empty to disable
+		"internalDBDumpInterval": "0s",			// interval between dumps, 0 to dump only on shutdown or on API request
+		"internalDBWriteLog": false,			// log the writes between dumps so they are not lost on crashes
 	}
 },
 
@@ -169,10 +190,15 @@ const CGRATES_CFG_JSON = `
 		"mongoQueryTimeout": "10s",			// timeout for query when mongo is used
 		"pgSSLMode": "disable",		 		// pgSSLMode in case of *postgres
 		"mysqlLocation": "Local",			// the location the time from mysql is retrieved
+		"internalDBDumpPath": "",			// folder where the *internal DB is dumped and restored from on start, empty to disable
+		"internalDBDumpInterval": "0s",		// interval between dumps, 0 to dump only on shutdown or on API request
+		"internalDBWriteLog": false,		// log the writes between dumps so they are not lost on crashes
 	},
 	"items":{
 		"*session_costs": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false}, 
 		"*cdrs": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false}, 		
+		"*balance_ledger": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
+		"*invoices": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
 		"*tp_timings": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false}, 					
 		"*tp_destinations": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
 		"*tp_rates": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false}, 
@@ -191,6 +217,7 @@ const CGRATES_CFG_JSON = `
 		"*tp_routes": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false}, 
 		"*tp_attributes": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false}, 
 		"*tp_chargers": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false}, 
+		"*tp_tax_profiles": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false}, 
 		"*versions": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false}, 
 		"*tp_dispatcher_profiles": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false}, 
 		"*tp_dispatcher_hosts": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false}, 
@@ -225,6 +252,7 @@ const CGRATES_CFG_JSON = `
 	"ws_url": "/ws",										// WebSockets relative URL ("" to disable)
 	"freeswitch_cdrs_url": "/freeswitch_json",				// Freeswitch CDRS relative URL ("" to disable)
 	"http_cdrs": "/cdr_http",								// CDRS relative URL ("" to disable)
+	"metrics_url": "",										// Prometheus metrics relative URL ("" to disable)
 	"use_basic_auth": false,								// use basic authentication
 	"auth_users": {},										// basic authentication usernames and base64-encoded passwords (eg: { "username1": "cGFzc3dvcmQ=", "username2": "cGFzc3dvcmQy "})
 	"client_opts":{
@@ -270,6 +298,8 @@ const CGRATES_CFG_JSON = `
 		"*action_triggers": {"limit": -1, "ttl": "", "static_ttl": false, "precache": false, "remote":false, "replicate": false},		// action triggers caching
 		"*shared_groups": {"limit": -1, "ttl": "", "static_ttl": false, "precache": false, "remote":false, "replicate": false},			// shared groups caching
 		"*timings": {"limit": -1, "ttl": "", "static_ttl": false, "precache": false, "remote":false, "replicate": false},				// timings caching
+		"*exchange_rates": {"limit": -1, "ttl": "", "static_ttl": false, "precache": false, "remote":false, "replicate": false},		// exchange rates caching
+		"*calendars": {"limit": -1, "ttl": "", "static_ttl": false, "precache": false, "remote":false, "replicate": false},		// calendars caching
 		"*resource_profiles": {"limit": -1, "ttl": "", "static_ttl": false, "precache": false, "remote":false, "replicate": false},		// control resource profiles caching
 		"*resources": {"limit": -1, "ttl": "", "static_ttl": false, "precache": false, "remote":false, "replicate": false},				// control resources caching
 		"*event_resources": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate": false},							// matching resources to events
@@ -281,6 +311,7 @@ const CGRATES_CFG_JSON = `
 		"*route_profiles": {"limit": -1, "ttl": "", "static_ttl": false, "precache": false, "remote":false, "replicate": false},		// control route profile caching
 		"*attribute_profiles": {"limit": -1, "ttl": "", "static_ttl": false, "precache": false, "remote":false, "replicate": false},	// control attribute profile caching
 		"*charger_profiles": {"limit": -1, "ttl": "", "static_ttl": false, "precache": false, "remote":false, "replicate": false},		// control charger profile caching
+		"*tax_profiles": {"limit": -1, "ttl": "", "static_ttl": false, "precache": false, "remote":false, "replicate": false},			// control tax profile caching
 		"*dispatcher_profiles": {"limit": -1, "ttl": "", "static_ttl": false, "precache": false, "remote":false, "replicate": false},	// control dispatcher profile caching
 		"*dispatcher_hosts": {"limit": -1, "ttl": "", "static_ttl": false, "precache": false, "remote":false, "replicate": false},		// control dispatcher hosts caching
 		"*resource_filter_indexes" : {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate": false}, 				// control resource filter indexes caching
@@ -289,12 +320,14 @@ const CGRATES_CFG_JSON = `
 		"*route_filter_indexes" : {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate": false}, 					// control route filter indexes caching
 		"*attribute_filter_indexes" : {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate": false}, 				// control attribute filter indexes caching
 		"*charger_filter_indexes" : {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate": false}, 					// control charger filter indexes caching
+		"*tax_filter_indexes" : {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate": false}, 						// control tax filter indexes caching
 		"*dispatcher_filter_indexes" : {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate": false}, 				// control dispatcher filter indexes caching
 		"*reverse_filter_indexes" : {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate": false}, 					// control reverse filter indexes caching used only for set and remove filters 
 		"*dispatcher_routes": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate": false}, 						// control dispatcher routes caching
 		"*dispatcher_loads": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate": false},							// control dispatcher load( in case of *ratio ConnParams is present)
 		"*dispatchers": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate": false}, 								// control dispatcher interface
 		"*diameter_messages": {"limit": -1, "ttl": "3h", "static_ttl": false, "remote":false, "replicate": false},						// diameter messages caching
+		"*radius_packets": {"limit": -1, "ttl": "3h", "static_ttl": false, "remote":false, "replicate": false},						// radius packets caching
 		"*rpc_responses": {"limit": 0, "ttl": "2s", "static_ttl": false, "remote":false, "replicate": false},							// RPC responses caching
 		"*closed_sessions": {"limit": -1, "ttl": "10s", "static_ttl": false, "remote":false, "replicate": false},						// closed sessions cached for CDRs
 		"*event_charges": {"limit": 0, "ttl": "10s", "static_ttl": false, "remote":false, "replicate": false},							// events proccessed by ChargerS
@@ -305,6 +338,7 @@ const CGRATES_CFG_JSON = `
 		"*stir": {"limit": -1, "ttl": "3h", "static_ttl": false, "remote":false, "replicate": false},									// stirShaken cache keys
 		"*apiban":{"limit": -1, "ttl": "2m", "static_ttl": false, "remote":false, "replicate": false}, 
 		"*sentrypeer":{"limit": -1, "ttl": "86400s", "static_ttl": true, "remote":false, "replicate": false},
+		"*http_lookups": {"limit": -1, "ttl": "1m", "static_ttl": false, "remote":false, "replicate": false},				// responses of the <~*http> dynamic lookups
 		"*caps_events": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate": false},								// caps cached samples
 		"*replication_hosts": {"limit": 0, "ttl": "", "static_ttl": false, "remote":false, "replicate": false},							// the replication hosts cache(used when replication_filtered is enbled)
 	},
@@ -317,6 +351,16 @@ const CGRATES_CFG_JSON = `
 	"stats_conns": [],						// connections to StatS for <*stats> filters, empty to disable stats functionality: <""|*internal|$rpc_conns_id>
 	"resources_conns": [],					// connections to ResourceS for <*resources> filters, empty to disable stats functionality: <""|*internal|$rpc_conns_id>
 	"apiers_conns": [],						// connections to RALs for <*accounts> filters, empty to disable stats functionality: <""|*internal|$rpc_conns_id>
+	"thresholds_conns": [],					// connections to ThresholdS for <*thresholds> filters, empty to disable thresholds functionality: <""|*internal|$rpc_conns_id>
+	"routes_conns": [],						// connections to RouteS for <*routes> filters, empty to disable routes functionality: <""|*internal|$rpc_conns_id>
+	"http_profiles": {						// profiles queried by the <~*http.$profile_id.$path> dynamic fields
+		// "MNP": {
+		//	"url": "http://127.0.0.1:8080/mnp?number=;~*req.Destination",	// URL template, built out of the event fields
+		//	"timeout": "2s",										// request timeout, 0 to use the general reply_timeout
+		//	"headers": {"Authorization": "Bearer token"},			// extra request headers
+		// },
+	},
+	"geoip_db_path": "",					// path towards the MaxMind format database used by <*geoip> filters and <~*geoip> dynamic fields, empty to disable
 },
 
 
@@ -338,6 +382,10 @@ const CGRATES_CFG_JSON = `
 		"*any": "*zero1ns",
 		"*voice": "*zero1s"
 	},
+	"balance_ledger": false,				// record the balance changes into the StorDB ledger
+	"default_currency": "",					// currency of the rating and of the *monetary balances without one, enables the exchange rates conversion
+	"max_transfer": {},						// maximum value moved by one balance transfer, per balance type, ie: {"*monetary": 100}
+	"transfer_fee": {},						// fee debited from the source balance of the transfers, per balance type, flat or percentage, ie: {"*monetary": "2%"}
 
 },
 
@@ -355,6 +403,7 @@ const CGRATES_CFG_JSON = `
 	"online_cdr_exports":[],				// list of CDRE profiles to use for real-time CDR exports
 	"scheduler_conns": [],					// connections to SchedulerS in case of *dynaprepaid request
 	"ees_conns": [],						// connections to EventExporter
+	"taxes": false,							// apply the matching TaxProfiles on the cost of the rated CDRs
 },
 
 
@@ -465,6 +514,12 @@ const CGRATES_CFG_JSON = `
 				// "natsClientCertificateProcessed": "",		// the path to a client certificate( used by tls)
 				// "natsClientKeyProcessed": "",				// the path to a client key( used by tls)
 				// "natsJetStreamMaxWaitProcessed": "5s",		// the maximum amount of time to wait for a response
+
+				// http
+				// "httpSecret": "",							// shared secret expected in the httpSecretHeader of the requests
+				// "httpSecretHeader": "X-Webhook-Secret",		// the header carrying the shared secret
+				// "httpUsername": "",							// username for basic authentication of the requests
+				// "httpPassword": "",							// password for basic authentication of the requests
 			},
 			"tenant": "",										// tenant used by import
 			"timezone": "",										// timezone for timestamps where not specified <""|UTC|Local|$IANA_TZ_DB>
@@ -495,7 +550,10 @@ const CGRATES_CFG_JSON = `
 	"attributes_conns":[],					// RPC Connections IDs
 	"cache": {
 		"*file_csv": {"limit": -1, "ttl": "5s", "static_ttl": false},
+		"*file_parquet": {"limit": -1, "ttl": "5s", "static_ttl": false},
+		"*file_avro": {"limit": -1, "ttl": "5s", "static_ttl": false},
 	},
+	"dead_letter_dir": "",					// store the events failing the export here, replacing the failed_posts_dir, empty to disable
 	"exporters": [
 		{
 			"id": "*default",									// identifier of the EventReader profile
@@ -507,6 +565,11 @@ const CGRATES_CFG_JSON = `
 				// CSV
 				// "csvFieldSeparator": ",",					// separator used when reading the fields
 
+				// Parquet and Avro
+				// "fileColumnTypes": {},						// types of the columns <*string|*int64|*float64|*bool|*timestamp|*duration|*decimal[:scale]>, *string if missing
+				// "parquetCompression": "snappy",				// compression of the parquet columns <uncompressed|snappy|gzip|zstd>
+				// "avroCodec": "snappy",						// compression of the avro blocks <null|deflate|snappy>
+
 				
  				// Elasticsearch options
 				// "elsCloud":true,                             //ExportPath will be  an CLoud ID deployment
@@ -605,6 +668,10 @@ const CGRATES_CFG_JSON = `
 			"attribute_context": "",							// context used to discover matching Attribute profiles
 			"synchronous": false,								// block processing until export has a result
 			"attempts": 1,										// export attempts
+			"retry_backoff": "1s",								// delay unit of the fibonacci backoff between the attempts
+			"retry_max_backoff": "0s",							// maximum delay between the attempts, 0 for unlimited
+			"retry_jitter": 0,									// randomize the delay between the attempts with up to this fraction of it <0-1>
+			"retry_max_age": "0s",								// stop retrying after this long since the first attempt, 0 for unlimited
 			"fields":[],										// import fields template, tag will match internally CDR field, in case of .csv value will be represented by index of the field value
 			"failed_posts_dir": "/var/spool/cgrates/failed_posts",	// directory path where we store failed requests
 		},
@@ -643,6 +710,7 @@ const CGRATES_CFG_JSON = `
 	"channel_sync_interval": "0",			// sync channels to detect stale sessions (0 to disable)
 	"stale_chan_max_extra_usage": "0",		// add random usage belllow max for stale channels
 	"terminate_attempts": 5,				// attempts to get the session before terminating it
+	"backup_interval": "0",					// backup the active sessions into DataDB, also on shutdown, and restore them on start, requires node_id (0 to disable)
 	"alterable_fields": [],					// the session fields that can be updated
 	//"min_dur_low_balance": "5s",			// threshold which will trigger low balance warnings for prepaid calls (needs to be lower than debit_interval)
 	"stir": {
@@ -727,6 +795,18 @@ const CGRATES_CFG_JSON = `
 		],
 			
 	},
+	"client_da_addresses": {									// per client Dynamic Authorization (RFC 5176) endpoints <$client_ip>
+		// "127.0.0.1": {
+		// 	"transport": "udp",									// network used to reach the NAS <udp>
+		// 	"host": "",											// NAS address, defaults to the client IP
+		// 	"port": 3799,										// NAS Dynamic Authorization port
+		// 	"flags": []											// additional flags <*log>
+		// },
+	},
+	"da_timeout": "1s",											// timeout of the Dynamic Authorization requests towards the NAS
+	"requests_cache_key": "",									// cache the requests under this key so they can be used to build DA requests, ie: <~*req.Acct-Session-Id>
+	"dmr_template": "",											// template used to build the Disconnect-Request on DisconnectSession, ie: <*dmr>
+	"coa_template": "",											// template used to build the CoA-Request on ReAuthorize, ie: <*coa>
 	"sessions_conns": ["*internal"],
 	"request_processors": [										// request processors to be applied to Radius messages
 	],
@@ -757,6 +837,8 @@ const CGRATES_CFG_JSON = `
 	"stats_conns": [],						// connections to StatS, empty to disable: <""|*internal|$rpc_conns_id>
 	"resources_conns": [],					// connections to ResourceS, empty to disable: <""|*internal|$rpc_conns_id>
 	"apiers_conns": [],						// connections to ApierS, empty to disable: <""|*internal|$rpc_conns_id>
+	"thresholds_conns": [],					// connections to ThresholdS, empty to disable: <""|*internal|$rpc_conns_id>
+	"routes_conns": [],						// connections to RouteS, empty to disable: <""|*internal|$rpc_conns_id>
 	"indexed_selects": true,				// enable profile matching exclusively on indexes
 	//"string_indexed_fields": [],			// query indexes based on these fields for faster processing
 	"prefix_indexed_fields": [],			// query indexes based on these fields for faster processing
@@ -867,6 +949,20 @@ const CGRATES_CFG_JSON = `
 		"field_separator": ",",								// separator used in case of csv files
 		"tp_in_dir": "/var/spool/cgrates/loader/in",		// absolute path towards the directory where the TPs are stored
 		"tp_out_dir": "/var/spool/cgrates/loader/out",		// absolute path towards the directory where processed TPs will be moved
+		"remote_sources": [									// remote locations the files are pulled from into the tp_in_dir on each run
+			// {
+			// 	"type": "*s3",								// remote source type <*s3|*http>
+			// 	"url": "",									// *s3: endpoint of the object storage; *http: URL the file names are appended to
+			// 	"processed_action": "*none",				// action on the remote files after a successful load <*none|*delete|*archive>
+			// 	"s3_bucket": "",							// bucket holding the files
+			// 	"s3_prefix": "",							// prefix of the file keys within the bucket
+			// 	"s3_archive_prefix": "",					// prefix the files are moved under on *archive
+			// 	"aws_region": "",
+			// 	"aws_key": "",
+			// 	"aws_secret": "",
+			// 	"aws_token": "",
+			// },
+		],
 		"data":[											// data profiles to load
 			{
 				"type": "*attributes",						// data source type
@@ -1159,6 +1255,27 @@ const CGRATES_CFG_JSON = `
 },
 
 
+"invoices": {
+	"enabled": false,						// starts the InvoiceS service: <true|false>
+	"ees_conns": [],						// connections to EEs for the invoices export, empty to disable the export: <""|*internal|$rpc_conns_id>
+	"exporter_ids": [],						// exporters used for the invoices built on the periodic runs, empty for all the exporters
+	"tenants": [],							// tenants billed on the periodic runs, empty for the default_tenant
+	"run_interval": "0s",					// billing period of the periodic runs, each run billing the last period: <""|0s for on demand only|$dur>
+	"group_by": "*destination",				// groups the usage line items: <*destination|*category>
+	"tax_percent": 0,						// tax percentage applied on the discounted subtotal
+	"discount_percent": 0,					// discount percentage applied on the subtotal
+},
+
+
+"taxes": {								// tax profiles matching when cdrs applies the taxes
+	"indexed_selects": true,				// enable profile matching exclusively on indexes
+	//"string_indexed_fields": [],			// query indexes based on these fields for faster processing
+	"prefix_indexed_fields": [],			// query indexes based on these fields for faster processing
+	"suffix_indexed_fields": [],			// query indexes based on these fields for faster processing
+	"nested_fields": false,					// determines which field is checked when matching indexed filters(true: all; false: only the one on the first level)
+},
+
+
 "sip_agent": {							// SIP Agents, only used for redirections
 	"enabled": false,					// enables the SIP agent: <true|false>
 	"listen": "127.0.0.1:5060",			// address where to listen for SIP requests <x.y.z.y:1234>
@@ -1226,6 +1343,24 @@ const CGRATES_CFG_JSON = `
 		{"tag": "ReAuthRequestType", "path": "*diamreq.Re-Auth-Request-Type", "type": "*constant",
 			"value": "0"},
 	],
+	"*dmr": [
+		{"tag": "User-Name", "path": "*radDAReq.User-Name", "type": "*variable",
+			"value": "~*req.User-Name"},
+		{"tag": "NAS-IP-Address", "path": "*radDAReq.NAS-IP-Address", "type": "*variable",
+			"value": "~*req.NAS-IP-Address"},
+		{"tag": "Acct-Session-Id", "path": "*radDAReq.Acct-Session-Id", "type": "*variable",
+			"value": "~*req.Acct-Session-Id"},
+		{"tag": "ReplyMessage", "path": "*radDAReq.Reply-Message", "type": "*variable",
+			"value": "~*vars.DisconnectCause"},
+	],
+	"*coa": [
+		{"tag": "User-Name", "path": "*radDAReq.User-Name", "type": "*variable",
+			"value": "~*req.User-Name"},
+		{"tag": "NAS-IP-Address", "path": "*radDAReq.NAS-IP-Address", "type": "*variable",
+			"value": "~*req.NAS-IP-Address"},
+		{"tag": "Acct-Session-Id", "path": "*radDAReq.Acct-Session-Id", "type": "*variable",
+			"value": "~*req.Acct-Session-Id"},
+	],
 	"*errSip": [
 			{"tag": "Request", "path": "*rep.Request", "type": "*constant",
 				"value": "SIP/2.0 500 Internal Server Error", "mandatory": true},
@@ -66,6 +66,8 @@ const (
 	APIBanCfgJson      = "apiban"
 	SentryPeerCfgJson  = "sentrypeer"
 	CoreSCfgJson       = "cores"
+	InvoiceSJson       = "invoices"
+	TaxSJson           = "taxes"
 )
 
 var (
@@ -73,7 +75,8 @@ var (
 		CACHE_JSN, FilterSjsn, RALS_JSN, CDRS_JSN, ERsJson, SessionSJson, AsteriskAgentJSN, FreeSWITCHAgentJSN,
 		KamailioAgentJSN, DA_JSN, RA_JSN, HttpAgentJson, DNSAgentJson, ATTRIBUTE_JSN, ChargerSCfgJson, RESOURCES_JSON, STATS_JSON,
 		THRESHOLDS_JSON, RouteSJson, LoaderJson, MAILER_JSN, SURETAX_JSON, CgrLoaderCfgJson, CgrMigratorCfgJson, DispatcherSJson,
-		AnalyzerCfgJson, ApierS, EEsJson, SIPAgentJson, RegistrarCJson, TemplatesJson, ConfigSJson, APIBanCfgJson, SentryPeerCfgJson, CoreSCfgJson}
+		AnalyzerCfgJson, ApierS, EEsJson, SIPAgentJson, RegistrarCJson, TemplatesJson, ConfigSJson, APIBanCfgJson, SentryPeerCfgJson, CoreSCfgJson,
+		InvoiceSJson, TaxSJson}
 )
 
 // Loads the json config out of io.Reader, eg other sources than file, maybe over http
@@ -512,6 +515,30 @@ func (jsnCfg CgrJsonCfg) ApierCfgJson() (*ApierJsonCfg, error) {
 	return cfg, nil
 }
 
+func (jsnCfg CgrJsonCfg) InvoiceSCfgJson() (*InvoiceSJsonCfg, error) {
+	rawCfg, hasKey := jsnCfg[InvoiceSJson]
+	if !hasKey {
+		return nil, nil
+	}
+	cfg := new(InvoiceSJsonCfg)
+	if err := json.Unmarshal(*rawCfg, cfg); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func (jsnCfg CgrJsonCfg) TaxSCfgJson() (*TaxSJsonCfg, error) {
+	rawCfg, hasKey := jsnCfg[TaxSJson]
+	if !hasKey {
+		return nil, nil
+	}
+	cfg := new(TaxSJsonCfg)
+	if err := json.Unmarshal(*rawCfg, cfg); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
 func (jsnCfg CgrJsonCfg) SIPAgentJsonCfg() (*SIPAgentJsonCfg, error) {
 	rawCfg, hasKey := jsnCfg[SIPAgentJson]
 	if !hasKey {
@@ -70,6 +70,7 @@ func TestDfCoreSJsonCfg(t *testing.T) {
 		Caps:                utils.IntPointer(0),
 		Caps_strategy:       utils.StringPointer(utils.MetaBusy),
 		Caps_stats_interval: utils.StringPointer("0"),
+		Caps_limits:         &[]*CapsLimitJsonCfg{},
 		Shutdown_timeout:    utils.StringPointer("1s"),
 	}
 	dfCgrJSONCfg, err := NewCgrJsonCfgFromBytes([]byte(CGRATES_CFG_JSON))
@@ -116,6 +117,12 @@ func TestCacheJsonCfg(t *testing.T) {
 			utils.CacheTimings: {Limit: utils.IntPointer(-1),
 				Ttl: utils.StringPointer(""), Static_ttl: utils.BoolPointer(false),
 				Precache: utils.BoolPointer(false), Remote: utils.BoolPointer(false), Replicate: utils.BoolPointer(false)},
+			utils.CacheExchangeRates: {Limit: utils.IntPointer(-1),
+				Ttl: utils.StringPointer(""), Static_ttl: utils.BoolPointer(false),
+				Precache: utils.BoolPointer(false), Remote: utils.BoolPointer(false), Replicate: utils.BoolPointer(false)},
+			utils.CacheCalendars: {Limit: utils.IntPointer(-1),
+				Ttl: utils.StringPointer(""), Static_ttl: utils.BoolPointer(false),
+				Precache: utils.BoolPointer(false), Remote: utils.BoolPointer(false), Replicate: utils.BoolPointer(false)},
 			utils.CacheResourceProfiles: {Limit: utils.IntPointer(-1),
 				Ttl: utils.StringPointer(""), Static_ttl: utils.BoolPointer(false),
 				Precache: utils.BoolPointer(false), Remote: utils.BoolPointer(false), Replicate: utils.BoolPointer(false)},
@@ -149,6 +156,9 @@ func TestCacheJsonCfg(t *testing.T) {
 			utils.CacheChargerProfiles: {Limit: utils.IntPointer(-1),
 				Ttl: utils.StringPointer(""), Static_ttl: utils.BoolPointer(false),
 				Precache: utils.BoolPointer(false), Remote: utils.BoolPointer(false), Replicate: utils.BoolPointer(false)},
+			utils.CacheTaxProfiles: {Limit: utils.IntPointer(-1),
+				Ttl: utils.StringPointer(""), Static_ttl: utils.BoolPointer(false),
+				Precache: utils.BoolPointer(false), Remote: utils.BoolPointer(false), Replicate: utils.BoolPointer(false)},
 			utils.CacheDispatcherProfiles: {Limit: utils.IntPointer(-1),
 				Ttl: utils.StringPointer(""), Static_ttl: utils.BoolPointer(false),
 				Precache: utils.BoolPointer(false), Remote: utils.BoolPointer(false), Replicate: utils.BoolPointer(false)},
@@ -173,6 +183,9 @@ func TestCacheJsonCfg(t *testing.T) {
 			utils.CacheChargerFilterIndexes: {Limit: utils.IntPointer(-1),
 				Ttl: utils.StringPointer(""), Static_ttl: utils.BoolPointer(false),
 				Remote: utils.BoolPointer(false), Replicate: utils.BoolPointer(false)},
+			utils.CacheTaxFilterIndexes: {Limit: utils.IntPointer(-1),
+				Ttl: utils.StringPointer(""), Static_ttl: utils.BoolPointer(false),
+				Remote: utils.BoolPointer(false), Replicate: utils.BoolPointer(false)},
 			utils.CacheDispatcherFilterIndexes: {Limit: utils.IntPointer(-1),
 				Ttl: utils.StringPointer(""), Static_ttl: utils.BoolPointer(false),
 				Remote: utils.BoolPointer(false), Replicate: utils.BoolPointer(false)},
@@ -191,6 +204,9 @@ func TestCacheJsonCfg(t *testing.T) {
 			utils.CacheDiameterMessages: {Limit: utils.IntPointer(-1),
 				Ttl: utils.StringPointer("3h"), Static_ttl: utils.BoolPointer(false),
 				Remote: utils.BoolPointer(false), Replicate: utils.BoolPointer(false)},
+			utils.CacheRadiusPackets: {Limit: utils.IntPointer(-1),
+				Ttl: utils.StringPointer("3h"), Static_ttl: utils.BoolPointer(false),
+				Remote: utils.BoolPointer(false), Replicate: utils.BoolPointer(false)},
 			utils.CacheRPCResponses: {Limit: utils.IntPointer(0),
 				Ttl: utils.StringPointer("2s"), Static_ttl: utils.BoolPointer(false),
 				Remote: utils.BoolPointer(false), Replicate: utils.BoolPointer(false)},
@@ -226,6 +242,9 @@ func TestCacheJsonCfg(t *testing.T) {
 			utils.MetaSentryPeer: {Limit: utils.IntPointer(-1),
 				Ttl: utils.StringPointer("86400s"), Static_ttl: utils.BoolPointer(true),
 				Remote: utils.BoolPointer(false), Replicate: utils.BoolPointer(false)},
+			utils.CacheHTTPLookups: {Limit: utils.IntPointer(-1),
+				Ttl: utils.StringPointer("1m"), Static_ttl: utils.BoolPointer(false),
+				Remote: utils.BoolPointer(false), Replicate: utils.BoolPointer(false)},
 			utils.CacheReplicationHosts: {Limit: utils.IntPointer(0),
 				Ttl: utils.StringPointer(""), Static_ttl: utils.BoolPointer(false),
 				Remote: utils.BoolPointer(false), Replicate: utils.BoolPointer(false)},
@@ -293,6 +312,11 @@ func TestDfDataDbJsonCfg(t *testing.T) {
 			RedisClientCertificate:  utils.StringPointer(utils.EmptyString),
 			RedisClientKey:          utils.StringPointer(utils.EmptyString),
 			RedisCACertificate:      utils.StringPointer(utils.EmptyString),
+			RedisReadPolicy:         utils.StringPointer(utils.MetaPrimary),
+			RedisReadReplicas:       &[]string{},
+			InternalDBDumpPath:      utils.StringPointer(utils.EmptyString),
+			InternalDBDumpInterval:  utils.StringPointer("0s"),
+			InternalDBWriteLog:      utils.BoolPointer(false),
 		},
 		Items: &map[string]*ItemOptJson{
 			utils.MetaAccounts: {
@@ -372,6 +396,20 @@ func TestDfDataDbJsonCfg(t *testing.T) {
 				Ttl:        utils.StringPointer(utils.EmptyString),
 				Static_ttl: utils.BoolPointer(false),
 			},
+			utils.MetaExchangeRates: {
+				Replicate:  utils.BoolPointer(false),
+				Remote:     utils.BoolPointer(false),
+				Limit:      utils.IntPointer(-1),
+				Ttl:        utils.StringPointer(utils.EmptyString),
+				Static_ttl: utils.BoolPointer(false),
+			},
+			utils.MetaCalendars: {
+				Replicate:  utils.BoolPointer(false),
+				Remote:     utils.BoolPointer(false),
+				Limit:      utils.IntPointer(-1),
+				Ttl:        utils.StringPointer(utils.EmptyString),
+				Static_ttl: utils.BoolPointer(false),
+			},
 			utils.MetaResourceProfile: {
 				Replicate:  utils.BoolPointer(false),
 				Remote:     utils.BoolPointer(false),
@@ -449,6 +487,13 @@ func TestDfDataDbJsonCfg(t *testing.T) {
 				Ttl:        utils.StringPointer(utils.EmptyString),
 				Static_ttl: utils.BoolPointer(false),
 			},
+			utils.MetaTaxProfiles: {
+				Replicate:  utils.BoolPointer(false),
+				Remote:     utils.BoolPointer(false),
+				Limit:      utils.IntPointer(-1),
+				Ttl:        utils.StringPointer(utils.EmptyString),
+				Static_ttl: utils.BoolPointer(false),
+			},
 			utils.MetaDispatcherProfiles: {
 				Replicate:  utils.BoolPointer(false),
 				Remote:     utils.BoolPointer(false),
@@ -470,6 +515,13 @@ func TestDfDataDbJsonCfg(t *testing.T) {
 				Ttl:        utils.StringPointer(utils.EmptyString),
 				Static_ttl: utils.BoolPointer(false),
 			},
+			utils.CacheSessionsBackup: {
+				Replicate:  utils.BoolPointer(false),
+				Remote:     utils.BoolPointer(false),
+				Limit:      utils.IntPointer(-1),
+				Ttl:        utils.StringPointer(utils.EmptyString),
+				Static_ttl: utils.BoolPointer(false),
+			},
 
 			utils.CacheResourceFilterIndexes: {
 				Replicate:  utils.BoolPointer(false),
@@ -513,6 +565,13 @@ func TestDfDataDbJsonCfg(t *testing.T) {
 				Ttl:        utils.StringPointer(utils.EmptyString),
 				Static_ttl: utils.BoolPointer(false),
 			},
+			utils.CacheTaxFilterIndexes: {
+				Replicate:  utils.BoolPointer(false),
+				Remote:     utils.BoolPointer(false),
+				Limit:      utils.IntPointer(-1),
+				Ttl:        utils.StringPointer(utils.EmptyString),
+				Static_ttl: utils.BoolPointer(false),
+			},
 			utils.CacheDispatcherFilterIndexes: {
 				Replicate:  utils.BoolPointer(false),
 				Remote:     utils.BoolPointer(false),
@@ -551,13 +610,16 @@ func TestDfStorDBJsonCfg(t *testing.T) {
 		String_indexed_fields: &[]string{},
 		Prefix_indexed_fields: &[]string{},
 		Opts: &DBOptsJson{
-			SQLMaxOpenConns:    utils.IntPointer(100),
-			SQLMaxIdleConns:    utils.IntPointer(10),
-			MongoQueryTimeout:  utils.StringPointer("10s"),
-			SQLConnMaxLifetime: utils.StringPointer("0"),
-			MySQLDSNParams:     make(map[string]string),
-			PgSSLMode:          utils.StringPointer(utils.PostgresSSLModeDisable),
-			MySQLLocation:      utils.StringPointer("Local"),
+			SQLMaxOpenConns:        utils.IntPointer(100),
+			SQLMaxIdleConns:        utils.IntPointer(10),
+			MongoQueryTimeout:      utils.StringPointer("10s"),
+			SQLConnMaxLifetime:     utils.StringPointer("0"),
+			MySQLDSNParams:         make(map[string]string),
+			PgSSLMode:              utils.StringPointer(utils.PostgresSSLModeDisable),
+			MySQLLocation:          utils.StringPointer("Local"),
+			InternalDBDumpPath:     utils.StringPointer(utils.EmptyString),
+			InternalDBDumpInterval: utils.StringPointer("0s"),
+			InternalDBWriteLog:     utils.BoolPointer(false),
 		},
 		Items: &map[string]*ItemOptJson{
 			utils.CacheTBLTPTimings: {
@@ -693,6 +755,13 @@ func TestDfStorDBJsonCfg(t *testing.T) {
 				Ttl:        utils.StringPointer(utils.EmptyString),
 				Static_ttl: utils.BoolPointer(false),
 			},
+			utils.CacheTBLTPTaxProfiles: {
+				Replicate:  utils.BoolPointer(false),
+				Remote:     utils.BoolPointer(false),
+				Limit:      utils.IntPointer(-1),
+				Ttl:        utils.StringPointer(utils.EmptyString),
+				Static_ttl: utils.BoolPointer(false),
+			},
 			utils.CacheTBLTPDispatchers: {
 				Replicate:  utils.BoolPointer(false),
 				Remote:     utils.BoolPointer(false),
@@ -714,6 +783,20 @@ func TestDfStorDBJsonCfg(t *testing.T) {
 				Ttl:        utils.StringPointer(utils.EmptyString),
 				Static_ttl: utils.BoolPointer(false),
 			},
+			utils.CacheBalanceLedgerTBL: {
+				Replicate:  utils.BoolPointer(false),
+				Remote:     utils.BoolPointer(false),
+				Limit:      utils.IntPointer(-1),
+				Ttl:        utils.StringPointer(utils.EmptyString),
+				Static_ttl: utils.BoolPointer(false),
+			},
+			utils.CacheInvoicesTBL: {
+				Replicate:  utils.BoolPointer(false),
+				Remote:     utils.BoolPointer(false),
+				Limit:      utils.IntPointer(-1),
+				Ttl:        utils.StringPointer(utils.EmptyString),
+				Static_ttl: utils.BoolPointer(false),
+			},
 			utils.CacheVersions: {
 				Replicate:  utils.BoolPointer(false),
 				Remote:     utils.BoolPointer(false),
@@ -753,6 +836,10 @@ func TestDfRalsJsonCfg(t *testing.T) {
 			utils.MetaAny:   "*zero1ns",
 			utils.MetaVoice: "*zero1s",
 		},
+		Balance_ledger:   utils.BoolPointer(false),
+		Default_currency: utils.StringPointer(""),
+		Max_transfer:     &map[string]float64{},
+		Transfer_fee:     &map[string]string{},
 	}
 	dfCgrJSONCfg, err := NewCgrJsonCfgFromBytes([]byte(CGRATES_CFG_JSON))
 	if err != nil {
@@ -799,6 +886,7 @@ func TestDfCdrsJsonCfg(t *testing.T) {
 		Online_cdr_exports:   &[]string{},
 		Scheduler_conns:      &[]string{},
 		Ees_conns:            &[]string{},
+		Taxes:                utils.BoolPointer(false),
 	}
 	dfCgrJSONCfg, err := NewCgrJsonCfgFromBytes([]byte(CGRATES_CFG_JSON))
 	if err != nil {
@@ -833,6 +921,7 @@ func TestSmgJsonCfg(t *testing.T) {
 		Channel_sync_interval:      utils.StringPointer("0"),
 		Stale_chan_max_extra_usage: utils.StringPointer("0"),
 		Terminate_attempts:         utils.IntPointer(5),
+		Backup_interval:            utils.StringPointer("0"),
 		Alterable_fields:           &[]string{},
 		Default_usage: &map[string]string{
 			utils.MetaAny:   "3h",
@@ -985,8 +1074,13 @@ func TestRadiusAgentJsonCfg(t *testing.T) {
 		Client_dictionaries: utils.MapStringSlicePointer(map[string][]string{
 			utils.MetaDefault: {"/usr/share/cgrates/radius/dict/"},
 		}),
-		Sessions_conns:     &[]string{utils.MetaInternal},
-		Request_processors: &[]*ReqProcessorJsnCfg{},
+		Client_da_addresses: &map[string]*DAClientOptsJson{},
+		Da_timeout:          utils.StringPointer("1s"),
+		Requests_cache_key:  utils.StringPointer(""),
+		Dmr_template:        utils.StringPointer(""),
+		Coa_template:        utils.StringPointer(""),
+		Sessions_conns:      &[]string{utils.MetaInternal},
+		Request_processors:  &[]*ReqProcessorJsnCfg{},
 	}
 	dfCgrJSONCfg, err := NewCgrJsonCfgFromBytes([]byte(CGRATES_CFG_JSON))
 	if err != nil {
@@ -1043,6 +1137,8 @@ func TestDfAttributeServJsonCfg(t *testing.T) {
 		Stats_conns:           &[]string{},
 		Resources_conns:       &[]string{},
 		Apiers_conns:          &[]string{},
+		Thresholds_conns:      &[]string{},
+		Routes_conns:          &[]string{},
 		Indexed_selects:       utils.BoolPointer(true),
 		String_indexed_fields: nil,
 		Prefix_indexed_fields: &[]string{},
@@ -1090,9 +1186,13 @@ func TestDfChargerServJsonCfg(t *testing.T) {
 
 func TestDfFilterSJsonCfg(t *testing.T) {
 	eCfg := &FilterSJsonCfg{
-		Stats_conns:     &[]string{},
-		Resources_conns: &[]string{},
-		Apiers_conns:    &[]string{},
+		Stats_conns:      &[]string{},
+		Resources_conns:  &[]string{},
+		Apiers_conns:     &[]string{},
+		Thresholds_conns: &[]string{},
+		Routes_conns:     &[]string{},
+		Http_profiles:    map[string]*HTTPProfileJsonCfg{},
+		Geoip_db_path:    utils.StringPointer(""),
 	}
 	dfCgrJSONCfg, err := NewCgrJsonCfgFromBytes([]byte(CGRATES_CFG_JSON))
 	if err != nil {
@@ -1226,6 +1326,7 @@ func TestDfLoaderJsonCfg(t *testing.T) {
 			Field_separator: utils.StringPointer(","),
 			Tp_in_dir:       utils.StringPointer("/var/spool/cgrates/loader/in"),
 			Tp_out_dir:      utils.StringPointer("/var/spool/cgrates/loader/out"),
+			Remote_sources:  &[]*LoaderSourceJsonCfg{},
 			Data: &[]*LoaderJsonDataType{
 				{
 					Type:      utils.StringPointer(utils.MetaAttributes),
@@ -1793,6 +1894,7 @@ func TestDfHttpJsonCfg(t *testing.T) {
 		Ws_url:              utils.StringPointer("/ws"),
 		Freeswitch_cdrs_url: utils.StringPointer("/freeswitch_json"),
 		Http_Cdrs:           utils.StringPointer("/cdr_http"),
+		Metrics_url:         utils.StringPointer(""),
 		Use_basic_auth:      utils.BoolPointer(false),
 		Auth_users:          utils.MapStringStringPointer(map[string]string{}),
 		Client_opts: &HTTPClientOptsJson{
@@ -1973,6 +2075,47 @@ func TestDfApierCfg(t *testing.T) {
 	}
 }
 
+func TestDfInvoiceSCfg(t *testing.T) {
+	eCfg := &InvoiceSJsonCfg{
+		Enabled:          utils.BoolPointer(false),
+		Ees_conns:        &[]string{},
+		Exporter_ids:     &[]string{},
+		Tenants:          &[]string{},
+		Run_interval:     utils.StringPointer("0s"),
+		Group_by:         utils.StringPointer(utils.MetaDestination),
+		Tax_percent:      utils.Float64Pointer(0),
+		Discount_percent: utils.Float64Pointer(0),
+	}
+	dfCgrJSONCfg, err := NewCgrJsonCfgFromBytes([]byte(CGRATES_CFG_JSON))
+	if err != nil {
+		t.Error(err)
+	}
+	if cfg, err := dfCgrJSONCfg.InvoiceSCfgJson(); err != nil {
+		t.Error(err)
+	} else if !reflect.DeepEqual(eCfg, cfg) {
+		t.Errorf("Expected: %+v, received: %+v", utils.ToJSON(eCfg), utils.ToJSON(cfg))
+	}
+}
+
+func TestDfTaxSCfg(t *testing.T) {
+	eCfg := &TaxSJsonCfg{
+		Indexed_selects:       utils.BoolPointer(true),
+		String_indexed_fields: nil,
+		Prefix_indexed_fields: &[]string{},
+		Suffix_indexed_fields: &[]string{},
+		Nested_fields:         utils.BoolPointer(false),
+	}
+	dfCgrJSONCfg, err := NewCgrJsonCfgFromBytes([]byte(CGRATES_CFG_JSON))
+	if err != nil {
+		t.Error(err)
+	}
+	if cfg, err := dfCgrJSONCfg.TaxSCfgJson(); err != nil {
+		t.Error(err)
+	} else if !reflect.DeepEqual(eCfg, cfg) {
+		t.Errorf("Expected: %+v, received: %+v", utils.ToJSON(eCfg), utils.ToJSON(cfg))
+	}
+}
+
 func TestDfEventReaderCfg(t *testing.T) {
 	cdrFields := []*FcTemplateJsonCfg{
 		{Tag: utils.StringPointer(utils.ToR), Path: utils.StringPointer(utils.MetaCgreq + utils.NestingSep + utils.ToR), Type: utils.StringPointer(utils.MetaVariable),
@@ -2043,12 +2186,23 @@ func TestDfEventExporterCfg(t *testing.T) {
 	eCfg := &EEsJsonCfg{
 		Enabled:          utils.BoolPointer(false),
 		Attributes_conns: &[]string{},
+		Dead_letter_dir:  utils.StringPointer(""),
 		Cache: &map[string]*CacheParamJsonCfg{
 			utils.MetaFileCSV: {
 				Limit:      utils.IntPointer(-1),
 				Ttl:        utils.StringPointer("5s"),
 				Static_ttl: utils.BoolPointer(false),
 			},
+			utils.MetaFileParquet: {
+				Limit:      utils.IntPointer(-1),
+				Ttl:        utils.StringPointer("5s"),
+				Static_ttl: utils.BoolPointer(false),
+			},
+			utils.MetaFileAvro: {
+				Limit:      utils.IntPointer(-1),
+				Ttl:        utils.StringPointer("5s"),
+				Static_ttl: utils.BoolPointer(false),
+			},
 		},
 		Exporters: &[]*EventExporterJsonCfg{
 			{
@@ -2062,6 +2216,10 @@ func TestDfEventExporterCfg(t *testing.T) {
 				Flags:               &[]string{},
 				Synchronous:         utils.BoolPointer(false),
 				Attempts:            utils.IntPointer(1),
+				Retry_backoff:       utils.StringPointer("1s"),
+				Retry_max_backoff:   utils.StringPointer("0s"),
+				Retry_jitter:        utils.Float64Pointer(0),
+				Retry_max_age:       utils.StringPointer("0s"),
 				Fields:              &[]*FcTemplateJsonCfg{},
 				Opts:                &EventExporterOptsJson{},
 				Concurrent_requests: utils.IntPointer(0),
@@ -2235,6 +2393,52 @@ func TestDfTemplateSJsonCfg(t *testing.T) {
 				Value: utils.StringPointer("0"),
 			},
 		},
+		utils.MetaDMR: {
+			{
+				Tag:   utils.StringPointer("User-Name"),
+				Path:  utils.StringPointer(fmt.Sprintf("%s.User-Name", utils.MetaRadDAReq)),
+				Type:  utils.StringPointer(utils.MetaVariable),
+				Value: utils.StringPointer("~*req.User-Name"),
+			},
+			{
+				Tag:   utils.StringPointer("NAS-IP-Address"),
+				Path:  utils.StringPointer(fmt.Sprintf("%s.NAS-IP-Address", utils.MetaRadDAReq)),
+				Type:  utils.StringPointer(utils.MetaVariable),
+				Value: utils.StringPointer("~*req.NAS-IP-Address"),
+			},
+			{
+				Tag:   utils.StringPointer("Acct-Session-Id"),
+				Path:  utils.StringPointer(fmt.Sprintf("%s.Acct-Session-Id", utils.MetaRadDAReq)),
+				Type:  utils.StringPointer(utils.MetaVariable),
+				Value: utils.StringPointer("~*req.Acct-Session-Id"),
+			},
+			{
+				Tag:   utils.StringPointer("ReplyMessage"),
+				Path:  utils.StringPointer(fmt.Sprintf("%s.Reply-Message", utils.MetaRadDAReq)),
+				Type:  utils.StringPointer(utils.MetaVariable),
+				Value: utils.StringPointer("~*vars.DisconnectCause"),
+			},
+		},
+		utils.MetaCoA: {
+			{
+				Tag:   utils.StringPointer("User-Name"),
+				Path:  utils.StringPointer(fmt.Sprintf("%s.User-Name", utils.MetaRadDAReq)),
+				Type:  utils.StringPointer(utils.MetaVariable),
+				Value: utils.StringPointer("~*req.User-Name"),
+			},
+			{
+				Tag:   utils.StringPointer("NAS-IP-Address"),
+				Path:  utils.StringPointer(fmt.Sprintf("%s.NAS-IP-Address", utils.MetaRadDAReq)),
+				Type:  utils.StringPointer(utils.MetaVariable),
+				Value: utils.StringPointer("~*req.NAS-IP-Address"),
+			},
+			{
+				Tag:   utils.StringPointer("Acct-Session-Id"),
+				Path:  utils.StringPointer(fmt.Sprintf("%s.Acct-Session-Id", utils.MetaRadDAReq)),
+				Type:  utils.StringPointer(utils.MetaVariable),
+				Value: utils.StringPointer("~*req.Acct-Session-Id"),
+			},
+		},
 		utils.MetaCdrLog: {
 			{
 				Tag:       utils.StringPointer("ToR"),
@@ -137,6 +137,26 @@ func (cfg *CGRConfig) checkConfigSanity() error {
 				return fmt.Errorf("<%s> nonexistent folder: %s", utils.LoaderS, pathL)
 			}
 		}
+		for _, src := range ldrSCfg.RemoteSources {
+			switch src.Type {
+			case utils.MetaS3:
+				if src.S3Bucket == utils.EmptyString {
+					return fmt.Errorf("<%s> %s for remote source <%s>", utils.LoaderS, utils.NewErrMandatoryIeMissing(utils.S3BucketCfg), src.URL)
+				}
+			case utils.MetaHTTP:
+				if src.URL == utils.EmptyString {
+					return fmt.Errorf("<%s> %s for remote source of type <%s>", utils.LoaderS, utils.NewErrMandatoryIeMissing(utils.URLCfg), src.Type)
+				}
+				if src.ProcessedAction == utils.MetaArchive {
+					return fmt.Errorf("<%s> processed action <%s> not supported for remote source <%s>", utils.LoaderS, src.ProcessedAction, src.URL)
+				}
+			default:
+				return fmt.Errorf("<%s> unsupported remote source type <%s>", utils.LoaderS, src.Type)
+			}
+			if !slices.Contains([]string{utils.MetaNone, utils.MetaDelete, utils.MetaArchive}, src.ProcessedAction) {
+				return fmt.Errorf("<%s> unsupported processed action <%s> for remote source <%s>", utils.LoaderS, src.ProcessedAction, src.URL)
+			}
+		}
 		for _, data := range ldrSCfg.Data {
 			if !posibleLoaderTypes.Has(data.Type) {
 				return fmt.Errorf("<%s> unsupported data type %s", utils.LoaderS, data.Type)
@@ -168,6 +188,9 @@ func (cfg *CGRConfig) checkConfigSanity() error {
 		if cfg.sessionSCfg.TerminateAttempts < 1 {
 			return fmt.Errorf("<%s> 'terminate_attempts' should be at least 1", utils.SessionS)
 		}
+		if cfg.sessionSCfg.BackupInterval > 0 && !cfg.generalCfg.nodeIDCfg { // the backups are restored based on node_id
+			return fmt.Errorf("<%s> 'backup_interval' requires the 'node_id' to be configured", utils.SessionS)
+		}
 		for _, connID := range cfg.sessionSCfg.ChargerSConns {
 			if strings.HasPrefix(connID, utils.MetaInternal) && !cfg.chargerSCfg.Enabled {
 				return fmt.Errorf("<%s> not enabled but requested by <%s> component", utils.ChargerS, utils.SessionS)
@@ -374,13 +397,27 @@ func (cfg *CGRConfig) checkConfigSanity() error {
 	//Radius Agent
 	if cfg.radiusAgentCfg.Enabled {
 		for _, connID := range cfg.radiusAgentCfg.SessionSConns {
-			if strings.HasPrefix(connID, utils.MetaInternal) && !cfg.sessionSCfg.Enabled {
+			isInternal := strings.HasPrefix(connID, utils.MetaInternal) || strings.HasPrefix(connID, rpcclient.BiRPCInternal)
+			if isInternal && !cfg.sessionSCfg.Enabled {
 				return fmt.Errorf("<%s> not enabled but requested by <%s> component", utils.SessionS, utils.RadiusAgent)
 			}
-			if _, has := cfg.rpcConns[connID]; !has && !strings.HasPrefix(connID, utils.MetaInternal) {
+			if _, has := cfg.rpcConns[connID]; !has && !isInternal {
 				return fmt.Errorf("<%s> connection with id: <%s> not defined", utils.RadiusAgent, connID)
 			}
 		}
+		for _, tplID := range []string{cfg.radiusAgentCfg.DMRTemplate, cfg.radiusAgentCfg.CoATemplate} {
+			if tplID == utils.EmptyString {
+				continue
+			}
+			if _, has := cfg.templates[tplID]; !has {
+				return fmt.Errorf("<%s> template with id: <%s> not defined", utils.RadiusAgent, tplID)
+			}
+		}
+		for clntID, dac := range cfg.radiusAgentCfg.ClientDaAddresses {
+			if dac.Transport != utils.UDP {
+				return fmt.Errorf("<%s> unsupported transport <%s> for DA client <%s>", utils.RadiusAgent, dac.Transport, clntID)
+			}
+		}
 		for _, req := range cfg.radiusAgentCfg.RequestProcessors {
 			for _, field := range req.RequestFields {
 				if field.Type != utils.MetaNone && field.Path == utils.EmptyString {
@@ -418,6 +455,9 @@ func (cfg *CGRConfig) checkConfigSanity() error {
 				return fmt.Errorf("<%s> %s for %s at %s", utils.RadiusAgent, err, req.Filters, utils.RequestProcessorsCfg)
 			}
 		}
+		if cfg.radiusAgentCfg.DATimeout <= 0 {
+			return fmt.Errorf("<%s> %s must be greater than 0", utils.RadiusAgent, utils.DATimeoutCfg)
+		}
 	}
 	//DNS Agent
 	if cfg.dnsAgentCfg.Enabled {
@@ -744,11 +784,16 @@ func (cfg *CGRConfig) checkConfigSanity() error {
 					*rdr.Opts.CSV.FieldSeparator == utils.EmptyString {
 					return fmt.Errorf("<%s> empty %s for reader with ID: %s", utils.ERs, utils.CSVFieldSepOpt, rdr.ID)
 				}
+			case utils.MetaHTTPjson, utils.MetaHTTPPost:
+				if !strings.HasPrefix(rdr.SourcePath, utils.Slash) {
+					return fmt.Errorf("<%s> invalid HTTP path: %s for reader with ID: %s", utils.ERs, rdr.SourcePath, rdr.ID)
+				}
 			case utils.MetaKafkajsonMap:
 				if rdr.RunDelay > 0 {
 					return fmt.Errorf("<%s> the RunDelay field can not be bigger than zero for reader with ID: %s", utils.ERs, rdr.ID)
 				}
-			case utils.MetaFileXML, utils.MetaFileFWV, utils.MetaFileJSON:
+			case utils.MetaFileXML, utils.MetaFileFWV, utils.MetaFileJSON,
+				utils.MetaFileParquet, utils.MetaFileAvro:
 				for _, dir := range []string{rdr.ProcessedPath, rdr.SourcePath} {
 					if _, err := os.Stat(dir); err != nil && os.IsNotExist(err) {
 						return fmt.Errorf("<%s> nonexistent folder: %s for reader with ID: %s", utils.ERs, dir, rdr.ID)
@@ -857,10 +902,18 @@ func (cfg *CGRConfig) checkConfigSanity() error {
 				return fmt.Errorf("<%s> connection with id: <%s> not defined", utils.EEs, connID)
 			}
 		}
+		if cfg.eesCfg.DeadLetterDir != utils.EmptyString {
+			if _, err := os.Stat(cfg.eesCfg.DeadLetterDir); err != nil && os.IsNotExist(err) {
+				return fmt.Errorf("<%s> nonexistent folder: %s for %s", utils.EEs, cfg.eesCfg.DeadLetterDir, utils.DeadLetterDirCfg)
+			}
+		}
 		for _, exp := range cfg.eesCfg.Exporters {
 			if !possibleExporterTypes.Has(exp.Type) {
 				return fmt.Errorf("<%s> unsupported data type: %s for exporter with ID: %s", utils.EEs, exp.Type, exp.ID)
 			}
+			if exp.RetryJitter < 0 || exp.RetryJitter > 1 {
+				return fmt.Errorf("<%s> %s should be between 0 and 1 for exporter with ID: %s", utils.EEs, utils.RetryJitterCfg, exp.ID)
+			}
 
 			switch exp.Type {
 			case utils.MetaFileCSV:
@@ -882,6 +935,26 @@ func (cfg *CGRConfig) checkConfigSanity() error {
 				if len(exp.ContentFields()) == 0 {
 					return fmt.Errorf("<%s> empty content fields for exporter with ID: %s", utils.EEs, exp.ID)
 				}
+			case utils.MetaFileParquet, utils.MetaFileAvro:
+				if _, err := os.Stat(exp.ExportPath); err != nil && os.IsNotExist(err) {
+					return fmt.Errorf("<%s> nonexistent folder: %s for exporter with ID: %s", utils.EEs, exp.ExportPath, exp.ID)
+				}
+				if len(exp.ContentFields()) == 0 {
+					return fmt.Errorf("<%s> empty content fields for exporter with ID: %s", utils.EEs, exp.ID)
+				}
+				for col, colType := range exp.Opts.FileColumnTypes {
+					if _, _, err := utils.ParseColumnType(colType); err != nil {
+						return fmt.Errorf("<%s> %s for column %s of exporter with ID: %s", utils.EEs, err, col, exp.ID)
+					}
+				}
+				if exp.Opts.ParquetCompression != nil &&
+					!possibleParquetCompressions.Has(*exp.Opts.ParquetCompression) {
+					return fmt.Errorf("<%s> unsupported %s: %s for exporter with ID: %s", utils.EEs, utils.ParquetCompressionOpt, *exp.Opts.ParquetCompression, exp.ID)
+				}
+				if exp.Opts.AvroCodec != nil &&
+					!possibleAvroCodecs.Has(*exp.Opts.AvroCodec) {
+					return fmt.Errorf("<%s> unsupported %s: %s for exporter with ID: %s", utils.EEs, utils.AvroCodecOpt, *exp.Opts.AvroCodec, exp.ID)
+				}
 			}
 			for _, field := range exp.Fields {
 				if field.Type != utils.MetaNone && field.Path == utils.EmptyString {
@@ -942,6 +1015,20 @@ func (cfg *CGRConfig) checkConfigSanity() error {
 			return fmt.Errorf("<%s> the StoreInterval field needs to be -1 when DataBD is *internal, received : %d", utils.ThresholdS, cfg.thresholdSCfg.StoreInterval)
 		}
 	}
+	if cfg.dataDbCfg.Type == utils.MetaRedis {
+		switch cfg.dataDbCfg.Opts.RedisReadPolicy {
+		case utils.MetaPrimary:
+		case utils.MetaReplica, utils.MetaReplicaPreferred:
+			if !cfg.dataDbCfg.Opts.RedisCluster && cfg.dataDbCfg.Opts.RedisSentinel == utils.EmptyString &&
+				len(cfg.dataDbCfg.Opts.RedisReadReplicas) == 0 {
+				return fmt.Errorf("<%s> %s required by %s <%s>", utils.DataDB,
+					utils.RedisReadReplicasCfg, utils.RedisReadPolicyCfg, cfg.dataDbCfg.Opts.RedisReadPolicy)
+			}
+		default:
+			return fmt.Errorf("<%s> unsupported %s <%s>", utils.DataDB,
+				utils.RedisReadPolicyCfg, cfg.dataDbCfg.Opts.RedisReadPolicy)
+		}
+	}
 	for item, val := range cfg.dataDbCfg.Items {
 		if val.Remote && len(cfg.dataDbCfg.RmtConns) == 0 {
 			return fmt.Errorf("remote connections required by: <%s>", item)
@@ -1046,6 +1133,22 @@ func (cfg *CGRConfig) checkConfigSanity() error {
 			return fmt.Errorf("<%s> connection with id: <%s> not defined", utils.FilterS, connID)
 		}
 	}
+	for _, connID := range cfg.filterSCfg.ThresholdSConns {
+		if strings.HasPrefix(connID, utils.MetaInternal) && !cfg.thresholdSCfg.Enabled {
+			return fmt.Errorf("<%s> not enabled but requested by <%s> component", utils.ThresholdS, utils.FilterS)
+		}
+		if _, has := cfg.rpcConns[connID]; !has && !strings.HasPrefix(connID, utils.MetaInternal) {
+			return fmt.Errorf("<%s> connection with id: <%s> not defined", utils.FilterS, connID)
+		}
+	}
+	for _, connID := range cfg.filterSCfg.RouteSConns {
+		if strings.HasPrefix(connID, utils.MetaInternal) && !cfg.routeSCfg.Enabled {
+			return fmt.Errorf("<%s> not enabled but requested by <%s> component", utils.RouteS, utils.FilterS)
+		}
+		if _, has := cfg.rpcConns[connID]; !has && !strings.HasPrefix(connID, utils.MetaInternal) {
+			return fmt.Errorf("<%s> connection with id: <%s> not defined", utils.FilterS, connID)
+		}
+	}
 
 	if len(cfg.registrarCCfg.Dispatchers.RegistrarSConns) != 0 {
 		if len(cfg.registrarCCfg.Dispatchers.Hosts) == 0 {
@@ -1109,6 +1212,17 @@ func (cfg *CGRConfig) checkConfigSanity() error {
 		}
 	}
 
+	for _, lmt := range cfg.coreSCfg.CapsLimits {
+		for _, key := range lmt.Keys {
+			if !slices.Contains([]string{utils.MetaTenant, utils.MetaAPIMethod, utils.MetaConnection}, key) {
+				return fmt.Errorf("<%s> unsupported key <%s> for caps limit <%s>", utils.CoreS, key, lmt.ID)
+			}
+		}
+		if lmt.ConcurrentRequests < 0 || lmt.RequestsPerSecond < 0 || lmt.Burst < 0 {
+			return fmt.Errorf("<%s> negative values not allowed for caps limit <%s>", utils.CoreS, lmt.ID)
+		}
+	}
+
 	if cfg.analyzerSCfg.Enabled {
 		if !utils.AnzIndexType.Has(cfg.analyzerSCfg.IndexType) {
 			return fmt.Errorf("<%s> unsupported index type: %q", utils.AnalyzerS, cfg.analyzerSCfg.IndexType)
@@ -1127,5 +1241,30 @@ func (cfg *CGRConfig) checkConfigSanity() error {
 		}
 	}
 
+	if cfg.invoiceSCfg.Enabled {
+		if cfg.invoiceSCfg.GroupBy != utils.MetaDestination &&
+			cfg.invoiceSCfg.GroupBy != utils.MetaCategory {
+			return fmt.Errorf("<%s> unsupported group_by: %q", utils.InvoiceS, cfg.invoiceSCfg.GroupBy)
+		}
+		if cfg.invoiceSCfg.RunInterval < 0 {
+			return fmt.Errorf("<%s> the run_interval cannot be negative", utils.InvoiceS)
+		}
+		for _, expID := range cfg.invoiceSCfg.ExporterIDs {
+			if !slices.ContainsFunc(cfg.eesCfg.Exporters, func(ee *EventExporterCfg) bool {
+				return ee.ID == expID
+			}) {
+				return fmt.Errorf("<%s> cannot find exporter with ID: <%s>", utils.InvoiceS, expID)
+			}
+		}
+		for _, connID := range cfg.invoiceSCfg.EEsConns {
+			if strings.HasPrefix(connID, utils.MetaInternal) && !cfg.eesCfg.Enabled {
+				return fmt.Errorf("<%s> not enabled but requested by <%s> component", utils.EEs, utils.InvoiceS)
+			}
+			if _, has := cfg.rpcConns[connID]; !has && !strings.HasPrefix(connID, utils.MetaInternal) {
+				return fmt.Errorf("<%s> connection with id: <%s> not defined", utils.InvoiceS, connID)
+			}
+		}
+	}
+
 	return nil
 }
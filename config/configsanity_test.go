@@ -19,6 +19,7 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cgrates/cgrates/utils"
 )
@@ -260,6 +261,13 @@ func TestConfigSanitySessionS(t *testing.T) {
 	}
 	cfg.sessionSCfg.TerminateAttempts = 1
 
+	cfg.sessionSCfg.BackupInterval = time.Minute
+	expected = "<SessionS> 'backup_interval' requires the 'node_id' to be configured"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.generalCfg.nodeIDCfg = true
+
 	cfg.sessionSCfg.ChargerSConns = []string{utils.MetaInternal}
 	expected = "<ChargerS> not enabled but requested by <SessionS> component"
 	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
@@ -620,6 +628,20 @@ func TestConfigSanityRadiusAgent(t *testing.T) {
 	}
 
 	cfg.rpcConns["test"] = nil
+	cfg.radiusAgentCfg.DMRTemplate = "*dmr_custom"
+	expected = "<RadiusAgent> template with id: <*dmr_custom> not defined"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.radiusAgentCfg.DMRTemplate = utils.MetaDMR
+	cfg.radiusAgentCfg.ClientDaAddresses = map[string]*DAClientOpts{
+		"127.0.0.1": {Transport: utils.TCP, Port: 3799},
+	}
+	expected = "<RadiusAgent> unsupported transport <tcp> for DA client <127.0.0.1>"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.radiusAgentCfg.ClientDaAddresses["127.0.0.1"].Transport = utils.UDP
 	expected = "<RadiusAgent> MANDATORY_IE_MISSING: [Path] for cgrates at SessionId"
 	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
 		t.Errorf("Expecting: %+q  received: %+q", expected, err)
@@ -683,6 +705,10 @@ func TestConfigSanityRadiusAgent(t *testing.T) {
 		t.Errorf("Expecting: %+q  received: %+q", expected, err)
 	}
 	cfg.radiusAgentCfg.RequestProcessors[0].Filters = []string{"*string:~*req.Account:1001"}
+	expected = "<RadiusAgent> da_timeout must be greater than 0"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
 }
 
 func TestConfigSanityDNSAgent(t *testing.T) {
@@ -1270,6 +1296,18 @@ func TestConfigSanityEventReader(t *testing.T) {
 	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
 		t.Errorf("Expecting: %+q  received: %+q", expected, err)
 	}
+	cfg.ersCfg.Readers[0] = &EventReaderCfg{
+		ID:         "test_http",
+		Type:       utils.MetaHTTPjson,
+		SourcePath: "webhook",
+		Opts: &EventReaderOpts{
+			PartialCacheAction: utils.StringPointer(utils.MetaNone),
+		},
+	}
+	expected = "<ERs> invalid HTTP path: webhook for reader with ID: test_http"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
 	cfg.ersCfg.Readers[0] = &EventReaderCfg{
 		ID:            "test5",
 		Type:          utils.MetaFileXML,
@@ -1509,6 +1547,71 @@ func TestConfigSanityEventExporter(t *testing.T) {
 	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
 		t.Errorf("Expecting: %+q  received: %+q", expected, err)
 	}
+	cfg.eesCfg.Exporters[0].Filters = nil
+
+	cfg.eesCfg.Exporters[0].RetryJitter = 1.5
+	expected = "<EEs> retry_jitter should be between 0 and 1 for exporter with ID: "
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+
+	cfg.eesCfg.DeadLetterDir = "randomPath"
+	expected = "<EEs> nonexistent folder: randomPath for dead_letter_dir"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+}
+
+func TestConfigSanityEventExporterTypedFiles(t *testing.T) {
+	cfg := NewDefaultCGRConfig()
+	cfg.eesCfg = &EEsCfg{
+		Enabled: true,
+		Exporters: []*EventExporterCfg{
+			{
+				ID:         "parquet",
+				Type:       utils.MetaFileParquet,
+				ExportPath: "randomPath",
+				Opts:       &EventExporterOpts{},
+			},
+		},
+	}
+	expected := "<EEs> nonexistent folder: randomPath for exporter with ID: parquet"
+	if err := cfg.CheckConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.eesCfg.Exporters[0].ExportPath = "/"
+	expected = "<EEs> empty content fields for exporter with ID: parquet"
+	if err := cfg.CheckConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.eesCfg.Exporters[0].Fields = []*FCTemplate{
+		{Tag: "Cost", Path: "*exp.Cost", Type: utils.MetaVariable,
+			Value: NewRSRParsersMustCompile("~*req.Cost", utils.InfieldSep)},
+	}
+	cfg.eesCfg.Exporters[0].Fields[0].ComputePath()
+	cfg.eesCfg.Exporters[0].ComputeFields()
+	cfg.eesCfg.Exporters[0].Opts.FileColumnTypes = map[string]string{"Cost": "*decimal:20"}
+	expected = "<EEs> invalid scale for column type: <*decimal:20> for column Cost of exporter with ID: parquet"
+	if err := cfg.CheckConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.eesCfg.Exporters[0].Opts.FileColumnTypes = map[string]string{"Cost": "*decimal:2"}
+	cfg.eesCfg.Exporters[0].Opts.ParquetCompression = utils.StringPointer("lz4")
+	expected = "<EEs> unsupported parquetCompression: lz4 for exporter with ID: parquet"
+	if err := cfg.CheckConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.eesCfg.Exporters[0].Type = utils.MetaFileAvro
+	cfg.eesCfg.Exporters[0].Opts.ParquetCompression = nil
+	cfg.eesCfg.Exporters[0].Opts.AvroCodec = utils.StringPointer("gzip")
+	expected = "<EEs> unsupported avroCodec: gzip for exporter with ID: parquet"
+	if err := cfg.CheckConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.eesCfg.Exporters[0].Opts.AvroCodec = utils.StringPointer(utils.AvroDeflate)
+	if err := cfg.CheckConfigSanity(); err != nil {
+		t.Error(err)
+	}
 }
 
 func TestConfigSanityCache(t *testing.T) {
@@ -1716,6 +1819,97 @@ func TestConfigSanityAnalyzer(t *testing.T) {
 	}
 }
 
+func TestConfigSanityLoadersRemoteSources(t *testing.T) {
+	cfg := NewDefaultCGRConfig()
+	cfg.loaderCfg = LoaderSCfgs{
+		&LoaderSCfg{
+			Enabled:  true,
+			TpInDir:  "/",
+			TpOutDir: "/",
+			RemoteSources: []*LoaderSourceCfg{{
+				Type:            "*sftp",
+				URL:             "sftp://127.0.0.1",
+				ProcessedAction: utils.MetaNone,
+			}},
+		},
+	}
+	expected := "<LoaderS> unsupported remote source type <*sftp>"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.loaderCfg[0].RemoteSources[0].Type = utils.MetaS3
+	cfg.loaderCfg[0].RemoteSources[0].URL = "http://127.0.0.1:9000"
+	expected = "<LoaderS> MANDATORY_IE_MISSING: [s3_bucket] for remote source <http://127.0.0.1:9000>"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.loaderCfg[0].RemoteSources[0].S3Bucket = "tariffs"
+	cfg.loaderCfg[0].RemoteSources[0].ProcessedAction = "*move"
+	expected = "<LoaderS> unsupported processed action <*move> for remote source <http://127.0.0.1:9000>"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.loaderCfg[0].RemoteSources[0].ProcessedAction = utils.MetaArchive
+	if err := cfg.checkConfigSanity(); err != nil {
+		t.Error(err)
+	}
+	cfg.loaderCfg[0].RemoteSources[0].Type = utils.MetaHTTP
+	expected = "<LoaderS> processed action <*archive> not supported for remote source <http://127.0.0.1:9000>"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.loaderCfg[0].RemoteSources[0].URL = utils.EmptyString
+	expected = "<LoaderS> MANDATORY_IE_MISSING: [url] for remote source of type <*http>"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+}
+
+func TestConfigSanityCapsLimits(t *testing.T) {
+	cfg := NewDefaultCGRConfig()
+	cfg.coreSCfg.CapsLimits = []*CapsLimitCfg{{
+		ID:   "LMT1",
+		Keys: []string{utils.MetaTenant, "*account"},
+	}}
+	expected := "<CoreS> unsupported key <*account> for caps limit <LMT1>"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.coreSCfg.CapsLimits[0].Keys = []string{utils.MetaTenant, utils.MetaConnection}
+	cfg.coreSCfg.CapsLimits[0].RequestsPerSecond = -1
+	expected = "<CoreS> negative values not allowed for caps limit <LMT1>"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.coreSCfg.CapsLimits[0].RequestsPerSecond = 10
+	if err := cfg.checkConfigSanity(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestConfigSanityDataDBRedisReadPolicy(t *testing.T) {
+	cfg := NewDefaultCGRConfig()
+	cfg.dataDbCfg.Opts.RedisReadPolicy = "*invalid"
+	expected := "<data_db> unsupported redisReadPolicy <*invalid>"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.dataDbCfg.Opts.RedisReadPolicy = utils.MetaReplica
+	expected = "<data_db> redisReadReplicas required by redisReadPolicy <*replica>"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.dataDbCfg.Opts.RedisReadReplicas = []string{"127.0.0.1:6380"}
+	if err := cfg.checkConfigSanity(); err != nil {
+		t.Error(err)
+	}
+	cfg.dataDbCfg.Opts.RedisReadReplicas = nil
+	cfg.dataDbCfg.Opts.RedisSentinel = "redis-cluster"
+	if err := cfg.checkConfigSanity(); err != nil {
+		t.Error(err)
+	}
+}
+
 func TestConfigSanityDataDB(t *testing.T) {
 	cfg = NewDefaultCGRConfig()
 	cfg.dataDbCfg.Type = utils.MetaInternal
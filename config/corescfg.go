@@ -19,6 +19,7 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>
 package config
 
 import (
+	"slices"
 	"time"
 
 	"github.com/cgrates/cgrates/utils"
@@ -29,6 +30,7 @@ type CoreSCfg struct {
 	Caps              int
 	CapsStrategy      string
 	CapsStatsInterval time.Duration
+	CapsLimits        []*CapsLimitCfg
 	ShutdownTimeout   time.Duration
 }
 
@@ -47,6 +49,23 @@ func (cS *CoreSCfg) loadFromJSONCfg(jsnCfg *CoreSJsonCfg) (err error) {
 			return
 		}
 	}
+	if jsnCfg.Caps_limits != nil {
+		for _, lmtJsn := range *jsnCfg.Caps_limits {
+			lmt := new(CapsLimitCfg)
+			var haveID bool
+			for _, lmtSet := range cS.CapsLimits {
+				if lmtJsn.Id != nil && lmtSet.ID == *lmtJsn.Id {
+					lmt = lmtSet // Will load data into the one set
+					haveID = true
+					break
+				}
+			}
+			lmt.loadFromJSONCfg(lmtJsn)
+			if !haveID {
+				cS.CapsLimits = append(cS.CapsLimits, lmt)
+			}
+		}
+	}
 	if jsnCfg.Shutdown_timeout != nil {
 		if cS.ShutdownTimeout, err = utils.ParseDurationWithNanosecs(*jsnCfg.Shutdown_timeout); err != nil {
 			return
@@ -63,6 +82,11 @@ func (cS *CoreSCfg) AsMapInterface() map[string]any {
 		utils.CapsStatsIntervalCfg: cS.CapsStatsInterval.String(),
 		utils.ShutdownTimeoutCfg:   cS.ShutdownTimeout.String(),
 	}
+	capsLimits := make([]map[string]any, len(cS.CapsLimits))
+	for i, lmt := range cS.CapsLimits {
+		capsLimits[i] = lmt.AsMapInterface()
+	}
+	mp[utils.CapsLimitsCfg] = capsLimits
 	if cS.CapsStatsInterval == 0 {
 		mp[utils.CapsStatsIntervalCfg] = "0"
 	}
@@ -73,11 +97,99 @@ func (cS *CoreSCfg) AsMapInterface() map[string]any {
 }
 
 // Clone returns a deep copy of CoreSCfg
-func (cS CoreSCfg) Clone() *CoreSCfg {
-	return &CoreSCfg{
+func (cS CoreSCfg) Clone() (cln *CoreSCfg) {
+	cln = &CoreSCfg{
 		Caps:              cS.Caps,
 		CapsStrategy:      cS.CapsStrategy,
 		CapsStatsInterval: cS.CapsStatsInterval,
 		ShutdownTimeout:   cS.ShutdownTimeout,
 	}
+	if cS.CapsLimits != nil {
+		cln.CapsLimits = make([]*CapsLimitCfg, len(cS.CapsLimits))
+		for i, lmt := range cS.CapsLimits {
+			cln.CapsLimits[i] = lmt.Clone()
+		}
+	}
+	return
+}
+
+// CapsLimitCfg the config for one of the caps limits
+type CapsLimitCfg struct {
+	ID                 string
+	Tenants            utils.StringSet
+	APIMethods         utils.StringSet
+	Keys               []string
+	ConcurrentRequests int
+	RequestsPerSecond  float64
+	Burst              int
+}
+
+func (cL *CapsLimitCfg) loadFromJSONCfg(jsnCfg *CapsLimitJsonCfg) {
+	if jsnCfg == nil {
+		return
+	}
+	if jsnCfg.Id != nil {
+		cL.ID = *jsnCfg.Id
+	}
+	if jsnCfg.Tenants != nil {
+		cL.Tenants = utils.NewStringSet(*jsnCfg.Tenants)
+	}
+	if jsnCfg.Api_methods != nil {
+		cL.APIMethods = utils.NewStringSet(*jsnCfg.Api_methods)
+	}
+	if jsnCfg.Keys != nil {
+		cL.Keys = slices.Clone(*jsnCfg.Keys)
+	}
+	if jsnCfg.Concurrent_requests != nil {
+		cL.ConcurrentRequests = *jsnCfg.Concurrent_requests
+	}
+	if jsnCfg.Requests_per_second != nil {
+		cL.RequestsPerSecond = *jsnCfg.Requests_per_second
+	}
+	if jsnCfg.Burst != nil {
+		cL.Burst = *jsnCfg.Burst
+	}
+}
+
+// AsMapInterface returns the config as a map[string]any
+func (cL *CapsLimitCfg) AsMapInterface() map[string]any {
+	mp := map[string]any{
+		utils.IDCfg:                 cL.ID,
+		utils.Tenants:               []string{},
+		utils.APIMethodsCfg:         []string{},
+		utils.KeysCfg:               []string{},
+		utils.ConcurrentRequestsCfg: cL.ConcurrentRequests,
+		utils.RequestsPerSecondCfg:  cL.RequestsPerSecond,
+		utils.BurstCfg:              cL.Burst,
+	}
+	if cL.Tenants != nil {
+		mp[utils.Tenants] = cL.Tenants.AsOrderedSlice()
+	}
+	if cL.APIMethods != nil {
+		mp[utils.APIMethodsCfg] = cL.APIMethods.AsOrderedSlice()
+	}
+	if cL.Keys != nil {
+		mp[utils.KeysCfg] = slices.Clone(cL.Keys)
+	}
+	return mp
+}
+
+// Clone returns a deep copy of CapsLimitCfg
+func (cL CapsLimitCfg) Clone() *CapsLimitCfg {
+	cln := &CapsLimitCfg{
+		ID:                 cL.ID,
+		ConcurrentRequests: cL.ConcurrentRequests,
+		RequestsPerSecond:  cL.RequestsPerSecond,
+		Burst:              cL.Burst,
+	}
+	if cL.Tenants != nil {
+		cln.Tenants = cL.Tenants.Clone()
+	}
+	if cL.APIMethods != nil {
+		cln.APIMethods = cL.APIMethods.Clone()
+	}
+	if cL.Keys != nil {
+		cln.Keys = slices.Clone(cL.Keys)
+	}
+	return cln
 }
@@ -89,6 +89,7 @@ func TestCoreSAsMapInterface(t *testing.T) {
 		utils.CapsCfg:              0,
 		utils.CapsStrategyCfg:      utils.MetaBusy,
 		utils.CapsStatsIntervalCfg: "0",
+		utils.CapsLimitsCfg:        []map[string]any{},
 		utils.ShutdownTimeoutCfg:   "0",
 	}
 	if jsnCfg, err := NewCgrJsonCfgFromBytes([]byte(cfgJSONStr)); err != nil {
@@ -128,3 +129,100 @@ func TestCoreSCfgClone(t *testing.T) {
 		t.Errorf("Expected clone to not modify the cloned")
 	}
 }
+
+func TestCoreSCapsLimitsCfg(t *testing.T) {
+	var cS CoreSCfg
+	cfgJSONStr := `{
+		"cores": {
+			"caps_limits": [
+				{
+					"id": "TENANT_SESSIONS",
+					"tenants": ["cgrates.org"],
+					"api_methods": ["SessionSv1.AuthorizeEvent", "APIerSv1.*"],
+					"keys": ["*tenant", "*api_method"],
+					"concurrent_requests": 10,
+					"requests_per_second": 2.5,
+				},
+				{
+					"id": "PER_CONNECTION",
+					"keys": ["*connection"],
+					"requests_per_second": 100,
+					"burst": 200,
+				},
+			],
+		},
+}`
+	expected := CoreSCfg{
+		CapsLimits: []*CapsLimitCfg{
+			{
+				ID:                 "TENANT_SESSIONS",
+				Tenants:            utils.NewStringSet([]string{"cgrates.org"}),
+				APIMethods:         utils.NewStringSet([]string{"SessionSv1.AuthorizeEvent", "APIerSv1.*"}),
+				Keys:               []string{utils.MetaTenant, utils.MetaAPIMethod},
+				ConcurrentRequests: 10,
+				RequestsPerSecond:  2.5,
+			},
+			{
+				ID:                "PER_CONNECTION",
+				Keys:              []string{utils.MetaConnection},
+				RequestsPerSecond: 100,
+				Burst:             200,
+			},
+		},
+	}
+	if jsnCfg, err := NewCgrJsonCfgFromBytes([]byte(cfgJSONStr)); err != nil {
+		t.Fatal(err)
+	} else if jsnCS, err := jsnCfg.CoreSCfgJson(); err != nil {
+		t.Fatal(err)
+	} else if err = cS.loadFromJSONCfg(jsnCS); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(expected, cS) {
+		t.Errorf("Expected: %s , received: %s", utils.ToJSON(expected), utils.ToJSON(cS))
+	}
+
+	// the limits with the same ID are updated
+	if err := cS.loadFromJSONCfg(&CoreSJsonCfg{
+		Caps_limits: &[]*CapsLimitJsonCfg{{
+			Id:    utils.StringPointer("PER_CONNECTION"),
+			Burst: utils.IntPointer(300),
+		}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	expected.CapsLimits[1].Burst = 300
+	if !reflect.DeepEqual(expected, cS) {
+		t.Errorf("Expected: %s , received: %s", utils.ToJSON(expected), utils.ToJSON(cS))
+	}
+
+	eMap := []map[string]any{
+		{
+			utils.IDCfg:                 "TENANT_SESSIONS",
+			utils.Tenants:               []string{"cgrates.org"},
+			utils.APIMethodsCfg:         []string{"APIerSv1.*", "SessionSv1.AuthorizeEvent"},
+			utils.KeysCfg:               []string{utils.MetaTenant, utils.MetaAPIMethod},
+			utils.ConcurrentRequestsCfg: 10,
+			utils.RequestsPerSecondCfg:  2.5,
+			utils.BurstCfg:              0,
+		},
+		{
+			utils.IDCfg:                 "PER_CONNECTION",
+			utils.Tenants:               []string{},
+			utils.APIMethodsCfg:         []string{},
+			utils.KeysCfg:               []string{utils.MetaConnection},
+			utils.ConcurrentRequestsCfg: 0,
+			utils.RequestsPerSecondCfg:  100.,
+			utils.BurstCfg:              300,
+		},
+	}
+	if rcv := cS.AsMapInterface()[utils.CapsLimitsCfg]; !reflect.DeepEqual(eMap, rcv) {
+		t.Errorf("Expected: %s\nReceived: %s", utils.ToJSON(eMap), utils.ToJSON(rcv))
+	}
+
+	cln := cS.Clone()
+	if !reflect.DeepEqual(&cS, cln) {
+		t.Errorf("Expected: %s\nReceived: %s", utils.ToJSON(cS), utils.ToJSON(cln))
+	}
+	if cln.CapsLimits[0].Tenants.Add("itsyscom.com"); cS.CapsLimits[0].Tenants.Has("itsyscom.com") {
+		t.Errorf("Expected clone to not modify the cloned")
+	}
+}
@@ -20,6 +20,7 @@ package config
 
 import (
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -42,6 +43,11 @@ type DataDBOpts struct {
 	RedisClientCertificate  string
 	RedisClientKey          string
 	RedisCACertificate      string
+	RedisReadPolicy         string        // where the Get*Drv reads are sent <*primary|*replica|*replica_preferred>
+	RedisReadReplicas       []string      // the replicas used for reads when not in sentinel or cluster mode
+	InternalDBDumpPath      string        // folder where the *internal DB is dumped, empty to disable
+	InternalDBDumpInterval  time.Duration // interval between dumps, 0 to dump only on shutdown
+	InternalDBWriteLog      bool          // log the writes between dumps
 }
 
 // DataDbCfg Database config
@@ -119,6 +125,23 @@ func (dbOpts *DataDBOpts) loadFromJSONCfg(jsnCfg *DBOptsJson) (err error) {
 	if jsnCfg.RedisCACertificate != nil {
 		dbOpts.RedisCACertificate = *jsnCfg.RedisCACertificate
 	}
+	if jsnCfg.RedisReadPolicy != nil {
+		dbOpts.RedisReadPolicy = *jsnCfg.RedisReadPolicy
+	}
+	if jsnCfg.RedisReadReplicas != nil {
+		dbOpts.RedisReadReplicas = slices.Clone(*jsnCfg.RedisReadReplicas)
+	}
+	if jsnCfg.InternalDBDumpPath != nil {
+		dbOpts.InternalDBDumpPath = *jsnCfg.InternalDBDumpPath
+	}
+	if jsnCfg.InternalDBDumpInterval != nil {
+		if dbOpts.InternalDBDumpInterval, err = utils.ParseDurationWithNanosecs(*jsnCfg.InternalDBDumpInterval); err != nil {
+			return
+		}
+	}
+	if jsnCfg.InternalDBWriteLog != nil {
+		dbOpts.InternalDBWriteLog = *jsnCfg.InternalDBWriteLog
+	}
 	return
 }
 
@@ -200,8 +223,8 @@ func (dbcfg *DataDbCfg) loadFromJSONCfg(jsnDbCfg *DbJsonCfg) (err error) {
 	return
 }
 
-func (dbOpts *DataDBOpts) Clone() *DataDBOpts {
-	return &DataDBOpts{
+func (dbOpts *DataDBOpts) Clone() (cln *DataDBOpts) {
+	cln = &DataDBOpts{
 		RedisMaxConns:           dbOpts.RedisMaxConns,
 		RedisConnectAttempts:    dbOpts.RedisConnectAttempts,
 		RedisSentinel:           dbOpts.RedisSentinel,
@@ -216,7 +239,15 @@ func (dbOpts *DataDBOpts) Clone() *DataDBOpts {
 		RedisClientCertificate:  dbOpts.RedisClientCertificate,
 		RedisClientKey:          dbOpts.RedisClientKey,
 		RedisCACertificate:      dbOpts.RedisCACertificate,
+		RedisReadPolicy:         dbOpts.RedisReadPolicy,
+		InternalDBDumpPath:      dbOpts.InternalDBDumpPath,
+		InternalDBDumpInterval:  dbOpts.InternalDBDumpInterval,
+		InternalDBWriteLog:      dbOpts.InternalDBWriteLog,
 	}
+	if dbOpts.RedisReadReplicas != nil {
+		cln.RedisReadReplicas = slices.Clone(dbOpts.RedisReadReplicas)
+	}
+	return
 }
 
 // Clone returns the cloned object
@@ -269,6 +300,11 @@ func (dbcfg *DataDbCfg) AsMapInterface() (mp map[string]any) {
 		utils.RedisClientCertificate:     dbcfg.Opts.RedisClientCertificate,
 		utils.RedisClientKey:             dbcfg.Opts.RedisClientKey,
 		utils.RedisCACertificate:         dbcfg.Opts.RedisCACertificate,
+		utils.RedisReadPolicyCfg:         dbcfg.Opts.RedisReadPolicy,
+		utils.RedisReadReplicasCfg:       dbcfg.Opts.RedisReadReplicas,
+		utils.InternalDBDumpPathCfg:      dbcfg.Opts.InternalDBDumpPath,
+		utils.InternalDBDumpIntervalCfg:  dbcfg.Opts.InternalDBDumpInterval.String(),
+		utils.InternalDBWriteLogCfg:      dbcfg.Opts.InternalDBWriteLog,
 	}
 	mp = map[string]any{
 		utils.DataDbTypeCfg:          dbcfg.Type,
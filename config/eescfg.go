@@ -29,6 +29,7 @@ type EEsCfg struct {
 	Enabled         bool
 	AttributeSConns []string
 	Cache           map[string]*CacheParamCfg
+	DeadLetterDir   string // where the events failing the export are stored, empty to disable
 	Exporters       []*EventExporterCfg
 }
 
@@ -58,6 +59,9 @@ func (eeS *EEsCfg) loadFromJSONCfg(jsnCfg *EEsJsonCfg, msgTemplates map[string][
 			eeS.Cache[kJsn] = val
 		}
 	}
+	if jsnCfg.Dead_letter_dir != nil {
+		eeS.DeadLetterDir = *jsnCfg.Dead_letter_dir
+	}
 	if jsnCfg.Attributes_conns != nil {
 		eeS.AttributeSConns = make([]string, len(*jsnCfg.Attributes_conns))
 		for i, fID := range *jsnCfg.Attributes_conns {
@@ -107,6 +111,7 @@ func (eeS *EEsCfg) Clone() (cln *EEsCfg) {
 		Enabled:         eeS.Enabled,
 		AttributeSConns: make([]string, len(eeS.AttributeSConns)),
 		Cache:           make(map[string]*CacheParamCfg),
+		DeadLetterDir:   eeS.DeadLetterDir,
 		Exporters:       make([]*EventExporterCfg, len(eeS.Exporters)),
 	}
 	for idx, sConn := range eeS.AttributeSConns {
@@ -124,7 +129,8 @@ func (eeS *EEsCfg) Clone() (cln *EEsCfg) {
 // AsMapInterface returns the config as a map[string]any
 func (eeS *EEsCfg) AsMapInterface(separator string) (initialMP map[string]any) {
 	initialMP = map[string]any{
-		utils.EnabledCfg: eeS.Enabled,
+		utils.EnabledCfg:       eeS.Enabled,
+		utils.DeadLetterDirCfg: eeS.DeadLetterDir,
 	}
 	if eeS.AttributeSConns != nil {
 		attributeSConns := make([]string, len(eeS.AttributeSConns))
@@ -235,14 +241,17 @@ type KafkaOpts struct {
 	KafkaTopic *string
 }
 type EventExporterOpts struct {
-	CSVFieldSeparator *string
-	Els               *ElsOpts
-	SQL               *SQLOpts
-	AMQP              *AMQPOpts
-	AWS               *AWSOpts
-	NATS              *NATSOpts
-	RPC               *RPCOpts
-	Kafka             *KafkaOpts
+	CSVFieldSeparator  *string
+	FileColumnTypes    map[string]string // column types for *file_parquet and *file_avro
+	ParquetCompression *string
+	AvroCodec          *string
+	Els                *ElsOpts
+	SQL                *SQLOpts
+	AMQP               *AMQPOpts
+	AWS                *AWSOpts
+	NATS               *NATSOpts
+	RPC                *RPCOpts
+	Kafka              *KafkaOpts
 }
 
 // EventExporterCfg the config for a Event Exporter
@@ -258,6 +267,10 @@ type EventExporterCfg struct {
 	AttributeSCtx      string   // context to use when querying AttributeS
 	Synchronous        bool
 	Attempts           int
+	RetryBackoff       time.Duration // delay unit of the fibonacci backoff between attempts
+	RetryMaxBackoff    time.Duration // upper limit for the delay between attempts, 0 for unlimited
+	RetryJitter        float64       // randomizes the delay with up to this fraction of it
+	RetryMaxAge        time.Duration // stop retrying after this long since the first attempt, 0 for unlimited
 	FailedPostsDir     string
 	ConcurrentRequests int
 	Fields             []*FCTemplate
@@ -278,6 +291,7 @@ func NewEventExporterCfg(ID, exportType, exportPath, failedPostsDir string, atte
 		ExportPath:     exportPath,
 		FailedPostsDir: failedPostsDir,
 		Attempts:       attempts,
+		RetryBackoff:   time.Second,
 		Opts:           opts,
 	}
 }
@@ -534,6 +548,18 @@ func (eeOpts *EventExporterOpts) loadFromJSONCfg(jsnCfg *EventExporterOptsJson)
 	if jsnCfg.CSVFieldSeparator != nil {
 		eeOpts.CSVFieldSeparator = jsnCfg.CSVFieldSeparator
 	}
+	if jsnCfg.FileColumnTypes != nil {
+		eeOpts.FileColumnTypes = make(map[string]string)
+		for col, typ := range jsnCfg.FileColumnTypes {
+			eeOpts.FileColumnTypes[col] = typ
+		}
+	}
+	if jsnCfg.ParquetCompression != nil {
+		eeOpts.ParquetCompression = jsnCfg.ParquetCompression
+	}
+	if jsnCfg.AvroCodec != nil {
+		eeOpts.AvroCodec = jsnCfg.AvroCodec
+	}
 	if err = eeOpts.Els.loadFromJSONCfg(jsnCfg); err != nil {
 		return
 	}
@@ -599,6 +625,24 @@ func (eeC *EventExporterCfg) loadFromJSONCfg(jsnEec *EventExporterJsonCfg, msgTe
 	if jsnEec.Attempts != nil {
 		eeC.Attempts = *jsnEec.Attempts
 	}
+	if jsnEec.Retry_backoff != nil {
+		if eeC.RetryBackoff, err = utils.ParseDurationWithNanosecs(*jsnEec.Retry_backoff); err != nil {
+			return
+		}
+	}
+	if jsnEec.Retry_max_backoff != nil {
+		if eeC.RetryMaxBackoff, err = utils.ParseDurationWithNanosecs(*jsnEec.Retry_max_backoff); err != nil {
+			return
+		}
+	}
+	if jsnEec.Retry_jitter != nil {
+		eeC.RetryJitter = *jsnEec.Retry_jitter
+	}
+	if jsnEec.Retry_max_age != nil {
+		if eeC.RetryMaxAge, err = utils.ParseDurationWithNanosecs(*jsnEec.Retry_max_age); err != nil {
+			return
+		}
+	}
 	if jsnEec.Concurrent_requests != nil {
 		eeC.ConcurrentRequests = *jsnEec.Concurrent_requests
 	}
@@ -894,6 +938,20 @@ func (eeOpts *EventExporterOpts) Clone() *EventExporterOpts {
 		cln.CSVFieldSeparator = new(string)
 		*cln.CSVFieldSeparator = *eeOpts.CSVFieldSeparator
 	}
+	if eeOpts.FileColumnTypes != nil {
+		cln.FileColumnTypes = make(map[string]string)
+		for col, typ := range eeOpts.FileColumnTypes {
+			cln.FileColumnTypes[col] = typ
+		}
+	}
+	if eeOpts.ParquetCompression != nil {
+		cln.ParquetCompression = new(string)
+		*cln.ParquetCompression = *eeOpts.ParquetCompression
+	}
+	if eeOpts.AvroCodec != nil {
+		cln.AvroCodec = new(string)
+		*cln.AvroCodec = *eeOpts.AvroCodec
+	}
 	if eeOpts.Els != nil {
 		cln.Els = eeOpts.Els.Clone()
 	}
@@ -929,6 +987,10 @@ func (eeC EventExporterCfg) Clone() (cln *EventExporterCfg) {
 		AttributeSCtx:      eeC.AttributeSCtx,
 		Synchronous:        eeC.Synchronous,
 		Attempts:           eeC.Attempts,
+		RetryBackoff:       eeC.RetryBackoff,
+		RetryMaxBackoff:    eeC.RetryMaxBackoff,
+		RetryJitter:        eeC.RetryJitter,
+		RetryMaxAge:        eeC.RetryMaxAge,
 		ConcurrentRequests: eeC.ConcurrentRequests,
 		Fields:             make([]*FCTemplate, len(eeC.Fields)),
 		headerFields:       make([]*FCTemplate, len(eeC.headerFields)),
@@ -972,6 +1034,15 @@ func (eeC *EventExporterCfg) AsMapInterface(separator string) (initialMP map[str
 	if eeC.Opts.CSVFieldSeparator != nil {
 		opts[utils.CSVFieldSepOpt] = *eeC.Opts.CSVFieldSeparator
 	}
+	if eeC.Opts.FileColumnTypes != nil {
+		opts[utils.FileColumnTypesOpt] = eeC.Opts.FileColumnTypes
+	}
+	if eeC.Opts.ParquetCompression != nil {
+		opts[utils.ParquetCompressionOpt] = *eeC.Opts.ParquetCompression
+	}
+	if eeC.Opts.AvroCodec != nil {
+		opts[utils.AvroCodecOpt] = *eeC.Opts.AvroCodec
+	}
 	if elsOpts := eeC.Opts.Els; elsOpts != nil {
 		if elsOpts.Index != nil {
 			opts[utils.ElsIndex] = *elsOpts.Index
@@ -1149,6 +1220,10 @@ func (eeC *EventExporterCfg) AsMapInterface(separator string) (initialMP map[str
 		utils.AttributeIDsCfg:       eeC.AttributeSIDs,
 		utils.SynchronousCfg:        eeC.Synchronous,
 		utils.AttemptsCfg:           eeC.Attempts,
+		utils.RetryBackoffCfg:       eeC.RetryBackoff.String(),
+		utils.RetryMaxBackoffCfg:    eeC.RetryMaxBackoff.String(),
+		utils.RetryJitterCfg:        eeC.RetryJitter,
+		utils.RetryMaxAgeCfg:        eeC.RetryMaxAge.String(),
 		utils.ConcurrentRequestsCfg: eeC.ConcurrentRequests,
 		utils.FailedPostsDirCfg:     eeC.FailedPostsDir,
 		utils.OptsCfg:               opts,
@@ -124,6 +124,14 @@ func TestEESClone(t *testing.T) {
 				Precache:  false,
 				Replicate: false,
 			},
+			utils.MetaFileParquet: {
+				Limit: -1,
+				TTL:   5 * time.Second,
+			},
+			utils.MetaFileAvro: {
+				Limit: -1,
+				TTL:   5 * time.Second,
+			},
 		},
 		Exporters: []*EventExporterCfg{
 			{
@@ -132,6 +140,7 @@ func TestEESClone(t *testing.T) {
 				Synchronous:   false,
 				ExportPath:    "/var/spool/cgrates/ees",
 				Attempts:      1,
+				RetryBackoff:  time.Second,
 				Timezone:      utils.EmptyString,
 				AttributeSCtx: utils.EmptyString,
 				Filters:       []string{},
@@ -158,6 +167,7 @@ func TestEESClone(t *testing.T) {
 				Synchronous:    false,
 				ExportPath:     "/var/spool/cgrates/ees",
 				Attempts:       2,
+				RetryBackoff:   time.Second,
 				Timezone:       "local",
 				Filters:        []string{"randomFiletrs"},
 				AttributeSIDs:  []string{"randomID"},
@@ -510,6 +520,16 @@ func TestEventExporterSameID(t *testing.T) {
 				TTL:       5 * time.Second,
 				StaticTTL: false,
 			},
+			utils.MetaFileParquet: {
+				Limit:     -1,
+				TTL:       5 * time.Second,
+				StaticTTL: false,
+			},
+			utils.MetaFileAvro: {
+				Limit:     -1,
+				TTL:       5 * time.Second,
+				StaticTTL: false,
+			},
 		},
 		Exporters: []*EventExporterCfg{
 			{
@@ -517,6 +537,7 @@ func TestEventExporterSameID(t *testing.T) {
 				Type:          utils.MetaNone,
 				ExportPath:    "/var/spool/cgrates/ees",
 				Attempts:      1,
+				RetryBackoff:  time.Second,
 				Timezone:      utils.EmptyString,
 				Filters:       []string{},
 				AttributeSIDs: []string{},
@@ -544,6 +565,7 @@ func TestEventExporterSameID(t *testing.T) {
 				AttributeSIDs: []string{},
 				ExportPath:    "/var/spool/cgrates/ees",
 				Attempts:      1,
+				RetryBackoff:  time.Second,
 				Flags:         utils.FlagsWithParams{},
 				Fields: []*FCTemplate{
 					{Tag: "CustomTag2", Path: "*exp.CustomPath2", Type: utils.MetaVariable,
@@ -648,6 +670,14 @@ func TestEEsCfgloadFromJsonCfgCase1(t *testing.T) {
 				TTL:       time.Second,
 				StaticTTL: false,
 			},
+			utils.MetaFileParquet: {
+				Limit: -1,
+				TTL:   5 * time.Second,
+			},
+			utils.MetaFileAvro: {
+				Limit: -1,
+				TTL:   5 * time.Second,
+			},
 		},
 		Exporters: []*EventExporterCfg{
 			{
@@ -655,6 +685,7 @@ func TestEEsCfgloadFromJsonCfgCase1(t *testing.T) {
 				Type:          utils.MetaNone,
 				ExportPath:    "/var/spool/cgrates/ees",
 				Attempts:      1,
+				RetryBackoff:  time.Second,
 				Timezone:      utils.EmptyString,
 				Filters:       []string{},
 				AttributeSIDs: []string{},
@@ -684,6 +715,7 @@ func TestEEsCfgloadFromJsonCfgCase1(t *testing.T) {
 				Timezone:      "UTC",
 				Synchronous:   true,
 				Attempts:      1,
+				RetryBackoff:  time.Second,
 				headerFields:  []*FCTemplate{},
 				trailerFields: []*FCTemplate{},
 				contentFields: []*FCTemplate{
@@ -777,6 +809,14 @@ func TestEEsCfgloadFromJsonCfgCase2(t *testing.T) {
 				TTL:       time.Second,
 				StaticTTL: false,
 			},
+			utils.MetaFileParquet: {
+				Limit: -1,
+				TTL:   5 * time.Second,
+			},
+			utils.MetaFileAvro: {
+				Limit: -1,
+				TTL:   5 * time.Second,
+			},
 		},
 		Exporters: []*EventExporterCfg{
 			{
@@ -784,6 +824,7 @@ func TestEEsCfgloadFromJsonCfgCase2(t *testing.T) {
 				Type:          utils.MetaNone,
 				ExportPath:    "/var/spool/cgrates/ees",
 				Attempts:      1,
+				RetryBackoff:  time.Second,
 				Timezone:      utils.EmptyString,
 				Filters:       []string{},
 				AttributeSIDs: []string{},
@@ -813,6 +854,7 @@ func TestEEsCfgloadFromJsonCfgCase2(t *testing.T) {
 				Timezone:      "UTC",
 				Synchronous:   true,
 				Attempts:      1,
+				RetryBackoff:  time.Second,
 				headerFields:  []*FCTemplate{},
 				trailerFields: []*FCTemplate{},
 				contentFields: []*FCTemplate{
@@ -966,6 +1008,7 @@ func TestEEsCfgAsMapInterface(t *testing.T) {
     }`
 	eMap := map[string]any{
 		utils.EnabledCfg:         true,
+		utils.DeadLetterDirCfg:   "",
 		utils.AttributeSConnsCfg: []string{utils.MetaInternal, "*conn2"},
 		utils.CacheCfg: map[string]any{
 			utils.MetaFileCSV: map[string]any{
@@ -976,6 +1019,22 @@ func TestEEsCfgAsMapInterface(t *testing.T) {
 				utils.TTLCfg:       "1s",
 				utils.StaticTTLCfg: false,
 			},
+			utils.MetaFileParquet: map[string]any{
+				utils.LimitCfg:     -1,
+				utils.PrecacheCfg:  false,
+				utils.ReplicateCfg: false,
+				utils.RemoteCfg:    false,
+				utils.TTLCfg:       "5s",
+				utils.StaticTTLCfg: false,
+			},
+			utils.MetaFileAvro: map[string]any{
+				utils.LimitCfg:     -1,
+				utils.PrecacheCfg:  false,
+				utils.ReplicateCfg: false,
+				utils.RemoteCfg:    false,
+				utils.TTLCfg:       "5s",
+				utils.StaticTTLCfg: false,
+			},
 		},
 		utils.ExportersCfg: []map[string]any{
 			{
@@ -1043,6 +1102,10 @@ func TestEEsCfgAsMapInterface(t *testing.T) {
 				utils.AttributeContextCfg:   utils.EmptyString,
 				utils.SynchronousCfg:        false,
 				utils.AttemptsCfg:           1,
+				utils.RetryBackoffCfg:       "1s",
+				utils.RetryMaxBackoffCfg:    "0s",
+				utils.RetryJitterCfg:        0.,
+				utils.RetryMaxAgeCfg:        "0s",
 				utils.ConcurrentRequestsCfg: 0,
 				utils.FieldsCfg: []map[string]any{
 					{
@@ -1111,6 +1174,7 @@ func TestEEsCfgNewEventExporterCfg(t *testing.T) {
 		ExportPath:     str,
 		FailedPostsDir: str,
 		Attempts:       1,
+		RetryBackoff:   time.Second,
 		Opts:           &EventExporterOpts{},
 	}
 
@@ -1322,6 +1386,10 @@ func TestEventExporterCfgAsMapInterface(t *testing.T) {
 		utils.AttributeIDsCfg:       eeC.AttributeSIDs,
 		utils.SynchronousCfg:        eeC.Synchronous,
 		utils.AttemptsCfg:           eeC.Attempts,
+		utils.RetryBackoffCfg:       "0s",
+		utils.RetryMaxBackoffCfg:    "0s",
+		utils.RetryJitterCfg:        0.,
+		utils.RetryMaxAgeCfg:        "0s",
 		utils.ConcurrentRequestsCfg: eeC.ConcurrentRequests,
 		utils.FailedPostsDirCfg:     eeC.FailedPostsDir,
 		utils.OptsCfg:               opts,
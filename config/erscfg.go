@@ -430,6 +430,29 @@ func (csvROpts *CSVROpts) loadFromJSONCfg(jsnCfg *EventReaderOptsJson) (err erro
 	return
 }
 
+type HTTPROpts struct {
+	Secret       *string
+	SecretHeader *string
+	Username     *string
+	Password     *string
+}
+
+func (httpOpts *HTTPROpts) loadFromJSONCfg(jsnCfg *EventReaderOptsJson) (err error) {
+	if jsnCfg.HTTPSecret != nil {
+		httpOpts.Secret = jsnCfg.HTTPSecret
+	}
+	if jsnCfg.HTTPSecretHeader != nil {
+		httpOpts.SecretHeader = jsnCfg.HTTPSecretHeader
+	}
+	if jsnCfg.HTTPUsername != nil {
+		httpOpts.Username = jsnCfg.HTTPUsername
+	}
+	if jsnCfg.HTTPPassword != nil {
+		httpOpts.Password = jsnCfg.HTTPPassword
+	}
+	return
+}
+
 type EventReaderOpts struct {
 	PartialPath        *string
 	PartialCacheAction *string
@@ -441,6 +464,7 @@ type EventReaderOpts struct {
 	NATS               *NATSROpts
 	Kafka              *KafkaROpts
 	SQL                *SQLROpts
+	HTTP               *HTTPROpts
 }
 
 // EventReaderCfg the event for the Event Reader
@@ -483,6 +507,9 @@ func (erOpts *EventReaderOpts) loadFromJSONCfg(jsnCfg *EventReaderOptsJson) (err
 	if err = erOpts.CSV.loadFromJSONCfg(jsnCfg); err != nil {
 		return
 	}
+	if err = erOpts.HTTP.loadFromJSONCfg(jsnCfg); err != nil {
+		return
+	}
 	if jsnCfg.PartialPath != nil {
 		erOpts.PartialPath = jsnCfg.PartialPath
 	}
@@ -844,6 +871,27 @@ func (natOpts *NATSROpts) Clone() *NATSROpts {
 	return cln
 }
 
+func (httpOpts *HTTPROpts) Clone() *HTTPROpts {
+	cln := &HTTPROpts{}
+	if httpOpts.Secret != nil {
+		cln.Secret = new(string)
+		*cln.Secret = *httpOpts.Secret
+	}
+	if httpOpts.SecretHeader != nil {
+		cln.SecretHeader = new(string)
+		*cln.SecretHeader = *httpOpts.SecretHeader
+	}
+	if httpOpts.Username != nil {
+		cln.Username = new(string)
+		*cln.Username = *httpOpts.Username
+	}
+	if httpOpts.Password != nil {
+		cln.Password = new(string)
+		*cln.Password = *httpOpts.Password
+	}
+	return cln
+}
+
 func (erOpts *EventReaderOpts) Clone() *EventReaderOpts {
 	cln := &EventReaderOpts{}
 	if erOpts.PartialPath != nil {
@@ -880,6 +928,9 @@ func (erOpts *EventReaderOpts) Clone() *EventReaderOpts {
 	if erOpts.AWS != nil {
 		cln.AWS = erOpts.AWS.Clone()
 	}
+	if erOpts.HTTP != nil {
+		cln.HTTP = erOpts.HTTP.Clone()
+	}
 
 	return cln
 }
@@ -1138,6 +1189,21 @@ func (er *EventReaderCfg) AsMapInterface(separator string) (initialMP map[string
 			opts[utils.NATSJetStreamMaxWaitProcessedCfg] = natsOpts.JetStreamMaxWaitProcessed.String()
 		}
 	}
+
+	if httpOpts := er.Opts.HTTP; httpOpts != nil {
+		if httpOpts.Secret != nil {
+			opts[utils.HTTPSecret] = *httpOpts.Secret
+		}
+		if httpOpts.SecretHeader != nil {
+			opts[utils.HTTPSecretHeader] = *httpOpts.SecretHeader
+		}
+		if httpOpts.Username != nil {
+			opts[utils.HTTPUsername] = *httpOpts.Username
+		}
+		if httpOpts.Password != nil {
+			opts[utils.HTTPPassword] = *httpOpts.Password
+		}
+	}
 	initialMP = map[string]any{
 		utils.IDCfg:                 er.ID,
 		utils.TypeCfg:               er.Type,
@@ -113,6 +113,7 @@ func TestERSClone(t *testing.T) {
 					NATS: &NATSROpts{
 						Subject: utils.StringPointer("cgrates_cdrs"),
 					},
+					HTTP: &HTTPROpts{},
 				},
 			},
 			{
@@ -152,6 +153,7 @@ func TestERSClone(t *testing.T) {
 					NATS: &NATSROpts{
 						Subject: utils.StringPointer("cgrates_cdrs"),
 					},
+					HTTP: &HTTPROpts{},
 				},
 			},
 		},
@@ -279,6 +281,7 @@ func TestERSLoadFromjsonCfg(t *testing.T) {
 					NATS: &NATSROpts{
 						Subject: utils.StringPointer("cgrates_cdrs"),
 					},
+					HTTP: &HTTPROpts{},
 				},
 			},
 			{
@@ -333,6 +336,7 @@ func TestERSLoadFromjsonCfg(t *testing.T) {
 					NATS: &NATSROpts{
 						Subject: utils.StringPointer("cgrates_cdrs"),
 					},
+					HTTP: &HTTPROpts{},
 				},
 			},
 		},
@@ -520,6 +524,7 @@ func TestERSloadFromJsonCase3(t *testing.T) {
 					NATS: &NATSROpts{
 						Subject: utils.StringPointer("cgrates_cdrs"),
 					},
+					HTTP: &HTTPROpts{},
 				},
 			},
 			{
@@ -558,6 +563,7 @@ func TestERSloadFromJsonCase3(t *testing.T) {
 					NATS: &NATSROpts{
 						Subject: utils.StringPointer("cgrates_cdrs"),
 					},
+					HTTP: &HTTPROpts{},
 				},
 			},
 		},
@@ -669,6 +675,7 @@ func TestERSloadFromJsonCase4(t *testing.T) {
 					NATS: &NATSROpts{
 						Subject: utils.StringPointer("cgrates_cdrs"),
 					},
+					HTTP: &HTTPROpts{},
 				},
 			},
 			{
@@ -707,6 +714,7 @@ func TestERSloadFromJsonCase4(t *testing.T) {
 					NATS: &NATSROpts{
 						Subject: utils.StringPointer("cgrates_cdrs"),
 					},
+					HTTP: &HTTPROpts{},
 				},
 			},
 		},
@@ -813,6 +821,7 @@ func TestEventReaderSameID(t *testing.T) {
 					NATS: &NATSROpts{
 						Subject: utils.StringPointer("cgrates_cdrs"),
 					},
+					HTTP: &HTTPROpts{},
 				},
 			},
 			{
@@ -847,6 +856,7 @@ func TestEventReaderSameID(t *testing.T) {
 					NATS: &NATSROpts{
 						Subject: utils.StringPointer("cgrates_cdrs"),
 					},
+					HTTP: &HTTPROpts{},
 				},
 			},
 		},
@@ -1305,6 +1315,7 @@ func TestERsloadFromJsonCfg(t *testing.T) {
 					NATS: &NATSROpts{
 						Subject: utils.StringPointer("cgrates_cdrs"),
 					},
+					HTTP: &HTTPROpts{},
 				},
 			},
 			{
@@ -1345,6 +1356,7 @@ func TestERsloadFromJsonCfg(t *testing.T) {
 					NATS: &NATSROpts{
 						Subject: utils.StringPointer("cgrates_cdrs"),
 					},
+					HTTP: &HTTPROpts{},
 				},
 			},
 		},
@@ -1440,6 +1452,7 @@ func TestEventReaderOptsCfg(t *testing.T) {
 			AMQP:  &AMQPROpts{},
 			AWS:   &AWSROpts{},
 			NATS:  &NATSROpts{},
+			HTTP:  &HTTPROpts{},
 			Kafka: &KafkaROpts{},
 			SQL:   &SQLROpts{},
 		},
@@ -1545,6 +1558,7 @@ func TestEventReaderCfgClone(t *testing.T) {
 				JetStreamMaxWaitProcessed:     utils.DurationPointer(1 * time.Minute),
 				SubjectProcessed:              utils.StringPointer("process"),
 			},
+			HTTP: &HTTPROpts{},
 			Kafka: &KafkaROpts{
 				Topic:          utils.StringPointer("kafka"),
 				MaxWait:        utils.DurationPointer(1 * time.Minute),
@@ -1879,3 +1893,47 @@ func TestErsCfgloadFromJSONCfg(t *testing.T) {
 		}
 	}
 }
+
+func TestEventReaderCfgHTTPOpts(t *testing.T) {
+	secret := "secret"
+	hdr := "X-Token"
+	usr := "cgrates"
+	httpOpts := &HTTPROpts{}
+	exp := &HTTPROpts{
+		Secret:       &secret,
+		SecretHeader: &hdr,
+		Username:     &usr,
+		Password:     &secret,
+	}
+	if err := httpOpts.loadFromJSONCfg(&EventReaderOptsJson{
+		HTTPSecret:       &secret,
+		HTTPSecretHeader: &hdr,
+		HTTPUsername:     &usr,
+		HTTPPassword:     &secret,
+	}); err != nil {
+		t.Error(err)
+	} else if !reflect.DeepEqual(httpOpts, exp) {
+		t.Errorf("expected %v received %v", utils.ToJSON(exp), utils.ToJSON(httpOpts))
+	}
+
+	if rcv := httpOpts.Clone(); !reflect.DeepEqual(httpOpts, rcv) {
+		t.Errorf("expected %v received %v", utils.ToJSON(httpOpts), utils.ToJSON(rcv))
+	} else if rcv.Secret == httpOpts.Secret {
+		t.Error("expected a deep copy")
+	}
+
+	er := &EventReaderCfg{
+		Opts: &EventReaderOpts{
+			HTTP: httpOpts,
+		},
+	}
+	expOpts := map[string]any{
+		utils.HTTPSecret:       secret,
+		utils.HTTPSecretHeader: hdr,
+		utils.HTTPUsername:     usr,
+		utils.HTTPPassword:     secret,
+	}
+	if rcv := er.AsMapInterface("")[utils.OptsCfg]; !reflect.DeepEqual(expOpts, rcv) {
+		t.Errorf("expected %v received %v", utils.ToJSON(expOpts), utils.ToJSON(rcv))
+	}
+}
@@ -19,17 +19,23 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>
 package config
 
 import (
+	"time"
+
 	"github.com/cgrates/cgrates/utils"
 )
 
 // FilterSCfg the filters config section
 type FilterSCfg struct {
-	StatSConns     []string
-	ResourceSConns []string
-	ApierSConns    []string
+	StatSConns      []string
+	ResourceSConns  []string
+	ApierSConns     []string
+	ThresholdSConns []string
+	RouteSConns     []string
+	HTTPProfiles    map[string]*HTTPProfile
+	GeoIPDBPath     string
 }
 
-func (fSCfg *FilterSCfg) loadFromJSONCfg(jsnCfg *FilterSJsonCfg) (err error) {
+func (fSCfg *FilterSCfg) loadFromJSONCfg(jsnCfg *FilterSJsonCfg, sep string) (err error) {
 	if jsnCfg == nil {
 		return
 	}
@@ -63,11 +69,49 @@ func (fSCfg *FilterSCfg) loadFromJSONCfg(jsnCfg *FilterSJsonCfg) (err error) {
 			}
 		}
 	}
+	if jsnCfg.Thresholds_conns != nil {
+		fSCfg.ThresholdSConns = make([]string, len(*jsnCfg.Thresholds_conns))
+		for idx, connID := range *jsnCfg.Thresholds_conns {
+			// if we have the connection internal we change the name so we can have internal rpc for each subsystem
+			fSCfg.ThresholdSConns[idx] = connID
+			if connID == utils.MetaInternal {
+				fSCfg.ThresholdSConns[idx] = utils.ConcatenatedKey(utils.MetaInternal, utils.MetaThresholds)
+			}
+		}
+	}
+	if jsnCfg.Routes_conns != nil {
+		fSCfg.RouteSConns = make([]string, len(*jsnCfg.Routes_conns))
+		for idx, connID := range *jsnCfg.Routes_conns {
+			// if we have the connection internal we change the name so we can have internal rpc for each subsystem
+			fSCfg.RouteSConns[idx] = connID
+			if connID == utils.MetaInternal {
+				fSCfg.RouteSConns[idx] = utils.ConcatenatedKey(utils.MetaInternal, utils.MetaRoutes)
+			}
+		}
+	}
+	if jsnCfg.Http_profiles != nil {
+		if fSCfg.HTTPProfiles == nil {
+			fSCfg.HTTPProfiles = make(map[string]*HTTPProfile)
+		}
+		for id, jsnPrf := range jsnCfg.Http_profiles {
+			prf, has := fSCfg.HTTPProfiles[id]
+			if !has {
+				prf = new(HTTPProfile)
+				fSCfg.HTTPProfiles[id] = prf
+			}
+			if err = prf.loadFromJSONCfg(jsnPrf, sep); err != nil {
+				return
+			}
+		}
+	}
+	if jsnCfg.Geoip_db_path != nil {
+		fSCfg.GeoIPDBPath = *jsnCfg.Geoip_db_path
+	}
 	return
 }
 
 // AsMapInterface returns the config as a map[string]any
-func (fSCfg *FilterSCfg) AsMapInterface() (initialMP map[string]any) {
+func (fSCfg *FilterSCfg) AsMapInterface(separator string) (initialMP map[string]any) {
 	initialMP = make(map[string]any)
 	if fSCfg.StatSConns != nil {
 		statSConns := make([]string, len(fSCfg.StatSConns))
@@ -99,12 +143,40 @@ func (fSCfg *FilterSCfg) AsMapInterface() (initialMP map[string]any) {
 		}
 		initialMP[utils.ApierSConnsCfg] = apierConns
 	}
+	if fSCfg.ThresholdSConns != nil {
+		thresholdSConns := make([]string, len(fSCfg.ThresholdSConns))
+		for i, item := range fSCfg.ThresholdSConns {
+			thresholdSConns[i] = item
+			if item == utils.ConcatenatedKey(utils.MetaInternal, utils.MetaThresholds) {
+				thresholdSConns[i] = utils.MetaInternal
+			}
+		}
+		initialMP[utils.ThresholdSConnsCfg] = thresholdSConns
+	}
+	if fSCfg.RouteSConns != nil {
+		routeSConns := make([]string, len(fSCfg.RouteSConns))
+		for i, item := range fSCfg.RouteSConns {
+			routeSConns[i] = item
+			if item == utils.ConcatenatedKey(utils.MetaInternal, utils.MetaRoutes) {
+				routeSConns[i] = utils.MetaInternal
+			}
+		}
+		initialMP[utils.RouteSConnsCfg] = routeSConns
+	}
+	httpPrfs := make(map[string]any)
+	for id, prf := range fSCfg.HTTPProfiles {
+		httpPrfs[id] = prf.AsMapInterface(separator)
+	}
+	initialMP[utils.HTTPProfilesCfg] = httpPrfs
+	initialMP[utils.GeoIPDBPathCfg] = fSCfg.GeoIPDBPath
 	return
 }
 
 // Clone returns a deep copy of FilterSCfg
 func (fSCfg FilterSCfg) Clone() (cln *FilterSCfg) {
-	cln = new(FilterSCfg)
+	cln = &FilterSCfg{
+		GeoIPDBPath: fSCfg.GeoIPDBPath,
+	}
 	if fSCfg.StatSConns != nil {
 		cln.StatSConns = make([]string, len(fSCfg.StatSConns))
 		for i, con := range fSCfg.StatSConns {
@@ -123,5 +195,88 @@ func (fSCfg FilterSCfg) Clone() (cln *FilterSCfg) {
 			cln.ApierSConns[i] = con
 		}
 	}
+	if fSCfg.ThresholdSConns != nil {
+		cln.ThresholdSConns = make([]string, len(fSCfg.ThresholdSConns))
+		for i, con := range fSCfg.ThresholdSConns {
+			cln.ThresholdSConns[i] = con
+		}
+	}
+	if fSCfg.RouteSConns != nil {
+		cln.RouteSConns = make([]string, len(fSCfg.RouteSConns))
+		for i, con := range fSCfg.RouteSConns {
+			cln.RouteSConns[i] = con
+		}
+	}
+	if fSCfg.HTTPProfiles != nil {
+		cln.HTTPProfiles = make(map[string]*HTTPProfile)
+		for id, prf := range fSCfg.HTTPProfiles {
+			cln.HTTPProfiles[id] = prf.Clone()
+		}
+	}
+	return
+}
+
+// HTTPProfile is used by the ~*http.<ProfileID> dynamic lookups to query
+// an external HTTP service with an URL built out of the event fields
+type HTTPProfile struct {
+	URL     RSRParsers
+	Timeout time.Duration
+	Headers map[string]string
+}
+
+func (hp *HTTPProfile) loadFromJSONCfg(jsnCfg *HTTPProfileJsonCfg, sep string) (err error) {
+	if jsnCfg == nil {
+		return
+	}
+	if jsnCfg.Url != nil {
+		if hp.URL, err = NewRSRParsers(*jsnCfg.Url, sep); err != nil {
+			return
+		}
+	}
+	if jsnCfg.Timeout != nil {
+		if hp.Timeout, err = utils.ParseDurationWithNanosecs(*jsnCfg.Timeout); err != nil {
+			return
+		}
+	}
+	if jsnCfg.Headers != nil {
+		if hp.Headers == nil {
+			hp.Headers = make(map[string]string)
+		}
+		for k, v := range jsnCfg.Headers {
+			hp.Headers[k] = v
+		}
+	}
+	return
+}
+
+// AsMapInterface returns the config as a map[string]any
+func (hp *HTTPProfile) AsMapInterface(separator string) (initialMP map[string]any) {
+	initialMP = map[string]any{
+		utils.URLCfg:     hp.URL.GetRule(separator),
+		utils.TimeoutCfg: "0",
+	}
+	if hp.Timeout != 0 {
+		initialMP[utils.TimeoutCfg] = hp.Timeout.String()
+	}
+	headers := make(map[string]any)
+	for k, v := range hp.Headers {
+		headers[k] = v
+	}
+	initialMP[utils.HeadersCfg] = headers
+	return
+}
+
+// Clone returns a deep copy of HTTPProfile
+func (hp HTTPProfile) Clone() (cln *HTTPProfile) {
+	cln = &HTTPProfile{
+		URL:     hp.URL.Clone(),
+		Timeout: hp.Timeout,
+	}
+	if hp.Headers != nil {
+		cln.Headers = make(map[string]string)
+		for k, v := range hp.Headers {
+			cln.Headers[k] = v
+		}
+	}
 	return
 }
@@ -20,23 +20,44 @@ package config
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/cgrates/cgrates/utils"
 )
 
 func TestFilterSCfgloadFromJsonCfg(t *testing.T) {
 	cfgJSONS := &FilterSJsonCfg{
-		Stats_conns:     &[]string{utils.MetaInternal, "*conn1"},
-		Resources_conns: &[]string{utils.MetaInternal, "*conn1"},
-		Apiers_conns:    &[]string{utils.MetaInternal, "*conn1"},
+		Stats_conns:      &[]string{utils.MetaInternal, "*conn1"},
+		Resources_conns:  &[]string{utils.MetaInternal, "*conn1"},
+		Apiers_conns:     &[]string{utils.MetaInternal, "*conn1"},
+		Thresholds_conns: &[]string{utils.MetaInternal, "*conn1"},
+		Routes_conns:     &[]string{utils.MetaInternal, "*conn1"},
+		Http_profiles: map[string]*HTTPProfileJsonCfg{
+			"MNP": {
+				Url:     utils.StringPointer("http://127.0.0.1:8080/mnp?number=;~*req.Destination"),
+				Timeout: utils.StringPointer("2s"),
+				Headers: map[string]string{"Authorization": "Bearer token"},
+			},
+		},
+		Geoip_db_path: utils.StringPointer("/usr/share/GeoIP/GeoLite2-City.mmdb"),
 	}
 	expected := &FilterSCfg{
-		StatSConns:     []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaStats), "*conn1"},
-		ResourceSConns: []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaResources), "*conn1"},
-		ApierSConns:    []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaApier), "*conn1"},
+		StatSConns:      []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaStats), "*conn1"},
+		ResourceSConns:  []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaResources), "*conn1"},
+		ApierSConns:     []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaApier), "*conn1"},
+		ThresholdSConns: []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaThresholds), "*conn1"},
+		RouteSConns:     []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaRoutes), "*conn1"},
+		HTTPProfiles: map[string]*HTTPProfile{
+			"MNP": {
+				URL:     NewRSRParsersMustCompile("http://127.0.0.1:8080/mnp?number=;~*req.Destination", utils.InfieldSep),
+				Timeout: 2 * time.Second,
+				Headers: map[string]string{"Authorization": "Bearer token"},
+			},
+		},
+		GeoIPDBPath: "/usr/share/GeoIP/GeoLite2-City.mmdb",
 	}
 	jsnCfg := NewDefaultCGRConfig()
-	if err = jsnCfg.filterSCfg.loadFromJSONCfg(cfgJSONS); err != nil {
+	if err = jsnCfg.filterSCfg.loadFromJSONCfg(cfgJSONS, utils.InfieldSep); err != nil {
 		t.Error(err)
 	} else if !reflect.DeepEqual(expected, jsnCfg.filterSCfg) {
 		t.Errorf("Expected %+v \n, received %+v", utils.ToJSON(expected), utils.ToJSON(jsnCfg.filterSCfg))
@@ -49,16 +70,35 @@ func TestFilterSCfgAsMapInterface(t *testing.T) {
 			"stats_conns": ["*internal:*stats", "*conn1"],						
 			"resources_conns": ["*internal:*resources", "*conn1"],
             "apiers_conns": ["*internal:*apier", "*conn1"],
+			"thresholds_conns": ["*internal:*thresholds", "*conn1"],
+			"routes_conns": ["*internal:*routes", "*conn1"],
+			"http_profiles": {
+				"MNP": {
+					"url": "http://127.0.0.1:8080/mnp?number=;~*req.Destination",
+					"headers": {"Authorization": "Bearer token"},
+				},
+			},
+			"geoip_db_path": "/usr/share/GeoIP/GeoLite2-City.mmdb",
 	},
 }`
 	eMap := map[string]any{
-		utils.StatSConnsCfg:     []string{utils.MetaInternal, "*conn1"},
-		utils.ResourceSConnsCfg: []string{utils.MetaInternal, "*conn1"},
-		utils.ApierSConnsCfg:    []string{utils.MetaInternal, "*conn1"},
+		utils.StatSConnsCfg:      []string{utils.MetaInternal, "*conn1"},
+		utils.ResourceSConnsCfg:  []string{utils.MetaInternal, "*conn1"},
+		utils.ApierSConnsCfg:     []string{utils.MetaInternal, "*conn1"},
+		utils.ThresholdSConnsCfg: []string{utils.MetaInternal, "*conn1"},
+		utils.RouteSConnsCfg:     []string{utils.MetaInternal, "*conn1"},
+		utils.HTTPProfilesCfg: map[string]any{
+			"MNP": map[string]any{
+				utils.URLCfg:     "http://127.0.0.1:8080/mnp?number=;~*req.Destination",
+				utils.TimeoutCfg: "0",
+				utils.HeadersCfg: map[string]any{"Authorization": "Bearer token"},
+			},
+		},
+		utils.GeoIPDBPathCfg: "/usr/share/GeoIP/GeoLite2-City.mmdb",
 	}
 	if cgrCfg, err := NewCGRConfigFromJSONStringWithDefaults(cfgJSONStr); err != nil {
 		t.Error(err)
-	} else if rcv := cgrCfg.filterSCfg.AsMapInterface(); !reflect.DeepEqual(rcv, eMap) {
+	} else if rcv := cgrCfg.filterSCfg.AsMapInterface(cgrCfg.GeneralCfg().RSRSep); !reflect.DeepEqual(rcv, eMap) {
 		t.Errorf("Expected %+v, received %+v", eMap, rcv)
 	}
 }
@@ -68,13 +108,17 @@ func TestFilterSCfgAsMapInterface2(t *testing.T) {
       "filters": {}
 }`
 	eMap := map[string]any{
-		utils.StatSConnsCfg:     []string{},
-		utils.ResourceSConnsCfg: []string{},
-		utils.ApierSConnsCfg:    []string{},
+		utils.StatSConnsCfg:      []string{},
+		utils.ResourceSConnsCfg:  []string{},
+		utils.ApierSConnsCfg:     []string{},
+		utils.ThresholdSConnsCfg: []string{},
+		utils.RouteSConnsCfg:     []string{},
+		utils.HTTPProfilesCfg:    map[string]any{},
+		utils.GeoIPDBPathCfg:     "",
 	}
 	if cgrCfg, err := NewCGRConfigFromJSONStringWithDefaults(cfgJSONStr); err != nil {
 		t.Error(err)
-	} else if rcv := cgrCfg.filterSCfg.AsMapInterface(); !reflect.DeepEqual(rcv, eMap) {
+	} else if rcv := cgrCfg.filterSCfg.AsMapInterface(cgrCfg.GeneralCfg().RSRSep); !reflect.DeepEqual(rcv, eMap) {
 		t.Errorf("Expected %+v, received %+v", eMap, rcv)
 	}
 }
@@ -84,6 +128,14 @@ func TestFilterSCfgClone(t *testing.T) {
 		StatSConns:     []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaStats), "*conn1"},
 		ResourceSConns: []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaResources), "*conn1"},
 		ApierSConns:    []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaApier), "*conn1"},
+		HTTPProfiles: map[string]*HTTPProfile{
+			"MNP": {
+				URL:     NewRSRParsersMustCompile("http://127.0.0.1:8080/mnp?number=;~*req.Destination", utils.InfieldSep),
+				Timeout: 2 * time.Second,
+				Headers: map[string]string{"Authorization": "Bearer token"},
+			},
+		},
+		GeoIPDBPath: "/usr/share/GeoIP/GeoLite2-City.mmdb",
 	}
 	rcv := ban.Clone()
 	if !reflect.DeepEqual(ban, rcv) {
@@ -98,4 +150,7 @@ func TestFilterSCfgClone(t *testing.T) {
 	if rcv.ApierSConns[1] = ""; ban.ApierSConns[1] != "*conn1" {
 		t.Errorf("Expected clone to not modify the cloned")
 	}
+	if rcv.HTTPProfiles["MNP"].Headers["Authorization"] = ""; ban.HTTPProfiles["MNP"].Headers["Authorization"] != "Bearer token" {
+		t.Errorf("Expected clone to not modify the cloned")
+	}
 }
@@ -51,6 +51,8 @@ type GeneralCfg struct {
 	DigestEqual          string        //
 	RSRSep               string        // separator used to split RSRParser (by default is used ";")
 	MaxParallelConns     int           // the maximum number of connections used by the *parallel strategy
+
+	nodeIDCfg bool // the NodeID is configured, not generated on start
 }
 
 // loadFromJSONCfg loads General config from JsonCfg
@@ -60,6 +62,7 @@ func (gencfg *GeneralCfg) loadFromJSONCfg(jsnGeneralCfg *GeneralJsonCfg) (err er
 	}
 	if jsnGeneralCfg.Node_id != nil && *jsnGeneralCfg.Node_id != "" {
 		gencfg.NodeID = *jsnGeneralCfg.Node_id
+		gencfg.nodeIDCfg = true
 	}
 	if jsnGeneralCfg.Logger != nil {
 		gencfg.Logger = *jsnGeneralCfg.Logger
@@ -201,6 +204,7 @@ func (gencfg *GeneralCfg) AsMapInterface() (initialMP map[string]any) {
 func (gencfg GeneralCfg) Clone() *GeneralCfg {
 	return &GeneralCfg{
 		NodeID:               gencfg.NodeID,
+		nodeIDCfg:            gencfg.nodeIDCfg,
 		Logger:               gencfg.Logger,
 		LogLevel:             gencfg.LogLevel,
 		RoundingDecimals:     gencfg.RoundingDecimals,
@@ -48,6 +48,7 @@ func TestGeneralCfgloadFromJsonCfg(t *testing.T) {
 
 	expected := &GeneralCfg{
 		NodeID:           "randomID",
+		nodeIDCfg:        true,
 		Logger:           utils.MetaSysLog,
 		LogLevel:         6,
 		RoundingDecimals: 5,
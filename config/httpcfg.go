@@ -33,6 +33,7 @@ type HTTPCfg struct {
 	HTTPWSURL             string            // WebSocket relative URL ("" to disable)
 	HTTPFreeswitchCDRsURL string            // Freeswitch CDRS relative URL ("" to disable)
 	HTTPCDRsURL           string            // CDRS relative URL ("" to disable)
+	HTTPMetricsURL        string            // Prometheus metrics relative URL ("" to disable)
 	HTTPUseBasicAuth      bool              // Use basic auth for HTTP API
 	HTTPAuthUsers         map[string]string // Basic auth user:password map (base64 passwords)
 	ClientOpts            *http.Transport
@@ -132,6 +133,9 @@ func (httpcfg *HTTPCfg) loadFromJSONCfg(jsnHTTPCfg *HTTPJsonCfg) (err error) {
 	if jsnHTTPCfg.Http_Cdrs != nil {
 		httpcfg.HTTPCDRsURL = *jsnHTTPCfg.Http_Cdrs
 	}
+	if jsnHTTPCfg.Metrics_url != nil {
+		httpcfg.HTTPMetricsURL = *jsnHTTPCfg.Metrics_url
+	}
 	if jsnHTTPCfg.Use_basic_auth != nil {
 		httpcfg.HTTPUseBasicAuth = *jsnHTTPCfg.Use_basic_auth
 	}
@@ -174,6 +178,7 @@ func (httpcfg *HTTPCfg) AsMapInterface() map[string]any {
 		utils.HTTPWSURLCfg:             httpcfg.HTTPWSURL,
 		utils.HTTPFreeswitchCDRsURLCfg: httpcfg.HTTPFreeswitchCDRsURL,
 		utils.HTTPCDRsURLCfg:           httpcfg.HTTPCDRsURL,
+		utils.HTTPMetricsURLCfg:        httpcfg.HTTPMetricsURL,
 		utils.HTTPUseBasicAuthCfg:      httpcfg.HTTPUseBasicAuth,
 		utils.HTTPAuthUsersCfg:         httpcfg.HTTPAuthUsers,
 		utils.HTTPClientOptsCfg:        clientOpts,
@@ -194,6 +199,7 @@ func (httpcfg HTTPCfg) Clone() (cln *HTTPCfg) {
 		HTTPWSURL:             httpcfg.HTTPWSURL,
 		HTTPFreeswitchCDRsURL: httpcfg.HTTPFreeswitchCDRsURL,
 		HTTPCDRsURL:           httpcfg.HTTPCDRsURL,
+		HTTPMetricsURL:        httpcfg.HTTPMetricsURL,
 		HTTPUseBasicAuth:      httpcfg.HTTPUseBasicAuth,
 		HTTPAuthUsers:         make(map[string]string),
 		ClientOpts:            httpcfg.ClientOpts.Clone(),
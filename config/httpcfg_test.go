@@ -88,6 +88,7 @@ func TestHTTPCfgAsMapInterface(t *testing.T) {
 		utils.HTTPWSURLCfg:             "/ws",
 		utils.HTTPFreeswitchCDRsURLCfg: "/freeswitch_json",
 		utils.HTTPCDRsURLCfg:           "/cdr_http",
+		utils.HTTPMetricsURLCfg:        "",
 		utils.HTTPUseBasicAuthCfg:      false,
 		utils.HTTPAuthUsersCfg:         map[string]string{},
 		utils.HTTPClientOptsCfg: map[string]any{
@@ -129,6 +130,7 @@ func TestHTTPCfgAsMapInterface1(t *testing.T) {
 		utils.HTTPWSURLCfg:             "",
 		utils.HTTPFreeswitchCDRsURLCfg: "/freeswitch_json",
 		utils.HTTPCDRsURLCfg:           "/cdr_http",
+		utils.HTTPMetricsURLCfg:        "",
 		utils.HTTPUseBasicAuthCfg:      true,
 		utils.HTTPAuthUsersCfg: map[string]string{
 			"user1": "authenticated",
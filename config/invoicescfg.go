@@ -0,0 +1,116 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package config
+
+import (
+	"slices"
+	"time"
+
+	"github.com/cgrates/cgrates/utils"
+)
+
+// InvoiceSCfg is the configuration of the invoicing service
+type InvoiceSCfg struct {
+	Enabled         bool
+	EEsConns        []string      // connections towards EEs for the invoices export
+	ExporterIDs     []string      // exporters used for the invoices built on the periodic runs
+	Tenants         []string      // tenants billed on the periodic runs
+	RunInterval     time.Duration // billing period of the periodic runs, 0 to bill only on demand
+	GroupBy         string        // groups the usage line items: <*destination|*category>
+	TaxPercent      float64       // tax applied on the discounted subtotal
+	DiscountPercent float64       // discount applied on the subtotal
+}
+
+func (iCfg *InvoiceSCfg) loadFromJSONCfg(jsnCfg *InvoiceSJsonCfg) (err error) {
+	if jsnCfg == nil {
+		return
+	}
+	if jsnCfg.Enabled != nil {
+		iCfg.Enabled = *jsnCfg.Enabled
+	}
+	if jsnCfg.Ees_conns != nil {
+		iCfg.EEsConns = make([]string, len(*jsnCfg.Ees_conns))
+		for idx, connID := range *jsnCfg.Ees_conns {
+			// if we have the connection internal we change the name so we can have internal rpc for each subsystem
+			iCfg.EEsConns[idx] = connID
+			if connID == utils.MetaInternal {
+				iCfg.EEsConns[idx] = utils.ConcatenatedKey(utils.MetaInternal, utils.MetaEEs)
+			}
+		}
+	}
+	if jsnCfg.Exporter_ids != nil {
+		iCfg.ExporterIDs = slices.Clone(*jsnCfg.Exporter_ids)
+	}
+	if jsnCfg.Tenants != nil {
+		iCfg.Tenants = slices.Clone(*jsnCfg.Tenants)
+	}
+	if jsnCfg.Run_interval != nil {
+		if iCfg.RunInterval, err = utils.ParseDurationWithNanosecs(*jsnCfg.Run_interval); err != nil {
+			return
+		}
+	}
+	if jsnCfg.Group_by != nil {
+		iCfg.GroupBy = *jsnCfg.Group_by
+	}
+	if jsnCfg.Tax_percent != nil {
+		iCfg.TaxPercent = *jsnCfg.Tax_percent
+	}
+	if jsnCfg.Discount_percent != nil {
+		iCfg.DiscountPercent = *jsnCfg.Discount_percent
+	}
+	return nil
+}
+
+// AsMapInterface returns the config as a map[string]any
+func (iCfg *InvoiceSCfg) AsMapInterface() (initialMap map[string]any) {
+	initialMap = map[string]any{
+		utils.EnabledCfg:         iCfg.Enabled,
+		utils.ExporterIDsCfg:     slices.Clone(iCfg.ExporterIDs),
+		utils.Tenants:            slices.Clone(iCfg.Tenants),
+		utils.RunIntervalCfg:     iCfg.RunInterval.String(),
+		utils.GroupByCfg:         iCfg.GroupBy,
+		utils.TaxPercentCfg:      iCfg.TaxPercent,
+		utils.DiscountPercentCfg: iCfg.DiscountPercent,
+	}
+	if iCfg.EEsConns != nil {
+		eesConns := make([]string, len(iCfg.EEsConns))
+		for i, item := range iCfg.EEsConns {
+			eesConns[i] = item
+			if item == utils.ConcatenatedKey(utils.MetaInternal, utils.MetaEEs) {
+				eesConns[i] = utils.MetaInternal
+			}
+		}
+		initialMap[utils.EEsConnsCfg] = eesConns
+	}
+	return
+}
+
+// Clone returns a deep copy of InvoiceSCfg
+func (iCfg InvoiceSCfg) Clone() *InvoiceSCfg {
+	return &InvoiceSCfg{
+		Enabled:         iCfg.Enabled,
+		EEsConns:        slices.Clone(iCfg.EEsConns),
+		ExporterIDs:     slices.Clone(iCfg.ExporterIDs),
+		Tenants:         slices.Clone(iCfg.Tenants),
+		RunInterval:     iCfg.RunInterval,
+		GroupBy:         iCfg.GroupBy,
+		TaxPercent:      iCfg.TaxPercent,
+		DiscountPercent: iCfg.DiscountPercent,
+	}
+}
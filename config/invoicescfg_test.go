@@ -0,0 +1,107 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+package config
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestInvoiceSCfgloadFromJsonCfg(t *testing.T) {
+	jsonCfg := &InvoiceSJsonCfg{
+		Enabled:          utils.BoolPointer(true),
+		Ees_conns:        &[]string{utils.MetaInternal, "*conn1"},
+		Exporter_ids:     &[]string{"erp_csv"},
+		Tenants:          &[]string{"cgrates.org"},
+		Run_interval:     utils.StringPointer("720h"),
+		Group_by:         utils.StringPointer(utils.MetaCategory),
+		Tax_percent:      utils.Float64Pointer(19),
+		Discount_percent: utils.Float64Pointer(5),
+	}
+	expected := &InvoiceSCfg{
+		Enabled:         true,
+		EEsConns:        []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaEEs), "*conn1"},
+		ExporterIDs:     []string{"erp_csv"},
+		Tenants:         []string{"cgrates.org"},
+		RunInterval:     720 * time.Hour,
+		GroupBy:         utils.MetaCategory,
+		TaxPercent:      19,
+		DiscountPercent: 5,
+	}
+	jsnCfg := NewDefaultCGRConfig()
+	if err = jsnCfg.invoiceSCfg.loadFromJSONCfg(jsonCfg); err != nil {
+		t.Error(err)
+	} else if !reflect.DeepEqual(expected, jsnCfg.invoiceSCfg) {
+		t.Errorf("Expected %+v \n, received %+v", utils.ToJSON(expected), utils.ToJSON(jsnCfg.invoiceSCfg))
+	}
+	jsonCfg = &InvoiceSJsonCfg{Run_interval: utils.StringPointer("1ss")}
+	if err = jsnCfg.invoiceSCfg.loadFromJSONCfg(jsonCfg); err == nil {
+		t.Error("Expected error for the invalid run_interval")
+	}
+}
+
+func TestInvoiceSCfgAsMapInterface(t *testing.T) {
+	cfgJSONStr := `{
+	"invoices": {
+		"enabled": true,
+		"ees_conns": ["*internal", "*conn1"],
+		"run_interval": "24h",
+		"tax_percent": 19,
+	},
+}`
+	eMap := map[string]any{
+		utils.EnabledCfg:         true,
+		utils.EEsConnsCfg:        []string{utils.MetaInternal, "*conn1"},
+		utils.ExporterIDsCfg:     []string{},
+		utils.Tenants:            []string{},
+		utils.RunIntervalCfg:     "24h0m0s",
+		utils.GroupByCfg:         utils.MetaDestination,
+		utils.TaxPercentCfg:      19.,
+		utils.DiscountPercentCfg: 0.,
+	}
+	if cgrCfg, err := NewCGRConfigFromJSONStringWithDefaults(cfgJSONStr); err != nil {
+		t.Error(err)
+	} else if newMap := cgrCfg.invoiceSCfg.AsMapInterface(); !reflect.DeepEqual(eMap, newMap) {
+		t.Errorf("Expected %+v \n, received %+v", utils.ToJSON(eMap), utils.ToJSON(newMap))
+	}
+}
+
+func TestInvoiceSCfgClone(t *testing.T) {
+	iCfg := &InvoiceSCfg{
+		Enabled:     true,
+		EEsConns:    []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaEEs), "*conn1"},
+		ExporterIDs: []string{"erp_csv"},
+		Tenants:     []string{"cgrates.org"},
+		RunInterval: time.Hour,
+		GroupBy:     utils.MetaDestination,
+		TaxPercent:  19,
+	}
+	rcv := iCfg.Clone()
+	if !reflect.DeepEqual(iCfg, rcv) {
+		t.Errorf("Expected: %+v\nReceived: %+v", utils.ToJSON(iCfg), utils.ToJSON(rcv))
+	}
+	if rcv.EEsConns[1] = ""; iCfg.EEsConns[1] != "*conn1" {
+		t.Errorf("Expected clone to not modify the cloned")
+	}
+	if rcv.Tenants[0] = ""; iCfg.Tenants[0] != "cgrates.org" {
+		t.Errorf("Expected clone to not modify the cloned")
+	}
+}
@@ -84,6 +84,7 @@ type HTTPJsonCfg struct {
 	Ws_url              *string
 	Freeswitch_cdrs_url *string
 	Http_Cdrs           *string
+	Metrics_url         *string
 	Use_basic_auth      *bool
 	Auth_users          *map[string]string
 	Client_opts         *HTTPClientOptsJson
@@ -114,12 +115,17 @@ type DBOptsJson struct {
 	RedisClientCertificate  *string           `json:"redisClientCertificate"`
 	RedisClientKey          *string           `json:"redisClientKey"`
 	RedisCACertificate      *string           `json:"redisCACertificate"`
+	RedisReadPolicy         *string           `json:"redisReadPolicy"`
+	RedisReadReplicas       *[]string         `json:"redisReadReplicas"`
 	SQLMaxOpenConns         *int              `json:"sqlMaxOpenConns"`
 	SQLMaxIdleConns         *int              `json:"sqlMaxIdleConns"`
 	SQLConnMaxLifetime      *string           `json:"sqlConnMaxLifetime"`
 	MySQLDSNParams          map[string]string `json:"mysqlDSNParams"`
 	PgSSLMode               *string           `json:"pgSSLMode"`
 	MySQLLocation           *string           `json:"mysqlLocation"`
+	InternalDBDumpPath      *string           `json:"internalDBDumpPath"`
+	InternalDBDumpInterval  *string           `json:"internalDBDumpInterval"`
+	InternalDBWriteLog      *bool             `json:"internalDBWriteLog"`
 }
 
 // Database config
@@ -154,9 +160,20 @@ type ItemOptJson struct {
 
 // Filters config
 type FilterSJsonCfg struct {
-	Stats_conns     *[]string
-	Resources_conns *[]string
-	Apiers_conns    *[]string
+	Stats_conns      *[]string
+	Resources_conns  *[]string
+	Apiers_conns     *[]string
+	Thresholds_conns *[]string
+	Routes_conns     *[]string
+	Http_profiles    map[string]*HTTPProfileJsonCfg
+	Geoip_db_path    *string
+}
+
+// HTTPProfileJsonCfg is one profile of the *http dynamic lookups
+type HTTPProfileJsonCfg struct {
+	Url     *string
+	Timeout *string
+	Headers map[string]string
 }
 
 // Rater config section
@@ -169,6 +186,10 @@ type RalsJsonCfg struct {
 	Max_computed_usage         *map[string]string
 	Max_increments             *int
 	Balance_rating_subject     *map[string]string
+	Balance_ledger             *bool
+	Default_currency           *string
+	Max_transfer               *map[string]float64
+	Transfer_fee               *map[string]string
 }
 
 // Scheduler config section
@@ -195,6 +216,7 @@ type CdrsJsonCfg struct {
 	Online_cdr_exports   *[]string
 	Scheduler_conns      *[]string
 	Ees_conns            *[]string
+	Taxes                *bool
 }
 
 // EventReaderSJsonCfg contains the configuration of EventReaderService
@@ -270,6 +292,10 @@ type EventReaderOptsJson struct {
 	NATSClientCertificateProcessed    *string `json:"natsClientCertificateProcessed"`
 	NATSClientKeyProcessed            *string `json:"natsClientKeyProcessed"`
 	NATSJetStreamMaxWaitProcessed     *string `json:"natsJetStreamMaxWaitProcessed"`
+	HTTPSecret                        *string `json:"httpSecret"`
+	HTTPSecretHeader                  *string `json:"httpSecretHeader"`
+	HTTPUsername                      *string `json:"httpUsername"`
+	HTTPPassword                      *string `json:"httpPassword"`
 }
 
 // EventReaderSJsonCfg is the configuration of a single EventReader
@@ -295,11 +321,15 @@ type EEsJsonCfg struct {
 	Enabled          *bool
 	Attributes_conns *[]string
 	Cache            *map[string]*CacheParamJsonCfg
+	Dead_letter_dir  *string
 	Exporters        *[]*EventExporterJsonCfg
 }
 
 type EventExporterOptsJson struct {
 	CSVFieldSeparator           *string           `json:"csvFieldSeparator"`
+	FileColumnTypes             map[string]string `json:"fileColumnTypes"`
+	ParquetCompression          *string           `json:"parquetCompression"`
+	AvroCodec                   *string           `json:"avroCodec"`
 	ElsCloud                    *bool             `json:"elsCloud"`
 	ElsAPIKey                   *string           `json:"elsApiKey"`
 	ElsServiceToken             *string           `json:"elsServiceToken"`
@@ -379,6 +409,10 @@ type EventExporterJsonCfg struct {
 	Attribute_context   *string
 	Synchronous         *bool
 	Attempts            *int
+	Retry_backoff       *string
+	Retry_max_backoff   *string
+	Retry_jitter        *float64
+	Retry_max_age       *string
 	Failed_posts_dir    *string
 	Concurrent_requests *int
 	Fields              *[]*FcTemplateJsonCfg
@@ -410,6 +444,7 @@ type SessionSJsonCfg struct {
 	Channel_sync_interval      *string
 	Stale_chan_max_extra_usage *string
 	Terminate_attempts         *int
+	Backup_interval            *string
 	Alterable_fields           *[]string
 	Min_dur_low_balance        *string
 	Scheduler_conns            *[]string
@@ -545,11 +580,24 @@ type RadiusAgentJsonCfg struct {
 	Listen_acct         *string
 	Client_secrets      *map[string]string
 	Client_dictionaries *map[string][]string
+	Client_da_addresses *map[string]*DAClientOptsJson
+	Da_timeout          *string
+	Requests_cache_key  *string
+	Dmr_template        *string
+	Coa_template        *string
 	Sessions_conns      *[]string
 	Timezone            *string
 	Request_processors  *[]*ReqProcessorJsnCfg
 }
 
+// DAClientOptsJson describes the Dynamic Authorization client of a RADIUS NAS
+type DAClientOptsJson struct {
+	Transport *string
+	Host      *string
+	Port      *int
+	Flags     *[]string
+}
+
 // Conecto Agent configuration section
 type HttpAgentJsonCfg struct {
 	Id                 *string
@@ -598,6 +646,8 @@ type AttributeSJsonCfg struct {
 	Stats_conns           *[]string
 	Resources_conns       *[]string
 	Apiers_conns          *[]string
+	Thresholds_conns      *[]string
+	Routes_conns          *[]string
 	Indexed_selects       *bool
 	String_indexed_fields *[]string
 	Prefix_indexed_fields *[]string
@@ -716,9 +766,24 @@ type LoaderJsonCfg struct {
 	Field_separator *string
 	Tp_in_dir       *string
 	Tp_out_dir      *string
+	Remote_sources  *[]*LoaderSourceJsonCfg
 	Data            *[]*LoaderJsonDataType
 }
 
+// LoaderSourceJsonCfg the remote location the loader files are pulled from
+type LoaderSourceJsonCfg struct {
+	Type              *string
+	Url               *string
+	Processed_action  *string
+	S3_bucket         *string
+	S3_prefix         *string
+	S3_archive_prefix *string
+	Aws_region        *string
+	Aws_key           *string
+	Aws_secret        *string
+	Aws_token         *string
+}
+
 // Mailer config section
 type MailerJsonCfg struct {
 	Server        *string
@@ -848,6 +913,25 @@ type ApierJsonCfg struct {
 	Ees_conns        *[]string
 }
 
+type InvoiceSJsonCfg struct {
+	Enabled          *bool
+	Ees_conns        *[]string
+	Exporter_ids     *[]string
+	Tenants          *[]string
+	Run_interval     *string
+	Group_by         *string
+	Tax_percent      *float64
+	Discount_percent *float64
+}
+
+type TaxSJsonCfg struct {
+	Indexed_selects       *bool
+	String_indexed_fields *[]string
+	Prefix_indexed_fields *[]string
+	Suffix_indexed_fields *[]string
+	Nested_fields         *bool
+}
+
 type STIRJsonCfg struct {
 	Allowed_attest      *[]string
 	Payload_maxduration *string
@@ -892,5 +976,17 @@ type CoreSJsonCfg struct {
 	Caps                *int
 	Caps_strategy       *string
 	Caps_stats_interval *string
+	Caps_limits         *[]*CapsLimitJsonCfg
 	Shutdown_timeout    *string
 }
+
+// CapsLimitJsonCfg the config for one of the caps limits
+type CapsLimitJsonCfg struct {
+	Id                  *string
+	Tenants             *[]string
+	Api_methods         *[]string
+	Keys                *[]string
+	Concurrent_requests *int
+	Requests_per_second *float64
+	Burst               *int
+}
@@ -79,9 +79,81 @@ type LoaderSCfg struct {
 	FieldSeparator string
 	TpInDir        string
 	TpOutDir       string
+	RemoteSources  []*LoaderSourceCfg
 	Data           []*LoaderDataType
 }
 
+// LoaderSourceCfg the remote location the loader files are pulled from
+type LoaderSourceCfg struct {
+	Type            string
+	URL             string
+	ProcessedAction string
+	S3Bucket        string
+	S3Prefix        string
+	S3ArchivePrefix string
+	AWSRegion       string
+	AWSKey          string
+	AWSSecret       string
+	AWSToken        string
+}
+
+func (lSrc *LoaderSourceCfg) loadFromJSONCfg(jsnCfg *LoaderSourceJsonCfg) {
+	if jsnCfg == nil {
+		return
+	}
+	if jsnCfg.Type != nil {
+		lSrc.Type = *jsnCfg.Type
+	}
+	if jsnCfg.Url != nil {
+		lSrc.URL = *jsnCfg.Url
+	}
+	if jsnCfg.Processed_action != nil {
+		lSrc.ProcessedAction = *jsnCfg.Processed_action
+	}
+	if jsnCfg.S3_bucket != nil {
+		lSrc.S3Bucket = *jsnCfg.S3_bucket
+	}
+	if jsnCfg.S3_prefix != nil {
+		lSrc.S3Prefix = *jsnCfg.S3_prefix
+	}
+	if jsnCfg.S3_archive_prefix != nil {
+		lSrc.S3ArchivePrefix = *jsnCfg.S3_archive_prefix
+	}
+	if jsnCfg.Aws_region != nil {
+		lSrc.AWSRegion = *jsnCfg.Aws_region
+	}
+	if jsnCfg.Aws_key != nil {
+		lSrc.AWSKey = *jsnCfg.Aws_key
+	}
+	if jsnCfg.Aws_secret != nil {
+		lSrc.AWSSecret = *jsnCfg.Aws_secret
+	}
+	if jsnCfg.Aws_token != nil {
+		lSrc.AWSToken = *jsnCfg.Aws_token
+	}
+}
+
+// AsMapInterface returns the config as a map[string]any
+func (lSrc *LoaderSourceCfg) AsMapInterface() map[string]any {
+	return map[string]any{
+		utils.TypeCf:             lSrc.Type,
+		utils.URLCfg:             lSrc.URL,
+		utils.ProcessedActionCfg: lSrc.ProcessedAction,
+		utils.S3BucketCfg:        lSrc.S3Bucket,
+		utils.S3PrefixCfg:        lSrc.S3Prefix,
+		utils.S3ArchivePrefixCfg: lSrc.S3ArchivePrefix,
+		utils.AWSRegionCfg:       lSrc.AWSRegion,
+		utils.AWSKeyCfg:          lSrc.AWSKey,
+		utils.AWSSecretCfg:       lSrc.AWSSecret,
+		utils.AWSTokenCfg:        lSrc.AWSToken,
+	}
+}
+
+// Clone itself into a new LoaderSourceCfg
+func (lSrc LoaderSourceCfg) Clone() *LoaderSourceCfg {
+	return &lSrc
+}
+
 // LoaderDataType the template for profile loading
 type LoaderDataType struct {
 	Type     string
@@ -160,6 +232,13 @@ func (l *LoaderSCfg) loadFromJSONCfg(jsnCfg *LoaderJsonCfg, msgTemplates map[str
 	if jsnCfg.Lockfile_path != nil {
 		l.LockFilePath = *jsnCfg.Lockfile_path
 	}
+	if jsnCfg.Remote_sources != nil {
+		l.RemoteSources = make([]*LoaderSourceCfg, len(*jsnCfg.Remote_sources))
+		for idx, jsnSrc := range *jsnCfg.Remote_sources {
+			l.RemoteSources[idx] = &LoaderSourceCfg{ProcessedAction: utils.MetaNone}
+			l.RemoteSources[idx].loadFromJSONCfg(jsnSrc)
+		}
+	}
 	if jsnCfg.Data != nil {
 		data := make([]*LoaderDataType, len(*jsnCfg.Data))
 		for idx, jsnLoCfg := range *jsnCfg.Data {
@@ -221,6 +300,12 @@ func (l LoaderSCfg) Clone() (cln *LoaderSCfg) {
 	for idx, fld := range l.Data {
 		cln.Data[idx] = fld.Clone()
 	}
+	if l.RemoteSources != nil {
+		cln.RemoteSources = make([]*LoaderSourceCfg, len(l.RemoteSources))
+		for idx, src := range l.RemoteSources {
+			cln.RemoteSources[idx] = src.Clone()
+		}
+	}
 	return
 }
 
@@ -260,6 +345,13 @@ func (l *LoaderSCfg) AsMapInterface(separator string) (initialMP map[string]any)
 		}
 		initialMP[utils.DataCfg] = data
 	}
+	if l.RemoteSources != nil {
+		remoteSources := make([]map[string]any, len(l.RemoteSources))
+		for i, item := range l.RemoteSources {
+			remoteSources[i] = item.AsMapInterface()
+		}
+		initialMP[utils.RemoteSourcesCfg] = remoteSources
+	}
 	if l.RunDelay != 0 {
 		initialMP[utils.RunDelayCfg] = l.RunDelay.String()
 	}
@@ -67,6 +67,7 @@ func TestLoaderSCfgloadFromJsonCfgCase1(t *testing.T) {
 			FieldSeparator: ",",
 			TpInDir:        "/var/spool/cgrates/loader/in",
 			TpOutDir:       "/var/spool/cgrates/loader/out",
+			RemoteSources:  []*LoaderSourceCfg{},
 			Data: []*LoaderDataType{
 				{
 					Type:     "*attributes",
@@ -553,3 +554,87 @@ func TestLoadersCfgGetLockFilePath(t *testing.T) {
 		t.Error(rcv)
 	}
 }
+
+func TestLoaderSCfgRemoteSources(t *testing.T) {
+	jsnCfg := &LoaderJsonCfg{
+		Remote_sources: &[]*LoaderSourceJsonCfg{
+			{
+				Type:              utils.StringPointer(utils.MetaS3),
+				Url:               utils.StringPointer("http://127.0.0.1:9000"),
+				Processed_action:  utils.StringPointer(utils.MetaArchive),
+				S3_bucket:         utils.StringPointer("tariffs"),
+				S3_prefix:         utils.StringPointer("in"),
+				S3_archive_prefix: utils.StringPointer("archive"),
+				Aws_region:        utils.StringPointer("eu-central-1"),
+				Aws_key:           utils.StringPointer("key"),
+				Aws_secret:        utils.StringPointer("secret"),
+				Aws_token:         utils.StringPointer("token"),
+			},
+			{
+				Type: utils.StringPointer(utils.MetaHTTP),
+				Url:  utils.StringPointer("https://tariffs.cgrates.org/csv"),
+			},
+		},
+	}
+	expected := []*LoaderSourceCfg{
+		{
+			Type:            utils.MetaS3,
+			URL:             "http://127.0.0.1:9000",
+			ProcessedAction: utils.MetaArchive,
+			S3Bucket:        "tariffs",
+			S3Prefix:        "in",
+			S3ArchivePrefix: "archive",
+			AWSRegion:       "eu-central-1",
+			AWSKey:          "key",
+			AWSSecret:       "secret",
+			AWSToken:        "token",
+		},
+		{
+			Type:            utils.MetaHTTP,
+			URL:             "https://tariffs.cgrates.org/csv",
+			ProcessedAction: utils.MetaNone,
+		},
+	}
+	ldr := new(LoaderSCfg)
+	if err := ldr.loadFromJSONCfg(jsnCfg, nil, utils.InfieldSep); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(expected, ldr.RemoteSources) {
+		t.Errorf("Expected %s \n, received %s", utils.ToJSON(expected), utils.ToJSON(ldr.RemoteSources))
+	}
+	eMap := []map[string]any{
+		{
+			utils.TypeCf:             utils.MetaS3,
+			utils.URLCfg:             "http://127.0.0.1:9000",
+			utils.ProcessedActionCfg: utils.MetaArchive,
+			utils.S3BucketCfg:        "tariffs",
+			utils.S3PrefixCfg:        "in",
+			utils.S3ArchivePrefixCfg: "archive",
+			utils.AWSRegionCfg:       "eu-central-1",
+			utils.AWSKeyCfg:          "key",
+			utils.AWSSecretCfg:       "secret",
+			utils.AWSTokenCfg:        "token",
+		},
+		{
+			utils.TypeCf:             utils.MetaHTTP,
+			utils.URLCfg:             "https://tariffs.cgrates.org/csv",
+			utils.ProcessedActionCfg: utils.MetaNone,
+			utils.S3BucketCfg:        utils.EmptyString,
+			utils.S3PrefixCfg:        utils.EmptyString,
+			utils.S3ArchivePrefixCfg: utils.EmptyString,
+			utils.AWSRegionCfg:       utils.EmptyString,
+			utils.AWSKeyCfg:          utils.EmptyString,
+			utils.AWSSecretCfg:       utils.EmptyString,
+			utils.AWSTokenCfg:        utils.EmptyString,
+		},
+	}
+	if rcv := ldr.AsMapInterface(utils.InfieldSep)[utils.RemoteSourcesCfg]; !reflect.DeepEqual(eMap, rcv) {
+		t.Errorf("Expected %s \n, received %s", utils.ToJSON(eMap), utils.ToJSON(rcv))
+	}
+	cln := ldr.Clone()
+	if !reflect.DeepEqual(ldr.RemoteSources, cln.RemoteSources) {
+		t.Errorf("Expected %s \n, received %s", utils.ToJSON(ldr.RemoteSources), utils.ToJSON(cln.RemoteSources))
+	}
+	if cln.RemoteSources[0].S3Bucket = "archive"; ldr.RemoteSources[0].S3Bucket != "tariffs" {
+		t.Errorf("Expected clone to not modify the cloned")
+	}
+}
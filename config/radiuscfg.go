@@ -19,7 +19,10 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>
 package config
 
 import (
+	"time"
+
 	"github.com/cgrates/cgrates/utils"
+	"github.com/cgrates/rpcclient"
 )
 
 // RadiusAgentCfg the config section that describes the Radius Agent
@@ -30,10 +33,61 @@ type RadiusAgentCfg struct {
 	ListenAcct         string
 	ClientSecrets      map[string]string
 	ClientDictionaries map[string][]string
+	ClientDaAddresses  map[string]*DAClientOpts
+	DATimeout          time.Duration // timeout of the Dynamic Authorization requests
+	RequestsCacheKey   RSRParsers
+	DMRTemplate        string
+	CoATemplate        string
 	SessionSConns      []string
 	RequestProcessors  []*RequestProcessor
 }
 
+// DAClientOpts describes the Dynamic Authorization (RFC 5176) endpoint of a NAS
+type DAClientOpts struct {
+	Transport string
+	Host      string
+	Port      int
+	Flags     utils.FlagsWithParams
+}
+
+func (dac *DAClientOpts) loadFromJSONCfg(jsnCfg *DAClientOptsJson) {
+	if jsnCfg == nil {
+		return
+	}
+	if jsnCfg.Transport != nil {
+		dac.Transport = *jsnCfg.Transport
+	}
+	if jsnCfg.Host != nil {
+		dac.Host = *jsnCfg.Host
+	}
+	if jsnCfg.Port != nil {
+		dac.Port = *jsnCfg.Port
+	}
+	if jsnCfg.Flags != nil {
+		dac.Flags = utils.FlagsWithParamsFromSlice(*jsnCfg.Flags)
+	}
+}
+
+// AsMapInterface returns the config as a map[string]any
+func (dac *DAClientOpts) AsMapInterface() map[string]any {
+	return map[string]any{
+		utils.TransportCfg: dac.Transport,
+		utils.HostCfg:      dac.Host,
+		utils.PortCfg:      dac.Port,
+		utils.FlagsCfg:     dac.Flags.SliceFlags(),
+	}
+}
+
+// Clone returns a deep copy of DAClientOpts
+func (dac *DAClientOpts) Clone() *DAClientOpts {
+	return &DAClientOpts{
+		Transport: dac.Transport,
+		Host:      dac.Host,
+		Port:      dac.Port,
+		Flags:     dac.Flags.Clone(),
+	}
+}
+
 func (ra *RadiusAgentCfg) loadFromJSONCfg(jsnCfg *RadiusAgentJsonCfg, separator string) (err error) {
 	if jsnCfg == nil {
 		return nil
@@ -66,13 +120,46 @@ func (ra *RadiusAgentCfg) loadFromJSONCfg(jsnCfg *RadiusAgentJsonCfg, separator
 			ra.ClientDictionaries[k] = v
 		}
 	}
+	if jsnCfg.Client_da_addresses != nil {
+		for k, v := range *jsnCfg.Client_da_addresses {
+			if ra.ClientDaAddresses == nil {
+				ra.ClientDaAddresses = make(map[string]*DAClientOpts)
+			}
+			dac, has := ra.ClientDaAddresses[k]
+			if !has {
+				dac = &DAClientOpts{
+					Transport: utils.UDP,
+					Port:      3799,
+				}
+			}
+			dac.loadFromJSONCfg(v)
+			ra.ClientDaAddresses[k] = dac
+		}
+	}
+	if jsnCfg.Da_timeout != nil {
+		if ra.DATimeout, err = utils.ParseDurationWithNanosecs(*jsnCfg.Da_timeout); err != nil {
+			return
+		}
+	}
+	if jsnCfg.Requests_cache_key != nil {
+		if ra.RequestsCacheKey, err = NewRSRParsers(*jsnCfg.Requests_cache_key, separator); err != nil {
+			return
+		}
+	}
+	if jsnCfg.Dmr_template != nil {
+		ra.DMRTemplate = *jsnCfg.Dmr_template
+	}
+	if jsnCfg.Coa_template != nil {
+		ra.CoATemplate = *jsnCfg.Coa_template
+	}
 	if jsnCfg.Sessions_conns != nil {
 		ra.SessionSConns = make([]string, len(*jsnCfg.Sessions_conns))
 		for idx, attrConn := range *jsnCfg.Sessions_conns {
 			// if we have the connection internal we change the name so we can have internal rpc for each subsystem
 			ra.SessionSConns[idx] = attrConn
-			if attrConn == utils.MetaInternal {
-				ra.SessionSConns[idx] = utils.ConcatenatedKey(utils.MetaInternal, utils.MetaSessionS)
+			if attrConn == utils.MetaInternal ||
+				attrConn == rpcclient.BiRPCInternal {
+				ra.SessionSConns[idx] = utils.ConcatenatedKey(attrConn, utils.MetaSessionS)
 			}
 		}
 	}
@@ -101,10 +188,16 @@ func (ra *RadiusAgentCfg) loadFromJSONCfg(jsnCfg *RadiusAgentJsonCfg, separator
 // AsMapInterface returns the config as a map[string]any
 func (ra *RadiusAgentCfg) AsMapInterface(separator string) (initialMP map[string]any) {
 	initialMP = map[string]any{
-		utils.EnabledCfg:    ra.Enabled,
-		utils.ListenNetCfg:  ra.ListenNet,
-		utils.ListenAuthCfg: ra.ListenAuth,
-		utils.ListenAcctCfg: ra.ListenAcct,
+		utils.EnabledCfg:     ra.Enabled,
+		utils.ListenNetCfg:   ra.ListenNet,
+		utils.ListenAuthCfg:  ra.ListenAuth,
+		utils.ListenAcctCfg:  ra.ListenAcct,
+		utils.DMRTemplateCfg: ra.DMRTemplate,
+		utils.CoATemplateCfg: ra.CoATemplate,
+		utils.DATimeoutCfg:   ra.DATimeout.String(),
+	}
+	if ra.RequestsCacheKey != nil {
+		initialMP[utils.RequestsCacheKeyCfg] = ra.RequestsCacheKey.GetRule(separator)
 	}
 
 	requestProcessors := make([]map[string]any, len(ra.RequestProcessors))
@@ -119,6 +212,8 @@ func (ra *RadiusAgentCfg) AsMapInterface(separator string) (initialMP map[string
 			sessionSConns[i] = item
 			if item == utils.ConcatenatedKey(utils.MetaInternal, utils.MetaSessionS) {
 				sessionSConns[i] = utils.MetaInternal
+			} else if item == utils.ConcatenatedKey(rpcclient.BiRPCInternal, utils.MetaSessionS) {
+				sessionSConns[i] = rpcclient.BiRPCInternal
 			}
 		}
 		initialMP[utils.SessionSConnsCfg] = sessionSConns
@@ -133,6 +228,11 @@ func (ra *RadiusAgentCfg) AsMapInterface(separator string) (initialMP map[string
 		clientDictionaries[k] = v
 	}
 	initialMP[utils.ClientDictionariesCfg] = clientDictionaries
+	clientDaAddresses := make(map[string]any)
+	for k, v := range ra.ClientDaAddresses {
+		clientDaAddresses[k] = v.AsMapInterface()
+	}
+	initialMP[utils.ClientDaAddressesCfg] = clientDaAddresses
 	return
 }
 
@@ -145,6 +245,10 @@ func (ra RadiusAgentCfg) Clone() (cln *RadiusAgentCfg) {
 		ListenAcct:         ra.ListenAcct,
 		ClientSecrets:      make(map[string]string),
 		ClientDictionaries: make(map[string][]string),
+		DATimeout:          ra.DATimeout,
+		RequestsCacheKey:   ra.RequestsCacheKey.Clone(),
+		DMRTemplate:        ra.DMRTemplate,
+		CoATemplate:        ra.CoATemplate,
 	}
 	if ra.SessionSConns != nil {
 		cln.SessionSConns = make([]string, len(ra.SessionSConns))
@@ -158,6 +262,12 @@ func (ra RadiusAgentCfg) Clone() (cln *RadiusAgentCfg) {
 	for k, v := range ra.ClientDictionaries {
 		cln.ClientDictionaries[k] = v
 	}
+	if ra.ClientDaAddresses != nil {
+		cln.ClientDaAddresses = make(map[string]*DAClientOpts)
+		for k, v := range ra.ClientDaAddresses {
+			cln.ClientDaAddresses[k] = v.Clone()
+		}
+	}
 	if ra.RequestProcessors != nil {
 		cln.RequestProcessors = make([]*RequestProcessor, len(ra.RequestProcessors))
 		for i, req := range ra.RequestProcessors {
@@ -34,6 +34,7 @@ func TestRadiusAgentCfgloadFromJsonCfgCase1(t *testing.T) {
 		Listen_acct:         utils.StringPointer("127.0.0.1:1813"),
 		Client_secrets:      &map[string]string{utils.MetaDefault: "CGRateS.org"},
 		Client_dictionaries: &map[string][]string{utils.MetaDefault: {"/usr/share/cgrates/radius/dict/"}},
+		Da_timeout:          utils.StringPointer("3s"),
 		Sessions_conns:      &[]string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaSessionS)},
 		Request_processors: &[]*ReqProcessorJsnCfg{
 			{
@@ -63,6 +64,7 @@ func TestRadiusAgentCfgloadFromJsonCfgCase1(t *testing.T) {
 		ListenAcct:         "127.0.0.1:1813",
 		ClientSecrets:      map[string]string{utils.MetaDefault: "CGRateS.org"},
 		ClientDictionaries: map[string][]string{utils.MetaDefault: {"/usr/share/cgrates/radius/dict/"}},
+		DATimeout:          3 * time.Second,
 		SessionSConns:      []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaSessionS)},
 		RequestProcessors: []*RequestProcessor{
 			{
@@ -145,6 +147,17 @@ func TestRadiusAgentCfgloadFromJsonCfgCase3(t *testing.T) {
 	}
 }
 
+func TestRadiusAgentCfgloadFromJsonCfgDATimeoutError(t *testing.T) {
+	cfgJSON := &RadiusAgentJsonCfg{
+		Da_timeout: utils.StringPointer("1ss"),
+	}
+	expected := `time: unknown unit "ss" in duration "1ss"`
+	jsonCfg := NewDefaultCGRConfig()
+	if err = jsonCfg.radiusAgentCfg.loadFromJSONCfg(cfgJSON, jsonCfg.generalCfg.RSRSep); err == nil || err.Error() != expected {
+		t.Errorf("Expected %+v, received %+v", expected, err)
+	}
+}
+
 func TestRadiusAgentCfgAsMapInterface(t *testing.T) {
 	cfgJSONStr := `{
 	"radius_agent": {
@@ -157,6 +170,16 @@ func TestRadiusAgentCfgAsMapInterface(t *testing.T) {
 				"/usr/share/cgrates/",
 			],			
 	     },
+	     "client_da_addresses": {
+			"127.0.0.1": {
+				"port": 3800,
+				"flags": ["*log"],
+			},
+	     },
+	     "da_timeout": "5s",
+	     "requests_cache_key": "~*req.Acct-Session-Id",
+	     "dmr_template": "*dmr",
+	     "coa_template": "*coa",
 	     "sessions_conns": ["*birpc_internal", "*conn1","*conn2"],
          "request_processors": [
 			{
@@ -183,7 +206,19 @@ func TestRadiusAgentCfgAsMapInterface(t *testing.T) {
 		utils.ClientDictionariesCfg: map[string][]string{
 			utils.MetaDefault: {"/usr/share/cgrates/"},
 		},
-		utils.SessionSConnsCfg: []string{rpcclient.BiRPCInternal, "*conn1", "*conn2"},
+		utils.ClientDaAddressesCfg: map[string]any{
+			"127.0.0.1": map[string]any{
+				utils.TransportCfg: utils.UDP,
+				utils.HostCfg:      utils.EmptyString,
+				utils.PortCfg:      3800,
+				utils.FlagsCfg:     []string{utils.MetaLog},
+			},
+		},
+		utils.DATimeoutCfg:        "5s",
+		utils.RequestsCacheKeyCfg: "~*req.Acct-Session-Id",
+		utils.DMRTemplateCfg:      "*dmr",
+		utils.CoATemplateCfg:      "*coa",
+		utils.SessionSConnsCfg:    []string{rpcclient.BiRPCInternal, "*conn1", "*conn2"},
 		utils.RequestProcessorsCfg: []map[string]any{
 			{
 				utils.IDCfg:            "OutboundAUTHDryRun",
@@ -220,6 +255,10 @@ func TestRadiusAgentCfgAsMapInterface1(t *testing.T) {
 		utils.ClientDictionariesCfg: map[string][]string{
 			utils.MetaDefault: {"/usr/share/cgrates/radius/dict/"},
 		},
+		utils.ClientDaAddressesCfg: map[string]any{},
+		utils.DATimeoutCfg:         "1s",
+		utils.DMRTemplateCfg:       utils.EmptyString,
+		utils.CoATemplateCfg:       utils.EmptyString,
 		utils.SessionSConnsCfg:     []string{"*internal"},
 		utils.RequestProcessorsCfg: []map[string]any{},
 	}
@@ -238,7 +277,18 @@ func TestRadiusAgentCfgClone(t *testing.T) {
 		ListenAcct:         "127.0.0.1:1813",
 		ClientSecrets:      map[string]string{utils.MetaDefault: "CGRateS.org"},
 		ClientDictionaries: map[string][]string{utils.MetaDefault: {"/usr/share/cgrates/radius/dict/"}},
-		SessionSConns:      []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaSessionS), "*conn1"},
+		ClientDaAddresses: map[string]*DAClientOpts{
+			"127.0.0.1": {
+				Transport: utils.UDP,
+				Port:      3799,
+				Flags:     utils.FlagsWithParams{utils.MetaLog: {}},
+			},
+		},
+		DATimeout:        2 * time.Second,
+		RequestsCacheKey: NewRSRParsersMustCompile("~*req.Acct-Session-Id", utils.InfieldSep),
+		DMRTemplate:      "*dmr",
+		CoATemplate:      "*coa",
+		SessionSConns:    []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaSessionS), "*conn1"},
 		RequestProcessors: []*RequestProcessor{
 			{
 				ID:            "OutboundAUTHDryRun",
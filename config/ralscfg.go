@@ -19,7 +19,9 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>
 package config
 
 import (
+	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/cgrates/cgrates/utils"
@@ -35,6 +37,10 @@ type RalsCfg struct {
 	MaxComputedUsage        map[string]time.Duration
 	BalanceRatingSubject    map[string]string
 	MaxIncrements           int
+	BalanceLedger           bool               // record the balance changes into StorDB
+	DefaultCurrency         string             // currency of the rating, the balances in other currencies are debited using the exchange rates
+	MaxTransfer             map[string]float64 // maximum value moved by one balance transfer, per balance type
+	TransferFee             map[string]string  // fee of the balance transfers, per balance type, flat or percentage with the % suffix
 }
 
 // loadFromJSONCfg loads Rals config from JsonCfg
@@ -86,6 +92,25 @@ func (ralsCfg *RalsCfg) loadFromJSONCfg(jsnRALsCfg *RalsJsonCfg) (err error) {
 			ralsCfg.BalanceRatingSubject[k] = v
 		}
 	}
+	if jsnRALsCfg.Balance_ledger != nil {
+		ralsCfg.BalanceLedger = *jsnRALsCfg.Balance_ledger
+	}
+	if jsnRALsCfg.Default_currency != nil {
+		ralsCfg.DefaultCurrency = *jsnRALsCfg.Default_currency
+	}
+	if jsnRALsCfg.Max_transfer != nil {
+		for k, v := range *jsnRALsCfg.Max_transfer {
+			ralsCfg.MaxTransfer[k] = v
+		}
+	}
+	if jsnRALsCfg.Transfer_fee != nil {
+		for k, v := range *jsnRALsCfg.Transfer_fee {
+			if _, err = strconv.ParseFloat(strings.TrimSuffix(v, utils.PercentSep), 64); err != nil {
+				return fmt.Errorf("invalid transfer_fee <%s> for <%s>: %s", v, k, err.Error())
+			}
+			ralsCfg.TransferFee[k] = v
+		}
+	}
 
 	return nil
 }
@@ -97,6 +122,8 @@ func (ralsCfg *RalsCfg) AsMapInterface() (initialMP map[string]any) {
 		utils.RpSubjectPrefixMatchingCfg: ralsCfg.RpSubjectPrefixMatching,
 		utils.RemoveExpiredCfg:           ralsCfg.RemoveExpired,
 		utils.MaxIncrementsCfg:           ralsCfg.MaxIncrements,
+		utils.BalanceLedgerCfg:           ralsCfg.BalanceLedger,
+		utils.DefaultCurrencyCfg:         ralsCfg.DefaultCurrency,
 	}
 	if ralsCfg.ThresholdSConns != nil {
 		threSholds := make([]string, len(ralsCfg.ThresholdSConns))
@@ -132,6 +159,16 @@ func (ralsCfg *RalsCfg) AsMapInterface() (initialMP map[string]any) {
 		balanceRatSubj[k] = v
 	}
 	initialMP[utils.BalanceRatingSubjectCfg] = balanceRatSubj
+	maxTransfer := make(map[string]float64)
+	for k, v := range ralsCfg.MaxTransfer {
+		maxTransfer[k] = v
+	}
+	initialMP[utils.MaxTransferCfg] = maxTransfer
+	transferFee := make(map[string]string)
+	for k, v := range ralsCfg.TransferFee {
+		transferFee[k] = v
+	}
+	initialMP[utils.TransferFeeCfg] = transferFee
 	return
 }
 
@@ -142,9 +179,13 @@ func (ralsCfg RalsCfg) Clone() (cln *RalsCfg) {
 		RpSubjectPrefixMatching: ralsCfg.RpSubjectPrefixMatching,
 		RemoveExpired:           ralsCfg.RemoveExpired,
 		MaxIncrements:           ralsCfg.MaxIncrements,
+		BalanceLedger:           ralsCfg.BalanceLedger,
+		DefaultCurrency:         ralsCfg.DefaultCurrency,
 
 		MaxComputedUsage:     make(map[string]time.Duration),
 		BalanceRatingSubject: make(map[string]string),
+		MaxTransfer:          make(map[string]float64),
+		TransferFee:          make(map[string]string),
 	}
 	if ralsCfg.ThresholdSConns != nil {
 		cln.ThresholdSConns = make([]string, len(ralsCfg.ThresholdSConns))
@@ -165,5 +206,11 @@ func (ralsCfg RalsCfg) Clone() (cln *RalsCfg) {
 	for k, r := range ralsCfg.BalanceRatingSubject {
 		cln.BalanceRatingSubject[k] = r
 	}
+	for k, v := range ralsCfg.MaxTransfer {
+		cln.MaxTransfer[k] = v
+	}
+	for k, v := range ralsCfg.TransferFee {
+		cln.TransferFee[k] = v
+	}
 	return
 }
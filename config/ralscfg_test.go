@@ -44,6 +44,10 @@ func TestRalsCfgFromJsonCfgCase1(t *testing.T) {
 			utils.MetaAny:   "*zero1ns",
 			utils.MetaVoice: "*zero1s",
 		},
+		Balance_ledger:   utils.BoolPointer(true),
+		Default_currency: utils.StringPointer("EUR"),
+		Max_transfer:     &map[string]float64{utils.MetaMonetary: 100},
+		Transfer_fee:     &map[string]string{utils.MetaMonetary: "2%"},
 	}
 	expected := &RalsCfg{
 		Enabled:                 true,
@@ -63,6 +67,10 @@ func TestRalsCfgFromJsonCfgCase1(t *testing.T) {
 			utils.MetaAny:   "*zero1ns",
 			utils.MetaVoice: "*zero1s",
 		},
+		BalanceLedger:   true,
+		DefaultCurrency: "EUR",
+		MaxTransfer:     map[string]float64{utils.MetaMonetary: 100},
+		TransferFee:     map[string]string{utils.MetaMonetary: "2%"},
 	}
 	cfg := NewDefaultCGRConfig()
 	if err = cfg.ralsCfg.loadFromJSONCfg(cfgJSON); err != nil {
@@ -83,6 +91,15 @@ func TestRalsCfgFromJsonCfgCase2(t *testing.T) {
 	if err = jsonCfg.ralsCfg.loadFromJSONCfg(cfgJSON); err == nil || err.Error() != expected {
 		t.Errorf("Expected %+v, received %+v", expected, err)
 	}
+	cfgJSON = &RalsJsonCfg{
+		Transfer_fee: &map[string]string{
+			utils.MetaMonetary: "2p",
+		},
+	}
+	expected = "invalid transfer_fee <2p> for <*monetary>: strconv.ParseFloat: parsing \"2p\": invalid syntax"
+	if err = jsonCfg.ralsCfg.loadFromJSONCfg(cfgJSON); err == nil || err.Error() != expected {
+		t.Errorf("Expected %+v, received %+v", expected, err)
+	}
 }
 
 func TestRalsCfgAsMapInterfaceCase1(t *testing.T) {
@@ -112,11 +129,15 @@ func TestRalsCfgAsMapInterfaceCase1(t *testing.T) {
 			"*sms":   "5000",
 			"*mms":   "10000",
 		},
-		utils.MaxIncrementsCfg: 1000000,
+		utils.MaxIncrementsCfg:   1000000,
+		utils.BalanceLedgerCfg:   false,
+		utils.DefaultCurrencyCfg: "",
 		utils.BalanceRatingSubjectCfg: map[string]string{
 			"*any":   "*zero1ns",
 			"*voice": "*zero1s",
 		},
+		utils.MaxTransferCfg: map[string]float64{},
+		utils.TransferFeeCfg: map[string]string{},
 	}
 	if cgrCfg, err := NewCGRConfigFromJSONStringWithDefaults(cfgJSONStr); err != nil {
 		t.Error(err)
@@ -145,11 +166,15 @@ func TestRalsCfgAsMapInterfaceCase2(t *testing.T) {
 			"*sms":   "10000",
 			"*mms":   "10000",
 		},
-		utils.MaxIncrementsCfg: 1000000,
+		utils.MaxIncrementsCfg:   1000000,
+		utils.BalanceLedgerCfg:   false,
+		utils.DefaultCurrencyCfg: "",
 		utils.BalanceRatingSubjectCfg: map[string]string{
 			"*any":   "*zero1ns",
 			"*voice": "*zero1s",
 		},
+		utils.MaxTransferCfg: map[string]float64{},
+		utils.TransferFeeCfg: map[string]string{},
 	}
 	if cgrCfg, err := NewCGRConfigFromJSONStringWithDefaults(cfgJSONStr); err != nil {
 		t.Error(err)
@@ -177,6 +202,8 @@ func TestRalsCfgClone(t *testing.T) {
 			utils.MetaAny:   "*zero1ns",
 			utils.MetaVoice: "*zero1s",
 		},
+		MaxTransfer: map[string]float64{utils.MetaMonetary: 100},
+		TransferFee: map[string]string{utils.MetaMonetary: "0.5"},
 	}
 	rcv := ban.Clone()
 	if !reflect.DeepEqual(ban, rcv) {
@@ -194,4 +221,7 @@ func TestRalsCfgClone(t *testing.T) {
 	if rcv.BalanceRatingSubject[utils.MetaAny] = ""; ban.BalanceRatingSubject[utils.MetaAny] != "*zero1ns" {
 		t.Errorf("Expected clone to not modify the cloned")
 	}
+	if rcv.TransferFee[utils.MetaMonetary] = ""; ban.TransferFee[utils.MetaMonetary] != "0.5" {
+		t.Errorf("Expected clone to not modify the cloned")
+	}
 }
@@ -119,6 +119,7 @@ type SessionSCfg struct {
 	ChannelSyncInterval    time.Duration
 	StaleChanMaxExtraUsage time.Duration
 	TerminateAttempts      int
+	BackupInterval         time.Duration
 	AlterableFields        utils.StringSet
 	MinDurLowBalance       time.Duration
 	SchedulerConns         []string
@@ -288,6 +289,11 @@ func (scfg *SessionSCfg) loadFromJSONCfg(jsnCfg *SessionSJsonCfg) (err error) {
 	if jsnCfg.Terminate_attempts != nil {
 		scfg.TerminateAttempts = *jsnCfg.Terminate_attempts
 	}
+	if jsnCfg.Backup_interval != nil {
+		if scfg.BackupInterval, err = utils.ParseDurationWithNanosecs(*jsnCfg.Backup_interval); err != nil {
+			return err
+		}
+	}
 	if jsnCfg.Alterable_fields != nil {
 		scfg.AlterableFields = utils.NewStringSet(*jsnCfg.Alterable_fields)
 	}
@@ -347,6 +353,7 @@ func (scfg *SessionSCfg) AsMapInterface() (initialMP map[string]any) {
 		utils.MinDurLowBalanceCfg:       "0",
 		utils.ChannelSyncIntervalCfg:    "0",
 		utils.StaleChanMaxExtraUsageCfg: "0",
+		utils.BackupIntervalCfg:         "0",
 		utils.DebitIntervalCfg:          "0",
 		utils.SessionTTLCfg:             "0",
 		utils.DefaultUsageCfg:           maxComputed,
@@ -375,6 +382,9 @@ func (scfg *SessionSCfg) AsMapInterface() (initialMP map[string]any) {
 	if scfg.StaleChanMaxExtraUsage != 0 {
 		initialMP[utils.StaleChanMaxExtraUsageCfg] = scfg.StaleChanMaxExtraUsage.String()
 	}
+	if scfg.BackupInterval != 0 {
+		initialMP[utils.BackupIntervalCfg] = scfg.BackupInterval.String()
+	}
 	if scfg.MinDurLowBalance != 0 {
 		initialMP[utils.MinDurLowBalanceCfg] = scfg.MinDurLowBalance.String()
 	}
@@ -483,6 +493,7 @@ func (scfg SessionSCfg) Clone() (cln *SessionSCfg) {
 		ChannelSyncInterval:    scfg.ChannelSyncInterval,
 		StaleChanMaxExtraUsage: scfg.StaleChanMaxExtraUsage,
 		TerminateAttempts:      scfg.TerminateAttempts,
+		BackupInterval:         scfg.BackupInterval,
 		MinDurLowBalance:       scfg.MinDurLowBalance,
 
 		SessionIndexes:  scfg.SessionIndexes.Clone(),
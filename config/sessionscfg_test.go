@@ -366,6 +366,7 @@ func TestSessionSCfgAsMapInterfaceCase1(t *testing.T) {
 		utils.ChannelSyncIntervalCfg:    "1s",
 		utils.StaleChanMaxExtraUsageCfg: "10ms",
 		utils.TerminateAttemptsCfg:      5,
+		utils.BackupIntervalCfg:         "0",
 		utils.MinDurLowBalanceCfg:       "0",
 		utils.AlterableFieldsCfg:        []string{},
 		utils.STIRCfg: map[string]any{
@@ -442,6 +443,7 @@ func TestSessionSCfgAsMapInterfaceCase2(t *testing.T) {
 		utils.ChannelSyncIntervalCfg:    "0",
 		utils.StaleChanMaxExtraUsageCfg: "0",
 		utils.TerminateAttemptsCfg:      10,
+		utils.BackupIntervalCfg:         "0",
 		utils.AlterableFieldsCfg:        []string{},
 		utils.STIRCfg: map[string]any{
 			utils.AllowedAtestCfg:       []string{"any1", "any2"},
@@ -28,13 +28,16 @@ import (
 )
 
 type StorDBOpts struct {
-	SQLMaxOpenConns    int
-	SQLMaxIdleConns    int
-	SQLConnMaxLifetime time.Duration
-	MongoQueryTimeout  time.Duration
-	PgSSLMode          string
-	MySQLLocation      string
-	MySQLDSNParams     map[string]string
+	SQLMaxOpenConns        int
+	SQLMaxIdleConns        int
+	SQLConnMaxLifetime     time.Duration
+	MongoQueryTimeout      time.Duration
+	PgSSLMode              string
+	MySQLLocation          string
+	MySQLDSNParams         map[string]string
+	InternalDBDumpPath     string        // folder where the *internal DB is dumped, empty to disable
+	InternalDBDumpInterval time.Duration // interval between dumps, 0 to dump only on shutdown
+	InternalDBWriteLog     bool          // log the writes between dumps
 }
 
 // StorDbCfg StroreDb config
@@ -83,6 +86,17 @@ func (dbOpts *StorDBOpts) loadFromJSONCfg(jsnCfg *DBOptsJson) (err error) {
 	if jsnCfg.MySQLLocation != nil {
 		dbOpts.MySQLLocation = *jsnCfg.MySQLLocation
 	}
+	if jsnCfg.InternalDBDumpPath != nil {
+		dbOpts.InternalDBDumpPath = *jsnCfg.InternalDBDumpPath
+	}
+	if jsnCfg.InternalDBDumpInterval != nil {
+		if dbOpts.InternalDBDumpInterval, err = utils.ParseDurationWithNanosecs(*jsnCfg.InternalDBDumpInterval); err != nil {
+			return
+		}
+	}
+	if jsnCfg.InternalDBWriteLog != nil {
+		dbOpts.InternalDBWriteLog = *jsnCfg.InternalDBWriteLog
+	}
 	return
 }
 
@@ -158,13 +172,16 @@ func (dbcfg *StorDbCfg) loadFromJSONCfg(jsnDbCfg *DbJsonCfg) (err error) {
 
 func (dbOpts *StorDBOpts) Clone() *StorDBOpts {
 	return &StorDBOpts{
-		SQLMaxOpenConns:    dbOpts.SQLMaxOpenConns,
-		SQLMaxIdleConns:    dbOpts.SQLMaxIdleConns,
-		SQLConnMaxLifetime: dbOpts.SQLConnMaxLifetime,
-		MySQLDSNParams:     dbOpts.MySQLDSNParams,
-		MongoQueryTimeout:  dbOpts.MongoQueryTimeout,
-		PgSSLMode:          dbOpts.PgSSLMode,
-		MySQLLocation:      dbOpts.MySQLLocation,
+		SQLMaxOpenConns:        dbOpts.SQLMaxOpenConns,
+		SQLMaxIdleConns:        dbOpts.SQLMaxIdleConns,
+		SQLConnMaxLifetime:     dbOpts.SQLConnMaxLifetime,
+		MySQLDSNParams:         dbOpts.MySQLDSNParams,
+		MongoQueryTimeout:      dbOpts.MongoQueryTimeout,
+		PgSSLMode:              dbOpts.PgSSLMode,
+		MySQLLocation:          dbOpts.MySQLLocation,
+		InternalDBDumpPath:     dbOpts.InternalDBDumpPath,
+		InternalDBDumpInterval: dbOpts.InternalDBDumpInterval,
+		InternalDBWriteLog:     dbOpts.InternalDBWriteLog,
 	}
 }
 
@@ -214,13 +231,16 @@ func (dbcfg *StorDbCfg) Clone() (cln *StorDbCfg) {
 // AsMapInterface returns the config as a map[string]any
 func (dbcfg *StorDbCfg) AsMapInterface() (mp map[string]any) {
 	opts := map[string]any{
-		utils.SQLMaxOpenConnsCfg:   dbcfg.Opts.SQLMaxOpenConns,
-		utils.SQLMaxIdleConnsCfg:   dbcfg.Opts.SQLMaxIdleConns,
-		utils.SQLConnMaxLifetime:   dbcfg.Opts.SQLConnMaxLifetime.String(),
-		utils.MYSQLDSNParams:       dbcfg.Opts.MySQLDSNParams,
-		utils.MongoQueryTimeoutCfg: dbcfg.Opts.MongoQueryTimeout.String(),
-		utils.PgSSLModeCfg:         dbcfg.Opts.PgSSLMode,
-		utils.MysqlLocation:        dbcfg.Opts.MySQLLocation,
+		utils.SQLMaxOpenConnsCfg:        dbcfg.Opts.SQLMaxOpenConns,
+		utils.SQLMaxIdleConnsCfg:        dbcfg.Opts.SQLMaxIdleConns,
+		utils.SQLConnMaxLifetime:        dbcfg.Opts.SQLConnMaxLifetime.String(),
+		utils.MYSQLDSNParams:            dbcfg.Opts.MySQLDSNParams,
+		utils.MongoQueryTimeoutCfg:      dbcfg.Opts.MongoQueryTimeout.String(),
+		utils.PgSSLModeCfg:              dbcfg.Opts.PgSSLMode,
+		utils.MysqlLocation:             dbcfg.Opts.MySQLLocation,
+		utils.InternalDBDumpPathCfg:     dbcfg.Opts.InternalDBDumpPath,
+		utils.InternalDBDumpIntervalCfg: dbcfg.Opts.InternalDBDumpInterval.String(),
+		utils.InternalDBWriteLogCfg:     dbcfg.Opts.InternalDBWriteLog,
 	}
 	mp = map[string]any{
 		utils.DataDbTypeCfg:          dbcfg.Type,
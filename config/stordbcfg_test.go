@@ -254,13 +254,16 @@ func TestStorDbCfgAsMapInterface(t *testing.T) {
 		utils.RemoteConnsCfg:         []string{"*conn1"},
 		utils.ReplicationConnsCfg:    []string{"*conn1"},
 		utils.OptsCfg: map[string]any{
-			utils.SQLMaxOpenConnsCfg:    100,
-			utils.SQLMaxIdleConnsCfg:    10,
-			utils.SQLConnMaxLifetimeCfg: "0s",
-			utils.MYSQLDSNParams:        make(map[string]string),
-			utils.MongoQueryTimeoutCfg:  "10s",
-			utils.PgSSLModeCfg:          "disable",
-			utils.MysqlLocation:         "UTC",
+			utils.SQLMaxOpenConnsCfg:        100,
+			utils.SQLMaxIdleConnsCfg:        10,
+			utils.SQLConnMaxLifetimeCfg:     "0s",
+			utils.MYSQLDSNParams:            make(map[string]string),
+			utils.MongoQueryTimeoutCfg:      "10s",
+			utils.PgSSLModeCfg:              "disable",
+			utils.MysqlLocation:             "UTC",
+			utils.InternalDBDumpPathCfg:     "",
+			utils.InternalDBDumpIntervalCfg: "0s",
+			utils.InternalDBWriteLogCfg:     false,
 		},
 		utils.ItemsCfg: map[string]any{
 			utils.SessionCostsTBL: map[string]any{utils.RemoteCfg: false, utils.ReplicateCfg: false},
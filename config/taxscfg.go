@@ -0,0 +1,126 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package config
+
+import (
+	"github.com/cgrates/cgrates/utils"
+)
+
+// TaxSCfg is the configuration of the tax profiles matching
+type TaxSCfg struct {
+	IndexedSelects      bool
+	StringIndexedFields *[]string
+	PrefixIndexedFields *[]string
+	SuffixIndexedFields *[]string
+	NestedFields        bool
+}
+
+func (tS *TaxSCfg) loadFromJSONCfg(jsnCfg *TaxSJsonCfg) (err error) {
+	if jsnCfg == nil {
+		return
+	}
+	if jsnCfg.Indexed_selects != nil {
+		tS.IndexedSelects = *jsnCfg.Indexed_selects
+	}
+	if jsnCfg.String_indexed_fields != nil {
+		sif := make([]string, len(*jsnCfg.String_indexed_fields))
+		for i, fID := range *jsnCfg.String_indexed_fields {
+			sif[i] = fID
+		}
+		tS.StringIndexedFields = &sif
+	}
+	if jsnCfg.Prefix_indexed_fields != nil {
+		pif := make([]string, len(*jsnCfg.Prefix_indexed_fields))
+		for i, fID := range *jsnCfg.Prefix_indexed_fields {
+			pif[i] = fID
+		}
+		tS.PrefixIndexedFields = &pif
+	}
+	if jsnCfg.Suffix_indexed_fields != nil {
+		sif := make([]string, len(*jsnCfg.Suffix_indexed_fields))
+		for i, fID := range *jsnCfg.Suffix_indexed_fields {
+			sif[i] = fID
+		}
+		tS.SuffixIndexedFields = &sif
+	}
+	if jsnCfg.Nested_fields != nil {
+		tS.NestedFields = *jsnCfg.Nested_fields
+	}
+	return
+}
+
+// AsMapInterface returns the config as a map[string]any
+func (tS *TaxSCfg) AsMapInterface() (initialMP map[string]any) {
+	initialMP = map[string]any{
+		utils.IndexedSelectsCfg: tS.IndexedSelects,
+		utils.NestedFieldsCfg:   tS.NestedFields,
+	}
+	if tS.StringIndexedFields != nil {
+		stringIndexedFields := make([]string, len(*tS.StringIndexedFields))
+		for i, item := range *tS.StringIndexedFields {
+			stringIndexedFields[i] = item
+		}
+		initialMP[utils.StringIndexedFieldsCfg] = stringIndexedFields
+	}
+	if tS.PrefixIndexedFields != nil {
+		prefixIndexedFields := make([]string, len(*tS.PrefixIndexedFields))
+		for i, item := range *tS.PrefixIndexedFields {
+			prefixIndexedFields[i] = item
+		}
+		initialMP[utils.PrefixIndexedFieldsCfg] = prefixIndexedFields
+	}
+	if tS.SuffixIndexedFields != nil {
+		suffixIndexedFields := make([]string, len(*tS.SuffixIndexedFields))
+		for i, item := range *tS.SuffixIndexedFields {
+			suffixIndexedFields[i] = item
+		}
+		initialMP[utils.SuffixIndexedFieldsCfg] = suffixIndexedFields
+	}
+	return
+}
+
+// Clone returns a deep copy of TaxSCfg
+func (tS TaxSCfg) Clone() (cln *TaxSCfg) {
+	cln = &TaxSCfg{
+		IndexedSelects: tS.IndexedSelects,
+		NestedFields:   tS.NestedFields,
+	}
+	if tS.StringIndexedFields != nil {
+		idx := make([]string, len(*tS.StringIndexedFields))
+		for i, dx := range *tS.StringIndexedFields {
+			idx[i] = dx
+		}
+		cln.StringIndexedFields = &idx
+	}
+	if tS.PrefixIndexedFields != nil {
+		idx := make([]string, len(*tS.PrefixIndexedFields))
+		for i, dx := range *tS.PrefixIndexedFields {
+			idx[i] = dx
+		}
+		cln.PrefixIndexedFields = &idx
+	}
+	if tS.SuffixIndexedFields != nil {
+		idx := make([]string, len(*tS.SuffixIndexedFields))
+		for i, dx := range *tS.SuffixIndexedFields {
+			idx[i] = dx
+		}
+		cln.SuffixIndexedFields = &idx
+	}
+	return
+}
@@ -0,0 +1,94 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package config
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestTaxSCfgloadFromJsonCfg(t *testing.T) {
+	jsonCfg := &TaxSJsonCfg{
+		Indexed_selects:       utils.BoolPointer(false),
+		String_indexed_fields: &[]string{"*req.Field1"},
+		Prefix_indexed_fields: &[]string{"*req.Field1", "*req.Field2"},
+		Suffix_indexed_fields: &[]string{"*req.Field1", "*req.Field2"},
+		Nested_fields:         utils.BoolPointer(true),
+	}
+	expected := &TaxSCfg{
+		IndexedSelects:      false,
+		StringIndexedFields: &[]string{"*req.Field1"},
+		PrefixIndexedFields: &[]string{"*req.Field1", "*req.Field2"},
+		SuffixIndexedFields: &[]string{"*req.Field1", "*req.Field2"},
+		NestedFields:        true,
+	}
+	jsncfg := NewDefaultCGRConfig()
+	if err = jsncfg.taxSCfg.loadFromJSONCfg(jsonCfg); err != nil {
+		t.Error(err)
+	} else if !reflect.DeepEqual(expected, jsncfg.taxSCfg) {
+		t.Errorf("Expected %+v \n, received %+v", utils.ToJSON(expected), utils.ToJSON(jsncfg.taxSCfg))
+	}
+}
+
+func TestTaxSCfgAsMapInterface(t *testing.T) {
+	cfgJSONStr := `{
+	"taxes": {
+		"string_indexed_fields": ["*req.Account"],
+		"prefix_indexed_fields": ["*req.Destination"],
+		"nested_fields": true,
+	},
+}`
+	eMap := map[string]any{
+		utils.IndexedSelectsCfg:      true,
+		utils.StringIndexedFieldsCfg: []string{"*req.Account"},
+		utils.PrefixIndexedFieldsCfg: []string{"*req.Destination"},
+		utils.SuffixIndexedFieldsCfg: []string{},
+		utils.NestedFieldsCfg:        true,
+	}
+	if cgrCfg, err := NewCGRConfigFromJSONStringWithDefaults(cfgJSONStr); err != nil {
+		t.Error(err)
+	} else if rcv := cgrCfg.taxSCfg.AsMapInterface(); !reflect.DeepEqual(eMap, rcv) {
+		t.Errorf("Expected %+v, recieved %+v", eMap, rcv)
+	}
+}
+
+func TestTaxSCfgClone(t *testing.T) {
+	ban := &TaxSCfg{
+		IndexedSelects:      true,
+		StringIndexedFields: &[]string{"*req.Field1"},
+		PrefixIndexedFields: &[]string{"*req.Field1", "*req.Field2"},
+		SuffixIndexedFields: &[]string{"*req.Field1", "*req.Field2"},
+		NestedFields:        true,
+	}
+	rcv := ban.Clone()
+	if !reflect.DeepEqual(ban, rcv) {
+		t.Errorf("Expected: %+v\nReceived: %+v", utils.ToJSON(ban), utils.ToJSON(rcv))
+	}
+	if (*rcv.StringIndexedFields)[0] = ""; (*ban.StringIndexedFields)[0] != "*req.Field1" {
+		t.Errorf("Expected clone to not modify the cloned")
+	}
+	if (*rcv.PrefixIndexedFields)[0] = ""; (*ban.PrefixIndexedFields)[0] != "*req.Field1" {
+		t.Errorf("Expected clone to not modify the cloned")
+	}
+	if (*rcv.SuffixIndexedFields)[0] = ""; (*ban.SuffixIndexedFields)[0] != "*req.Field1" {
+		t.Errorf("Expected clone to not modify the cloned")
+	}
+}
@@ -0,0 +1,66 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package console
+
+import (
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func init() {
+	c := &CmdEEsGetDeadLetters{
+		name:      "ees_dead_letters",
+		rpcMethod: utils.EeSv1GetDeadLetters,
+		rpcParams: new(engine.ArgsDeadLetters),
+	}
+	commands[c.Name()] = c
+	c.CommandExecuter = &CommandExecuter{c}
+}
+
+// Commander implementation
+type CmdEEsGetDeadLetters struct {
+	name      string
+	rpcMethod string
+	rpcParams *engine.ArgsDeadLetters
+	*CommandExecuter
+}
+
+func (self *CmdEEsGetDeadLetters) Name() string {
+	return self.name
+}
+
+func (self *CmdEEsGetDeadLetters) RpcMethod() string {
+	return self.rpcMethod
+}
+
+func (self *CmdEEsGetDeadLetters) RpcParams(reset bool) any {
+	if reset || self.rpcParams == nil {
+		self.rpcParams = new(engine.ArgsDeadLetters)
+	}
+	return self.rpcParams
+}
+
+func (self *CmdEEsGetDeadLetters) PostprocessRpcParams() error {
+	return nil
+}
+
+func (self *CmdEEsGetDeadLetters) RpcResult() any {
+	a := make([]*engine.DeadLetter, 0)
+	return &a
+}
@@ -0,0 +1,66 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package console
+
+import (
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func init() {
+	c := &CmdEEsReplayDeadLetters{
+		name:      "ees_replay_dead_letters",
+		rpcMethod: utils.EeSv1ReplayDeadLetters,
+		rpcParams: new(engine.ArgsDeadLetters),
+	}
+	commands[c.Name()] = c
+	c.CommandExecuter = &CommandExecuter{c}
+}
+
+// Commander implementation
+type CmdEEsReplayDeadLetters struct {
+	name      string
+	rpcMethod string
+	rpcParams *engine.ArgsDeadLetters
+	*CommandExecuter
+}
+
+func (self *CmdEEsReplayDeadLetters) Name() string {
+	return self.name
+}
+
+func (self *CmdEEsReplayDeadLetters) RpcMethod() string {
+	return self.rpcMethod
+}
+
+func (self *CmdEEsReplayDeadLetters) RpcParams(reset bool) any {
+	if reset || self.rpcParams == nil {
+		self.rpcParams = new(engine.ArgsDeadLetters)
+	}
+	return self.rpcParams
+}
+
+func (self *CmdEEsReplayDeadLetters) PostprocessRpcParams() error {
+	return nil
+}
+
+func (self *CmdEEsReplayDeadLetters) RpcResult() any {
+	var s string
+	return &s
+}
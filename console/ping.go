@@ -81,6 +81,8 @@ func (self *CmdApierPing) RpcMethod() string {
 		return utils.APIerSv1Ping
 	case utils.EEsLow:
 		return utils.EeSv1Ping
+	case utils.InvoicesLow:
+		return utils.InvoiceSv1Ping
 	default:
 	}
 	return self.rpcMethod
@@ -540,6 +540,40 @@ func TestCmdPingEEsLow(t *testing.T) {
 	}
 }
 
+func TestCmdPingInvoicesLow(t *testing.T) {
+	// commands map is initiated in init function
+	command := commands["ping"]
+	castCommand, canCast := command.(*CmdApierPing)
+	if !canCast {
+		t.Fatalf("cannot cast")
+	}
+	castCommand.item = utils.InvoicesLow
+	result2 := command.RpcMethod()
+	if !reflect.DeepEqual(result2, utils.InvoiceSv1Ping) {
+		t.Errorf("Expected <%+v>, Received <%+v>", utils.InvoiceSv1Ping, result2)
+	}
+	m, ok := reflect.TypeOf(new(v1.InvoiceSv1)).MethodByName(strings.Split(command.RpcMethod(), utils.NestingSep)[1])
+	if !ok {
+		t.Fatal("method not found")
+	}
+	if m.Type.NumIn() != 4 { // expecting 4 inputs
+		t.Fatalf("invalid number of input parameters ")
+	}
+	// for coverage purpose
+	result := command.RpcParams(true)
+	if !reflect.DeepEqual(result, new(StringWrapper)) {
+		t.Errorf("Expected <%T>, Received <%T>", new(StringWrapper), result)
+	}
+	// verify the type of output parameter
+	if ok := m.Type.In(3).AssignableTo(reflect.TypeOf(command.RpcResult())); !ok {
+		t.Fatalf("cannot assign output parameter")
+	}
+	// for coverage purpose
+	if err := command.PostprocessRpcParams(); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestCmdPingTestDefault(t *testing.T) {
 	// commands map is initiated in init function
 	command := commands["ping"]
@@ -0,0 +1,62 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package console
+
+import "github.com/cgrates/cgrates/utils"
+
+func init() {
+	c := &CmdSessionsBackupStore{
+		name:      "sessions_backup_store",
+		rpcMethod: utils.SessionSv1BackupActiveSessions,
+	}
+	commands[c.Name()] = c
+	c.CommandExecuter = &CommandExecuter{c}
+}
+
+// CmdSessionsBackupStore forces the backup of the active sessions
+type CmdSessionsBackupStore struct {
+	name      string
+	rpcMethod string
+	rpcParams *utils.TenantWithAPIOpts
+	*CommandExecuter
+}
+
+func (cmd *CmdSessionsBackupStore) Name() string {
+	return cmd.name
+}
+
+func (cmd *CmdSessionsBackupStore) RpcMethod() string {
+	return cmd.rpcMethod
+}
+
+func (cmd *CmdSessionsBackupStore) RpcParams(reset bool) any {
+	if reset || cmd.rpcParams == nil {
+		cmd.rpcParams = &utils.TenantWithAPIOpts{APIOpts: make(map[string]any)}
+	}
+	return cmd.rpcParams
+}
+
+func (cmd *CmdSessionsBackupStore) PostprocessRpcParams() error {
+	return nil
+}
+
+func (cmd *CmdSessionsBackupStore) RpcResult() any {
+	var stored int
+	return &stored
+}
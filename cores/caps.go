@@ -20,10 +20,13 @@ package cores
 
 import (
 	"net"
+	"reflect"
+	"sync"
 
 	"github.com/cgrates/birpc"
 	"github.com/cgrates/birpc/jsonrpc"
 	"github.com/cgrates/cgrates/analyzers"
+	"github.com/cgrates/cgrates/config"
 	"github.com/cgrates/cgrates/engine"
 	"github.com/cgrates/cgrates/utils"
 	"github.com/cgrates/rpcclient"
@@ -38,7 +41,7 @@ type conn interface {
 }
 
 func newCapsGOBCodec(conn conn, caps *engine.Caps, anz *analyzers.AnalyzerService) (r birpc.ServerCodec) {
-	r = newCapsServerCodec(birpc.NewServerCodec(conn), caps)
+	r = newCapsServerCodec(birpc.NewServerCodec(conn), caps, remoteAddr(conn))
 	if anz != nil {
 		from := conn.RemoteAddr()
 		var fromstr string
@@ -56,7 +59,7 @@ func newCapsGOBCodec(conn conn, caps *engine.Caps, anz *analyzers.AnalyzerServic
 }
 
 func newCapsJSONCodec(conn conn, caps *engine.Caps, anz *analyzers.AnalyzerService) (r birpc.ServerCodec) {
-	r = newCapsServerCodec(jsonrpc.NewServerCodec(conn), caps)
+	r = newCapsServerCodec(jsonrpc.NewServerCodec(conn), caps, remoteAddr(conn))
 	if anz != nil {
 		from := conn.RemoteAddr()
 		var fromstr string
@@ -73,43 +76,60 @@ func newCapsJSONCodec(conn conn, caps *engine.Caps, anz *analyzers.AnalyzerServi
 	return
 }
 
-func newCapsServerCodec(sc birpc.ServerCodec, caps *engine.Caps) birpc.ServerCodec {
-	if !caps.IsLimited() {
+func newCapsServerCodec(sc birpc.ServerCodec, caps *engine.Caps, conn string) birpc.ServerCodec {
+	if !caps.IsLimited() && caps.Limiter() == nil {
 		return sc
 	}
 	return &capsServerCodec{
 		sc:   sc,
 		caps: caps,
+		lmts: newCapsLimits(caps.Limiter(), conn),
 	}
 }
 
 type capsServerCodec struct {
 	sc   birpc.ServerCodec
 	caps *engine.Caps
+	lmts *capsLimits
+
+	seq    uint64 // the request which body is read next
+	method string
 }
 
-func (c *capsServerCodec) ReadRequestHeader(r *birpc.Request) error {
-	return c.sc.ReadRequestHeader(r)
+func (c *capsServerCodec) ReadRequestHeader(r *birpc.Request) (err error) {
+	err = c.sc.ReadRequestHeader(r)
+	c.seq, c.method = r.Seq, r.ServiceMethod
+	return
 }
 
-func (c *capsServerCodec) ReadRequestBody(x any) error {
-	if err := c.caps.Allocate(); err != nil {
-		return err
+func (c *capsServerCodec) ReadRequestBody(x any) (err error) {
+	if c.caps.IsLimited() {
+		if err = c.caps.Allocate(); err != nil {
+			return
+		}
+	}
+	if err = c.sc.ReadRequestBody(x); err != nil ||
+		x == nil { // body discarded for invalid requests
+		return
 	}
-	return c.sc.ReadRequestBody(x)
+	return c.lmts.allocate(c.seq, c.method, x)
 }
 func (c *capsServerCodec) WriteResponse(r *birpc.Response, x any) error {
+	c.lmts.release(r.Seq)
 	if r.Error == utils.ErrMaxConcurrentRPCExceededNoCaps.Error() {
 		r.Error = utils.ErrMaxConcurrentRPCExceeded.Error()
-	} else {
+	} else if c.caps.IsLimited() {
 		defer c.caps.Deallocate()
 	}
 	return c.sc.WriteResponse(r, x)
 }
-func (c *capsServerCodec) Close() error { return c.sc.Close() }
+func (c *capsServerCodec) Close() error {
+	c.lmts.close()
+	return c.sc.Close()
+}
 
 func newCapsBiRPCGOBCodec(conn conn, caps *engine.Caps, anz *analyzers.AnalyzerService) (r birpc.BirpcCodec) {
-	r = newCapsBiRPCCodec(birpc.NewGobBirpcCodec(conn), caps)
+	r = newCapsBiRPCCodec(birpc.NewGobBirpcCodec(conn), caps, remoteAddr(conn))
 	if anz != nil {
 		from := conn.RemoteAddr()
 		var fromstr string
@@ -127,7 +147,7 @@ func newCapsBiRPCGOBCodec(conn conn, caps *engine.Caps, anz *analyzers.AnalyzerS
 }
 
 func newCapsBiRPCJSONCodec(conn conn, caps *engine.Caps, anz *analyzers.AnalyzerService) (r birpc.BirpcCodec) {
-	r = newCapsBiRPCCodec(jsonrpc.NewJSONBirpcCodec(conn), caps)
+	r = newCapsBiRPCCodec(jsonrpc.NewJSONBirpcCodec(conn), caps, remoteAddr(conn))
 	if anz != nil {
 		from := conn.RemoteAddr()
 		var fromstr string
@@ -144,19 +164,24 @@ func newCapsBiRPCJSONCodec(conn conn, caps *engine.Caps, anz *analyzers.Analyzer
 	return
 }
 
-func newCapsBiRPCCodec(sc birpc.BirpcCodec, caps *engine.Caps) birpc.BirpcCodec {
-	if !caps.IsLimited() {
+func newCapsBiRPCCodec(sc birpc.BirpcCodec, caps *engine.Caps, conn string) birpc.BirpcCodec {
+	if !caps.IsLimited() && caps.Limiter() == nil {
 		return sc
 	}
 	return &capsBiRPCCodec{
 		sc:   sc,
 		caps: caps,
+		lmts: newCapsLimits(caps.Limiter(), conn),
 	}
 }
 
 type capsBiRPCCodec struct {
 	sc   birpc.BirpcCodec
 	caps *engine.Caps
+	lmts *capsLimits
+
+	seq    uint64 // the request which body is read next
+	method string
 }
 
 // ReadHeader must read a message and populate either the request
@@ -166,16 +191,23 @@ func (c *capsBiRPCCodec) ReadHeader(req *birpc.Request, resp *birpc.Response) (e
 		req.ServiceMethod == utils.EmptyString { // caps will not process replies
 		return
 	}
-	if err = c.caps.Allocate(); err != nil {
-		req.ServiceMethod = utils.SessionSv1CapsError
-		err = nil
+	if c.caps.IsLimited() {
+		if err = c.caps.Allocate(); err != nil {
+			req.ServiceMethod = utils.SessionSv1CapsError
+			err = nil
+		}
 	}
+	c.seq, c.method = req.Seq, req.ServiceMethod
 	return
 }
 
 // ReadRequestBody into args argument of handler function.
 func (c *capsBiRPCCodec) ReadRequestBody(x any) (err error) {
-	return c.sc.ReadRequestBody(x)
+	if err = c.sc.ReadRequestBody(x); err != nil ||
+		c.method == utils.SessionSv1CapsError { // already refused by caps
+		return
+	}
+	return c.lmts.allocate(c.seq, c.method, x)
 }
 
 // ReadResponseBody into reply argument of handler function.
@@ -190,13 +222,102 @@ func (c *capsBiRPCCodec) WriteRequest(req *birpc.Request, x any) error {
 
 // WriteResponse must be safe for concurrent use by multiple goroutines.
 func (c *capsBiRPCCodec) WriteResponse(r *birpc.Response, x any) error {
+	c.lmts.release(r.Seq)
 	if r.Error == utils.ErrMaxConcurrentRPCExceededNoCaps.Error() {
 		r.Error = utils.ErrMaxConcurrentRPCExceeded.Error()
-	} else {
+	} else if c.caps.IsLimited() {
 		defer c.caps.Deallocate()
 	}
 	return c.sc.WriteResponse(r, x)
 }
 
 // Close is called when client/server finished with the connection.
-func (c *capsBiRPCCodec) Close() error { return c.sc.Close() }
+func (c *capsBiRPCCodec) Close() error {
+	c.lmts.close()
+	return c.sc.Close()
+}
+
+// remoteAddr returns the address of the client connection
+func remoteAddr(conn conn) string {
+	if addr := conn.RemoteAddr(); addr != nil {
+		return addr.String()
+	}
+	return utils.EmptyString
+}
+
+func newCapsLimits(lmtr *engine.CapsLimiter, conn string) *capsLimits {
+	if lmtr == nil {
+		return nil
+	}
+	return &capsLimits{
+		lmtr:     lmtr,
+		conn:     conn,
+		releases: make(map[uint64][]func()),
+	}
+}
+
+// capsLimits applies the caps limiter on the requests of one client connection,
+// so the releases are kept per connection and sequence of the requests
+type capsLimits struct {
+	lmtr     *engine.CapsLimiter
+	conn     string
+	mx       sync.Mutex
+	releases map[uint64][]func() // indexed on the sequence of the requests allocated, queued if the client reuses it
+}
+
+// allocate reserves the request on the limiter once its arguments are known
+func (cl *capsLimits) allocate(seq uint64, method string, args any) error {
+	if cl == nil {
+		return nil
+	}
+	release, err := cl.lmtr.Allocate(argsTenant(args), method, cl.conn)
+	if err != nil {
+		return err
+	}
+	cl.mx.Lock()
+	cl.releases[seq] = append(cl.releases[seq], release)
+	cl.mx.Unlock()
+	return nil
+}
+
+// release frees the request on the limiter after it was answered
+func (cl *capsLimits) release(seq uint64) {
+	if cl == nil {
+		return
+	}
+	cl.mx.Lock()
+	rls := cl.releases[seq]
+	if len(rls) > 1 {
+		cl.releases[seq] = rls[1:]
+	} else {
+		delete(cl.releases, seq)
+	}
+	cl.mx.Unlock()
+	if len(rls) != 0 {
+		rls[0]()
+	}
+}
+
+// close removes the counters kept for the connection
+func (cl *capsLimits) close() {
+	if cl == nil {
+		return
+	}
+	cl.lmtr.CloseConnection(cl.conn)
+}
+
+// argsTenant returns the Tenant field of the request arguments, the default tenant if missing
+func argsTenant(args any) string {
+	v := reflect.ValueOf(args)
+	for v.Kind() == reflect.Pointer && !v.IsNil() {
+		v = v.Elem()
+	}
+	if v.Kind() == reflect.Struct {
+		if fldTyp, has := v.Type().FieldByName(utils.Tenant); has && fldTyp.Type.Kind() == reflect.String {
+			if fld, err := v.FieldByIndexErr(fldTyp.Index); err == nil && fld.String() != utils.EmptyString {
+				return fld.String()
+			}
+		}
+	}
+	return config.CgrConfig().GeneralCfg().DefaultTenant
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/cgrates/birpc"
 	"github.com/cgrates/birpc/jsonrpc"
 	"github.com/cgrates/cgrates/analyzers"
+	"github.com/cgrates/cgrates/config"
 	"github.com/cgrates/cgrates/engine"
 	"github.com/cgrates/cgrates/utils"
 	"github.com/cgrates/rpcclient"
@@ -50,7 +51,7 @@ func (c *mockServerCodec) Close() error { return nil }
 func TestNewCapsServerCodec(t *testing.T) {
 	mk := new(mockServerCodec)
 	cr := engine.NewCaps(0, utils.MetaBusy)
-	if r := newCapsServerCodec(mk, cr); !reflect.DeepEqual(mk, r) {
+	if r := newCapsServerCodec(mk, cr, utils.EmptyString); !reflect.DeepEqual(mk, r) {
 		t.Errorf("Expected: %v ,received:%v", mk, r)
 	}
 	cr = engine.NewCaps(1, utils.MetaBusy)
@@ -58,7 +59,7 @@ func TestNewCapsServerCodec(t *testing.T) {
 		sc:   mk,
 		caps: cr,
 	}
-	codec := newCapsServerCodec(mk, cr)
+	codec := newCapsServerCodec(mk, cr, utils.EmptyString)
 	if !reflect.DeepEqual(exp, codec) {
 		t.Errorf("Expected: %v ,received:%v", exp, codec)
 	}
@@ -138,7 +139,7 @@ func (mockBiRPCCodec) Close() error                             { return nil }
 func TestNewCapsBiRPCCodec(t *testing.T) {
 	mk := new(mockBiRPCCodec)
 	cr := engine.NewCaps(0, utils.MetaBusy)
-	if r := newCapsBiRPCCodec(mk, cr); !reflect.DeepEqual(mk, r) {
+	if r := newCapsBiRPCCodec(mk, cr, utils.EmptyString); !reflect.DeepEqual(mk, r) {
 		t.Errorf("Expected: %v ,received:%v", mk, r)
 	}
 	cr = engine.NewCaps(1, utils.MetaBusy)
@@ -146,7 +147,7 @@ func TestNewCapsBiRPCCodec(t *testing.T) {
 		sc:   mk,
 		caps: cr,
 	}
-	codec := newCapsBiRPCCodec(mk, cr)
+	codec := newCapsBiRPCCodec(mk, cr, utils.EmptyString)
 	if !reflect.DeepEqual(exp, codec) {
 		t.Errorf("Expected: %v ,received:%v", exp, codec)
 	}
@@ -230,3 +231,151 @@ func TestNewCapsJSONBiRPCCodec(t *testing.T) {
 		t.Errorf("Expected: %v ,received:%v", exp, r)
 	}
 }
+
+type mockBodyServerCodec struct {
+	seq uint64
+}
+
+func (c *mockBodyServerCodec) ReadRequestHeader(r *birpc.Request) (err error) {
+	c.seq++
+	r.Seq = c.seq
+	r.ServiceMethod = utils.CoreSv1Ping
+	return
+}
+
+func (c *mockBodyServerCodec) ReadRequestBody(x any) (err error) { return }
+func (c *mockBodyServerCodec) WriteResponse(r *birpc.Response, x any) error {
+	return nil
+}
+func (c *mockBodyServerCodec) Close() error { return nil }
+
+func TestCapsServerCodecLimiter(t *testing.T) {
+	cr := engine.NewCaps(0, utils.MetaBusy)
+	cr.SetLimiter(engine.NewCapsLimiter([]*config.CapsLimitCfg{{
+		ID:                 "PER_TENANT",
+		Keys:               []string{utils.MetaTenant, utils.MetaConnection},
+		ConcurrentRequests: 1,
+	}}))
+	codec := newCapsServerCodec(new(mockBodyServerCodec), cr, "127.0.0.1:2012")
+	args := &utils.TenantWithAPIOpts{Tenant: "cgrates.org"}
+	r := new(birpc.Request)
+	if err := codec.ReadRequestHeader(r); err != nil {
+		t.Fatal(err)
+	}
+	if err := codec.ReadRequestBody(args); err != nil {
+		t.Fatal(err)
+	}
+	if err := codec.ReadRequestHeader(r); err != nil {
+		t.Fatal(err)
+	}
+	if err := codec.ReadRequestBody(args); err != utils.ErrMaxConcurrentRPCExceeded {
+		t.Errorf("Expected error: %v ,received: %v ", utils.ErrMaxConcurrentRPCExceeded, err)
+	}
+	// other tenant is counted separately
+	if err := codec.ReadRequestHeader(r); err != nil {
+		t.Fatal(err)
+	}
+	if err := codec.ReadRequestBody(&utils.TenantWithAPIOpts{Tenant: "itsyscom.com"}); err != nil {
+		t.Error(err)
+	}
+	if err := codec.WriteResponse(&birpc.Response{Seq: 1}, "reply"); err != nil {
+		t.Fatal(err)
+	}
+	if err := codec.ReadRequestHeader(r); err != nil {
+		t.Fatal(err)
+	}
+	if err := codec.ReadRequestBody(args); err != nil {
+		t.Error(err)
+	}
+	if err := codec.WriteResponse(&birpc.Response{Seq: 3}, "reply"); err != nil {
+		t.Fatal(err)
+	}
+	if err := codec.Close(); err != nil {
+		t.Fatal(err)
+	}
+	exp := map[string]map[string]*engine.CapsLimitStats{
+		"PER_TENANT": {
+			utils.ConcatenatedKey("cgrates.org", "127.0.0.1:2012"): {Active: 1, Peak: 1, Allowed: 2, Rejected: 1},
+		},
+	}
+	if rcv := cr.Limiter().Stats(); !reflect.DeepEqual(exp, rcv) {
+		t.Errorf("Expected: %s ,received: %s", utils.ToJSON(exp), utils.ToJSON(rcv))
+	}
+}
+
+type mockSeqServerCodec struct{}
+
+func (c *mockSeqServerCodec) ReadRequestHeader(r *birpc.Request) (err error) {
+	r.Seq = 7 // the client reuses the sequence
+	r.ServiceMethod = utils.CoreSv1Ping
+	return
+}
+
+func (c *mockSeqServerCodec) ReadRequestBody(x any) (err error) { return }
+func (c *mockSeqServerCodec) WriteResponse(r *birpc.Response, x any) error {
+	return nil
+}
+func (c *mockSeqServerCodec) Close() error { return nil }
+
+func TestCapsServerCodecLimiterSameSeq(t *testing.T) {
+	cr := engine.NewCaps(0, utils.MetaBusy)
+	cr.SetLimiter(engine.NewCapsLimiter([]*config.CapsLimitCfg{{
+		ID:                 "PER_TENANT",
+		Keys:               []string{utils.MetaTenant},
+		ConcurrentRequests: 3,
+	}}))
+	args := &utils.TenantWithAPIOpts{Tenant: "cgrates.org"}
+	codec1 := newCapsServerCodec(new(mockSeqServerCodec), cr, "127.0.0.1:2012")
+	codec2 := newCapsServerCodec(new(mockSeqServerCodec), cr, "127.0.0.1:2013")
+	for _, codec := range []birpc.ServerCodec{codec1, codec1, codec2} {
+		if err := codec.ReadRequestHeader(new(birpc.Request)); err != nil {
+			t.Fatal(err)
+		}
+		if err := codec.ReadRequestBody(args); err != nil {
+			t.Fatal(err)
+		}
+	}
+	exp := map[string]map[string]*engine.CapsLimitStats{
+		"PER_TENANT": {
+			"cgrates.org": {Active: 3, Peak: 3, Allowed: 3},
+		},
+	}
+	if rcv := cr.Limiter().Stats(); !reflect.DeepEqual(exp, rcv) {
+		t.Errorf("Expected: %s ,received: %s", utils.ToJSON(exp), utils.ToJSON(rcv))
+	}
+	for _, codec := range []birpc.ServerCodec{codec1, codec2, codec1} {
+		if err := codec.WriteResponse(&birpc.Response{Seq: 7}, "reply"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	exp["PER_TENANT"]["cgrates.org"].Active = 0
+	if rcv := cr.Limiter().Stats(); !reflect.DeepEqual(exp, rcv) {
+		t.Errorf("Expected: %s ,received: %s", utils.ToJSON(exp), utils.ToJSON(rcv))
+	}
+	// nothing left to release
+	if err := codec1.WriteResponse(&birpc.Response{Seq: 7}, "reply"); err != nil {
+		t.Fatal(err)
+	}
+	if rcv := cr.Limiter().Stats(); !reflect.DeepEqual(exp, rcv) {
+		t.Errorf("Expected: %s ,received: %s", utils.ToJSON(exp), utils.ToJSON(rcv))
+	}
+}
+
+func TestCapsArgsTenant(t *testing.T) {
+	dfltTnt := config.CgrConfig().GeneralCfg().DefaultTenant
+	for _, tc := range []struct {
+		args any
+		exp  string
+	}{
+		{args: &utils.CGREvent{Tenant: "itsyscom.com"}, exp: "itsyscom.com"},
+		{args: &utils.TenantIDWithAPIOpts{TenantID: &utils.TenantID{Tenant: "itsyscom.com"}}, exp: "itsyscom.com"},
+		{args: &utils.TenantIDWithAPIOpts{}, exp: dfltTnt},
+		{args: &utils.CGREvent{}, exp: dfltTnt},
+		{args: utils.StringPointer("itsyscom.com"), exp: dfltTnt},
+		{args: nil, exp: dfltTnt},
+	} {
+		if rcv := argsTenant(tc.args); rcv != tc.exp {
+			t.Errorf("Expected %q for %s, received: %q", tc.exp, utils.ToJSON(tc.args), rcv)
+		}
+	}
+}
@@ -47,6 +47,7 @@ func NewCoreService(cfg *config.CGRConfig, caps *engine.Caps, fileCPU io.Closer,
 		shdChan:    shdChan,
 		cfg:        cfg,
 		CapsStats:  st,
+		capsLmtr:   caps.Limiter(),
 		fileCPU:    fileCPU,
 		fileMEM:    fileMem,
 	}
@@ -55,6 +56,7 @@ func NewCoreService(cfg *config.CGRConfig, caps *engine.Caps, fileCPU io.Closer,
 type CoreService struct {
 	cfg        *config.CGRConfig
 	CapsStats  *engine.CapsStats
+	capsLmtr   *engine.CapsLimiter
 	shdWg      *sync.WaitGroup
 	stopMemPrf chan struct{}
 	shdChan    *utils.SyncedChan
@@ -142,6 +144,9 @@ func (cS *CoreService) V1Status(_ *context.Context, _ *utils.TenantWithAPIOpts,
 	}
 	response[utils.RunningSince] = utils.GetStartTime()
 	response[utils.GoVersion] = runtime.Version()
+	if cS.capsLmtr != nil {
+		response[utils.CapsLimits] = cS.capsLmtr.Stats()
+	}
 	*reply = response
 	return
 }
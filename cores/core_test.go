@@ -113,3 +113,29 @@ func TestCoreServiceStatus(t *testing.T) {
 
 	utils.GitLastLog = ""
 }
+
+func TestCoreServiceStatusCapsLimits(t *testing.T) {
+	cfgDflt := config.NewDefaultCGRConfig()
+	caps := engine.NewCaps(0, utils.MetaBusy)
+	caps.SetLimiter(engine.NewCapsLimiter([]*config.CapsLimitCfg{{
+		ID:                 "PER_TENANT",
+		Keys:               []string{utils.MetaTenant},
+		ConcurrentRequests: 1,
+	}}))
+	if _, err := caps.Limiter().Allocate("cgrates.org", utils.CoreSv1Status, utils.EmptyString); err != nil {
+		t.Fatal(err)
+	}
+	cores := NewCoreService(cfgDflt, caps, nil, "/tmp", nil, nil, nil, nil)
+	var reply map[string]any
+	if err := cores.V1Status(context.Background(), new(utils.TenantWithAPIOpts), &reply); err != nil {
+		t.Fatal(err)
+	}
+	exp := map[string]map[string]*engine.CapsLimitStats{
+		"PER_TENANT": {
+			"cgrates.org": {Active: 1, Peak: 1, Allowed: 1},
+		},
+	}
+	if !reflect.DeepEqual(exp, reply[utils.CapsLimits]) {
+		t.Errorf("Expected %s, received %s", utils.ToJSON(exp), utils.ToJSON(reply[utils.CapsLimits]))
+	}
+}
@@ -50,3 +50,42 @@ CREATE TABLE session_costs (
   KEY run_origin_idx (run_id, origin_id),
   KEY deleted_at_idx (deleted_at)
 );
+
+DROP TABLE IF EXISTS balance_ledger;
+CREATE TABLE balance_ledger (
+  id int(11) NOT NULL AUTO_INCREMENT,
+  tenant varchar(64) NOT NULL,
+  account varchar(128) NOT NULL,
+  balance_type varchar(64) NOT NULL,
+  balance_id varchar(128) NOT NULL,
+  balance_uuid varchar(64) NOT NULL,
+  old_value DECIMAL(20,4) NOT NULL,
+  new_value DECIMAL(20,4) NOT NULL,
+  delta DECIMAL(20,4) NOT NULL,
+  cause varchar(64) NOT NULL,
+  cause_id varchar(128) NOT NULL,
+  time TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+  PRIMARY KEY (`id`),
+  KEY account_time_idx (tenant, account, time),
+  KEY cause_idx (cause, cause_id)
+);
+
+DROP TABLE IF EXISTS invoices;
+CREATE TABLE invoices (
+  id int(11) NOT NULL AUTO_INCREMENT,
+  invoice_id varchar(40) NOT NULL,
+  tenant varchar(64) NOT NULL,
+  account varchar(128) NOT NULL,
+  period_start TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+  period_end TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+  currency varchar(16) NOT NULL,
+  line_items MEDIUMTEXT NOT NULL,
+  subtotal DECIMAL(20,4) NOT NULL,
+  discount DECIMAL(20,4) NOT NULL,
+  tax DECIMAL(20,4) NOT NULL,
+  total DECIMAL(20,4) NOT NULL,
+  created_at TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+  PRIMARY KEY (`id`),
+  UNIQUE KEY invoice_id (invoice_id),
+  KEY account_period_idx (tenant, account, period_start)
+);
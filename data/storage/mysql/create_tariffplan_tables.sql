@@ -421,6 +421,31 @@ CREATE TABLE tp_chargers (
     `id`,`filter_ids`,`run_id`,`attribute_ids`)
 );
 
+--
+-- Table structure for table `tp_tax_profiles`
+--
+
+DROP TABLE IF EXISTS tp_tax_profiles;
+CREATE TABLE tp_tax_profiles (
+  `pk` int(11) NOT NULL AUTO_INCREMENT,
+  `tpid` varchar(64) NOT NULL,
+  `tenant` varchar(64) NOT NULL,
+  `id` varchar(64) NOT NULL,
+  `filter_ids` varchar(64) NOT NULL,
+  `activation_interval` varchar(64) NOT NULL,
+  `weight` decimal(8,2) NOT NULL,
+  `component_id` varchar(64) NOT NULL,
+  `jurisdiction` varchar(64) NOT NULL,
+  `type` varchar(16) NOT NULL,
+  `value` decimal(16,4) NOT NULL,
+  `compound` BOOLEAN NOT NULL,
+  `created_at` TIMESTAMP,
+  PRIMARY KEY (`pk`),
+  KEY `tpid` (`tpid`),
+  UNIQUE KEY `unique_tp_tax_profiles` (`tpid`,`tenant`,
+    `id`,`filter_ids`,`component_id`)
+);
+
 --
 -- Table structure for table `tp_dispatchers`
 --
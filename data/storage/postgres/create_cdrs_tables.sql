@@ -57,3 +57,43 @@ DROP INDEX IF EXISTS run_origin_sessionscost_idx;
 CREATE INDEX run_origin_sessionscost_idx ON session_costs (run_id, origin_id);
 DROP INDEX IF EXISTS deleted_at_sessionscost_idx;
 CREATE INDEX deleted_at_sessionscost_idx ON session_costs (deleted_at);
+
+DROP TABLE IF EXISTS balance_ledger;
+CREATE TABLE balance_ledger (
+  id SERIAL PRIMARY KEY,
+  tenant VARCHAR(64) NOT NULL,
+  account VARCHAR(128) NOT NULL,
+  balance_type VARCHAR(64) NOT NULL,
+  balance_id VARCHAR(128) NOT NULL,
+  balance_uuid VARCHAR(64) NOT NULL,
+  old_value NUMERIC(20,4) NOT NULL,
+  new_value NUMERIC(20,4) NOT NULL,
+  delta NUMERIC(20,4) NOT NULL,
+  cause VARCHAR(64) NOT NULL,
+  cause_id VARCHAR(128) NOT NULL,
+  time TIMESTAMP WITH TIME ZONE NOT NULL
+);
+DROP INDEX IF EXISTS account_time_balanceledger_idx;
+CREATE INDEX account_time_balanceledger_idx ON balance_ledger (tenant, account, time);
+DROP INDEX IF EXISTS cause_balanceledger_idx;
+CREATE INDEX cause_balanceledger_idx ON balance_ledger (cause, cause_id);
+
+DROP TABLE IF EXISTS invoices;
+CREATE TABLE invoices (
+  id SERIAL PRIMARY KEY,
+  invoice_id VARCHAR(40) NOT NULL,
+  tenant VARCHAR(64) NOT NULL,
+  account VARCHAR(128) NOT NULL,
+  period_start TIMESTAMP WITH TIME ZONE NOT NULL,
+  period_end TIMESTAMP WITH TIME ZONE NOT NULL,
+  currency VARCHAR(16) NOT NULL,
+  line_items TEXT NOT NULL,
+  subtotal NUMERIC(20,4) NOT NULL,
+  discount NUMERIC(20,4) NOT NULL,
+  tax NUMERIC(20,4) NOT NULL,
+  total NUMERIC(20,4) NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+  UNIQUE (invoice_id)
+);
+DROP INDEX IF EXISTS account_period_invoices_idx;
+CREATE INDEX account_period_invoices_idx ON invoices (tenant, account, period_start);
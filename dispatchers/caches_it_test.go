@@ -213,6 +213,7 @@ func testDspChcPrecacheStatus(t *testing.T) {
 		utils.CacheReverseFilterIndexes:    utils.MetaReady,
 		utils.CacheCapsEvents:              utils.MetaReady,
 		utils.MetaSentryPeer:               utils.MetaReady,
+		utils.CacheHTTPLookups:             utils.MetaReady,
 		utils.MetaAPIBan:                   utils.MetaReady,
 
 		utils.CacheReplicationHosts: utils.MetaReady,
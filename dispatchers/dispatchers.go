@@ -60,6 +60,22 @@ func (dS *DispatcherService) Shutdown() {
 	utils.Logger.Info(fmt.Sprintf("<%s> service shutdown complete", utils.DispatcherS))
 }
 
+// CollectMetrics implements engine.MetricsCollector
+func (dS *DispatcherService) CollectMetrics(mw *engine.MetricsWriter) {
+	mw.Family("dispatcher_host_requests_total", engine.MetricTypeCounter, "Number of requests dispatched to the host.")
+	for _, hostKey := range hostRequests.Keys() {
+		tntID := utils.NewTenantID(hostKey)
+		mw.Sample("dispatcher_host_requests_total", float64(hostRequests.Get(hostKey)),
+			"tenant", tntID.Tenant, "host", tntID.ID)
+	}
+	mw.Family("dispatcher_host_errors_total", engine.MetricTypeCounter, "Number of requests failed on the host.")
+	for _, hostKey := range hostRequests.Keys() {
+		tntID := utils.NewTenantID(hostKey)
+		mw.Sample("dispatcher_host_errors_total", float64(hostErrors.Get(hostKey)),
+			"tenant", tntID.Tenant, "host", tntID.ID)
+	}
+}
+
 func (dS *DispatcherService) authorizeEvent(ev *utils.CGREvent,
 	reply *engine.AttrSProcessEventReply) (err error) {
 	ev.APIOpts[utils.OptsContext] = utils.MetaAuth
@@ -69,3 +69,57 @@ func (dS *DispatcherService) EeSv1ProcessEvent(ctx *context.Context, args *engin
 	}
 	return dS.Dispatch(&utils.CGREvent{Tenant: tnt, Event: ev, APIOpts: opts}, utils.MetaCore, utils.EeSv1ProcessEvent, args, reply)
 }
+
+func (dS *DispatcherService) EeSv1GetDeadLetters(ctx *context.Context, args *engine.ArgsDeadLetters, reply *[]*engine.DeadLetter) (err error) {
+	tnt := dS.cfg.GeneralCfg().DefaultTenant
+	if args != nil && len(args.Tenant) != 0 {
+		tnt = args.Tenant
+	}
+	opts := make(map[string]any)
+	if args != nil {
+		opts = args.APIOpts
+	}
+	if len(dS.cfg.DispatcherSCfg().AttributeSConns) != 0 {
+		if err = dS.authorize(utils.EeSv1GetDeadLetters, tnt,
+			utils.IfaceAsString(opts[utils.OptsAPIKey]), utils.TimePointer(time.Now())); err != nil {
+			return
+		}
+	}
+	return dS.Dispatch(&utils.CGREvent{Tenant: tnt, APIOpts: opts}, utils.MetaEEs, utils.EeSv1GetDeadLetters, args, reply)
+}
+
+func (dS *DispatcherService) EeSv1ReplayDeadLetters(ctx *context.Context, args *engine.ArgsDeadLetters, reply *string) (err error) {
+	tnt := dS.cfg.GeneralCfg().DefaultTenant
+	if args != nil && len(args.Tenant) != 0 {
+		tnt = args.Tenant
+	}
+	opts := make(map[string]any)
+	if args != nil {
+		opts = args.APIOpts
+	}
+	if len(dS.cfg.DispatcherSCfg().AttributeSConns) != 0 {
+		if err = dS.authorize(utils.EeSv1ReplayDeadLetters, tnt,
+			utils.IfaceAsString(opts[utils.OptsAPIKey]), utils.TimePointer(time.Now())); err != nil {
+			return
+		}
+	}
+	return dS.Dispatch(&utils.CGREvent{Tenant: tnt, APIOpts: opts}, utils.MetaEEs, utils.EeSv1ReplayDeadLetters, args, reply)
+}
+
+func (dS *DispatcherService) EeSv1PurgeDeadLetters(ctx *context.Context, args *engine.ArgsDeadLetters, reply *string) (err error) {
+	tnt := dS.cfg.GeneralCfg().DefaultTenant
+	if args != nil && len(args.Tenant) != 0 {
+		tnt = args.Tenant
+	}
+	opts := make(map[string]any)
+	if args != nil {
+		opts = args.APIOpts
+	}
+	if len(dS.cfg.DispatcherSCfg().AttributeSConns) != 0 {
+		if err = dS.authorize(utils.EeSv1PurgeDeadLetters, tnt,
+			utils.IfaceAsString(opts[utils.OptsAPIKey]), utils.TimePointer(time.Now())); err != nil {
+			return
+		}
+	}
+	return dS.Dispatch(&utils.CGREvent{Tenant: tnt, APIOpts: opts}, utils.MetaEEs, utils.EeSv1PurgeDeadLetters, args, reply)
+}
@@ -21,6 +21,8 @@ package dispatchers
 import (
 	"encoding/gob"
 	"fmt"
+	"hash/fnv"
+	"math"
 	"math/rand"
 	"sort"
 	"sync"
@@ -35,6 +37,10 @@ import (
 
 var (
 	internalDispatcher = &engine.DispatcherProfile{Tenant: utils.MetaInternal, ID: utils.MetaInternal}
+
+	// hostRequests and hostErrors count the calls dispatched per tenant:host
+	hostRequests = engine.NewMetricCounters()
+	hostErrors   = engine.NewMetricCounters()
 )
 
 func init() {
@@ -95,6 +101,14 @@ func newDispatcher(pfl *engine.DispatcherProfile) (d Dispatcher, err error) {
 		return newSingleDispatcher(hosts, pfl.StrategyParams, pfl.TenantID(), new(randomSort))
 	case utils.MetaRoundRobin:
 		return newSingleDispatcher(hosts, pfl.StrategyParams, pfl.TenantID(), new(roundRobinSort))
+	case utils.MetaHash:
+		var srt *hashSort
+		if srt, err = newHashSort(pfl.StrategyParams); err != nil {
+			return
+		}
+		return newSingleDispatcher(hosts, pfl.StrategyParams, pfl.TenantID(), srt)
+	case utils.MetaLeastLoad:
+		return newLeastLoadDispatcher(hosts, pfl.StrategyParams, pfl.TenantID())
 	case rpcclient.PoolBroadcast,
 		rpcclient.PoolBroadcastSync,
 		rpcclient.PoolBroadcastAsync:
@@ -169,6 +183,81 @@ func (rs *roundRobinSort) Sort(fltrs *engine.FilterS, ev utils.DataProvider, tnt
 	return getDispatcherHosts(fltrs, ev, tnt, dh)
 }
 
+// newHashSort constructs the hashSort out of the strategy params
+func newHashSort(params map[string]any) (*hashSort, error) {
+	fld, has := params[utils.MetaHashField]
+	if !has {
+		return nil, fmt.Errorf("missing <%s> parameter for <%s> strategy",
+			utils.MetaHashField, utils.MetaHash)
+	}
+	return &hashSort{field: utils.IfaceAsString(fld)}, nil
+}
+
+// hashSort will sort the matching hosts for the event using rendezvous (highest random weight) hashing
+// over the value of the configured field so the same value always lands on the same host
+// and adding or removing a host remaps only the values bound to that host
+// the host weights are used as relative capacities, the hosts with a weight
+// lower or equal to 0 counting with the capacity of a host with weight 1
+type hashSort struct{ field string }
+
+func (hs *hashSort) Sort(fltrs *engine.FilterS, ev utils.DataProvider, tnt string, hosts engine.DispatcherHostProfiles) (hostIDs engine.DispatcherHostIDs, err error) {
+	var key string
+	if key, err = utils.DPDynamicString(hs.field, ev); err != nil {
+		if err != utils.ErrNotFound {
+			return
+		}
+		err = nil // without the field we dispatch on the weights only
+		return getDispatcherHosts(fltrs, ev, tnt, hosts)
+	}
+	dh := make(engine.DispatcherHostProfiles, len(hosts))
+	scores := make(map[string]float64, len(hosts))
+	for i, host := range hosts {
+		dh[i] = host
+		scores[host.ID] = hashScore(key, host)
+	}
+	sort.SliceStable(dh, func(i, j int) bool {
+		return scores[dh[i].ID] > scores[dh[j].ID]
+	})
+	return getDispatcherHosts(fltrs, ev, tnt, dh)
+}
+
+// hashScore returns the weighted rendezvous score of the host for the key
+func hashScore(key string, host *engine.DispatcherHostProfile) float64 {
+	h := fnv.New64a()
+	h.Write([]byte(host.ID))
+	h.Write([]byte{0})
+	h.Write([]byte(key))
+	// fnv has poor avalanche on short keys so we finalize it as splitmix64 does
+	z := h.Sum64()
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	z ^= z >> 31
+	u := (float64(z>>11) + 0.5) / (1 << 53) // uniform in (0,1)
+	weight := host.Weight
+	if weight <= 0 { // no weight means the capacity of one
+		weight = 1
+	}
+	return weight / -math.Log(u)
+}
+
+// newLeastLoadDispatcher constructs a loadDispatcher which sends the event to the host with less
+// requests in flight, the hosts with the same load are used in order of their weights
+func newLeastLoadDispatcher(hosts engine.DispatcherHostProfiles, params map[string]any, tntID string) (_ Dispatcher, err error) {
+	ratio := int64(1)
+	if dflt, has := params[utils.MetaDefaultRatio]; has {
+		if ratio, err = utils.IfaceAsTInt64(dflt); err != nil {
+			return
+		}
+	}
+	return &loadDispatcher{
+		tntID:        tntID,
+		defaultRatio: ratio,
+		sorter:       new(noSort),
+		hosts:        hosts,
+		keepLoad:     true,
+	}, nil
+}
+
 // newSingleDispatcher is the constructor for singleDispatcher struct
 func newSingleDispatcher(hosts engine.DispatcherHostProfiles, params map[string]any, tntID string, sorter hostSorter) (_ Dispatcher, err error) {
 	if dflt, has := params[utils.MetaDefaultRatio]; has {
@@ -296,6 +385,23 @@ type loadDispatcher struct {
 	defaultRatio int64
 	sorter       hostSorter
 	hosts        engine.DispatcherHostProfiles
+
+	keepLoad bool         // count the requests in flight across dispatches when not replicated (*least_load)
+	lM       *LoadMetrics // local load used by keepLoad
+	lMMux    sync.Mutex
+}
+
+// getLoadMetrics returns the local load of the hosts, building it on first use
+// so the requests in flight are counted across dispatches
+func (ld *loadDispatcher) getLoadMetrics() (lM *LoadMetrics, err error) {
+	ld.lMMux.Lock()
+	defer ld.lMMux.Unlock()
+	if ld.lM == nil {
+		if ld.lM, err = newLoadMetrics(ld.hosts, ld.defaultRatio); err != nil {
+			return
+		}
+	}
+	return ld.lM, nil
 }
 
 func (ld *loadDispatcher) Dispatch(dm *engine.DataManager, flts *engine.FilterS,
@@ -307,6 +413,10 @@ func (ld *loadDispatcher) Dispatch(dm *engine.DataManager, flts *engine.FilterS,
 		if lM, canCast = x.(*LoadMetrics); !canCast {
 			return fmt.Errorf("cannot cast %+v to *LoadMetrics", x)
 		}
+	} else if ld.keepLoad {
+		if lM, err = ld.getLoadMetrics(); err != nil {
+			return
+		}
 	} else if lM, err = newLoadMetrics(ld.hosts, ld.defaultRatio); err != nil {
 		return
 	}
@@ -465,7 +575,10 @@ func callDH(dh *engine.DispatcherHost, routeID string, dR *DispatcherRoute,
 				utils.DispatcherS, err.Error(), dR))
 		}
 	}
+	hostKey := utils.ConcatenatedKey(dh.Tenant, dh.ID)
+	hostRequests.Inc(hostKey)
 	if err = dh.Call(context.TODO(), method, args, reply); err != nil {
+		hostErrors.Inc(hostKey)
 		return
 	}
 	return
@@ -21,6 +21,7 @@ package dispatchers
 import (
 	"net/rpc"
 	"reflect"
+	"strconv"
 	"testing"
 
 	"github.com/cgrates/birpc"
@@ -1009,3 +1010,155 @@ func TestLibDispatcherDispatchFilterError(t *testing.T) {
 		t.Errorf("Expected error: %s received: %v", expErrMsg, err)
 	}
 }
+
+func TestLibDispatcherNewDispatcherMetaHash(t *testing.T) {
+	pfl := &engine.DispatcherProfile{
+		Hosts: engine.DispatcherHostProfiles{},
+		StrategyParams: map[string]any{
+			utils.MetaHashField: "~*req.Account",
+		},
+		Strategy: utils.MetaHash,
+	}
+	result, err := newDispatcher(pfl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := &hashSort{field: "~*req.Account"}
+	if !reflect.DeepEqual(result.(*singleResultDispatcher).sorter, exp) {
+		t.Errorf("\nExpected <%+v>, \nReceived <%+v>", exp, result.(*singleResultDispatcher).sorter)
+	}
+	pfl.StrategyParams = map[string]any{}
+	expected := "missing <*hash_field> parameter for <*hash> strategy"
+	if _, err = newDispatcher(pfl); err == nil || err.Error() != expected {
+		t.Errorf("\nExpected <%+v>, \nReceived <%+v>", expected, err)
+	}
+}
+
+func TestLibDispatcherNewDispatcherMetaLeastLoad(t *testing.T) {
+	pfl := &engine.DispatcherProfile{
+		Tenant:   "cgrates.org",
+		ID:       "DSP1",
+		Hosts:    engine.DispatcherHostProfiles{{ID: "testID1"}},
+		Strategy: utils.MetaLeastLoad,
+	}
+	result, err := newDispatcher(pfl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ld := result.(*loadDispatcher)
+	if ld.defaultRatio != 1 || ld.tntID != "cgrates.org:DSP1" ||
+		!reflect.DeepEqual(ld.sorter, new(noSort)) || !ld.keepLoad {
+		t.Errorf("Received <%+v>", ld)
+	}
+	pfl.StrategyParams = map[string]any{utils.MetaDefaultRatio: false}
+	expected := "cannot convert field<bool>: false to int"
+	if _, err = newDispatcher(pfl); err == nil || err.Error() != expected {
+		t.Errorf("\nExpected <%+v>, \nReceived <%+v>", expected, err)
+	}
+}
+
+func TestLibDispatcherLeastLoadGetHosts(t *testing.T) {
+	hosts := engine.DispatcherHostProfiles{
+		{ID: "testID1", Weight: 30},
+		{ID: "testID2", Weight: 20},
+		{ID: "testID3", Weight: 10},
+	}
+	ld, err := newLeastLoadDispatcher(hosts, nil, "cgrates.org:DSP1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	lM, err := ld.(*loadDispatcher).getLoadMetrics()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lM2, err := ld.(*loadDispatcher).getLoadMetrics(); err != nil {
+		t.Fatal(err)
+	} else if lM != lM2 {
+		t.Error("expected the same load metrics between dispatches")
+	}
+	lM.incrementLoad("testID1", "cgrates.org:DSP1")
+	lM.incrementLoad("testID1", "cgrates.org:DSP1")
+	lM.incrementLoad("testID3", "cgrates.org:DSP1")
+	exp := engine.DispatcherHostProfiles{hosts[1], hosts[2], hosts[0]}
+	if rcv := lM.getHosts(hosts.Clone()); !reflect.DeepEqual(exp, rcv) {
+		t.Errorf("Expected: %s, received: %s", utils.ToJSON(exp), utils.ToJSON(rcv))
+	}
+	lM.decrementLoad("testID1", "cgrates.org:DSP1")
+	lM.decrementLoad("testID1", "cgrates.org:DSP1")
+	exp = engine.DispatcherHostProfiles{hosts[0], hosts[1], hosts[2]}
+	if rcv := lM.getHosts(hosts.Clone()); !reflect.DeepEqual(exp, rcv) {
+		t.Errorf("Expected: %s, received: %s", utils.ToJSON(exp), utils.ToJSON(rcv))
+	}
+}
+
+func TestLibDispatcherHashSort(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	flts := engine.NewFilterS(cfg, nil, nil)
+	sorter := &hashSort{field: "~*req.Account"}
+	hosts := engine.DispatcherHostProfiles{
+		{ID: "testID1"},
+		{ID: "testID2"},
+		{ID: "testID3"},
+	}
+	ev := utils.MapStorage{utils.MetaReq: map[string]any{utils.AccountField: "1001"}}
+	hostIDs, err := sorter.Sort(flts, ev, "cgrates.org", hosts.Clone())
+	if err != nil {
+		t.Fatal(err)
+	} else if len(hostIDs) != 3 {
+		t.Fatalf("Expected 3 hosts, received: %q", hostIDs)
+	}
+	for i := 0; i < 10; i++ {
+		if rcv, err := sorter.Sort(flts, ev, "cgrates.org", hosts.Clone()); err != nil {
+			t.Fatal(err)
+		} else if !reflect.DeepEqual(hostIDs, rcv) {
+			t.Errorf("Expected: %q, received: %q", hostIDs, rcv)
+		}
+	}
+	// without the field the hosts are returned in their original order
+	exp := engine.DispatcherHostIDs{"testID1", "testID2", "testID3"}
+	if rcv, err := sorter.Sort(flts, utils.MapStorage{utils.MetaReq: map[string]any{}}, "cgrates.org", hosts.Clone()); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(exp, rcv) {
+		t.Errorf("Expected: %q, received: %q", exp, rcv)
+	}
+}
+
+func TestLibDispatcherHashSortRemoveHost(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	flts := engine.NewFilterS(cfg, nil, nil)
+	sorter := &hashSort{field: "~*req.OriginID"}
+	hosts := engine.DispatcherHostProfiles{
+		{ID: "testID1"},
+		{ID: "testID2"},
+		{ID: "testID3"},
+		{ID: "testID4"},
+	}
+	pinned := make(map[string]string)
+	counts := make(map[string]int)
+	for i := 0; i < 1000; i++ {
+		originID := strconv.Itoa(i)
+		ev := utils.MapStorage{utils.MetaReq: map[string]any{utils.OriginID: originID}}
+		hostIDs, err := sorter.Sort(flts, ev, "cgrates.org", hosts.Clone())
+		if err != nil {
+			t.Fatal(err)
+		}
+		pinned[originID] = hostIDs[0]
+		counts[hostIDs[0]]++
+	}
+	for _, host := range hosts {
+		if counts[host.ID] < 150 {
+			t.Errorf("unbalanced distribution: %v", counts)
+		}
+	}
+	// remove one host and make sure only its keys are remapped
+	for originID, hostID := range pinned {
+		ev := utils.MapStorage{utils.MetaReq: map[string]any{utils.OriginID: originID}}
+		hostIDs, err := sorter.Sort(flts, ev, "cgrates.org", hosts[:3].Clone())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hostID != "testID4" && hostIDs[0] != hostID {
+			t.Errorf("key %q remapped from %q to %q", originID, hostID, hostIDs[0])
+		}
+	}
+}
@@ -460,6 +460,60 @@ func (dS *DispatcherService) ReplicatorSv1GetDispatcherHost(ctx *context.Context
 	}, utils.MetaReplicator, utils.ReplicatorSv1GetDispatcherHost, args, reply)
 }
 
+func (dS *DispatcherService) ReplicatorSv1GetExchangeRate(ctx *context.Context, args *utils.TenantIDWithAPIOpts, reply *engine.ExchangeRate) (err error) {
+	tnt := dS.cfg.GeneralCfg().DefaultTenant
+	if args.TenantID != nil && args.TenantID.Tenant != utils.EmptyString {
+		tnt = args.TenantID.Tenant
+	}
+	if len(dS.cfg.DispatcherSCfg().AttributeSConns) != 0 {
+		if err = dS.authorize(utils.ReplicatorSv1GetExchangeRate, tnt,
+			utils.IfaceAsString(args.APIOpts[utils.OptsAPIKey]), utils.TimePointer(time.Now())); err != nil {
+			return
+		}
+	}
+	return dS.Dispatch(&utils.CGREvent{
+		Tenant:  tnt,
+		ID:      args.ID,
+		APIOpts: args.APIOpts,
+	}, utils.MetaReplicator, utils.ReplicatorSv1GetExchangeRate, args, reply)
+}
+
+func (dS *DispatcherService) ReplicatorSv1GetCalendar(ctx *context.Context, args *utils.TenantIDWithAPIOpts, reply *engine.Calendar) (err error) {
+	tnt := dS.cfg.GeneralCfg().DefaultTenant
+	if args.TenantID != nil && args.TenantID.Tenant != utils.EmptyString {
+		tnt = args.TenantID.Tenant
+	}
+	if len(dS.cfg.DispatcherSCfg().AttributeSConns) != 0 {
+		if err = dS.authorize(utils.ReplicatorSv1GetCalendar, tnt,
+			utils.IfaceAsString(args.APIOpts[utils.OptsAPIKey]), utils.TimePointer(time.Now())); err != nil {
+			return
+		}
+	}
+	return dS.Dispatch(&utils.CGREvent{
+		Tenant:  tnt,
+		ID:      args.ID,
+		APIOpts: args.APIOpts,
+	}, utils.MetaReplicator, utils.ReplicatorSv1GetCalendar, args, reply)
+}
+
+func (dS *DispatcherService) ReplicatorSv1GetTaxProfile(ctx *context.Context, args *utils.TenantIDWithAPIOpts, reply *engine.TaxProfile) (err error) {
+	tnt := dS.cfg.GeneralCfg().DefaultTenant
+	if args.TenantID != nil && args.TenantID.Tenant != utils.EmptyString {
+		tnt = args.TenantID.Tenant
+	}
+	if len(dS.cfg.DispatcherSCfg().AttributeSConns) != 0 {
+		if err = dS.authorize(utils.ReplicatorSv1GetTaxProfile, tnt,
+			utils.IfaceAsString(args.APIOpts[utils.OptsAPIKey]), utils.TimePointer(time.Now())); err != nil {
+			return
+		}
+	}
+	return dS.Dispatch(&utils.CGREvent{
+		Tenant:  tnt,
+		ID:      args.ID,
+		APIOpts: args.APIOpts,
+	}, utils.MetaReplicator, utils.ReplicatorSv1GetTaxProfile, args, reply)
+}
+
 func (dS *DispatcherService) ReplicatorSv1GetItemLoadIDs(ctx *context.Context, args *utils.StringWithAPIOpts, rpl *map[string]int64) (err error) {
 	if args == nil {
 		args = new(utils.StringWithAPIOpts)
@@ -894,6 +948,63 @@ func (dS *DispatcherService) ReplicatorSv1SetDispatcherHost(ctx *context.Context
 	}, utils.MetaReplicator, utils.ReplicatorSv1SetDispatcherHost, args, rpl)
 }
 
+func (dS *DispatcherService) ReplicatorSv1SetExchangeRate(ctx *context.Context, args *engine.ExchangeRateWithAPIOpts, rpl *string) (err error) {
+	if args == nil {
+		args = &engine.ExchangeRateWithAPIOpts{
+			ExchangeRate: &engine.ExchangeRate{},
+		}
+	}
+	args.Tenant = utils.FirstNonEmpty(args.Tenant, dS.cfg.GeneralCfg().DefaultTenant)
+	if len(dS.cfg.DispatcherSCfg().AttributeSConns) != 0 {
+		if err = dS.authorize(utils.ReplicatorSv1SetExchangeRate, args.Tenant,
+			utils.IfaceAsString(args.APIOpts[utils.OptsAPIKey]), utils.TimePointer(time.Now())); err != nil {
+			return
+		}
+	}
+	return dS.Dispatch(&utils.CGREvent{
+		Tenant:  args.Tenant,
+		APIOpts: args.APIOpts,
+	}, utils.MetaReplicator, utils.ReplicatorSv1SetExchangeRate, args, rpl)
+}
+
+func (dS *DispatcherService) ReplicatorSv1SetCalendar(ctx *context.Context, args *engine.CalendarWithAPIOpts, rpl *string) (err error) {
+	if args == nil {
+		args = &engine.CalendarWithAPIOpts{
+			Calendar: &engine.Calendar{},
+		}
+	}
+	args.Tenant = utils.FirstNonEmpty(args.Tenant, dS.cfg.GeneralCfg().DefaultTenant)
+	if len(dS.cfg.DispatcherSCfg().AttributeSConns) != 0 {
+		if err = dS.authorize(utils.ReplicatorSv1SetCalendar, args.Tenant,
+			utils.IfaceAsString(args.APIOpts[utils.OptsAPIKey]), utils.TimePointer(time.Now())); err != nil {
+			return
+		}
+	}
+	return dS.Dispatch(&utils.CGREvent{
+		Tenant:  args.Tenant,
+		APIOpts: args.APIOpts,
+	}, utils.MetaReplicator, utils.ReplicatorSv1SetCalendar, args, rpl)
+}
+
+func (dS *DispatcherService) ReplicatorSv1SetTaxProfile(ctx *context.Context, args *engine.TaxProfileWithAPIOpts, rpl *string) (err error) {
+	if args == nil {
+		args = &engine.TaxProfileWithAPIOpts{
+			TaxProfile: &engine.TaxProfile{},
+		}
+	}
+	args.Tenant = utils.FirstNonEmpty(args.Tenant, dS.cfg.GeneralCfg().DefaultTenant)
+	if len(dS.cfg.DispatcherSCfg().AttributeSConns) != 0 {
+		if err = dS.authorize(utils.ReplicatorSv1SetTaxProfile, args.Tenant,
+			utils.IfaceAsString(args.APIOpts[utils.OptsAPIKey]), utils.TimePointer(time.Now())); err != nil {
+			return
+		}
+	}
+	return dS.Dispatch(&utils.CGREvent{
+		Tenant:  args.Tenant,
+		APIOpts: args.APIOpts,
+	}, utils.MetaReplicator, utils.ReplicatorSv1SetTaxProfile, args, rpl)
+}
+
 func (dS *DispatcherService) ReplicatorSv1RemoveThreshold(ctx *context.Context, args *utils.TenantIDWithAPIOpts, rpl *string) (err error) {
 	if args == nil {
 		args = &utils.TenantIDWithAPIOpts{
@@ -1309,6 +1420,63 @@ func (dS *DispatcherService) ReplicatorSv1RemoveDispatcherHost(ctx *context.Cont
 	}, utils.MetaReplicator, utils.ReplicatorSv1RemoveDispatcherHost, args, rpl)
 }
 
+func (dS *DispatcherService) ReplicatorSv1RemoveExchangeRate(ctx *context.Context, args *utils.TenantIDWithAPIOpts, rpl *string) (err error) {
+	if args == nil {
+		args = &utils.TenantIDWithAPIOpts{
+			TenantID: &utils.TenantID{},
+		}
+	}
+	args.Tenant = utils.FirstNonEmpty(args.Tenant, dS.cfg.GeneralCfg().DefaultTenant)
+	if len(dS.cfg.DispatcherSCfg().AttributeSConns) != 0 {
+		if err = dS.authorize(utils.ReplicatorSv1RemoveExchangeRate, args.Tenant,
+			utils.IfaceAsString(args.APIOpts[utils.OptsAPIKey]), utils.TimePointer(time.Now())); err != nil {
+			return
+		}
+	}
+	return dS.Dispatch(&utils.CGREvent{
+		Tenant:  args.Tenant,
+		APIOpts: args.APIOpts,
+	}, utils.MetaReplicator, utils.ReplicatorSv1RemoveExchangeRate, args, rpl)
+}
+
+func (dS *DispatcherService) ReplicatorSv1RemoveCalendar(ctx *context.Context, args *utils.TenantIDWithAPIOpts, rpl *string) (err error) {
+	if args == nil {
+		args = &utils.TenantIDWithAPIOpts{
+			TenantID: &utils.TenantID{},
+		}
+	}
+	args.Tenant = utils.FirstNonEmpty(args.Tenant, dS.cfg.GeneralCfg().DefaultTenant)
+	if len(dS.cfg.DispatcherSCfg().AttributeSConns) != 0 {
+		if err = dS.authorize(utils.ReplicatorSv1RemoveCalendar, args.Tenant,
+			utils.IfaceAsString(args.APIOpts[utils.OptsAPIKey]), utils.TimePointer(time.Now())); err != nil {
+			return
+		}
+	}
+	return dS.Dispatch(&utils.CGREvent{
+		Tenant:  args.Tenant,
+		APIOpts: args.APIOpts,
+	}, utils.MetaReplicator, utils.ReplicatorSv1RemoveCalendar, args, rpl)
+}
+
+func (dS *DispatcherService) ReplicatorSv1RemoveTaxProfile(ctx *context.Context, args *utils.TenantIDWithAPIOpts, rpl *string) (err error) {
+	if args == nil {
+		args = &utils.TenantIDWithAPIOpts{
+			TenantID: &utils.TenantID{},
+		}
+	}
+	args.Tenant = utils.FirstNonEmpty(args.Tenant, dS.cfg.GeneralCfg().DefaultTenant)
+	if len(dS.cfg.DispatcherSCfg().AttributeSConns) != 0 {
+		if err = dS.authorize(utils.ReplicatorSv1RemoveTaxProfile, args.Tenant,
+			utils.IfaceAsString(args.APIOpts[utils.OptsAPIKey]), utils.TimePointer(time.Now())); err != nil {
+			return
+		}
+	}
+	return dS.Dispatch(&utils.CGREvent{
+		Tenant:  args.Tenant,
+		APIOpts: args.APIOpts,
+	}, utils.MetaReplicator, utils.ReplicatorSv1RemoveTaxProfile, args, rpl)
+}
+
 // ReplicatorSv1GetIndexes .
 func (dS *DispatcherService) ReplicatorSv1GetIndexes(ctx *context.Context, args *utils.GetIndexesArg, reply *map[string]utils.StringSet) (err error) {
 	if args == nil {
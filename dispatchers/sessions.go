@@ -335,6 +335,42 @@ func (dS *DispatcherService) SessionSv1DeactivateSessions(ctx *context.Context,
 	}, utils.MetaSessionS, utils.SessionSv1DeactivateSessions, args, reply)
 }
 
+func (dS *DispatcherService) SessionSv1BackupActiveSessions(ctx *context.Context, args *utils.TenantWithAPIOpts,
+	reply *int) (err error) {
+	tnt := dS.cfg.GeneralCfg().DefaultTenant
+	if args.Tenant != utils.EmptyString {
+		tnt = args.Tenant
+	}
+	if len(dS.cfg.DispatcherSCfg().AttributeSConns) != 0 {
+		if err = dS.authorize(utils.SessionSv1BackupActiveSessions, tnt,
+			utils.IfaceAsString(args.APIOpts[utils.OptsAPIKey]), utils.TimePointer(time.Now())); err != nil {
+			return
+		}
+	}
+	return dS.Dispatch(&utils.CGREvent{
+		Tenant:  tnt,
+		APIOpts: args.APIOpts,
+	}, utils.MetaSessionS, utils.SessionSv1BackupActiveSessions, args, reply)
+}
+
+func (dS *DispatcherService) SessionSv1GetBackupSessions(ctx *context.Context, args *utils.SessionFilter,
+	reply *[]*sessions.ExternalSession) (err error) {
+	tnt := dS.cfg.GeneralCfg().DefaultTenant
+	if args.Tenant != utils.EmptyString {
+		tnt = args.Tenant
+	}
+	if len(dS.cfg.DispatcherSCfg().AttributeSConns) != 0 {
+		if err = dS.authorize(utils.SessionSv1GetBackupSessions,
+			tnt, utils.IfaceAsString(args.APIOpts[utils.OptsAPIKey]), utils.TimePointer(time.Now())); err != nil {
+			return
+		}
+	}
+	return dS.Dispatch(&utils.CGREvent{
+		Tenant:  tnt,
+		APIOpts: args.APIOpts,
+	}, utils.MetaSessionS, utils.SessionSv1GetBackupSessions, args, reply)
+}
+
 func (dS *DispatcherService) SessionSv1STIRAuthenticate(ctx *context.Context, args *sessions.V1STIRAuthenticateArgs, reply *string) (err error) {
 	tnt := dS.cfg.GeneralCfg().DefaultTenant
 	if len(dS.cfg.DispatcherSCfg().AttributeSConns) != 0 {
@@ -0,0 +1,171 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package ees
+
+import (
+	"encoding/gob"
+	"fmt"
+	"math/big"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+var errDeadLettersDisabled = fmt.Errorf("%s not configured", utils.DeadLetterDirCfg)
+
+func init() {
+	gob.Register(new(utils.CGREvent)) // prepared by the *rpc exporter
+	gob.Register([]any{})             // the typed records of the *parquet and *avro exporters
+	gob.Register(new(big.Rat))        // the *decimal columns of the typed records
+}
+
+// newDeadLetterStore returns the store for the failed exports, nil if not configured
+func newDeadLetterStore(dir string) *deadLetterStore {
+	if dir == utils.EmptyString {
+		return nil
+	}
+	return &deadLetterStore{dir: dir}
+}
+
+// deadLetterStore keeps on disk the events failing the export,
+// one file per event inside a folder per exporter
+type deadLetterStore struct {
+	sync.Mutex // serializes the replays and purges
+	dir        string
+}
+
+func (dlS *deadLetterStore) exporterDir(expID string) string {
+	return filepath.Join(dlS.dir, url.PathEscape(expID))
+}
+
+func (dlS *deadLetterStore) filePath(dl *engine.DeadLetter) string {
+	return filepath.Join(dlS.exporterDir(dl.ExporterID), dl.ID+utils.GOBSuffix)
+}
+
+// add stores the event that the exporter failed to export with the given error
+func (dlS *deadLetterStore) add(expID, key string, ev any, expErr error) (err error) {
+	now := time.Now()
+	return dlS.set(&engine.DeadLetter{
+		ID:         strconv.FormatInt(now.UnixNano(), 10) + utils.Underline + utils.UUIDSha1Prefix(),
+		ExporterID: expID,
+		Key:        key,
+		FailedAt:   now,
+		Error:      expErr.Error(),
+		Event:      ev,
+	})
+}
+
+// set writes the dead letter, replacing the previous version of it
+func (dlS *deadLetterStore) set(dl *engine.DeadLetter) (err error) {
+	if err = os.MkdirAll(dlS.exporterDir(dl.ExporterID), 0755); err != nil {
+		return
+	}
+	fPath := dlS.filePath(dl)
+	tmpPath := fPath + utils.TmpSuffix
+	var f *os.File
+	if f, err = os.Create(tmpPath); err != nil {
+		return
+	}
+	if err = gob.NewEncoder(f).Encode(dl); err == nil {
+		err = f.Sync()
+	}
+	if errClose := f.Close(); err == nil {
+		err = errClose
+	}
+	if err != nil {
+		os.Remove(tmpPath)
+		return
+	}
+	return os.Rename(tmpPath, fPath)
+}
+
+// remove deletes the dead letter from disk
+func (dlS *deadLetterStore) remove(dl *engine.DeadLetter) error {
+	return os.Remove(dlS.filePath(dl))
+}
+
+// find returns the dead letters of the exporters, failed within [tStart, tEnd),
+// ordered by the failure time; zero times leave the interval open
+func (dlS *deadLetterStore) find(expIDs []string, tStart, tEnd time.Time) (dls []*engine.DeadLetter, err error) {
+	if len(expIDs) == 0 {
+		var dirs []os.DirEntry
+		if dirs, err = os.ReadDir(dlS.dir); err != nil {
+			return
+		}
+		for _, dir := range dirs {
+			if !dir.IsDir() {
+				continue
+			}
+			var expID string
+			if expID, err = url.PathUnescape(dir.Name()); err != nil {
+				return
+			}
+			expIDs = append(expIDs, expID)
+		}
+	}
+	for _, expID := range expIDs {
+		var files []os.DirEntry
+		if files, err = os.ReadDir(dlS.exporterDir(expID)); err != nil {
+			if os.IsNotExist(err) {
+				err = nil
+				continue
+			}
+			return
+		}
+		for _, file := range files {
+			if file.IsDir() || !strings.HasSuffix(file.Name(), utils.GOBSuffix) {
+				continue
+			}
+			var dl *engine.DeadLetter
+			if dl, err = readDeadLetter(filepath.Join(dlS.exporterDir(expID), file.Name())); err != nil {
+				return
+			}
+			if (!tStart.IsZero() && dl.FailedAt.Before(tStart)) ||
+				(!tEnd.IsZero() && !dl.FailedAt.Before(tEnd)) {
+				continue
+			}
+			dls = append(dls, dl)
+		}
+	}
+	sort.Slice(dls, func(i, j int) bool {
+		return dls[i].FailedAt.Before(dls[j].FailedAt)
+	})
+	return
+}
+
+func readDeadLetter(fPath string) (dl *engine.DeadLetter, err error) {
+	var f *os.File
+	if f, err = os.Open(fPath); err != nil {
+		return
+	}
+	defer f.Close()
+	dl = new(engine.DeadLetter)
+	if err = gob.NewDecoder(f).Decode(dl); err != nil {
+		return nil, fmt.Errorf("cannot decode dead letter <%s>: %s", fPath, err.Error())
+	}
+	return
+}
@@ -0,0 +1,288 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package ees
+
+import (
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestDeadLetterStore(t *testing.T) {
+	dlS := newDeadLetterStore(t.TempDir())
+	if err := dlS.add("HTTP/1", "key1", []byte("ev1"), errors.New("connection refused")); err != nil {
+		t.Fatal(err)
+	}
+	tMid := time.Now()
+	if err := dlS.add("SQS", "key2", map[string]any{"Account": "1001"}, errors.New("timeout")); err != nil {
+		t.Fatal(err)
+	}
+	dls, err := dlS.find(nil, time.Time{}, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dls) != 2 {
+		t.Fatalf("Expected 2 dead letters, received %s", utils.ToJSON(dls))
+	}
+	if dls[0].ExporterID != "HTTP/1" || dls[0].Key != "key1" ||
+		dls[0].Error != "connection refused" || !reflect.DeepEqual(dls[0].Event, []byte("ev1")) {
+		t.Errorf("Unexpected dead letter: %s", utils.ToJSON(dls[0]))
+	}
+	if exp := map[string]any{"Account": "1001"}; dls[1].ExporterID != "SQS" ||
+		!reflect.DeepEqual(dls[1].Event, exp) {
+		t.Errorf("Unexpected dead letter: %s", utils.ToJSON(dls[1]))
+	}
+
+	if rcv, err := dlS.find([]string{"HTTP/1"}, time.Time{}, time.Time{}); err != nil {
+		t.Error(err)
+	} else if len(rcv) != 1 || rcv[0].ID != dls[0].ID {
+		t.Errorf("Unexpected dead letters: %s", utils.ToJSON(rcv))
+	}
+	if rcv, err := dlS.find(nil, tMid, time.Time{}); err != nil {
+		t.Error(err)
+	} else if len(rcv) != 1 || rcv[0].ID != dls[1].ID {
+		t.Errorf("Unexpected dead letters: %s", utils.ToJSON(rcv))
+	}
+	if rcv, err := dlS.find(nil, time.Time{}, tMid); err != nil {
+		t.Error(err)
+	} else if len(rcv) != 1 || rcv[0].ID != dls[0].ID {
+		t.Errorf("Unexpected dead letters: %s", utils.ToJSON(rcv))
+	}
+	if rcv, err := dlS.find([]string{"UNKNOWN"}, time.Time{}, time.Time{}); err != nil {
+		t.Error(err)
+	} else if len(rcv) != 0 {
+		t.Errorf("Unexpected dead letters: %s", utils.ToJSON(rcv))
+	}
+
+	dls[0].Replays++
+	if err := dlS.set(dls[0]); err != nil {
+		t.Fatal(err)
+	}
+	if err := dlS.remove(dls[1]); err != nil {
+		t.Fatal(err)
+	}
+	if rcv, err := dlS.find(nil, time.Time{}, time.Time{}); err != nil {
+		t.Error(err)
+	} else if len(rcv) != 1 || rcv[0].Replays != 1 {
+		t.Errorf("Unexpected dead letters: %s", utils.ToJSON(rcv))
+	}
+}
+
+func TestDeadLetterStoreTypedRecord(t *testing.T) {
+	cols := []*typedColumn{
+		{name: utils.Cost, typ: utils.MetaDecimal, scale: 4},
+		{name: utils.AnswerTime, typ: utils.MetaTimestamp},
+		{name: utils.AccountField, typ: utils.MetaString},
+	}
+	rec, err := typedRecord(cols, map[string]any{
+		utils.Cost:         "1.23456",
+		utils.AnswerTime:   "2026-10-19T10:00:00Z",
+		utils.AccountField: "1001",
+	}, "UTC")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dlS := newDeadLetterStore(t.TempDir())
+	if err := dlS.add("PARQUET", "key1", rec, errors.New("disk full")); err != nil {
+		t.Fatal(err)
+	}
+	dls, err := dlS.find(nil, time.Time{}, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dls) != 1 {
+		t.Fatalf("Expected 1 dead letter, received %s", utils.ToJSON(dls))
+	}
+	rcv, canCast := dls[0].Event.([]any)
+	if !canCast || len(rcv) != 3 {
+		t.Fatalf("Unexpected event: %#v", dls[0].Event)
+	}
+	if cost, canCast := rcv[0].(*big.Rat); !canCast || cost.FloatString(4) != "1.2346" {
+		t.Errorf("Unexpected cost: %#v", rcv[0])
+	}
+	if !reflect.DeepEqual(rec[1:], rcv[1:]) {
+		t.Errorf("Expected %v, received %v", rec, rcv)
+	}
+}
+
+type mockRetryExporter struct {
+	mockEventExporter
+	cfg     *config.EventExporterCfg
+	exports int
+}
+
+func (m *mockRetryExporter) Cfg() *config.EventExporterCfg { return m.cfg }
+func (m *mockRetryExporter) ExportEvent(any, string) error {
+	m.exports++
+	return errors.New("export failed")
+}
+
+func TestExportWithRetries(t *testing.T) {
+	eeCfg := config.NewEventExporterCfg("MOCK", utils.MetaNone, utils.EmptyString, utils.MetaNone, 3, nil)
+	eeCfg.RetryBackoff = 0
+	ee := &mockRetryExporter{cfg: eeCfg}
+	if err := exportWithRetries(ee, nil, utils.EmptyString); err == nil || err.Error() != "export failed" {
+		t.Errorf("Expected error <export failed>, received <%v>", err)
+	}
+	if ee.exports != 3 {
+		t.Errorf("Expected 3 exports, received %d", ee.exports)
+	}
+
+	// the first delay would already exceed the max age
+	eeCfg.RetryBackoff = time.Hour
+	eeCfg.RetryMaxAge = time.Minute
+	ee.exports = 0
+	if err := exportWithRetries(ee, nil, utils.EmptyString); err == nil {
+		t.Error("Expected error")
+	}
+	if ee.exports != 1 {
+		t.Errorf("Expected 1 export, received %d", ee.exports)
+	}
+}
+
+func TestRetryPolicyDelay(t *testing.T) {
+	eeCfg := &config.EventExporterCfg{
+		RetryBackoff:    time.Second,
+		RetryMaxBackoff: 2 * time.Second,
+	}
+	rp := newRetryPolicy(eeCfg)
+	for _, exp := range []time.Duration{time.Second, time.Second, 2 * time.Second, 2 * time.Second} {
+		if d, retry := rp.delay(); !retry || d != exp {
+			t.Errorf("Expected delay %v, received %v, retry: %v", exp, d, retry)
+		}
+	}
+
+	eeCfg.RetryMaxBackoff = 0
+	eeCfg.RetryJitter = 0.5
+	rp = newRetryPolicy(eeCfg)
+	for i := 0; i < 10; i++ {
+		if d, retry := rp.delay(); !retry || d < 500*time.Millisecond || d > 1500*time.Millisecond {
+			t.Fatalf("Expected delay within jitter, received %v, retry: %v", d, retry)
+		}
+		rp.next = func() time.Duration { return time.Second }
+	}
+
+	eeCfg.RetryJitter = 0
+	eeCfg.RetryMaxAge = 1500 * time.Millisecond
+	rp = newRetryPolicy(eeCfg)
+	if _, retry := rp.delay(); !retry {
+		t.Error("Expected to retry")
+	}
+	rp.start = rp.start.Add(-time.Second)
+	if _, retry := rp.delay(); retry {
+		t.Error("Expected to not retry after max age")
+	}
+}
+
+func TestV1DeadLetters(t *testing.T) {
+	var fail atomic.Bool
+	fail.Store(true)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if fail.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer srv.Close()
+
+	cfg := config.NewDefaultCGRConfig()
+	cfg.EEsCfg().DeadLetterDir = t.TempDir()
+	cfg.EEsCfg().Exporters[0].ID = "HTTP_POST"
+	cfg.EEsCfg().Exporters[0].Type = utils.MetaHTTPPost
+	cfg.EEsCfg().Exporters[0].ExportPath = srv.URL
+	cfg.EEsCfg().Exporters[0].Synchronous = true
+	cfg.EEsCfg().Exporters[0].FailedPostsDir = utils.MetaNone
+	dm := engine.NewDataManager(engine.NewInternalDB(nil, nil, true, cfg.DataDbCfg().Items), cfg.CacheCfg(), nil)
+	eeS := NewEventExporterS(cfg, engine.NewFilterS(cfg, nil, dm), nil)
+
+	args := &engine.ArgsDeadLetters{ExporterIDs: []string{"HTTP_POST"}}
+	var dls []*engine.DeadLetter
+	if err := eeS.V1GetDeadLetters(context.Background(), args, &dls); err != utils.ErrNotFound {
+		t.Errorf("Expected error <%v>, received <%v>", utils.ErrNotFound, err)
+	}
+	cgrEv := &engine.CGREventWithEeIDs{
+		CGREvent: &utils.CGREvent{
+			Tenant: "cgrates.org",
+			ID:     "voiceEvent",
+			Event: map[string]any{
+				utils.CGRID:        "CGRID_1",
+				utils.AccountField: "1001",
+			},
+		},
+	}
+	var rply map[string]map[string]any
+	for i := 0; i < 2; i++ {
+		if err := eeS.V1ProcessEvent(context.Background(), cgrEv, &rply); err != utils.ErrPartiallyExecuted {
+			t.Errorf("Expected error <%v>, received <%v>", utils.ErrPartiallyExecuted, err)
+		}
+	}
+	if err := eeS.V1GetDeadLetters(context.Background(), args, &dls); err != nil {
+		t.Fatal(err)
+	}
+	if len(dls) != 2 {
+		t.Fatalf("Expected 2 dead letters, received %s", utils.ToJSON(dls))
+	}
+	if exp := "unexpected status code received: <500>"; dls[0].Error != exp {
+		t.Errorf("Expected error %q, received %q", exp, dls[0].Error)
+	}
+
+	var reply string
+	if err := eeS.V1ReplayDeadLetters(context.Background(), args, &reply); err != utils.ErrPartiallyExecuted {
+		t.Errorf("Expected error <%v>, received <%v>", utils.ErrPartiallyExecuted, err)
+	}
+	if err := eeS.V1GetDeadLetters(context.Background(), args, &dls); err != nil {
+		t.Fatal(err)
+	} else if len(dls) != 2 || dls[0].Replays != 1 || dls[1].Replays != 1 {
+		t.Errorf("Unexpected dead letters: %s", utils.ToJSON(dls))
+	}
+
+	fail.Store(false)
+	if err := eeS.V1ReplayDeadLetters(context.Background(),
+		&engine.ArgsDeadLetters{TimeEnd: dls[1].FailedAt.Format(time.RFC3339Nano)}, &reply); err != nil {
+		t.Error(err)
+	} else if reply != utils.OK {
+		t.Errorf("Expected %q, received %q", utils.OK, reply)
+	}
+	if err := eeS.V1GetDeadLetters(context.Background(), args, &dls); err != nil {
+		t.Fatal(err)
+	} else if len(dls) != 1 {
+		t.Errorf("Unexpected dead letters: %s", utils.ToJSON(dls))
+	}
+
+	if err := eeS.V1PurgeDeadLetters(context.Background(), args, &reply); err != nil {
+		t.Error(err)
+	}
+	if err := eeS.V1GetDeadLetters(context.Background(), args, &dls); err != utils.ErrNotFound {
+		t.Errorf("Expected error <%v>, received <%v>", utils.ErrNotFound, err)
+	}
+
+	eeS.dlStore = nil
+	if err := eeS.V1PurgeDeadLetters(context.Background(), args, &reply); err != errDeadLettersDisabled {
+		t.Errorf("Expected error <%v>, received <%v>", errDeadLettersDisabled, err)
+	}
+}
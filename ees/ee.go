@@ -53,6 +53,10 @@ func NewEventExporter(cfg *config.EventExporterCfg, cgrCfg *config.CGRConfig, fi
 		return NewFileCSVee(cfg, cgrCfg, filterS, dc)
 	case utils.MetaFileFWV:
 		return NewFileFWVee(cfg, cgrCfg, filterS, dc)
+	case utils.MetaFileParquet:
+		return NewFileParquetee(cfg, cgrCfg, dc)
+	case utils.MetaFileAvro:
+		return NewFileAvroee(cfg, cgrCfg, dc)
 	case utils.MetaHTTPPost:
 		return NewHTTPPostEE(cfg, cgrCfg, filterS, dc)
 	case utils.MetaHTTPjsonMap:
@@ -20,6 +20,7 @@ package ees
 
 import (
 	"fmt"
+	"math/rand"
 	"sync"
 	"time"
 
@@ -40,10 +41,13 @@ func onCacheEvicted(_ string, value any) {
 func NewEventExporterS(cfg *config.CGRConfig, filterS *engine.FilterS,
 	connMgr *engine.ConnManager) (eeS *EventExporterS) {
 	eeS = &EventExporterS{
-		cfg:     cfg,
-		filterS: filterS,
-		connMgr: connMgr,
-		eesChs:  make(map[string]*ltcache.Cache),
+		cfg:       cfg,
+		filterS:   filterS,
+		connMgr:   connMgr,
+		eesChs:    make(map[string]*ltcache.Cache),
+		processed: engine.NewMetricCounters(),
+		failed:    engine.NewMetricCounters(),
+		dlStore:   newDeadLetterStore(cfg.EEsNoLksCfg().DeadLetterDir),
 	}
 	eeS.setupCache(cfg.EEsNoLksCfg().Cache)
 	return
@@ -55,8 +59,24 @@ type EventExporterS struct {
 	filterS *engine.FilterS
 	connMgr *engine.ConnManager
 
-	eesChs map[string]*ltcache.Cache // map[eeType]*ltcache.Cache
-	eesMux sync.RWMutex              // protects the eesChs
+	eesChs  map[string]*ltcache.Cache // map[eeType]*ltcache.Cache
+	dlStore *deadLetterStore          // nil if the dead letters are disabled
+	eesMux  sync.RWMutex              // protects the eesChs and dlStore
+
+	processed *engine.MetricCounters // events processed per exporter
+	failed    *engine.MetricCounters // events failed per exporter
+}
+
+// CollectMetrics implements engine.MetricsCollector
+func (eeS *EventExporterS) CollectMetrics(mw *engine.MetricsWriter) {
+	mw.Family("ees_events_processed_total", engine.MetricTypeCounter, "Number of events sent to the exporter.")
+	for _, expID := range eeS.processed.Keys() {
+		mw.Sample("ees_events_processed_total", float64(eeS.processed.Get(expID)), "exporter", expID)
+	}
+	mw.Family("ees_events_failed_total", engine.MetricTypeCounter, "Number of events the exporter failed to export.")
+	for _, expID := range eeS.processed.Keys() {
+		mw.Sample("ees_events_failed_total", float64(eeS.failed.Get(expID)), "exporter", expID)
+	}
 }
 
 // ListenAndServe keeps the service alive
@@ -70,6 +90,9 @@ func (eeS *EventExporterS) ListenAndServe(stopChan, cfgRld chan struct{}) {
 			utils.Logger.Info(fmt.Sprintf("<%s> reloading configuration internals.",
 				utils.EEs))
 			eeS.setupCache(eeS.cfg.EEsCfg().Cache)
+			eeS.eesMux.Lock()
+			eeS.dlStore = newDeadLetterStore(eeS.cfg.EEsCfg().DeadLetterDir)
+			eeS.eesMux.Unlock()
 		}
 	}
 }
@@ -85,6 +108,13 @@ func (eeS *EventExporterS) Call(ctx *context.Context, serviceMethod string, args
 	return utils.RPCCall(eeS, serviceMethod, args, reply)
 }
 
+// deadLetters returns the store of the failed exports, nil if disabled
+func (eeS *EventExporterS) deadLetters() *deadLetterStore {
+	eeS.eesMux.RLock()
+	defer eeS.eesMux.RUnlock()
+	return eeS.dlStore
+}
+
 // setupCache deals with cleanup and initialization of the cache of EventExporters
 func (eeS *EventExporterS) setupCache(chCfgs map[string]*config.CacheParamCfg) {
 	eeS.eesMux.Lock()
@@ -204,7 +234,9 @@ func (eeS *EventExporterS) V1ProcessEvent(ctx *context.Context, cgrEv *engine.CG
 					utils.EEs, ee.Cfg().ID))
 		}
 		go func(evict, sync bool, ee EventExporter) {
-			if err := exportEventWithExporter(ee, cgrEv.CGREvent, evict, eeS.cfg, eeS.filterS); err != nil {
+			eeS.processed.Inc(ee.Cfg().ID)
+			if err := exportEventWithExporter(ee, cgrEv.CGREvent, evict, eeS.cfg, eeS.filterS, eeS.deadLetters()); err != nil {
+				eeS.failed.Inc(ee.Cfg().ID)
 				withErr = true
 			}
 			if sync {
@@ -248,7 +280,124 @@ func (eeS *EventExporterS) V1ProcessEvent(ctx *context.Context, cgrEv *engine.CG
 	return
 }
 
-func exportEventWithExporter(exp EventExporter, ev *utils.CGREvent, oneTime bool, cfg *config.CGRConfig, filterS *engine.FilterS) (err error) {
+// V1GetDeadLetters returns the events that failed the export, ordered by the failure time
+func (eeS *EventExporterS) V1GetDeadLetters(ctx *context.Context, args *engine.ArgsDeadLetters, rply *[]*engine.DeadLetter) (err error) {
+	var dls []*engine.DeadLetter
+	if dls, err = eeS.findDeadLetters(args); err != nil {
+		return
+	}
+	if len(dls) == 0 {
+		return utils.ErrNotFound
+	}
+	*rply = dls
+	return
+}
+
+// V1ReplayDeadLetters exports again the events that failed, removing the ones exported successfully
+func (eeS *EventExporterS) V1ReplayDeadLetters(ctx *context.Context, args *engine.ArgsDeadLetters, rply *string) (err error) {
+	dlStore := eeS.deadLetters()
+	if dlStore == nil {
+		return errDeadLettersDisabled
+	}
+	dlStore.Lock()
+	defer dlStore.Unlock()
+	var dls []*engine.DeadLetter
+	if dls, err = eeS.findDeadLetters(args); err != nil {
+		return
+	}
+	if len(dls) == 0 {
+		return utils.ErrNotFound
+	}
+	exporters := make(map[string]EventExporter) // one time exporters, built from the current config
+	defer func() {
+		for _, ee := range exporters {
+			ee.Close()
+		}
+	}()
+	var withErr bool
+	for _, dl := range dls {
+		ee, has := exporters[dl.ExporterID]
+		if !has {
+			if ee, err = eeS.newExporterWithID(dl.ExporterID); err != nil {
+				utils.Logger.Warning(
+					fmt.Sprintf("<%s> cannot replay the dead letter <%s> of exporter <%s> because err: <%s>",
+						utils.EEs, dl.ID, dl.ExporterID, err.Error()))
+				withErr = true
+				continue
+			}
+			exporters[dl.ExporterID] = ee
+		}
+		if expErr := exportWithRetries(ee, dl.Event, dl.Key); expErr != nil {
+			withErr = true
+			dl.Replays++
+			dl.Error = expErr.Error()
+			dl.FailedAt = time.Now()
+			err = dlStore.set(dl)
+		} else {
+			err = dlStore.remove(dl)
+		}
+		if err != nil {
+			return
+		}
+	}
+	if withErr {
+		return utils.ErrPartiallyExecuted
+	}
+	*rply = utils.OK
+	return
+}
+
+// V1PurgeDeadLetters removes the events that failed the export
+func (eeS *EventExporterS) V1PurgeDeadLetters(ctx *context.Context, args *engine.ArgsDeadLetters, rply *string) (err error) {
+	dlStore := eeS.deadLetters()
+	if dlStore == nil {
+		return errDeadLettersDisabled
+	}
+	dlStore.Lock()
+	defer dlStore.Unlock()
+	var dls []*engine.DeadLetter
+	if dls, err = eeS.findDeadLetters(args); err != nil {
+		return
+	}
+	for _, dl := range dls {
+		if err = dlStore.remove(dl); err != nil {
+			return
+		}
+	}
+	*rply = utils.OK
+	return
+}
+
+// findDeadLetters queries the dead letter store with the API arguments
+func (eeS *EventExporterS) findDeadLetters(args *engine.ArgsDeadLetters) (dls []*engine.DeadLetter, err error) {
+	dlStore := eeS.deadLetters()
+	if dlStore == nil {
+		return nil, errDeadLettersDisabled
+	}
+	var tStart, tEnd time.Time
+	if tStart, err = utils.ParseTimeDetectLayout(args.TimeStart, eeS.cfg.GeneralCfg().DefaultTimezone); err != nil {
+		return
+	}
+	if tEnd, err = utils.ParseTimeDetectLayout(args.TimeEnd, eeS.cfg.GeneralCfg().DefaultTimezone); err != nil {
+		return
+	}
+	return dlStore.find(args.ExporterIDs, tStart, tEnd)
+}
+
+// newExporterWithID builds a new exporter out of the configuration with the given ID
+func (eeS *EventExporterS) newExporterWithID(expID string) (ee EventExporter, err error) {
+	eeS.cfg.RLocks(config.EEsJson)
+	defer eeS.cfg.RUnlocks(config.EEsJson)
+	for _, eeCfg := range eeS.cfg.EEsNoLksCfg().Exporters {
+		if eeCfg.ID == expID {
+			return NewEventExporter(eeCfg, eeS.cfg, eeS.filterS, eeS.connMgr)
+		}
+	}
+	return nil, fmt.Errorf("exporter with ID <%s> not configured", expID)
+}
+
+func exportEventWithExporter(exp EventExporter, ev *utils.CGREvent, oneTime bool, cfg *config.CGRConfig,
+	filterS *engine.FilterS, dlStore *deadLetterStore) (err error) {
 	defer func() {
 		updateEEMetrics(exp.GetMetrics(), ev.ID, ev.Event, err != nil, utils.FirstNonEmpty(exp.Cfg().Timezone,
 			cfg.GeneralCfg().DefaultTimezone))
@@ -292,9 +441,21 @@ func exportEventWithExporter(exp EventExporter, ev *utils.CGREvent, oneTime bool
 	key := utils.ConcatenatedKey(utils.FirstNonEmpty(engine.MapEvent(ev.Event).GetStringIgnoreErrors(utils.CGRID), utils.GenUUID()),
 		utils.FirstNonEmpty(engine.MapEvent(ev.Event).GetStringIgnoreErrors(utils.RunID), utils.MetaDefault))
 
-	return ExportWithAttempts(exp, eEv, key)
+	if dlStore == nil {
+		return ExportWithAttempts(exp, eEv, key)
+	}
+	if err = exportWithRetries(exp, eEv, key); err != nil {
+		if dlErr := dlStore.add(exp.Cfg().ID, key, eEv, err); dlErr != nil {
+			utils.Logger.Warning(
+				fmt.Sprintf("<%s> Exporter <%s> could not store the dead letter because err: <%s>",
+					utils.EEs, exp.Cfg().ID, dlErr.Error()))
+		}
+	}
+	return
 }
 
+// ExportWithAttempts exports the event following the retry policy of the exporter,
+// adding it to the failed posts if the export does not succeed
 func ExportWithAttempts(exp EventExporter, eEv any, key string) (err error) {
 	if exp.Cfg().FailedPostsDir != utils.MetaNone {
 		defer func() {
@@ -304,14 +465,18 @@ func ExportWithAttempts(exp EventExporter, eEv any, key string) (err error) {
 			}
 		}()
 	}
-	fib := utils.FibDuration(time.Second, 0)
+	return exportWithRetries(exp, eEv, key)
+}
 
+// exportWithRetries connects and exports the event, waiting between the attempts as configured in the exporter
+func exportWithRetries(exp EventExporter, eEv any, key string) (err error) {
+	rp := newRetryPolicy(exp.Cfg())
 	for i := 0; i < exp.Cfg().Attempts; i++ {
 		if err = exp.Connect(); err == nil {
 			break
 		}
-		if i+1 < exp.Cfg().Attempts {
-			time.Sleep(fib())
+		if i+1 == exp.Cfg().Attempts || !rp.wait() {
+			break
 		}
 	}
 	if err != nil {
@@ -325,8 +490,8 @@ func ExportWithAttempts(exp EventExporter, eEv any, key string) (err error) {
 			err == utils.ErrDisconnected { // special error in case the exporter was closed
 			break
 		}
-		if i+1 < exp.Cfg().Attempts {
-			time.Sleep(fib())
+		if i+1 == exp.Cfg().Attempts || !rp.wait() {
+			break
 		}
 	}
 	if err != nil {
@@ -336,3 +501,46 @@ func ExportWithAttempts(exp EventExporter, eEv any, key string) (err error) {
 	}
 	return
 }
+
+// newRetryPolicy returns the retry policy of the exporter, starting now
+func newRetryPolicy(eeCfg *config.EventExporterCfg) *retryPolicy {
+	rp := &retryPolicy{
+		jitter: eeCfg.RetryJitter,
+		maxAge: eeCfg.RetryMaxAge,
+		start:  time.Now(),
+	}
+	rp.next = func() time.Duration { return 0 } // no backoff, retry immediately
+	if eeCfg.RetryBackoff > 0 {
+		rp.next = utils.FibDuration(eeCfg.RetryBackoff, eeCfg.RetryMaxBackoff)
+	}
+	return rp
+}
+
+// retryPolicy computes the delays between the export attempts
+type retryPolicy struct {
+	next   func() time.Duration // fibonacci backoff
+	jitter float64              // randomize the delay with up to this fraction of it
+	maxAge time.Duration        // do not retry after this long since start
+	start  time.Time
+}
+
+// delay returns how long to wait before the next attempt and false if the attempt would exceed the max age
+func (rp *retryPolicy) delay() (d time.Duration, retry bool) {
+	d = rp.next()
+	if rp.jitter > 0 {
+		d += time.Duration(rp.jitter * float64(d) * (2*rand.Float64() - 1))
+	}
+	if rp.maxAge > 0 && time.Since(rp.start)+d > rp.maxAge {
+		return 0, false
+	}
+	return d, true
+}
+
+// wait sleeps before the next attempt, returns false without waiting if no more attempts should be done
+func (rp *retryPolicy) wait() bool {
+	d, retry := rp.delay()
+	if retry {
+		time.Sleep(d)
+	}
+	return retry
+}
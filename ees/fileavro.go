@@ -0,0 +1,209 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package ees
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+	"github.com/linkedin/goavro/v2"
+)
+
+// avroBlockSize is the number of records buffered before writing an Avro block
+const avroBlockSize = 1024
+
+func NewFileAvroee(cfg *config.EventExporterCfg,
+	cgrCfg *config.CGRConfig, dc *utils.SafeMapStorage) (fAvro *FileAvroee, err error) {
+	fAvro = &FileAvroee{
+		cfg: cfg,
+		dc:  dc,
+		tz:  utils.FirstNonEmpty(cfg.Timezone, cgrCfg.GeneralCfg().DefaultTimezone),
+	}
+	err = fAvro.init()
+	return
+}
+
+// FileAvroee implements EventExporter interface for .avro object container files
+type FileAvroee struct {
+	cfg       *config.EventExporterCfg
+	dc        *utils.SafeMapStorage
+	tz        string
+	cols      []*typedColumn
+	fldNames  []string // Avro field name for each of the cols
+	unionType []string // Avro type name of the non-null union branch for each of the cols
+	file      *os.File
+	writer    *goavro.OCFWriter
+	records   []any // buffered records, written as one block
+	sync.Mutex
+}
+
+func (fAvro *FileAvroee) init() (err error) {
+	fAvro.Lock()
+	defer fAvro.Unlock()
+	if fAvro.cols, err = newTypedColumns(fAvro.Cfg()); err != nil {
+		return
+	}
+	fAvro.fldNames = make([]string, len(fAvro.cols))
+	fAvro.unionType = make([]string, len(fAvro.cols))
+	fldNames := make(utils.StringSet)
+	fields := make([]map[string]any, len(fAvro.cols))
+	for i, col := range fAvro.cols {
+		fAvro.fldNames[i] = avroName(col.name)
+		if fldNames.Has(fAvro.fldNames[i]) {
+			return fmt.Errorf("duplicated Avro field name <%s> for column <%s>",
+				fAvro.fldNames[i], col.name)
+		}
+		fldNames.Add(fAvro.fldNames[i])
+		var fldType any
+		fldType, fAvro.unionType[i] = avroType(col)
+		fields[i] = map[string]any{
+			"name":    fAvro.fldNames[i],
+			"type":    []any{"null", fldType},
+			"default": nil,
+		}
+	}
+	var schema []byte
+	if schema, err = json.Marshal(map[string]any{
+		"type":   "record",
+		"name":   avroName(fAvro.Cfg().ID),
+		"fields": fields,
+	}); err != nil {
+		return
+	}
+	var codec *goavro.Codec
+	if codec, err = goavro.NewCodec(string(schema)); err != nil {
+		return
+	}
+	compression := utils.AvroSnappy
+	if fAvro.Cfg().Opts.AvroCodec != nil {
+		compression = *fAvro.Cfg().Opts.AvroCodec
+	}
+	// create the file
+	filePath := typedFilePath(fAvro.Cfg(), utils.AvroSuffix)
+	fAvro.dc.Lock()
+	fAvro.dc.MapStorage[utils.ExportPath] = filePath
+	fAvro.dc.Unlock()
+	if fAvro.file, err = os.Create(filePath); err != nil {
+		return
+	}
+	fAvro.writer, err = goavro.NewOCFWriter(goavro.OCFConfig{
+		W:               fAvro.file,
+		Codec:           codec,
+		CompressionName: compression,
+	})
+	return
+}
+
+// avroType returns the Avro schema type of the column together with its name used in unions
+func avroType(col *typedColumn) (any, string) {
+	switch col.typ {
+	case utils.MetaInt64, utils.MetaDuration:
+		return "long", "long"
+	case utils.MetaFloat64:
+		return "double", "double"
+	case utils.MetaBool:
+		return "boolean", "boolean"
+	case utils.MetaTimestamp:
+		return map[string]any{
+			"type":        "long",
+			"logicalType": "timestamp-micros",
+		}, "long.timestamp-micros"
+	case utils.MetaDecimal:
+		return map[string]any{
+			"type":        "bytes",
+			"logicalType": "decimal",
+			"precision":   utils.MaxDecimalPrecision,
+			"scale":       col.scale,
+		}, "bytes.decimal"
+	default:
+		return "string", "string"
+	}
+}
+
+func (fAvro *FileAvroee) Cfg() *config.EventExporterCfg { return fAvro.cfg }
+
+func (fAvro *FileAvroee) Connect() (_ error) { return }
+
+func (fAvro *FileAvroee) ExportEvent(ev any, _ string) (err error) {
+	rec := ev.([]any)
+	avroRec := make(map[string]any, len(fAvro.cols))
+	for i := range fAvro.cols {
+		var val any
+		if rec[i] != nil {
+			val = goavro.Union(fAvro.unionType[i], rec[i])
+		}
+		avroRec[fAvro.fldNames[i]] = val
+	}
+	fAvro.Lock() // make sure that only one event is writen in file at once
+	defer fAvro.Unlock()
+	if fAvro.records = append(fAvro.records, avroRec); len(fAvro.records) < avroBlockSize {
+		return
+	}
+	return fAvro.flush()
+}
+
+// flush writes the buffered records as one block, locked by the caller
+func (fAvro *FileAvroee) flush() (err error) {
+	if len(fAvro.records) == 0 {
+		return
+	}
+	err = fAvro.writer.Append(fAvro.records)
+	fAvro.records = nil
+	return
+}
+
+func (fAvro *FileAvroee) Close() (err error) {
+	fAvro.Lock()
+	defer fAvro.Unlock()
+	if err = fAvro.flush(); err != nil {
+		utils.Logger.Warning(fmt.Sprintf("<%s> Exporter with id: <%s> received error: <%s> when writing the records",
+			utils.EEs, fAvro.Cfg().ID, err.Error()))
+	}
+	if err = fAvro.file.Close(); err != nil {
+		utils.Logger.Warning(fmt.Sprintf("<%s> Exporter with id: <%s> received error: <%s> when closing the file",
+			utils.EEs, fAvro.Cfg().ID, err.Error()))
+	}
+	return
+}
+
+func (fAvro *FileAvroee) GetMetrics() *utils.SafeMapStorage { return fAvro.dc }
+
+func (fAvro *FileAvroee) PrepareMap(cgrEv *utils.CGREvent) (any, error) {
+	return typedRecord(fAvro.cols, cgrEv.Event, fAvro.tz)
+}
+
+func (fAvro *FileAvroee) PrepareOrderMap(mp *utils.OrderedNavigableMap) (any, error) {
+	return typedRecord(fAvro.cols, orderedMapValues(mp), fAvro.tz)
+}
+
+// avroName replaces the characters not allowed in Avro names with underscores
+func avroName(name string) string {
+	avroNm := []byte(name)
+	for i, c := range avroNm {
+		if c != '_' && (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') &&
+			(i == 0 || c < '0' || c > '9') {
+			avroNm[i] = '_'
+		}
+	}
+	return string(avroNm)
+}
@@ -0,0 +1,105 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package ees
+
+import (
+	"math/big"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+	"github.com/linkedin/goavro/v2"
+)
+
+func TestFileAvroExportEvent(t *testing.T) {
+	dir := t.TempDir()
+	dc, err := newEEMetrics(utils.EmptyString)
+	if err != nil {
+		t.Fatal(err)
+	}
+	eeCfg := typedExporterCfg(utils.MetaFileAvro, dir)
+	eeCfg.Opts.AvroCodec = utils.StringPointer(utils.AvroDeflate)
+	fAvro, err := NewFileAvroee(eeCfg, config.NewDefaultCGRConfig(), dc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exportTypedEvents(t, fAvro)
+
+	file, err := os.Open(utils.IfaceAsString(dc.MapStorage[utils.ExportPath]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	ocfRdr, err := goavro.NewOCFReader(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if codec := ocfRdr.CompressionName(); codec != utils.AvroDeflate {
+		t.Errorf("expected codec %s, received %s", utils.AvroDeflate, codec)
+	}
+	var rcv []any
+	for ocfRdr.Scan() {
+		datum, err := ocfRdr.Read()
+		if err != nil {
+			t.Fatal(err)
+		}
+		rcv = append(rcv, datum)
+	}
+	if err = ocfRdr.Err(); err != nil {
+		t.Fatal(err)
+	}
+	exp := []any{
+		map[string]any{
+			"CGRID":      map[string]any{"string": "cgrid1"},
+			"AnswerTime": map[string]any{"long.timestamp-micros": time.Date(2024, 1, 2, 3, 4, 5, 123456000, time.UTC)},
+			"Usage":      map[string]any{"long": int64(90 * time.Second)},
+			"Cost":       map[string]any{"bytes.decimal": big.NewRat(123, 100)},
+			"Rated":      map[string]any{"boolean": true},
+			"Extra":      nil,
+		},
+		map[string]any{
+			"CGRID":      map[string]any{"string": "cgrid2"},
+			"AnswerTime": nil,
+			"Usage":      nil,
+			"Cost":       nil,
+			"Rated":      nil,
+			"Extra":      map[string]any{"string": "extra2"},
+		},
+	}
+	if !reflect.DeepEqual(exp, rcv) {
+		t.Errorf("expected %s, received %s", utils.ToJSON(exp), utils.ToJSON(rcv))
+	}
+}
+
+func TestAvroName(t *testing.T) {
+	for name, exp := range map[string]string{
+		"Account":          "Account",
+		"*default":         "_default",
+		"CostDetails.Cost": "CostDetails_Cost",
+		"1stField":         "_stField",
+		"field_2":          "field_2",
+	} {
+		if rcv := avroName(name); rcv != exp {
+			t.Errorf("expected %s for %s, received %s", exp, name, rcv)
+		}
+	}
+}
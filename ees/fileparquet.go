@@ -0,0 +1,192 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package ees
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+	"github.com/parquet-go/parquet-go"
+	"github.com/parquet-go/parquet-go/compress"
+)
+
+func NewFileParquetee(cfg *config.EventExporterCfg,
+	cgrCfg *config.CGRConfig, dc *utils.SafeMapStorage) (fPq *FileParquetee, err error) {
+	fPq = &FileParquetee{
+		cfg: cfg,
+		dc:  dc,
+		tz:  utils.FirstNonEmpty(cfg.Timezone, cgrCfg.GeneralCfg().DefaultTimezone),
+	}
+	err = fPq.init()
+	return
+}
+
+// FileParquetee implements EventExporter interface for .parquet files
+type FileParquetee struct {
+	cfg    *config.EventExporterCfg
+	dc     *utils.SafeMapStorage
+	tz     string
+	cols   []*typedColumn
+	colIdx []int // index of the leaf column in the schema for each of the cols
+	file   *os.File
+	writer *parquet.Writer
+	sync.Mutex
+}
+
+func (fPq *FileParquetee) init() (err error) {
+	fPq.Lock()
+	defer fPq.Unlock()
+	if fPq.cols, err = newTypedColumns(fPq.Cfg()); err != nil {
+		return
+	}
+	group := make(parquet.Group, len(fPq.cols))
+	for _, col := range fPq.cols {
+		group[col.name] = parquet.Optional(parquetNode(col))
+	}
+	schema := parquet.NewSchema(utils.CDRs, group)
+	leafIdx := make(map[string]int)
+	for i, colPath := range schema.Columns() {
+		leafIdx[strings.Join(colPath, utils.NestingSep)] = i
+	}
+	fPq.colIdx = make([]int, len(fPq.cols))
+	for i, col := range fPq.cols {
+		fPq.colIdx[i] = leafIdx[col.name]
+	}
+	var codec compress.Codec = &parquet.Snappy
+	if fPq.Cfg().Opts.ParquetCompression != nil {
+		switch *fPq.Cfg().Opts.ParquetCompression {
+		case utils.ParquetUncompressed:
+			codec = &parquet.Uncompressed
+		case utils.ParquetGzip:
+			codec = &parquet.Gzip
+		case utils.ParquetZstd:
+			codec = &parquet.Zstd
+		}
+	}
+	// create the file
+	filePath := typedFilePath(fPq.Cfg(), utils.ParquetSuffix)
+	fPq.dc.Lock()
+	fPq.dc.MapStorage[utils.ExportPath] = filePath
+	fPq.dc.Unlock()
+	if fPq.file, err = os.Create(filePath); err != nil {
+		return
+	}
+	fPq.writer = parquet.NewWriter(fPq.file, schema, parquet.Compression(codec))
+	return
+}
+
+// parquetNode returns the parquet type of the column
+func parquetNode(col *typedColumn) parquet.Node {
+	switch col.typ {
+	case utils.MetaInt64, utils.MetaDuration:
+		return parquet.Int(64)
+	case utils.MetaFloat64:
+		return parquet.Leaf(parquet.DoubleType)
+	case utils.MetaBool:
+		return parquet.Leaf(parquet.BooleanType)
+	case utils.MetaTimestamp:
+		return parquet.Timestamp(parquet.Microsecond)
+	case utils.MetaDecimal:
+		return parquet.Decimal(col.scale, utils.MaxDecimalPrecision, parquet.Int64Type)
+	default:
+		return parquet.String()
+	}
+}
+
+// parquetValue converts the column value to a parquet one
+func parquetValue(col *typedColumn, val any) (pqVal parquet.Value, err error) {
+	switch v := val.(type) {
+	case nil:
+		return
+	case string:
+		return parquet.ByteArrayValue([]byte(v)), nil
+	case int64:
+		return parquet.Int64Value(v), nil
+	case float64:
+		return parquet.DoubleValue(v), nil
+	case bool:
+		return parquet.BooleanValue(v), nil
+	case time.Time:
+		return parquet.Int64Value(v.UnixMicro()), nil
+	case *big.Rat:
+		exp := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(col.scale)), nil)
+		unscaled := new(big.Rat).Mul(v, new(big.Rat).SetInt(exp)).Num()
+		if !unscaled.IsInt64() {
+			return pqVal, fmt.Errorf("decimal <%s> of column <%s> exceeds %d digits precision",
+				v.FloatString(col.scale), col.name, utils.MaxDecimalPrecision)
+		}
+		return parquet.Int64Value(unscaled.Int64()), nil
+	default:
+		return pqVal, fmt.Errorf("unsupported value <%v> for column <%s>", val, col.name)
+	}
+}
+
+func (fPq *FileParquetee) Cfg() *config.EventExporterCfg { return fPq.cfg }
+
+func (fPq *FileParquetee) Connect() (_ error) { return }
+
+func (fPq *FileParquetee) ExportEvent(ev any, _ string) (err error) {
+	rec := ev.([]any)
+	row := make(parquet.Row, len(fPq.cols))
+	for i, col := range fPq.cols {
+		var val parquet.Value
+		if val, err = parquetValue(col, rec[i]); err != nil {
+			return
+		}
+		var defLvl int
+		if !val.IsNull() {
+			defLvl = 1
+		}
+		row[fPq.colIdx[i]] = val.Level(0, defLvl, fPq.colIdx[i])
+	}
+	fPq.Lock() // make sure that only one event is writen in file at once
+	defer fPq.Unlock()
+	_, err = fPq.writer.WriteRows([]parquet.Row{row})
+	return
+}
+
+func (fPq *FileParquetee) Close() (err error) {
+	fPq.Lock()
+	defer fPq.Unlock()
+	if err = fPq.writer.Close(); err != nil {
+		utils.Logger.Warning(fmt.Sprintf("<%s> Exporter with id: <%s> received error: <%s> when writing the file footer",
+			utils.EEs, fPq.Cfg().ID, err.Error()))
+	}
+	if err = fPq.file.Close(); err != nil {
+		utils.Logger.Warning(fmt.Sprintf("<%s> Exporter with id: <%s> received error: <%s> when closing the file",
+			utils.EEs, fPq.Cfg().ID, err.Error()))
+	}
+	return
+}
+
+func (fPq *FileParquetee) GetMetrics() *utils.SafeMapStorage { return fPq.dc }
+
+func (fPq *FileParquetee) PrepareMap(cgrEv *utils.CGREvent) (any, error) {
+	return typedRecord(fPq.cols, cgrEv.Event, fPq.tz)
+}
+
+func (fPq *FileParquetee) PrepareOrderMap(mp *utils.OrderedNavigableMap) (any, error) {
+	return typedRecord(fPq.cols, orderedMapValues(mp), fPq.tz)
+}
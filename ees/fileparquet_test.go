@@ -0,0 +1,188 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package ees
+
+import (
+	"io"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+	"github.com/parquet-go/parquet-go"
+)
+
+// typedExporterCfg returns an exporter writing typed columns in the dir
+func typedExporterCfg(typ, dir string) *config.EventExporterCfg {
+	eeCfg := config.NewDefaultCGRConfig().EEsCfg().GetDefaultExporter()
+	eeCfg.ID = "typed_exporter"
+	eeCfg.Type = typ
+	eeCfg.ExportPath = dir
+	eeCfg.Fields = []*config.FCTemplate{
+		{Path: "*exp.CGRID", Type: utils.MetaVariable,
+			Value: config.NewRSRParsersMustCompile("~*req.CGRID", utils.InfieldSep)},
+		{Path: "*exp.AnswerTime", Type: utils.MetaVariable,
+			Value: config.NewRSRParsersMustCompile("~*req.AnswerTime", utils.InfieldSep)},
+		{Path: "*exp.Usage", Type: utils.MetaVariable,
+			Value: config.NewRSRParsersMustCompile("~*req.Usage", utils.InfieldSep)},
+		{Path: "*exp.Cost", Type: utils.MetaVariable,
+			Value: config.NewRSRParsersMustCompile("~*req.Cost", utils.InfieldSep)},
+		{Path: "*exp.Rated", Type: utils.MetaVariable,
+			Value: config.NewRSRParsersMustCompile("~*req.Rated", utils.InfieldSep)},
+		{Path: "*exp.Extra", Type: utils.MetaVariable,
+			Value: config.NewRSRParsersMustCompile("~*req.Extra", utils.InfieldSep)},
+	}
+	for _, fld := range eeCfg.Fields {
+		fld.ComputePath()
+	}
+	eeCfg.ComputeFields()
+	eeCfg.Opts.FileColumnTypes = map[string]string{
+		"AnswerTime": utils.MetaTimestamp,
+		"Usage":      utils.MetaDuration,
+		"Cost":       utils.MetaDecimal + utils.InInFieldSep + "2",
+		"Rated":      utils.MetaBool,
+	}
+	return eeCfg
+}
+
+// exportTypedEvents exports two events, the second one without the optional fields
+func exportTypedEvents(t *testing.T, ee EventExporter) {
+	t.Helper()
+	cgrCfg := config.NewDefaultCGRConfig()
+	for _, ev := range []map[string]any{
+		{
+			"CGRID":      "cgrid1",
+			"AnswerTime": "2024-01-02T03:04:05.123456Z",
+			"Usage":      "1m30s",
+			"Cost":       1.2345,
+			"Rated":      "true",
+		},
+		{
+			"CGRID": "cgrid2",
+			"Extra": "extra2",
+		},
+	} {
+		expNM, err := composeExp(ee.Cfg().ContentFields(),
+			&utils.CGREvent{Tenant: "cgrates.org", Event: ev}, nil, cgrCfg, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rec, err := ee.PrepareOrderMap(expNM)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = ee.ExportEvent(rec, utils.EmptyString); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := ee.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFileParquetExportEvent(t *testing.T) {
+	dir := t.TempDir()
+	dc, err := newEEMetrics(utils.EmptyString)
+	if err != nil {
+		t.Fatal(err)
+	}
+	eeCfg := typedExporterCfg(utils.MetaFileParquet, dir)
+	fPq, err := NewFileParquetee(eeCfg, config.NewDefaultCGRConfig(), dc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exportTypedEvents(t, fPq)
+
+	filePath := utils.IfaceAsString(dc.MapStorage[utils.ExportPath])
+	file, err := os.Open(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	fInfo, err := file.Stat()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pqFile, err := parquet.OpenFile(file, fInfo.Size())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cost, has := pqFile.Schema().Lookup("Cost"); !has {
+		t.Error("missing Cost column")
+	} else if dec := cost.Node.Type().LogicalType().Decimal; dec == nil || dec.Scale != 2 {
+		t.Errorf("expected decimal column with scale 2, received: %+v", cost.Node.Type().LogicalType())
+	}
+	rows := make([]parquet.Row, 3)
+	n, err := parquet.NewReader(pqFile).ReadRows(rows)
+	if err != nil && err != io.EOF {
+		t.Fatal(err)
+	}
+	if n != 2 {
+		t.Fatalf("expected 2 rows, received %d", n)
+	}
+	rcv := make([]map[string]any, n)
+	for i := range rcv {
+		rcv[i] = make(map[string]any)
+		for _, val := range rows[i] {
+			if val.IsNull() {
+				continue
+			}
+			colName := pqFile.Schema().Columns()[val.Column()][0]
+			switch val.Kind() {
+			case parquet.ByteArray:
+				rcv[i][colName] = string(val.ByteArray())
+			case parquet.Boolean:
+				rcv[i][colName] = val.Boolean()
+			default:
+				rcv[i][colName] = val.Int64()
+			}
+		}
+	}
+	exp := []map[string]any{
+		{
+			"CGRID":      "cgrid1",
+			"AnswerTime": time.Date(2024, 1, 2, 3, 4, 5, 123456000, time.UTC).UnixMicro(),
+			"Usage":      int64(90 * time.Second),
+			"Cost":       int64(123),
+			"Rated":      true,
+		},
+		{
+			"CGRID": "cgrid2",
+			"Extra": "extra2",
+		},
+	}
+	if !reflect.DeepEqual(exp, rcv) {
+		t.Errorf("expected %s, received %s", utils.ToJSON(exp), utils.ToJSON(rcv))
+	}
+}
+
+func TestFileParquetInvalidColumnType(t *testing.T) {
+	dc, err := newEEMetrics(utils.EmptyString)
+	if err != nil {
+		t.Fatal(err)
+	}
+	eeCfg := typedExporterCfg(utils.MetaFileParquet, t.TempDir())
+	eeCfg.Opts.FileColumnTypes["Usage"] = "*uint8"
+	expErr := "unsupported column type: <*uint8> for column <Usage>"
+	if _, err = NewFileParquetee(eeCfg, config.NewDefaultCGRConfig(), dc); err == nil || err.Error() != expErr {
+		t.Errorf("expected error <%s>, received <%v>", expErr, err)
+	}
+}
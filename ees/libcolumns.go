@@ -0,0 +1,134 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package ees
+
+import (
+	"fmt"
+	"math/big"
+	"path"
+	"strings"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// typedColumn is a column of the exporters writing typed files
+type typedColumn struct {
+	name  string
+	typ   string
+	scale int // digits after the decimal point for *decimal
+}
+
+// newTypedColumns builds the columns out of the content fields paths,
+// in the order of the template, typed based on the fileColumnTypes option
+func newTypedColumns(eeCfg *config.EventExporterCfg) (cols []*typedColumn, err error) {
+	colIdx := make(utils.StringSet)
+	for _, fld := range eeCfg.ContentFields() {
+		pathSlice := fld.GetPathSlice()
+		if len(pathSlice) < 2 || pathSlice[0] != utils.MetaExp {
+			continue
+		}
+		col := &typedColumn{
+			name: strings.Join(pathSlice[1:], utils.NestingSep),
+			typ:  utils.MetaString,
+		}
+		if colIdx.Has(col.name) { // multiple fields populating the same column
+			continue
+		}
+		colIdx.Add(col.name)
+		if colType, has := eeCfg.Opts.FileColumnTypes[col.name]; has {
+			if col.typ, col.scale, err = utils.ParseColumnType(colType); err != nil {
+				return nil, fmt.Errorf("%s for column <%s>", err.Error(), col.name)
+			}
+		}
+		cols = append(cols, col)
+	}
+	if len(cols) == 0 {
+		return nil, fmt.Errorf("no columns defined in the fields of exporter <%s>", eeCfg.ID)
+	}
+	return
+}
+
+// orderedMapValues returns the exported values indexed on their path
+func orderedMapValues(mp *utils.OrderedNavigableMap) (vals map[string]any) {
+	vals = make(map[string]any)
+	for el := mp.GetFirstElement(); el != nil; el = el.Next() {
+		nmIt, _ := mp.Field(el.Value)
+		vals[strings.Join(el.Value[:len(el.Value)-1], utils.NestingSep)] = nmIt.Data // remove the index path.index
+	}
+	return
+}
+
+// typedRecord converts the exported values into the values of the columns,
+// the missing ones being nil
+func typedRecord(cols []*typedColumn, vals map[string]any, timezone string) (rec []any, err error) {
+	rec = make([]any, len(cols))
+	for i, col := range cols {
+		val, has := vals[col.name]
+		if !has || val == nil {
+			continue
+		}
+		if rec[i], err = col.value(val, timezone); err != nil {
+			return nil, fmt.Errorf("cannot convert value <%v> of column <%s> to %s, err: %s",
+				val, col.name, col.typ, err.Error())
+		}
+	}
+	return
+}
+
+// value converts the exported value to the Go type of the column:
+// string, int64, float64, bool, time.Time, int64 nanoseconds for *duration
+// and *big.Rat rounded to the scale for *decimal
+func (col *typedColumn) value(itm any, timezone string) (any, error) {
+	switch col.typ {
+	case utils.MetaInt64:
+		return utils.IfaceAsTInt64(itm)
+	case utils.MetaFloat64:
+		return utils.IfaceAsFloat64(itm)
+	case utils.MetaBool:
+		return utils.IfaceAsBool(itm)
+	case utils.MetaTimestamp:
+		return utils.IfaceAsTime(itm, timezone)
+	case utils.MetaDuration:
+		d, err := utils.IfaceAsDuration(itm)
+		return int64(d), err
+	case utils.MetaDecimal:
+		d, err := utils.IfaceAsBig(itm)
+		if err != nil {
+			return nil, err
+		}
+		return roundRat(d.Rat(nil), col.scale), nil
+	default:
+		return utils.IfaceAsString(itm), nil
+	}
+}
+
+// roundRat rounds half away from zero the number to the given digits after the decimal point
+func roundRat(r *big.Rat, scale int) *big.Rat {
+	exp := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale)), nil)
+	unscaled, _ := new(big.Int).SetString(
+		new(big.Rat).Mul(r, new(big.Rat).SetInt(exp)).FloatString(0), 10)
+	return new(big.Rat).SetFrac(unscaled, exp)
+}
+
+// typedFilePath returns the path of a new file of the exporter
+func typedFilePath(eeCfg *config.EventExporterCfg, suffix string) string {
+	return path.Join(eeCfg.ExportPath,
+		eeCfg.ID+utils.Underline+utils.UUIDSha1Prefix()+suffix)
+}
@@ -115,7 +115,7 @@ func TestNatsEEJetStream(t *testing.T) {
 			"Destination": "1002",
 		},
 	}
-	if err := exportEventWithExporter(evExp, cgrEv, true, cgrCfg, new(engine.FilterS)); err != nil {
+	if err := exportEventWithExporter(evExp, cgrEv, true, cgrCfg, new(engine.FilterS), nil); err != nil {
 		t.Fatal(err)
 	}
 	testCleanDirectory(t)
@@ -183,7 +183,7 @@ func TestNatsEE(t *testing.T) {
 			"Destination": "1002",
 		},
 	}
-	if err := exportEventWithExporter(evExp, cgrEv, true, cgrCfg, new(engine.FilterS)); err != nil {
+	if err := exportEventWithExporter(evExp, cgrEv, true, cgrCfg, new(engine.FilterS), nil); err != nil {
 		t.Fatal(err)
 	}
 	testCleanDirectory(t)
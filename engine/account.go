@@ -43,7 +43,9 @@ type Account struct {
 	AllowNegative     bool
 	Disabled          bool
 	UpdateTime        time.Time
+	Holds             map[string]*BalanceHold // amounts reserved on the balances, indexed on hold ID
 	executingTriggers bool
+	lockedAccounts    utils.StringSet // lock IDs of the accounts locked together with this one, used by the transfers
 }
 
 type AccountWithAPIOpts struct {
@@ -53,6 +55,8 @@ type AccountWithAPIOpts struct {
 
 // User's available minutes for the specified destination
 func (acc *Account) getCreditForPrefix(cd *CallDescriptor) (duration time.Duration, credit float64, balances Balances) {
+	acc.releaseExpiredHolds(time.Now())
+	acc.setHeldOnBalances() // the held amounts are not available
 	creditBalances := acc.getBalancesForPrefix(cd.Destination, cd.Category, utils.MetaMonetary, "", cd.TimeStart)
 
 	unitBalances := acc.getBalancesForPrefix(cd.Destination, cd.Category, cd.ToR, "", cd.TimeStart)
@@ -85,7 +89,7 @@ func (acc *Account) getCreditForPrefix(cd *CallDescriptor) (duration time.Durati
 			extendedMinuteBalances = append(extendedMinuteBalances, mb)
 		}
 	}
-	credit = extendedCreditBalances.GetTotalValue()
+	credit = extendedCreditBalances.getTotalCredit()
 	balances = extendedMinuteBalances
 	for _, b := range balances {
 		d, c := b.GetMinutesForCredit(cd, credit)
@@ -294,7 +298,7 @@ func (acc *Account) getBalancesForPrefix(prefix, category, tor,
 		if b.Disabled {
 			continue
 		}
-		if b.IsExpiredAt(aTime) || (len(b.SharedGroups) == 0 && b.GetValue() <= 0 && !b.Blocker) {
+		if b.IsExpiredAt(aTime) || (len(b.SharedGroups) == 0 && b.availableValue() <= 0 && !b.Blocker) {
 			continue
 		}
 		if sharedGroup != "" && !b.SharedGroups[sharedGroup] {
@@ -304,6 +308,10 @@ func (acc *Account) getBalancesForPrefix(prefix, category, tor,
 			continue
 		}
 		b.account = acc
+		if tor == utils.MetaMonetary &&
+			!b.setExchangeRate(utils.NewTenantID(acc.ID).Tenant) {
+			continue
+		}
 
 		if len(b.DestinationIDs) > 0 && !b.DestinationIDs[utils.MetaAny] {
 			for _, p := range utils.SplitPrefix(prefix, MIN_PREFIX_MATCH) {
@@ -376,10 +384,21 @@ func (acc *Account) getAlldBalancesForPrefix(destination, category,
 }
 
 func (acc *Account) debitCreditBalance(cd *CallDescriptor, count bool, dryRun bool, goNegative bool, fltrS *FilterS) (cc *CallCost, err error) {
+	acc.releaseExpiredHolds(time.Now())
+	acc.setHeldOnBalances() // the held amounts are not available
 	usefulUnitBalances := acc.getAlldBalancesForPrefix(cd.Destination, cd.Category, cd.ToR, cd.TimeStart)
 	usefulMoneyBalances := acc.getAlldBalancesForPrefix(cd.Destination, cd.Category, utils.MetaMonetary, cd.TimeStart)
 	// intiValues map[UUID]float64 and pass them to publish updating initial value
 	initUnitBal, initMoneyBal := balancesValues(usefulUnitBalances), balancesValues(usefulMoneyBalances)
+	var ldgSnap balanceLedgerSnapshot
+	if !dryRun {
+		ldgSnap = newBalanceLedgerSnapshot(acc)
+		for _, bc := range []Balances{usefulUnitBalances, usefulMoneyBalances} {
+			for _, b := range bc {
+				ldgSnap.add(b.account) // the shared balances of other accounts
+			}
+		}
+	}
 
 	var leftCC *CallCost
 	cc = cd.CreateCallCost()
@@ -508,9 +527,11 @@ func (acc *Account) debitCreditBalance(cd *CallDescriptor, count bool, dryRun bo
 					Cost:     ts.RateInterval.Rating.ConnectFee,
 					BalanceInfo: &DebitInfo{
 						Monetary: &MonetaryInfo{
-							UUID:  debitedConnectFeeBalance.Uuid,
-							ID:    debitedConnectFeeBalance.ID,
-							Value: debitedConnectFeeBalance.Value,
+							UUID:         debitedConnectFeeBalance.Uuid,
+							ID:           debitedConnectFeeBalance.ID,
+							Value:        debitedConnectFeeBalance.Value,
+							Currency:     debitedConnectFeeBalance.Currency,
+							ExchangeRate: debitedConnectFeeBalance.exchangeRate,
 						},
 						AccountID: acc.ID,
 					},
@@ -525,14 +546,16 @@ func (acc *Account) debitCreditBalance(cd *CallDescriptor, count bool, dryRun bo
 				if tsIndex == 0 && incIndex == 0 && ts.RateInterval.Rating.ConnectFee > 0 && cc.deductConnectFee && ok {
 					continue
 				}
-				cost := increment.Cost
-				defaultBalance := acc.GetDefaultMoneyBalance()
+				defaultBalance := acc.defaultMoneyBalanceWithRate()
+				cost := defaultBalance.convertCost(increment.Cost)
 				defaultBalance.SubstractValue(cost)
 
 				increment.BalanceInfo.Monetary = &MonetaryInfo{
-					UUID:  defaultBalance.Uuid,
-					ID:    defaultBalance.ID,
-					Value: defaultBalance.Value,
+					UUID:         defaultBalance.Uuid,
+					ID:           defaultBalance.ID,
+					Value:        defaultBalance.Value,
+					Currency:     defaultBalance.Currency,
+					ExchangeRate: defaultBalance.exchangeRate,
 				}
 				increment.BalanceInfo.AccountID = acc.ID
 				if count {
@@ -582,6 +605,7 @@ COMMIT:
 		// save darty shared balances
 		usefulMoneyBalances.SaveDirtyBalances(acc, initMoneyBal)
 		usefulUnitBalances.SaveDirtyBalances(acc, initUnitBal)
+		ldgSnap.store(utils.MetaDebit, cd.CgrID)
 	}
 	//log.Printf("Final CC: %+v", cc)
 	return
@@ -854,6 +878,12 @@ func (acc *Account) Clone() *Account {
 			newAcc.ActionTriggers[key] = actionTrigger.Clone()
 		}
 	}
+	if acc.Holds != nil {
+		newAcc.Holds = make(map[string]*BalanceHold, len(acc.Holds))
+		for holdID, hold := range acc.Holds {
+			newAcc.Holds[holdID] = hold.Clone()
+		}
+	}
 	return newAcc
 }
 
@@ -867,11 +897,11 @@ func (acc *Account) DebitConnectionFee(cc *CallCost, ufMoneyBalances Balances, c
 	//log.Print("CONNECT FEE: %f", connectFee)
 	var connectFeePaid bool
 	for _, b := range ufMoneyBalances {
-		if b.GetValue() >= connectFee {
-			b.SubstractValue(connectFee)
+		if blcConnectFee := b.convertCost(connectFee); b.availableValue() >= blcConnectFee {
+			b.SubstractValue(blcConnectFee)
 			// the conect fee is not refundable!
 			if count {
-				acc.countUnits(connectFee, utils.MetaMonetary, cc, b, fltrS)
+				acc.countUnits(blcConnectFee, utils.MetaMonetary, cc, b, fltrS)
 			}
 			connectFeePaid = true
 			debitedBalance = *b
@@ -885,12 +915,13 @@ func (acc *Account) DebitConnectionFee(cc *CallCost, ufMoneyBalances Balances, c
 	if connectFee > 0 && !connectFeePaid {
 		cc.negativeConnectFee = true
 		// there are no money for the connect fee; go negative
-		b := acc.GetDefaultMoneyBalance()
-		b.SubstractValue(connectFee)
+		b := acc.defaultMoneyBalanceWithRate()
+		blcConnectFee := b.convertCost(connectFee)
+		b.SubstractValue(blcConnectFee)
 		debitedBalance = *b
 		// the conect fee is not refundable!
 		if count {
-			acc.countUnits(connectFee, utils.MetaMonetary, cc, b, fltrS)
+			acc.countUnits(blcConnectFee, utils.MetaMonetary, cc, b, fltrS)
 		}
 	}
 	return true, debitedBalance
@@ -1048,6 +1079,7 @@ func (acc *Account) AsOldStructure() any {
 func (acc *Account) AsAccountSummary() *AccountSummary {
 	idSplt := strings.Split(acc.ID, utils.ConcatenatedKeySep)
 	ad := &AccountSummary{AllowNegative: acc.AllowNegative, Disabled: acc.Disabled}
+	held := acc.heldOnBalances()
 	if len(idSplt) == 1 {
 		ad.ID = idSplt[0]
 	} else if len(idSplt) == 2 {
@@ -1061,7 +1093,9 @@ func (acc *Account) AsAccountSummary() *AccountSummary {
 			continue
 		}
 		for _, balance := range balances {
-			ad.BalanceSummaries = append(ad.BalanceSummaries, balance.AsBalanceSummary(balanceType))
+			bs := balance.AsBalanceSummary(balanceType)
+			bs.Held = held[balance.Uuid]
+			ad.BalanceSummaries = append(ad.BalanceSummaries, bs)
 		}
 	}
 	return ad
@@ -100,6 +100,7 @@ func init() {
 	actionFuncMap[utils.MetaRemoveBalance] = removeBalanceAction
 	actionFuncMap[utils.MetaSetBalance] = setBalanceAction
 	actionFuncMap[utils.MetaTransferMonetaryDefault] = transferMonetaryDefaultAction
+	actionFuncMap[utils.MetaTransferBalance] = transferBalanceAction
 	actionFuncMap[utils.MetaCgrRpc] = cgrRPCAction
 	actionFuncMap[utils.TopUpZeroNegative] = topupZeroNegativeAction
 	actionFuncMap[utils.SetExpiry] = setExpiryAction
@@ -172,7 +173,7 @@ func cdrLogAction(acc *Account, a *Action, acs Actions, _ *FilterS, extraData an
 	// set stored cdr values
 	var cdrs []*CDR
 	for _, action := range acs {
-		if !slices.Contains([]string{utils.MetaDebit, utils.MetaDebitReset, utils.MetaSetBalance, utils.MetaTopUp, utils.MetaTopUpReset}, action.ActionType) ||
+		if !slices.Contains([]string{utils.MetaDebit, utils.MetaDebitReset, utils.MetaSetBalance, utils.MetaTopUp, utils.MetaTopUpReset, utils.MetaTransferBalance}, action.ActionType) ||
 			action.Balance == nil {
 			continue // Only log specific actions
 		}
@@ -42,6 +42,7 @@ type ActionTiming struct {
 	actions      Actions
 	accountIDs   utils.StringMap // copy of action plans accounts
 	actionPlanID string          // the id of the belonging action plan (info only)
+	apiMethod    string          // the API executing the actions, recorded in the balance ledger
 	stCache      time.Time       // cached time of the next start
 }
 
@@ -183,6 +184,19 @@ func (at *ActionTiming) GetActionPlanID() string {
 	return at.actionPlanID
 }
 
+// SetAPIMethod marks the actions as executed by the API, used as cause in the balance ledger
+func (at *ActionTiming) SetAPIMethod(method string) {
+	at.apiMethod = method
+}
+
+// ledgerCause returns the cause of the balance changes done by the action
+func (at *ActionTiming) ledgerCause(a *Action) (cause, causeID string) {
+	if at.apiMethod != utils.EmptyString {
+		return utils.MetaAPI, at.apiMethod
+	}
+	return utils.MetaActions, utils.FirstNonEmpty(a.Id, at.ActionsID)
+}
+
 func (at *ActionTiming) getActions() (as []*Action, err error) {
 	if at.actions == nil {
 		at.actions, err = dm.GetActions(at.ActionsID, false, utils.NonTransactional)
@@ -202,6 +216,8 @@ func (at *ActionTiming) Execute(fltrS *FilterS) (err error) {
 	}
 	var partialyExecuted bool
 	for accID := range at.accountIDs {
+		// lock the destination accounts of the transfers as well, in order
+		lkIDs := transferLockIDs(append(Actions(aac).transferPeers(accID), accID)...)
 		err = guardian.Guardian.Guard(func() error {
 			acc, err := dm.GetAccount(accID)
 			if err != nil { // create account
@@ -214,8 +230,11 @@ func (at *ActionTiming) Execute(fltrS *FilterS) (err error) {
 					ID: accID,
 				}
 			}
+			acc.lockedAccounts = utils.NewStringSet(lkIDs)
 			transactionFailed := false
 			removeAccountActionFound := false
+			ldgSnap := newBalanceLedgerSnapshot(acc)
+			var ldgEntries []*BalanceLedgerEntry
 			for _, a := range aac {
 				// check action filter
 				if len(a.Filters) > 0 {
@@ -255,12 +274,17 @@ func (at *ActionTiming) Execute(fltrS *FilterS) (err error) {
 				if a.ActionType == utils.MetaRemoveAccount {
 					removeAccountActionFound = true
 				}
+				if ldgSnap != nil {
+					cause, causeID := at.ledgerCause(a)
+					ldgEntries = append(ldgEntries, ldgSnap.entries(cause, causeID, time.Now())...)
+				}
 			}
 			if !transactionFailed && !removeAccountActionFound {
 				dm.SetAccount(acc)
+				storeBalanceLedger(ldgEntries)
 			}
 			return nil
-		}, config.CgrConfig().GeneralCfg().LockingTimeout, utils.AccountPrefix+accID)
+		}, config.CgrConfig().GeneralCfg().LockingTimeout, lkIDs...)
 	}
 	//reset the error in case that the account is not found
 	err = nil
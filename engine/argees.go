@@ -21,6 +21,7 @@ package engine
 import (
 	"encoding/json"
 	"slices"
+	"time"
 
 	"github.com/cgrates/cgrates/utils"
 )
@@ -100,3 +101,23 @@ func (cgr *CGREventWithEeIDs) UnmarshalJSON(data []byte) (err error) {
 	}
 	return
 }
+
+// DeadLetter is an event which EEs failed to export, kept for inspection and replay
+type DeadLetter struct {
+	ID         string
+	ExporterID string
+	Key        string    // the export key, used by some exporters as message or object key
+	FailedAt   time.Time // time of the last failed export
+	Replays    int       // number of failed replays
+	Error      string    // reason of the last failure
+	Event      any       // the event as prepared by the exporter
+}
+
+// ArgsDeadLetters selects the dead letters by exporter and failure time
+type ArgsDeadLetters struct {
+	Tenant      string
+	ExporterIDs []string // all the exporters if empty
+	TimeStart   string   // failed at or after, inclusive
+	TimeEnd     string   // failed before, exclusive
+	APIOpts     map[string]any
+}
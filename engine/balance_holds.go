@@ -0,0 +1,292 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/guardian"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// BalanceHold is an amount reserved on the balances of an account. The amount
+// stays in the balances but is not available for debits until it is captured
+// (debited out of the balances) or released
+type BalanceHold struct {
+	ID          string
+	BalanceType string
+	Parts       []*BalanceHoldPart // amounts taken from each balance, in the order they were taken
+	ExpiryTime  time.Time          // released automatically after this time
+}
+
+// BalanceHoldPart is the amount held on one balance
+type BalanceHoldPart struct {
+	BalanceUUID string
+	BalanceID   string
+	Amount      float64
+}
+
+// Amount returns the total amount held
+func (h *BalanceHold) Amount() (amount float64) {
+	for _, part := range h.Parts {
+		amount += part.Amount
+	}
+	return utils.Round(amount, globalRoundingDecimals, utils.MetaRoundingMiddle)
+}
+
+// IsExpiredAt returns true if the hold needs to be released
+func (h *BalanceHold) IsExpiredAt(t time.Time) bool {
+	return !h.ExpiryTime.IsZero() && !h.ExpiryTime.After(t)
+}
+
+// Clone returns a copy of the hold
+func (h *BalanceHold) Clone() (cln *BalanceHold) {
+	cln = &BalanceHold{
+		ID:          h.ID,
+		BalanceType: h.BalanceType,
+		ExpiryTime:  h.ExpiryTime,
+	}
+	if h.Parts != nil {
+		cln.Parts = make([]*BalanceHoldPart, len(h.Parts))
+		for i, part := range h.Parts {
+			cln.Parts[i] = &BalanceHoldPart{
+				BalanceUUID: part.BalanceUUID,
+				BalanceID:   part.BalanceID,
+				Amount:      part.Amount,
+			}
+		}
+	}
+	return
+}
+
+// heldOnBalances returns the amounts held, indexed on the balance UUID
+func (acc *Account) heldOnBalances() (held map[string]float64) {
+	held = make(map[string]float64)
+	for _, h := range acc.Holds {
+		for _, part := range h.Parts {
+			held[part.BalanceUUID] += part.Amount
+		}
+	}
+	return
+}
+
+// setHeldOnBalances marks the amounts held on the balances so they are not available for debits
+func (acc *Account) setHeldOnBalances() {
+	held := acc.heldOnBalances()
+	for _, blcs := range acc.BalanceMap {
+		for _, blc := range blcs {
+			blc.held = held[blc.Uuid]
+		}
+	}
+}
+
+// placeHold reserves the amount out of the value available on the balances of the type,
+// ordered by weight. Only the balance with blcID is used if specified
+func (acc *Account) placeHold(holdID, blcType, blcID string, amount float64,
+	expiryTime time.Time) (hold *BalanceHold, err error) {
+	if _, has := acc.Holds[holdID]; has {
+		return nil, utils.ErrExists
+	}
+	if amount <= 0 {
+		return nil, fmt.Errorf("invalid amount to hold: %v", amount)
+	}
+	hold = &BalanceHold{
+		ID:          holdID,
+		BalanceType: blcType,
+		ExpiryTime:  expiryTime,
+	}
+	acc.setHeldOnBalances()
+	now := time.Now()
+	blcs := make(Balances, 0, len(acc.BalanceMap[blcType]))
+	for _, blc := range acc.BalanceMap[blcType] {
+		if blc.Disabled || !blc.IsActiveAt(now) || blc.availableValue() <= 0 ||
+			(blcID != utils.EmptyString && blc.ID != blcID) {
+			continue
+		}
+		blcs = append(blcs, blc)
+	}
+	blcs.Sort()
+	left := amount
+	for _, blc := range blcs {
+		if left <= 0 {
+			break
+		}
+		partAmount := min(left, blc.availableValue())
+		hold.Parts = append(hold.Parts, &BalanceHoldPart{
+			BalanceUUID: blc.Uuid,
+			BalanceID:   blc.ID,
+			Amount:      partAmount,
+		})
+		left = utils.Round(left-partAmount, globalRoundingDecimals, utils.MetaRoundingMiddle)
+	}
+	if left > 0 {
+		if !acc.AllowNegative || blcType != utils.MetaMonetary || blcID != utils.EmptyString {
+			return nil, utils.ErrInsufficientCredit
+		}
+		dfltBlc := acc.GetDefaultMoneyBalance() // go negative on the default balance for the rest
+		hold.Parts = append(hold.Parts, &BalanceHoldPart{
+			BalanceUUID: dfltBlc.Uuid,
+			BalanceID:   dfltBlc.ID,
+			Amount:      left,
+		})
+	}
+	if acc.Holds == nil {
+		acc.Holds = make(map[string]*BalanceHold)
+	}
+	acc.Holds[holdID] = hold
+	acc.setHeldOnBalances()
+	return
+}
+
+// debitHoldPart debits the amount captured out of the balance it was held on,
+// falling back on the default balance for the monetary ones no longer in the account
+func (acc *Account) debitHoldPart(blcType, blcUUID string, amount float64) {
+	if blc := acc.BalanceMap[blcType].GetBalance(blcUUID); blc != nil {
+		blc.SubstractValue(amount)
+		return
+	}
+	if blcType == utils.MetaMonetary {
+		acc.GetDefaultMoneyBalance().SubstractValue(amount)
+		return
+	}
+	utils.Logger.Warning(fmt.Sprintf("<%s> cannot capture %v held on missing balance <%s> of account <%s>",
+		utils.RALService, amount, blcUUID, acc.ID))
+}
+
+// captureHold debits the amount of the hold out of the balances, releasing the rest of it.
+// The whole hold is captured if the amount is not specified
+func (acc *Account) captureHold(holdID string, amount *float64) (captured float64, err error) {
+	hold, has := acc.Holds[holdID]
+	if !has {
+		return 0, utils.ErrNotFound
+	}
+	captured = hold.Amount()
+	if amount != nil {
+		if *amount < 0 || *amount > captured {
+			return 0, fmt.Errorf("invalid amount to capture: %v, held: %v", *amount, captured)
+		}
+		captured = *amount
+	}
+	left := captured
+	for _, part := range hold.Parts {
+		if left <= 0 {
+			break
+		}
+		taken := min(left, part.Amount)
+		left = utils.Round(left-taken, globalRoundingDecimals, utils.MetaRoundingMiddle)
+		acc.debitHoldPart(hold.BalanceType, part.BalanceUUID, taken)
+	}
+	delete(acc.Holds, holdID)
+	acc.setHeldOnBalances()
+	return
+}
+
+// releaseHold makes the amount held available again, the balances being left unchanged
+func (acc *Account) releaseHold(holdID string) (err error) {
+	if _, has := acc.Holds[holdID]; !has {
+		return utils.ErrNotFound
+	}
+	delete(acc.Holds, holdID)
+	acc.setHeldOnBalances()
+	return
+}
+
+// releaseExpiredHolds releases the holds which were not captured in time
+func (acc *Account) releaseExpiredHolds(t time.Time) {
+	for holdID, hold := range acc.Holds {
+		if hold.IsExpiredAt(t) {
+			acc.releaseHold(holdID)
+		}
+	}
+	if len(acc.Holds) == 0 {
+		acc.Holds = nil // leave it nil if empty
+	}
+}
+
+// ArgsBalanceHold are the arguments used to place, capture or release a hold
+type ArgsBalanceHold struct {
+	Tenant      string
+	Account     string
+	HoldID      string
+	BalanceType string        // *monetary if not specified
+	BalanceID   string        // hold on a single balance
+	Amount      *float64      // the amount to hold or to capture (the whole hold if not specified)
+	TTL         time.Duration // hold until captured or released if not specified
+	APIOpts     map[string]any
+}
+
+// updateAccountHolds applies the function on the account, locked, saving it afterwards.
+// The changes are recorded in the balance ledger with the API method as cause
+func updateAccountHolds(accID, apiMethod string, f func(acc *Account) error) (err error) {
+	return guardian.Guardian.Guard(func() (err error) {
+		var acc *Account
+		if acc, err = dm.GetAccount(accID); err != nil {
+			return
+		}
+		ldgSnap := newBalanceLedgerSnapshot(acc)
+		acc.releaseExpiredHolds(time.Now())
+		if err = f(acc); err != nil {
+			return
+		}
+		if err = dm.SetAccount(acc); err != nil {
+			return
+		}
+		ldgSnap.store(utils.MetaAPI, apiMethod)
+		return
+	}, config.CgrConfig().GeneralCfg().LockingTimeout, utils.AccountPrefix+accID)
+}
+
+// HoldBalance places a hold on the account, returning it
+func HoldBalance(args *ArgsBalanceHold) (hold *BalanceHold, err error) {
+	if args.Amount == nil {
+		return nil, utils.NewErrMandatoryIeMissing(utils.Amount)
+	}
+	holdID := utils.FirstNonEmpty(args.HoldID, utils.GenUUID())
+	blcType := utils.FirstNonEmpty(args.BalanceType, utils.MetaMonetary)
+	var expiryTime time.Time
+	if args.TTL > 0 {
+		expiryTime = time.Now().Add(args.TTL)
+	}
+	err = updateAccountHolds(utils.ConcatenatedKey(args.Tenant, args.Account), utils.APIerSv1HoldBalance, func(acc *Account) (err error) {
+		if hold, err = acc.placeHold(holdID, blcType, args.BalanceID, *args.Amount, expiryTime); err != nil {
+			return
+		}
+		hold = hold.Clone()
+		return
+	})
+	return
+}
+
+// CaptureBalanceHold consumes the hold fully or partially, returning the amount captured
+func CaptureBalanceHold(args *ArgsBalanceHold) (captured float64, err error) {
+	err = updateAccountHolds(utils.ConcatenatedKey(args.Tenant, args.Account), utils.APIerSv1CaptureBalanceHold, func(acc *Account) (err error) {
+		captured, err = acc.captureHold(args.HoldID, args.Amount)
+		return
+	})
+	return
+}
+
+// ReleaseBalanceHold makes the amount held on the account available again
+func ReleaseBalanceHold(args *ArgsBalanceHold) (err error) {
+	return updateAccountHolds(utils.ConcatenatedKey(args.Tenant, args.Account), utils.APIerSv1ReleaseBalanceHold, func(acc *Account) error {
+		return acc.releaseHold(args.HoldID)
+	})
+}
@@ -0,0 +1,226 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cgrates/cgrates/utils"
+)
+
+func newHoldsAccount() *Account {
+	return &Account{
+		ID: "cgrates.org:holds",
+		BalanceMap: map[string]Balances{
+			utils.MetaMonetary: {
+				{Uuid: "uuid1", ID: "BAL_1", Value: 5, Weight: 20},
+				{Uuid: "uuid2", ID: "BAL_2", Value: 10, Weight: 10},
+			},
+		},
+	}
+}
+
+func TestAccountPlaceHold(t *testing.T) {
+	acc := newHoldsAccount()
+	hold, err := acc.placeHold("HOLD_1", utils.MetaMonetary, utils.EmptyString, 7, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := &BalanceHold{
+		ID:          "HOLD_1",
+		BalanceType: utils.MetaMonetary,
+		Parts: []*BalanceHoldPart{
+			{BalanceUUID: "uuid1", BalanceID: "BAL_1", Amount: 5},
+			{BalanceUUID: "uuid2", BalanceID: "BAL_2", Amount: 2},
+		},
+	}
+	if !reflect.DeepEqual(exp, hold) {
+		t.Errorf("Expected: %s, received: %s", utils.ToJSON(exp), utils.ToJSON(hold))
+	}
+	if hold.Amount() != 7 {
+		t.Errorf("Expected amount: 7, received: %v", hold.Amount())
+	}
+	// the held amounts stay in the balances, out of the available value
+	if v1, v2 := acc.BalanceMap[utils.MetaMonetary][0].Value, acc.BalanceMap[utils.MetaMonetary][1].Value; v1 != 5 || v2 != 10 {
+		t.Errorf("Unexpected balance values: %v, %v", v1, v2)
+	}
+	if v1, v2 := acc.BalanceMap[utils.MetaMonetary][0].availableValue(), acc.BalanceMap[utils.MetaMonetary][1].availableValue(); v1 != 0 || v2 != 8 {
+		t.Errorf("Unexpected available values: %v, %v", v1, v2)
+	}
+	if _, err = acc.placeHold("HOLD_1", utils.MetaMonetary, utils.EmptyString, 1, time.Time{}); err != utils.ErrExists {
+		t.Errorf("Expected error: %v, received: %v", utils.ErrExists, err)
+	}
+	if _, err = acc.placeHold("HOLD_2", utils.MetaMonetary, utils.EmptyString, 9, time.Time{}); err != utils.ErrInsufficientCredit {
+		t.Errorf("Expected error: %v, received: %v", utils.ErrInsufficientCredit, err)
+	}
+	if _, err = acc.placeHold("HOLD_2", utils.MetaMonetary, "BAL_1", 1, time.Time{}); err != utils.ErrInsufficientCredit {
+		t.Errorf("Expected error: %v, received: %v", utils.ErrInsufficientCredit, err)
+	}
+	// the held amounts are visible in the summary
+	expSummaries := BalanceSummaries{
+		{UUID: "uuid1", ID: "BAL_1", Type: utils.MetaMonetary, Value: 5, Held: 5},
+		{UUID: "uuid2", ID: "BAL_2", Type: utils.MetaMonetary, Value: 10, Held: 2},
+	}
+	if rcv := acc.AsAccountSummary().BalanceSummaries; !reflect.DeepEqual(expSummaries, rcv) {
+		t.Errorf("Expected: %s, received: %s", utils.ToJSON(expSummaries), utils.ToJSON(rcv))
+	}
+}
+
+func TestAccountPlaceHoldAllowNegative(t *testing.T) {
+	acc := newHoldsAccount()
+	acc.AllowNegative = true
+	hold, err := acc.placeHold("HOLD_1", utils.MetaMonetary, utils.EmptyString, 20, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hold.Parts) != 3 || hold.Parts[2].BalanceID != utils.MetaDefault || hold.Parts[2].Amount != 5 {
+		t.Errorf("Unexpected hold: %s", utils.ToJSON(hold))
+	}
+	if dflt := acc.GetDefaultMoneyBalance(); dflt.availableValue() != -5 {
+		t.Errorf("Expected the default balance to go negative, received: %v", dflt.availableValue())
+	}
+}
+
+func TestAccountCaptureHold(t *testing.T) {
+	acc := newHoldsAccount()
+	if _, err := acc.placeHold("HOLD_1", utils.MetaMonetary, utils.EmptyString, 7, time.Time{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := acc.captureHold("HOLD_1", utils.Float64Pointer(8)); err == nil {
+		t.Error("Expected error capturing more than held")
+	}
+	// the captured amount is debited in the order it was held, the rest being released
+	if captured, err := acc.captureHold("HOLD_1", utils.Float64Pointer(6)); err != nil {
+		t.Fatal(err)
+	} else if captured != 6 {
+		t.Errorf("Expected captured: 6, received: %v", captured)
+	}
+	if v1, v2 := acc.BalanceMap[utils.MetaMonetary][0].Value, acc.BalanceMap[utils.MetaMonetary][1].Value; v1 != 0 || v2 != 9 {
+		t.Errorf("Unexpected balance values: %v, %v", v1, v2)
+	}
+	if _, has := acc.Holds["HOLD_1"]; has {
+		t.Error("Expected the hold to be removed")
+	}
+	if _, err := acc.captureHold("HOLD_1", nil); err != utils.ErrNotFound {
+		t.Errorf("Expected error: %v, received: %v", utils.ErrNotFound, err)
+	}
+	// full capture
+	if _, err := acc.placeHold("HOLD_2", utils.MetaMonetary, utils.EmptyString, 4, time.Time{}); err != nil {
+		t.Fatal(err)
+	}
+	if captured, err := acc.captureHold("HOLD_2", nil); err != nil {
+		t.Fatal(err)
+	} else if captured != 4 {
+		t.Errorf("Expected captured: 4, received: %v", captured)
+	}
+	if v2 := acc.BalanceMap[utils.MetaMonetary][1].Value; v2 != 5 {
+		t.Errorf("Unexpected balance value: %v", v2)
+	}
+}
+
+func TestAccountReleaseHolds(t *testing.T) {
+	acc := newHoldsAccount()
+	if _, err := acc.placeHold("HOLD_1", utils.MetaMonetary, utils.EmptyString, 7, time.Time{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := acc.placeHold("HOLD_2", utils.MetaMonetary, "BAL_2", 3, time.Now().Add(-time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	// only the expired hold is released
+	acc.releaseExpiredHolds(time.Now())
+	if _, has := acc.Holds["HOLD_2"]; has {
+		t.Error("Expected the expired hold to be released")
+	}
+	if v2 := acc.BalanceMap[utils.MetaMonetary][1].availableValue(); v2 != 8 {
+		t.Errorf("Unexpected available value: %v", v2)
+	}
+	// the balance reset in the meantime is not credited back
+	acc.BalanceMap[utils.MetaMonetary][1].SetValue(0)
+	if err := acc.releaseHold("HOLD_1"); err != nil {
+		t.Fatal(err)
+	}
+	if v1, v2 := acc.BalanceMap[utils.MetaMonetary][0].availableValue(), acc.BalanceMap[utils.MetaMonetary][1].availableValue(); v1 != 5 || v2 != 0 {
+		t.Errorf("Unexpected available values: %v, %v", v1, v2)
+	}
+	if acc.releaseExpiredHolds(time.Now()); acc.Holds != nil {
+		t.Errorf("Expected no holds, received: %s", utils.ToJSON(acc.Holds))
+	}
+}
+
+func TestAccountHoldsExcludedFromCredit(t *testing.T) {
+	acc := newHoldsAccount()
+	if _, err := acc.placeHold("HOLD_1", utils.MetaMonetary, utils.EmptyString, 12, time.Time{}); err != nil {
+		t.Fatal(err)
+	}
+	cd := &CallDescriptor{
+		Category:    utils.Call,
+		Destination: "0723",
+		ToR:         utils.MetaVoice,
+		TimeStart:   time.Now(),
+		TimeEnd:     time.Now().Add(time.Minute),
+	}
+	if _, credit, _ := acc.getCreditForPrefix(cd); credit != 3 {
+		t.Errorf("Expected credit: 3, received: %v", credit)
+	}
+	if _, err := transferValue(acc, newHoldsAccount(), &ArgsTransferBalance{ToBalanceID: "BAL_1", Amount: 4}); err != utils.ErrInsufficientCredit {
+		t.Errorf("Expected error: %v, received: %v", utils.ErrInsufficientCredit, err)
+	}
+	acc.Holds["HOLD_1"].ExpiryTime = time.Now().Add(-time.Second)
+	if _, credit, _ := acc.getCreditForPrefix(cd); credit != 15 {
+		t.Errorf("Expected credit: 15, received: %v", credit)
+	}
+}
+
+func TestHoldBalance(t *testing.T) {
+	if err := dm.SetAccount(newHoldsAccount()); err != nil {
+		t.Fatal(err)
+	}
+	args := &ArgsBalanceHold{
+		Tenant:  "cgrates.org",
+		Account: "holds",
+		HoldID:  "HOLD_API",
+		Amount:  utils.Float64Pointer(6),
+		TTL:     time.Hour,
+	}
+	hold, err := HoldBalance(args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hold.ID != "HOLD_API" || hold.Amount() != 6 || hold.ExpiryTime.IsZero() {
+		t.Errorf("Unexpected hold: %s", utils.ToJSON(hold))
+	}
+	args.Amount = utils.Float64Pointer(1)
+	if captured, err := CaptureBalanceHold(args); err != nil {
+		t.Fatal(err)
+	} else if captured != 1 {
+		t.Errorf("Expected captured: 1, received: %v", captured)
+	}
+	if err = ReleaseBalanceHold(args); err != utils.ErrNotFound {
+		t.Errorf("Expected error: %v, received: %v", utils.ErrNotFound, err)
+	}
+	acc, err := dm.GetAccount("cgrates.org:holds")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if credit := acc.BalanceMap[utils.MetaMonetary].GetTotalValue(); credit != 14 {
+		t.Errorf("Expected credit: 14, received: %v", credit)
+	}
+}
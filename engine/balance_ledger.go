@@ -0,0 +1,223 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// BalanceLedgerEntry records one change of a balance value
+type BalanceLedgerEntry struct {
+	Tenant      string
+	Account     string
+	BalanceType string
+	BalanceID   string
+	BalanceUUID string
+	OldValue    float64
+	NewValue    float64
+	Delta       float64
+	Cause       string // *debit, *refund, *actions or *api
+	CauseID     string // the CGRID, the ID of the action or the API method
+	Time        time.Time
+}
+
+// AsCGREvent converts the entry into a CGREvent, used for the export
+func (le *BalanceLedgerEntry) AsCGREvent() *utils.CGREvent {
+	return &utils.CGREvent{
+		Tenant: le.Tenant,
+		ID:     utils.UUIDSha1Prefix(),
+		Event: map[string]any{
+			utils.EventSource:  utils.AccountService,
+			utils.Tenant:       le.Tenant,
+			utils.AccountField: le.Account,
+			utils.BalanceType:  le.BalanceType,
+			utils.BalanceID:    le.BalanceID,
+			utils.BalanceUUID:  le.BalanceUUID,
+			utils.OldValue:     le.OldValue,
+			utils.NewValue:     le.NewValue,
+			utils.Delta:        le.Delta,
+			utils.Cause:        le.Cause,
+			utils.CauseID:      le.CauseID,
+			utils.Time:         le.Time,
+		},
+		APIOpts: map[string]any{
+			utils.MetaEventType: utils.BalanceLedgerEntry,
+		},
+	}
+}
+
+// BalanceLedgerFilter selects the ledger entries, ordered by time
+type BalanceLedgerFilter struct {
+	Tenants      []string
+	Accounts     []string // account IDs without the tenant
+	BalanceTypes []string
+	BalanceIDs   []string
+	Causes       []string
+	CauseIDs     []string
+	TimeStart    *time.Time // entries recorded at or after this time
+	TimeEnd      *time.Time // entries recorded before this time
+	utils.Paginator
+}
+
+// Pass returns true if the entry matches the filter, ignoring the pagination
+func (lf *BalanceLedgerFilter) Pass(le *BalanceLedgerEntry) bool {
+	for _, fltr := range []struct {
+		vals []string
+		val  string
+	}{
+		{lf.Tenants, le.Tenant},
+		{lf.Accounts, le.Account},
+		{lf.BalanceTypes, le.BalanceType},
+		{lf.BalanceIDs, le.BalanceID},
+		{lf.Causes, le.Cause},
+		{lf.CauseIDs, le.CauseID},
+	} {
+		if len(fltr.vals) != 0 && !slices.Contains(fltr.vals, fltr.val) {
+			return false
+		}
+	}
+	return (lf.TimeStart == nil || !le.Time.Before(*lf.TimeStart)) &&
+		(lf.TimeEnd == nil || le.Time.Before(*lf.TimeEnd))
+}
+
+// ledgerBalance is the state of a balance at the time of the snapshot
+type ledgerBalance struct {
+	blcType string
+	id      string
+	value   float64
+}
+
+// balanceLedgerSnapshot keeps the balance values of the accounts before an operation,
+// the nil snapshot is used when the ledger is disabled
+type balanceLedgerSnapshot map[*Account]map[string]*ledgerBalance
+
+// newBalanceLedgerSnapshot returns the snapshot of the accounts if the ledger is enabled
+func newBalanceLedgerSnapshot(accs ...*Account) (ls balanceLedgerSnapshot) {
+	if !config.CgrConfig().RalsCfg().BalanceLedger || cdrStorage == nil {
+		return
+	}
+	ls = make(balanceLedgerSnapshot)
+	for _, acc := range accs {
+		ls.add(acc)
+	}
+	return
+}
+
+// add takes the snapshot of the account, once
+func (ls balanceLedgerSnapshot) add(acc *Account) {
+	if ls == nil || acc == nil {
+		return
+	}
+	if _, has := ls[acc]; has {
+		return
+	}
+	ls[acc] = ledgerBalances(acc)
+}
+
+// ledgerBalances returns the current state of the account balances, indexed on UUID
+func ledgerBalances(acc *Account) (blcs map[string]*ledgerBalance) {
+	blcs = make(map[string]*ledgerBalance)
+	for blcType, bc := range acc.BalanceMap {
+		for _, b := range bc {
+			blcs[b.Uuid] = &ledgerBalance{blcType: blcType, id: b.ID, value: b.GetValue()}
+		}
+	}
+	return
+}
+
+// entries returns the ledger entries for the balances changed since the snapshot,
+// taking a new snapshot so the next changes are computed from the current values
+func (ls balanceLedgerSnapshot) entries(cause, causeID string, t time.Time) (entries []*BalanceLedgerEntry) {
+	for acc, blcs := range ls {
+		tntID := utils.NewTenantID(acc.ID)
+		newEntry := func(blcType, blcID, blcUUID string, oldVal, newVal float64) {
+			entries = append(entries, &BalanceLedgerEntry{
+				Tenant:      tntID.Tenant,
+				Account:     tntID.ID,
+				BalanceType: blcType,
+				BalanceID:   blcID,
+				BalanceUUID: blcUUID,
+				OldValue:    oldVal,
+				NewValue:    newVal,
+				Delta:       utils.Round(newVal-oldVal, globalRoundingDecimals, utils.MetaRoundingMiddle),
+				Cause:       cause,
+				CauseID:     causeID,
+				Time:        t,
+			})
+		}
+		seen := make(utils.StringSet)
+		for blcType, bc := range acc.BalanceMap {
+			for _, b := range bc {
+				seen.Add(b.Uuid)
+				var oldVal float64
+				if old, has := blcs[b.Uuid]; has {
+					oldVal = old.value
+				}
+				if oldVal != b.GetValue() {
+					newEntry(blcType, b.ID, b.Uuid, oldVal, b.GetValue())
+				}
+			}
+		}
+		for blcUUID, old := range blcs { // removed balances
+			if !seen.Has(blcUUID) && old.value != 0 {
+				newEntry(old.blcType, old.id, blcUUID, old.value, 0)
+			}
+		}
+		ls[acc] = ledgerBalances(acc)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Tenant != entries[j].Tenant {
+			return entries[i].Tenant < entries[j].Tenant
+		}
+		if entries[i].Account != entries[j].Account {
+			return entries[i].Account < entries[j].Account
+		}
+		if entries[i].BalanceType != entries[j].BalanceType {
+			return entries[i].BalanceType < entries[j].BalanceType
+		}
+		return entries[i].BalanceUUID < entries[j].BalanceUUID
+	})
+	return
+}
+
+// store writes the changes since the snapshot into the ledger
+func (ls balanceLedgerSnapshot) store(cause, causeID string) {
+	if ls == nil {
+		return
+	}
+	storeBalanceLedger(ls.entries(cause, causeID, time.Now()))
+}
+
+// storeBalanceLedger writes the entries into StorDB, logging the errors
+// since the balances are already updated
+func storeBalanceLedger(entries []*BalanceLedgerEntry) {
+	if len(entries) == 0 {
+		return
+	}
+	if err := cdrStorage.SetBalanceLedgerEntries(entries); err != nil {
+		utils.Logger.Warning(fmt.Sprintf("<%s> error: <%s> storing the balance ledger entries: %s",
+			utils.RALService, err.Error(), utils.ToJSON(entries)))
+	}
+}
@@ -0,0 +1,217 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// enableBalanceLedger turns on the ledger over a new internal StorDB, returning it
+func enableBalanceLedger(t *testing.T) *InternalDB {
+	cfg := config.NewDefaultCGRConfig()
+	cfg.RalsCfg().BalanceLedger = true
+	config.SetCgrConfig(cfg)
+	tmpCdrStorage := cdrStorage
+	t.Cleanup(func() {
+		config.SetCgrConfig(config.NewDefaultCGRConfig())
+		SetCdrStorage(tmpCdrStorage)
+	})
+	stordb := NewInternalDB(nil, nil, false, cfg.StorDbCfg().Items)
+	SetCdrStorage(stordb)
+	return stordb
+}
+
+func TestBalanceLedgerSnapshotEntries(t *testing.T) {
+	enableBalanceLedger(t)
+	acc := &Account{
+		ID: "cgrates.org:ledger",
+		BalanceMap: map[string]Balances{
+			utils.MetaMonetary: {
+				{Uuid: "uuid1", ID: "BAL_1", Value: 10},
+				{Uuid: "uuid2", ID: "BAL_2", Value: 5},
+			},
+			utils.MetaVoice: {
+				{Uuid: "uuid3", ID: "BAL_3", Value: 60},
+			},
+		},
+	}
+	ldgSnap := newBalanceLedgerSnapshot(acc)
+	acc.BalanceMap[utils.MetaMonetary][0].SubstractValue(2.5)
+	acc.BalanceMap[utils.MetaMonetary] = acc.BalanceMap[utils.MetaMonetary][:1]
+	acc.BalanceMap[utils.MetaVoice] = append(acc.BalanceMap[utils.MetaVoice],
+		&Balance{Uuid: "uuid4", ID: "BAL_4", Value: 30})
+	now := time.Now()
+	exp := []*BalanceLedgerEntry{
+		{Tenant: "cgrates.org", Account: "ledger", BalanceType: utils.MetaMonetary, BalanceID: "BAL_1", BalanceUUID: "uuid1",
+			OldValue: 10, NewValue: 7.5, Delta: -2.5, Cause: utils.MetaDebit, CauseID: "CGRID_1", Time: now},
+		{Tenant: "cgrates.org", Account: "ledger", BalanceType: utils.MetaMonetary, BalanceID: "BAL_2", BalanceUUID: "uuid2",
+			OldValue: 5, NewValue: 0, Delta: -5, Cause: utils.MetaDebit, CauseID: "CGRID_1", Time: now},
+		{Tenant: "cgrates.org", Account: "ledger", BalanceType: utils.MetaVoice, BalanceID: "BAL_4", BalanceUUID: "uuid4",
+			OldValue: 0, NewValue: 30, Delta: 30, Cause: utils.MetaDebit, CauseID: "CGRID_1", Time: now},
+	}
+	if rcv := ldgSnap.entries(utils.MetaDebit, "CGRID_1", now); !reflect.DeepEqual(exp, rcv) {
+		t.Errorf("Expected: %s, received: %s", utils.ToJSON(exp), utils.ToJSON(rcv))
+	}
+	// the next changes are computed from the current values
+	if rcv := ldgSnap.entries(utils.MetaDebit, "CGRID_1", now); len(rcv) != 0 {
+		t.Errorf("Expected no entries, received: %s", utils.ToJSON(rcv))
+	}
+	// no snapshot when the ledger is disabled
+	config.CgrConfig().RalsCfg().BalanceLedger = false
+	if ldgSnap = newBalanceLedgerSnapshot(acc); ldgSnap != nil {
+		t.Errorf("Expected nil snapshot, received: %+v", ldgSnap)
+	}
+	ldgSnap.add(acc)
+	ldgSnap.store(utils.MetaDebit, "CGRID_1")
+}
+
+func TestInternalDBBalanceLedger(t *testing.T) {
+	stordb := NewInternalDB(nil, nil, false, config.CgrConfig().StorDbCfg().Items)
+	t1 := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	entries := []*BalanceLedgerEntry{
+		{Tenant: "cgrates.org", Account: "1001", BalanceType: utils.MetaMonetary, BalanceID: "BAL_1",
+			OldValue: 10, NewValue: 9, Delta: -1, Cause: utils.MetaDebit, CauseID: "CGRID_1", Time: t1.Add(time.Minute)},
+		{Tenant: "cgrates.org", Account: "1001", BalanceType: utils.MetaMonetary, BalanceID: "BAL_1",
+			OldValue: 9, NewValue: 19, Delta: 10, Cause: utils.MetaAPI, CauseID: utils.APIerSv1AddBalance, Time: t1.Add(time.Minute)},
+		{Tenant: "cgrates.org", Account: "1002", BalanceType: utils.MetaMonetary, BalanceID: "BAL_2",
+			OldValue: 0, NewValue: 5, Delta: 5, Cause: utils.MetaActions, CauseID: "ACT_TOPUP", Time: t1},
+	}
+	if err := stordb.SetBalanceLedgerEntries(entries); err != nil {
+		t.Fatal(err)
+	}
+	if rcv, err := stordb.GetBalanceLedgerEntries(&BalanceLedgerFilter{}); err != nil {
+		t.Fatal(err)
+	} else if exp := []*BalanceLedgerEntry{entries[2], entries[0], entries[1]}; !reflect.DeepEqual(exp, rcv) {
+		t.Errorf("Expected: %s, received: %s", utils.ToJSON(exp), utils.ToJSON(rcv))
+	}
+	if rcv, err := stordb.GetBalanceLedgerEntries(&BalanceLedgerFilter{
+		Tenants:  []string{"cgrates.org"},
+		Accounts: []string{"1001"},
+		Paginator: utils.Paginator{
+			Limit:  utils.IntPointer(1),
+			Offset: utils.IntPointer(1),
+		},
+	}); err != nil {
+		t.Fatal(err)
+	} else if exp := []*BalanceLedgerEntry{entries[1]}; !reflect.DeepEqual(exp, rcv) {
+		t.Errorf("Expected: %s, received: %s", utils.ToJSON(exp), utils.ToJSON(rcv))
+	}
+	if rcv, err := stordb.GetBalanceLedgerEntries(&BalanceLedgerFilter{
+		Causes:    []string{utils.MetaDebit, utils.MetaActions},
+		TimeStart: utils.TimePointer(t1.Add(time.Second)),
+	}); err != nil {
+		t.Fatal(err)
+	} else if exp := []*BalanceLedgerEntry{entries[0]}; !reflect.DeepEqual(exp, rcv) {
+		t.Errorf("Expected: %s, received: %s", utils.ToJSON(exp), utils.ToJSON(rcv))
+	}
+	if _, err := stordb.GetBalanceLedgerEntries(&BalanceLedgerFilter{
+		TimeEnd: utils.TimePointer(t1),
+	}); err != utils.ErrNotFound {
+		t.Errorf("Expected error: %v, received: %v", utils.ErrNotFound, err)
+	}
+}
+
+func TestBalanceLedgerActionTiming(t *testing.T) {
+	stordb := enableBalanceLedger(t)
+	if err := dm.SetAccount(&Account{
+		ID: "cgrates.org:ledger_at",
+		BalanceMap: map[string]Balances{
+			utils.MetaMonetary: {{Uuid: "uuid1", ID: "BAL_1", Value: 10}},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	at := &ActionTiming{
+		accountIDs: utils.StringMap{"cgrates.org:ledger_at": true},
+		actions: Actions{{
+			Id:         "ACT_TOPUP",
+			ActionType: utils.MetaTopUp,
+			Balance: &BalanceFilter{
+				ID:    utils.StringPointer("BAL_1"),
+				Type:  utils.StringPointer(utils.MetaMonetary),
+				Value: &utils.ValueFormula{Static: 5},
+			},
+		}},
+	}
+	if err := at.Execute(nil); err != nil {
+		t.Fatal(err)
+	}
+	at.SetAPIMethod(utils.APIerSv1AddBalance)
+	if err := at.Execute(nil); err != nil {
+		t.Fatal(err)
+	}
+	rcv, err := stordb.GetBalanceLedgerEntries(&BalanceLedgerFilter{Accounts: []string{"ledger_at"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rcv) != 2 {
+		t.Fatalf("Expected 2 entries, received: %s", utils.ToJSON(rcv))
+	}
+	if rcv[0].Cause != utils.MetaActions || rcv[0].CauseID != "ACT_TOPUP" ||
+		rcv[0].OldValue != 10 || rcv[0].NewValue != 15 || rcv[0].Delta != 5 {
+		t.Errorf("Unexpected entry: %s", utils.ToJSON(rcv[0]))
+	}
+	if rcv[1].Cause != utils.MetaAPI || rcv[1].CauseID != utils.APIerSv1AddBalance ||
+		rcv[1].OldValue != 15 || rcv[1].NewValue != 20 {
+		t.Errorf("Unexpected entry: %s", utils.ToJSON(rcv[1]))
+	}
+}
+
+func TestBalanceLedgerDebit(t *testing.T) {
+	stordb := enableBalanceLedger(t)
+	acc := &Account{
+		ID: "cgrates.org:ledger_debit",
+		BalanceMap: map[string]Balances{
+			utils.MetaMonetary: {{Uuid: "uuid1", ID: "BAL_1", Value: 10}},
+		},
+	}
+	cd := &CallDescriptor{
+		CgrID:       "CGRID_LEDGER",
+		Category:    "0",
+		Tenant:      "vdf",
+		Subject:     "rif",
+		Destination: "0256",
+		ToR:         utils.MetaVoice,
+		TimeStart:   time.Date(2013, 10, 4, 15, 46, 0, 0, time.UTC),
+		TimeEnd:     time.Date(2013, 10, 4, 15, 46, 10, 0, time.UTC),
+	}
+	if _, err := acc.debitCreditBalance(cd, false, false, true, nil); err != nil {
+		t.Fatal(err)
+	}
+	rcv, err := stordb.GetBalanceLedgerEntries(&BalanceLedgerFilter{CauseIDs: []string{"CGRID_LEDGER"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the debit goes negative on the default balance, created during the debit
+	var delta float64
+	for _, le := range rcv {
+		if le.Cause != utils.MetaDebit || le.Account != "ledger_debit" {
+			t.Errorf("Unexpected entry: %s", utils.ToJSON(le))
+		}
+		delta += le.Delta
+	}
+	if exp := acc.BalanceMap[utils.MetaMonetary].GetTotalValue() - 10; len(rcv) != 2 || delta != exp {
+		t.Errorf("Expected delta: %v, received entries: %s", exp, utils.ToJSON(rcv))
+	}
+}
@@ -0,0 +1,368 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/guardian"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// ArgsTransferBalance are the arguments used to move value between the balances of two accounts
+type ArgsTransferBalance struct {
+	Tenant        string
+	FromAccount   string
+	FromBalanceID string // transfer out of a single balance, otherwise out of the balances of the type ordered by weight
+	ToAccount     string
+	ToBalanceID   string // the *default balance for *monetary if not specified, created if missing
+	BalanceType   string // *monetary if not specified
+	Amount        float64
+	APIOpts       map[string]any
+}
+
+// BalanceTransfer is the value moved by a transfer
+type BalanceTransfer struct {
+	FromAccount string
+	ToAccount   string
+	BalanceType string
+	ToBalanceID string
+	Amount      float64                // the value added on the destination balance
+	Fee         float64                // taken out of the source balances together with the amount, not credited to any account
+	Parts       []*BalanceTransferPart // the value taken out of each source balance, in order
+}
+
+// BalanceTransferPart is the value taken out of one source balance
+type BalanceTransferPart struct {
+	BalanceUUID string
+	BalanceID   string
+	Amount      float64
+}
+
+// transferBalanceParams are the ExtraParameters of the *transfer_balance action
+type transferBalanceParams struct {
+	ToAccount   string // with or without the tenant, the tenant of the account executing the action if missing
+	ToBalanceID string
+}
+
+// transferAccountID returns the ID of the destination account, adding the tenant if missing
+func (tp *transferBalanceParams) transferAccountID(tnt string) string {
+	tntID := utils.NewTenantID(tp.ToAccount)
+	return utils.ConcatenatedKey(utils.FirstNonEmpty(tntID.Tenant, tnt), tntID.ID)
+}
+
+// transferLockIDs returns the guardian lock IDs of the accounts, sorted so the
+// concurrent transfers always lock the accounts in the same order
+func transferLockIDs(accIDs ...string) (lkIDs []string) {
+	lkIDs = make([]string, 0, len(accIDs))
+	for _, accID := range accIDs {
+		if lkID := utils.AccountPrefix + accID; !slices.Contains(lkIDs, lkID) {
+			lkIDs = append(lkIDs, lkID)
+		}
+	}
+	slices.Sort(lkIDs)
+	return
+}
+
+// transferPeers returns the destination accounts of the *transfer_balance actions executed on the account
+func (apl Actions) transferPeers(accID string) (accIDs []string) {
+	tnt := utils.NewTenantID(accID).Tenant
+	for _, a := range apl {
+		if a.ActionType != utils.MetaTransferBalance {
+			continue
+		}
+		var params transferBalanceParams
+		if err := json.Unmarshal([]byte(a.ExtraParameters), &params); err != nil {
+			continue // the action will fail when executed
+		}
+		accIDs = append(accIDs, params.transferAccountID(tnt))
+	}
+	return
+}
+
+// transferFee returns the fee configured for the balance type, flat or percentage out of the amount
+func transferFee(blcType string, amount float64) (fee float64, err error) {
+	feeCfg, has := config.CgrConfig().RalsCfg().TransferFee[blcType]
+	if !has {
+		return
+	}
+	if fee, err = strconv.ParseFloat(strings.TrimSuffix(feeCfg, utils.PercentSep), 64); err != nil {
+		return
+	}
+	if strings.HasSuffix(feeCfg, utils.PercentSep) {
+		fee = amount * fee / 100
+	}
+	return utils.Round(fee, globalRoundingDecimals, utils.MetaRoundingMiddle), nil
+}
+
+// transferValue moves the amount between the balances of the accounts, taking the fee
+// out of the source balances as well. The source and destination can be the same account
+func transferValue(src, dst *Account, args *ArgsTransferBalance) (tr *BalanceTransfer, err error) {
+	if src.Disabled || dst.Disabled {
+		return nil, utils.ErrAccountDisabled
+	}
+	if args.Amount <= 0 {
+		return nil, fmt.Errorf("invalid amount to transfer: %v", args.Amount)
+	}
+	blcType := utils.FirstNonEmpty(args.BalanceType, utils.MetaMonetary)
+	if maxAmount := config.CgrConfig().RalsCfg().MaxTransfer[blcType]; maxAmount > 0 && args.Amount > maxAmount {
+		return nil, fmt.Errorf("amount to transfer: %v over the limit: %v", args.Amount, maxAmount)
+	}
+	tr = &BalanceTransfer{
+		FromAccount: src.ID,
+		ToAccount:   dst.ID,
+		BalanceType: blcType,
+		Amount:      args.Amount,
+	}
+	if tr.Fee, err = transferFee(blcType, args.Amount); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	var dstBlc *Balance
+	switch {
+	case args.ToBalanceID != utils.EmptyString:
+		for _, blc := range dst.BalanceMap[blcType] {
+			if blc.ID == args.ToBalanceID && !blc.IsExpiredAt(now) {
+				dstBlc = blc
+				break
+			}
+		}
+	case blcType == utils.MetaMonetary:
+		dstBlc = dst.GetDefaultMoneyBalance()
+	default:
+		return nil, utils.NewErrMandatoryIeMissing(utils.ToBalanceID)
+	}
+	newBlc := dstBlc == nil
+	if newBlc {
+		dstBlc = &Balance{
+			Uuid: utils.GenUUID(),
+			ID:   args.ToBalanceID,
+		}
+		if args.FromBalanceID != utils.EmptyString { // keep the currency of the source
+			for _, blc := range src.BalanceMap[blcType] {
+				if blc.ID == args.FromBalanceID {
+					dstBlc.Currency = blc.Currency
+					break
+				}
+			}
+		}
+	}
+	if dstBlc.Disabled {
+		return nil, fmt.Errorf("destination balance <%s> is disabled", dstBlc.ID)
+	}
+	// only the balances in the currency of the destination can be used, out of their held amounts
+	src.setHeldOnBalances()
+	srcBlcs := make(Balances, 0, len(src.BalanceMap[blcType]))
+	for _, blc := range src.BalanceMap[blcType] {
+		if blc.Disabled || !blc.IsActiveAt(now) || blc.availableValue() <= 0 ||
+			blc.Uuid == dstBlc.Uuid || blc.Currency != dstBlc.Currency ||
+			(args.FromBalanceID != utils.EmptyString && blc.ID != args.FromBalanceID) {
+			continue
+		}
+		srcBlcs = append(srcBlcs, blc)
+	}
+	srcBlcs.Sort()
+	left := utils.Round(args.Amount+tr.Fee, globalRoundingDecimals, utils.MetaRoundingMiddle)
+	for _, blc := range srcBlcs {
+		if left <= 0 {
+			break
+		}
+		partAmount := min(left, blc.availableValue())
+		tr.Parts = append(tr.Parts, &BalanceTransferPart{
+			BalanceUUID: blc.Uuid,
+			BalanceID:   blc.ID,
+			Amount:      partAmount,
+		})
+		left = utils.Round(left-partAmount, globalRoundingDecimals, utils.MetaRoundingMiddle)
+	}
+	if left > 0 {
+		return nil, utils.ErrInsufficientCredit
+	}
+	for _, part := range tr.Parts {
+		blc := src.BalanceMap[blcType].GetBalance(part.BalanceUUID)
+		blc.SubstractValue(part.Amount)
+		src.UnitCounters.addUnits(part.Amount, blcType, nil, blc)
+	}
+	if newBlc {
+		if dst.BalanceMap == nil {
+			dst.BalanceMap = make(map[string]Balances)
+		}
+		dst.BalanceMap[blcType] = append(dst.BalanceMap[blcType], dstBlc)
+	}
+	dstBlc.AddValue(args.Amount)
+	tr.ToBalanceID = dstBlc.ID
+	return
+}
+
+// revert puts back the value taken out of the source balances, together with their unit counters
+func (tr *BalanceTransfer) revert(src *Account) {
+	for _, part := range tr.Parts {
+		if blc := src.BalanceMap[tr.BalanceType].GetBalance(part.BalanceUUID); blc != nil {
+			blc.AddValue(part.Amount)
+			src.UnitCounters.addUnits(-part.Amount, tr.BalanceType, nil, blc)
+		}
+	}
+}
+
+// TransferBalance moves value between the balances of two accounts of the same tenant,
+// with both accounts locked so the transfer is applied on both of them or on none
+func TransferBalance(args *ArgsTransferBalance, fltrS *FilterS) (tr *BalanceTransfer, err error) {
+	return transferBalance(args, fltrS, utils.MetaAPI, utils.APIerSv1TransferBalance)
+}
+
+// transferBalance locks the accounts in order and applies the transfer on both of them or on none,
+// executing their ActionTriggers only once the transfer was stored
+func transferBalance(args *ArgsTransferBalance, fltrS *FilterS, cause, causeID string) (tr *BalanceTransfer, err error) {
+	srcID := utils.ConcatenatedKey(args.Tenant, args.FromAccount)
+	dstID := utils.ConcatenatedKey(args.Tenant, args.ToAccount)
+	lkIDs := transferLockIDs(srcID, dstID)
+	err = guardian.Guardian.Guard(func() (err error) {
+		var src, dst *Account
+		if src, err = dm.GetAccount(srcID); err != nil {
+			return
+		}
+		accs := []*Account{src}
+		dst = src
+		if dstID != srcID {
+			if dst, err = dm.GetAccount(dstID); err != nil {
+				return
+			}
+			accs = append(accs, dst)
+		}
+		ldgSnap := newBalanceLedgerSnapshot(accs...)
+		src.releaseExpiredHolds(time.Now())
+		if tr, err = transferValue(src, dst, args); err != nil {
+			return
+		}
+		if err = dm.SetAccount(src); err != nil {
+			return
+		}
+		if dst != src {
+			if err = dm.SetAccount(dst); err != nil {
+				tr.revert(src)
+				if errRev := dm.SetAccount(src); errRev != nil {
+					utils.Logger.Warning(fmt.Sprintf("<%s> error: <%s> reverting the transfer: %s on account: <%s>",
+						utils.RALService, errRev.Error(), utils.ToJSON(tr), srcID))
+				}
+				return
+			}
+		}
+		ldgSnap.store(cause, causeID)
+		// the triggers can transfer between the accounts locked together, without locking them again,
+		// so the destination is read again after the triggers of the source were stored
+		locked := utils.NewStringSet(lkIDs)
+		for i, acc := range accs {
+			if i != 0 {
+				if acc, err = dm.GetAccount(acc.ID); err != nil {
+					utils.Logger.Warning(fmt.Sprintf("<%s> error: <%s> executing the triggers after the transfer: %s on account: <%s>",
+						utils.RALService, err.Error(), utils.ToJSON(tr), dstID))
+					return nil
+				}
+			}
+			acc.lockedAccounts = locked
+			trgSnap := newBalanceLedgerSnapshot(acc)
+			acc.ExecuteActionTriggers(nil, fltrS)
+			if errTrg := dm.SetAccount(acc); errTrg != nil {
+				utils.Logger.Warning(fmt.Sprintf("<%s> error: <%s> storing the triggers executed after the transfer: %s on account: <%s>",
+					utils.RALService, errTrg.Error(), utils.ToJSON(tr), acc.ID))
+			}
+			trgSnap.store(cause, causeID)
+		}
+		return
+	}, config.CgrConfig().GeneralCfg().LockingTimeout, lkIDs...)
+	return
+}
+
+// transferBalanceAction moves the value of the action balance out of the account into the
+// account and balance from the ExtraParameters, ie: {"ToAccount":"1002","ToBalanceID":"GIFT"}
+func transferBalanceAction(acc *Account, a *Action, acs Actions, fltrS *FilterS, extraData any) (err error) {
+	if acc == nil {
+		return fmt.Errorf("nil account for %s action", utils.ToJSON(a))
+	}
+	var params transferBalanceParams
+	if err = json.Unmarshal([]byte(a.ExtraParameters), &params); err != nil {
+		return
+	}
+	if params.ToAccount == utils.EmptyString {
+		return utils.NewErrMandatoryIeMissing(utils.ToAccount)
+	}
+	tntID := utils.NewTenantID(acc.ID)
+	dstID := params.transferAccountID(tntID.Tenant)
+	args := &ArgsTransferBalance{
+		Tenant:        tntID.Tenant,
+		FromAccount:   tntID.ID,
+		FromBalanceID: a.Balance.GetID(),
+		ToAccount:     utils.NewTenantID(dstID).ID,
+		ToBalanceID:   params.ToBalanceID,
+		BalanceType:   a.Balance.GetType(),
+		Amount:        a.Balance.GetValue(),
+	}
+	if dstID == acc.ID {
+		if _, err = transferValue(acc, acc, args); err != nil {
+			return
+		}
+		acc.ExecuteActionTriggers(nil, fltrS)
+		return
+	}
+	causeID := utils.FirstNonEmpty(a.Id, a.ActionType)
+	// the account is locked by the caller (ie. on debit or by the action plan together
+	// with the destination) so only the destination is locked here, if not already
+	var lkIDs []string
+	for _, lkID := range transferLockIDs(acc.ID, dstID) {
+		if lkID != utils.AccountPrefix+acc.ID && !acc.lockedAccounts.Has(lkID) {
+			lkIDs = append(lkIDs, lkID)
+		}
+	}
+	locked := utils.NewStringSet(append(lkIDs, utils.AccountPrefix+acc.ID))
+	locked.AddSlice(acc.lockedAccounts.AsSlice())
+	return guardian.Guardian.Guard(func() (err error) {
+		// the triggers executed below can transfer between the two accounts without locking them again
+		accLocked := acc.lockedAccounts
+		acc.lockedAccounts = locked
+		defer func() { acc.lockedAccounts = accLocked }()
+		var dst *Account
+		if dst, err = dm.GetAccount(dstID); err != nil {
+			return
+		}
+		dst.lockedAccounts = locked
+		ldgSnap := newBalanceLedgerSnapshot(dst)
+		var tr *BalanceTransfer
+		if tr, err = transferValue(acc, dst, args); err != nil {
+			return
+		}
+		if err = dm.SetAccount(dst); err != nil {
+			tr.revert(acc)
+			return
+		}
+		dst.ExecuteActionTriggers(nil, fltrS)
+		if errTrg := dm.SetAccount(dst); errTrg != nil {
+			utils.Logger.Warning(fmt.Sprintf("<%s> error: <%s> storing the triggers executed after the transfer: %s on account: <%s>",
+				utils.RALService, errTrg.Error(), utils.ToJSON(tr), dstID))
+		}
+		ldgSnap.store(utils.MetaActions, causeID)
+		acc.ExecuteActionTriggers(nil, fltrS) // the account is saved by the caller
+		return
+	}, config.CgrConfig().GeneralCfg().LockingTimeout, lkIDs...)
+}
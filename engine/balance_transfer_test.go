@@ -0,0 +1,386 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/guardian"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func newTransferAccounts() (src, dst *Account) {
+	src = &Account{
+		ID: "cgrates.org:tr_src",
+		BalanceMap: map[string]Balances{
+			utils.MetaMonetary: {
+				{Uuid: "uuid1", ID: "BAL_1", Value: 5, Weight: 20},
+				{Uuid: "uuid2", ID: "BAL_2", Value: 10, Weight: 10},
+				{Uuid: "uuid3", ID: "BAL_EUR", Value: 50, Weight: 30, Currency: "EUR"},
+			},
+		},
+	}
+	dst = &Account{
+		ID: "cgrates.org:tr_dst",
+		BalanceMap: map[string]Balances{
+			utils.MetaMonetary: {
+				{Uuid: "uuid4", ID: "GIFT", Value: 1},
+			},
+		},
+	}
+	return
+}
+
+func TestTransferValue(t *testing.T) {
+	src, dst := newTransferAccounts()
+	tr, err := transferValue(src, dst, &ArgsTransferBalance{
+		ToBalanceID: "GIFT",
+		Amount:      7,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := &BalanceTransfer{
+		FromAccount: "cgrates.org:tr_src",
+		ToAccount:   "cgrates.org:tr_dst",
+		BalanceType: utils.MetaMonetary,
+		ToBalanceID: "GIFT",
+		Amount:      7,
+		Parts: []*BalanceTransferPart{
+			{BalanceUUID: "uuid1", BalanceID: "BAL_1", Amount: 5},
+			{BalanceUUID: "uuid2", BalanceID: "BAL_2", Amount: 2},
+		},
+	}
+	if !reflect.DeepEqual(exp, tr) {
+		t.Errorf("Expected: %s, received: %s", utils.ToJSON(exp), utils.ToJSON(tr))
+	}
+	if v1, v2, v3 := src.BalanceMap[utils.MetaMonetary][0].Value,
+		src.BalanceMap[utils.MetaMonetary][1].Value,
+		src.BalanceMap[utils.MetaMonetary][2].Value; v1 != 0 || v2 != 8 || v3 != 50 {
+		t.Errorf("Unexpected source values: %v, %v, %v", v1, v2, v3)
+	}
+	if v := dst.BalanceMap[utils.MetaMonetary][0].Value; v != 8 {
+		t.Errorf("Expected destination value: 8, received: %v", v)
+	}
+	// the EUR balance is not used for the destination without currency
+	if _, err = transferValue(src, dst, &ArgsTransferBalance{ToBalanceID: "GIFT", Amount: 9}); err != utils.ErrInsufficientCredit {
+		t.Errorf("Expected error: %v, received: %v", utils.ErrInsufficientCredit, err)
+	}
+	// a new destination balance keeps the currency of the source balance
+	if tr, err = transferValue(src, dst, &ArgsTransferBalance{
+		FromBalanceID: "BAL_EUR",
+		ToBalanceID:   "GIFT_EUR",
+		Amount:        20,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if blc := dst.BalanceMap[utils.MetaMonetary][1]; blc.ID != "GIFT_EUR" || blc.Currency != "EUR" || blc.Value != 20 {
+		t.Errorf("Unexpected destination balance: %s", utils.ToJSON(blc))
+	}
+	if _, err = transferValue(src, dst, &ArgsTransferBalance{BalanceType: utils.MetaVoice, Amount: 1}); err == nil ||
+		err.Error() != utils.NewErrMandatoryIeMissing(utils.ToBalanceID).Error() {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if _, err = transferValue(src, dst, &ArgsTransferBalance{Amount: -1}); err == nil {
+		t.Error("Expected error for the negative amount")
+	}
+	dst.Disabled = true
+	if _, err = transferValue(src, dst, &ArgsTransferBalance{Amount: 1}); err != utils.ErrAccountDisabled {
+		t.Errorf("Expected error: %v, received: %v", utils.ErrAccountDisabled, err)
+	}
+}
+
+func TestTransferValueRevert(t *testing.T) {
+	src, dst := newTransferAccounts()
+	src.UnitCounters = UnitCounters{
+		utils.MetaMonetary: {{
+			CounterType: utils.MetaBalance,
+			Counters: CounterFilters{{
+				Filter: &BalanceFilter{Type: utils.StringPointer(utils.MetaMonetary)},
+			}},
+		}},
+	}
+	tr, err := transferValue(src, dst, &ArgsTransferBalance{ToBalanceID: "GIFT", Amount: 7})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := src.UnitCounters[utils.MetaMonetary][0].Counters[0].Value; v != 7 {
+		t.Errorf("Expected counter value: 7, received: %v", v)
+	}
+	tr.revert(src)
+	if v1, v2 := src.BalanceMap[utils.MetaMonetary][0].Value, src.BalanceMap[utils.MetaMonetary][1].Value; v1 != 5 || v2 != 10 {
+		t.Errorf("Unexpected source values: %v, %v", v1, v2)
+	}
+	if v := src.UnitCounters[utils.MetaMonetary][0].Counters[0].Value; v != 0 {
+		t.Errorf("Expected counter value: 0, received: %v", v)
+	}
+}
+
+func TestTransferValueFeeAndLimit(t *testing.T) {
+	ralsCfg := config.CgrConfig().RalsCfg()
+	defer func() {
+		ralsCfg.MaxTransfer = make(map[string]float64)
+		ralsCfg.TransferFee = make(map[string]string)
+	}()
+	ralsCfg.MaxTransfer = map[string]float64{utils.MetaMonetary: 5}
+	ralsCfg.TransferFee = map[string]string{utils.MetaMonetary: "10%"}
+	src, dst := newTransferAccounts()
+	if _, err := transferValue(src, dst, &ArgsTransferBalance{Amount: 6}); err == nil {
+		t.Error("Expected error for the amount over the limit")
+	}
+	tr, err := transferValue(src, dst, &ArgsTransferBalance{Amount: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tr.Fee != 0.5 || tr.ToBalanceID != utils.MetaDefault || len(tr.Parts) != 2 || tr.Parts[1].Amount != 0.5 {
+		t.Errorf("Unexpected transfer: %s", utils.ToJSON(tr))
+	}
+	if dflt := dst.GetDefaultMoneyBalance(); dflt.Value != 5 {
+		t.Errorf("Expected the default balance value: 5, received: %v", dflt.Value)
+	}
+	ralsCfg.TransferFee = map[string]string{utils.MetaMonetary: "1"}
+	if tr, err = transferValue(src, dst, &ArgsTransferBalance{Amount: 2}); err != nil {
+		t.Fatal(err)
+	} else if tr.Fee != 1 {
+		t.Errorf("Expected fee: 1, received: %v", tr.Fee)
+	}
+	if v := src.BalanceMap[utils.MetaMonetary][1].Value; v != 6.5 {
+		t.Errorf("Expected source value: 6.5, received: %v", v)
+	}
+}
+
+func TestTransferLockIDs(t *testing.T) {
+	exp := []string{utils.AccountPrefix + "cgrates.org:1001", utils.AccountPrefix + "cgrates.org:1002"}
+	if rcv := transferLockIDs("cgrates.org:1002", "cgrates.org:1001", "cgrates.org:1002"); !reflect.DeepEqual(exp, rcv) {
+		t.Errorf("Expected: %v, received: %v", exp, rcv)
+	}
+	acs := Actions{
+		{ActionType: utils.MetaTopUp},
+		{ActionType: utils.MetaTransferBalance, ExtraParameters: `{"ToAccount":"1002"}`},
+		{ActionType: utils.MetaTransferBalance, ExtraParameters: `{"ToAccount":"itsyscom.com:1003"}`},
+	}
+	expPeers := []string{"cgrates.org:1002", "itsyscom.com:1003"}
+	if rcv := acs.transferPeers("cgrates.org:1001"); !reflect.DeepEqual(expPeers, rcv) {
+		t.Errorf("Expected: %v, received: %v", expPeers, rcv)
+	}
+}
+
+func TestTransferBalance(t *testing.T) {
+	src, dst := newTransferAccounts()
+	if err := dm.SetAccount(src); err != nil {
+		t.Fatal(err)
+	}
+	if err := dm.SetAccount(dst); err != nil {
+		t.Fatal(err)
+	}
+	args := &ArgsTransferBalance{
+		Tenant:      "cgrates.org",
+		FromAccount: "tr_src",
+		ToAccount:   "tr_dst",
+		ToBalanceID: "GIFT",
+		Amount:      12,
+	}
+	if _, err := TransferBalance(args, nil); err != nil {
+		t.Fatal(err)
+	}
+	args.Amount = 4
+	if _, err := TransferBalance(args, nil); err != utils.ErrInsufficientCredit {
+		t.Errorf("Expected error: %v, received: %v", utils.ErrInsufficientCredit, err)
+	}
+	if acc, err := dm.GetAccount("cgrates.org:tr_src"); err != nil {
+		t.Fatal(err)
+	} else if credit := acc.BalanceMap[utils.MetaMonetary].GetTotalValue(); credit != 53 {
+		t.Errorf("Expected credit: 53, received: %v", credit)
+	}
+	if acc, err := dm.GetAccount("cgrates.org:tr_dst"); err != nil {
+		t.Fatal(err)
+	} else if credit := acc.BalanceMap[utils.MetaMonetary].GetTotalValue(); credit != 13 {
+		t.Errorf("Expected credit: 13, received: %v", credit)
+	}
+	args.ToAccount = "tr_missing"
+	if _, err := TransferBalance(args, nil); err != utils.ErrNotFound {
+		t.Errorf("Expected error: %v, received: %v", utils.ErrNotFound, err)
+	}
+}
+
+func TestTransferBalanceTriggers(t *testing.T) {
+	src, dst := newTransferAccounts()
+	src.ID = "cgrates.org:tr_trg_src"
+	dst.ID = "cgrates.org:tr_trg_dst"
+	// the trigger of the destination transfers back into the source, both locked by the transfer
+	dst.ActionTriggers = ActionTriggers{{
+		ID:             "TR_BACK",
+		UniqueID:       "TR_BACK",
+		ThresholdType:  utils.TriggerMaxBalance,
+		ThresholdValue: 5,
+		Balance:        &BalanceFilter{Type: utils.StringPointer(utils.MetaMonetary)},
+		ActionsID:      "TR_BACK",
+	}}
+	if err := dm.SetActions("TR_BACK", Actions{{
+		Id:              "TR_BACK",
+		ActionType:      utils.MetaTransferBalance,
+		ExtraParameters: `{"ToAccount":"tr_trg_src","ToBalanceID":"BAL_1"}`,
+		Balance: &BalanceFilter{
+			Type:  utils.StringPointer(utils.MetaMonetary),
+			ID:    utils.StringPointer("GIFT"),
+			Value: &utils.ValueFormula{Static: 2},
+		},
+	}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := dm.SetAccount(src); err != nil {
+		t.Fatal(err)
+	}
+	if err := dm.SetAccount(dst); err != nil {
+		t.Fatal(err)
+	}
+	errChan := make(chan error, 1)
+	go func() {
+		_, err := TransferBalance(&ArgsTransferBalance{
+			Tenant:      "cgrates.org",
+			FromAccount: "tr_trg_src",
+			ToAccount:   "tr_trg_dst",
+			ToBalanceID: "GIFT",
+			Amount:      6,
+		}, nil)
+		errChan <- err
+	}()
+	select {
+	case err := <-errChan:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("the transfer fired by the trigger is blocked by the locks")
+	}
+	if acc, err := dm.GetAccount(src.ID); err != nil {
+		t.Fatal(err)
+	} else if credit := acc.BalanceMap[utils.MetaMonetary].GetTotalValue(); credit != 61 {
+		t.Errorf("Expected credit: 61, received: %v", credit)
+	}
+	if acc, err := dm.GetAccount(dst.ID); err != nil {
+		t.Fatal(err)
+	} else if credit := acc.BalanceMap[utils.MetaMonetary].GetTotalValue(); credit != 5 {
+		t.Errorf("Expected credit: 5, received: %v", credit)
+	} else if !acc.ActionTriggers[0].Executed {
+		t.Error("Expected the trigger to be executed")
+	}
+}
+
+func TestTransferBalanceAction(t *testing.T) {
+	src, dst := newTransferAccounts()
+	src.ID = "cgrates.org:tr_act_src"
+	dst.ID = "cgrates.org:tr_act_dst"
+	if err := dm.SetAccount(dst); err != nil {
+		t.Fatal(err)
+	}
+	src.lockedAccounts = utils.NewStringSet(transferLockIDs(src.ID, dst.ID))
+	a := &Action{
+		Id:              "TRANSFER",
+		ActionType:      utils.MetaTransferBalance,
+		ExtraParameters: `{"ToAccount":"tr_act_dst","ToBalanceID":"GIFT"}`,
+		Balance: &BalanceFilter{
+			Type:  utils.StringPointer(utils.MetaMonetary),
+			ID:    utils.StringPointer("BAL_2"),
+			Value: &utils.ValueFormula{Static: 3},
+		},
+	}
+	if err := transferBalanceAction(src, a, nil, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if v := src.BalanceMap[utils.MetaMonetary][1].Value; v != 7 {
+		t.Errorf("Expected source value: 7, received: %v", v)
+	}
+	if acc, err := dm.GetAccount("cgrates.org:tr_act_dst"); err != nil {
+		t.Fatal(err)
+	} else if v := acc.BalanceMap[utils.MetaMonetary][0].Value; v != 4 {
+		t.Errorf("Expected destination value: 4, received: %v", v)
+	}
+	// transfer between the balances of the same account
+	a.ExtraParameters = `{"ToAccount":"tr_act_src","ToBalanceID":"BAL_1"}`
+	if err := transferBalanceAction(src, a, nil, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if v1, v2 := src.BalanceMap[utils.MetaMonetary][0].Value, src.BalanceMap[utils.MetaMonetary][1].Value; v1 != 8 || v2 != 4 {
+		t.Errorf("Unexpected balance values: %v, %v", v1, v2)
+	}
+	a.ExtraParameters = `{"ToBalanceID":"BAL_1"}`
+	if err := transferBalanceAction(src, a, nil, nil, nil); err == nil ||
+		err.Error() != utils.NewErrMandatoryIeMissing(utils.ToAccount).Error() {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestTransferBalanceActionLocking(t *testing.T) {
+	src, dst := newTransferAccounts()
+	src.ID = "cgrates.org:tr_lck_src"
+	dst.ID = "cgrates.org:tr_lck_dst"
+	if err := dm.SetAccount(src); err != nil {
+		t.Fatal(err)
+	}
+	if err := dm.SetAccount(dst); err != nil {
+		t.Fatal(err)
+	}
+	a := &Action{
+		Id:              "TRANSFER",
+		ActionType:      utils.MetaTransferBalance,
+		ExtraParameters: `{"ToAccount":"tr_lck_dst","ToBalanceID":"GIFT"}`,
+		Balance: &BalanceFilter{
+			Type:  utils.StringPointer(utils.MetaMonetary),
+			ID:    utils.StringPointer("BAL_2"),
+			Value: &utils.ValueFormula{Static: 3},
+		},
+	}
+	// fired by a trigger with only the account locked (ie. on debit), the destination is locked by the action
+	transfer := func() error {
+		return guardian.Guardian.Guard(func() error {
+			return transferBalanceAction(src, a, nil, nil, nil)
+		}, 0, utils.AccountPrefix+src.ID)
+	}
+	if err := transfer(); err != nil {
+		t.Fatal(err)
+	}
+	if v := src.BalanceMap[utils.MetaMonetary][1].Value; v != 7 {
+		t.Errorf("Expected source value: 7, received: %v", v)
+	}
+	if acc, err := dm.GetAccount(dst.ID); err != nil {
+		t.Fatal(err)
+	} else if v := acc.BalanceMap[utils.MetaMonetary][0].Value; v != 4 {
+		t.Errorf("Expected destination value: 4, received: %v", v)
+	}
+	if src.lockedAccounts != nil {
+		t.Errorf("Expected the locked accounts to be restored, received: %v", src.lockedAccounts)
+	}
+	// the errors are returned to the caller of the action
+	a.Balance.Value = &utils.ValueFormula{Static: 30}
+	if err := transfer(); err != utils.ErrInsufficientCredit {
+		t.Errorf("Expected error <%v>, received <%v>", utils.ErrInsufficientCredit, err)
+	}
+	a.Balance.Value = &utils.ValueFormula{Static: 3}
+	a.ExtraParameters = `{"ToAccount":"tr_lck_missing"}`
+	if err := transfer(); err != utils.ErrNotFound {
+		t.Errorf("Expected error <%v>, received <%v>", utils.ErrNotFound, err)
+	}
+	if v := src.BalanceMap[utils.MetaMonetary][1].Value; v != 7 {
+		t.Errorf("Expected source value: 7, received: %v", v)
+	}
+}
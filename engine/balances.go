@@ -46,9 +46,12 @@ type Balance struct {
 	Disabled       bool
 	Factor         ValueFactor
 	Blocker        bool
+	Currency       string // *monetary balances in other currency than the rating one
 	precision      int
 	account        *Account // used to store ub reference for shared balances
 	dirty          bool
+	exchangeRate   float64 // from the rating currency into the balance one, 0 if not converted
+	held           float64 // reserved by the holds of the account, not available for debits
 }
 
 func (b *Balance) Equal(o *Balance) bool {
@@ -67,7 +70,8 @@ func (b *Balance) Equal(o *Balance) bool {
 		b.Categories.Equal(o.Categories) &&
 		b.SharedGroups.Equal(o.SharedGroups) &&
 		b.Disabled == o.Disabled &&
-		b.Blocker == o.Blocker
+		b.Blocker == o.Blocker &&
+		b.Currency == o.Currency
 }
 
 func (b *Balance) MatchFilter(o *BalanceFilter, skipIds, skipExpiry bool) bool {
@@ -178,7 +182,9 @@ func (b *Balance) Clone() *Balance {
 		Timings:        b.Timings, // should not be a problem with aliasing
 		Blocker:        b.Blocker,
 		Disabled:       b.Disabled,
+		Currency:       b.Currency,
 		dirty:          b.dirty,
+		held:           b.held,
 	}
 	if b.DestinationIDs != nil {
 		n.DestinationIDs = b.DestinationIDs.Clone()
@@ -204,7 +210,7 @@ func (b *Balance) getMatchingPrefixAndDestID(dest string) (prefix, destID string
 // Returns the available number of seconds for a specified credit
 func (b *Balance) GetMinutesForCredit(origCD *CallDescriptor, initialCredit float64) (duration time.Duration, credit float64) {
 	cd := origCD.Clone()
-	availableDuration := time.Duration(b.GetValue()) * time.Second
+	availableDuration := time.Duration(b.availableValue()) * time.Second
 	duration = availableDuration
 	credit = initialCredit
 	cc, err := b.GetCost(cd, false)
@@ -278,6 +284,11 @@ func (b *Balance) GetValue() float64 {
 	return b.Value
 }
 
+// availableValue returns the value which can be debited, out of the one reserved by the holds
+func (b *Balance) availableValue() float64 {
+	return b.GetValue() - b.held
+}
+
 func (b *Balance) AddValue(amount float64) {
 	b.SetValue(b.GetValue() + amount)
 }
@@ -299,7 +310,7 @@ func (b *Balance) SetDirty() {
 // debitUnits will debit units for call descriptor.
 // returns the amount debited within cc
 func (b *Balance) debitUnits(cd *CallDescriptor, ub *Account, moneyBalances Balances, count bool, dryRun, debitConnectFee bool, fltrS *FilterS) (cc *CallCost, err error) {
-	if !b.IsActiveAt(cd.TimeStart) || b.GetValue() <= 0 {
+	if !b.IsActiveAt(cd.TimeStart) || b.availableValue() <= 0 {
 		return
 	}
 	if duration, err := utils.ParseZeroRatingSubject(cd.ToR, b.RatingSubject, config.CgrConfig().RalsCfg().BalanceRatingSubject, true); err == nil {
@@ -346,7 +357,7 @@ func (b *Balance) debitUnits(cd *CallDescriptor, ub *Account, moneyBalances Bala
 				amount = utils.Round(amount/b.Factor.GetValue(cd.ToR),
 					globalRoundingDecimals, utils.MetaRoundingUp)
 			}
-			if b.GetValue() >= amount {
+			if b.availableValue() >= amount {
 				b.SubstractValue(amount)
 				inc.BalanceInfo.Unit = &UnitInfo{
 					UUID:          b.Uuid,
@@ -467,7 +478,7 @@ func (b *Balance) debitUnits(cd *CallDescriptor, ub *Account, moneyBalances Bala
 				}
 				var moneyBal *Balance
 				for _, mb := range moneyBalances {
-					if mb.GetValue() >= cost {
+					if mb.availableValue() >= cost {
 						moneyBal = mb
 						break
 					}
@@ -476,7 +487,7 @@ func (b *Balance) debitUnits(cd *CallDescriptor, ub *Account, moneyBalances Bala
 					utils.Logger.Warning(fmt.Sprintf("<RALs> Going negative on account %s with AllowNegative: false", cd.GetAccountKey()))
 					moneyBal = ub.GetDefaultMoneyBalance()
 				}
-				if b.GetValue() >= amount && (moneyBal != nil || cost == 0) {
+				if b.availableValue() >= amount && (moneyBal != nil || cost == 0) {
 					b.SubstractValue(amount)
 					inc.BalanceInfo.Unit = &UnitInfo{
 						UUID:          b.Uuid,
@@ -524,7 +535,7 @@ func (b *Balance) debitUnits(cd *CallDescriptor, ub *Account, moneyBalances Bala
 }
 
 func (b *Balance) debitMoney(cd *CallDescriptor, ub *Account, moneyBalances Balances, count bool, dryRun, debitConnectFee bool, fltrS *FilterS) (cc *CallCost, err error) {
-	if !b.IsActiveAt(cd.TimeStart) || b.GetValue() <= 0 {
+	if !b.IsActiveAt(cd.TimeStart) || b.availableValue() <= 0 {
 		return
 	}
 	//log.Print("B: ", utils.ToJSON(b))
@@ -634,7 +645,7 @@ func (b *Balance) debitMoney(cd *CallDescriptor, ub *Account, moneyBalances Bala
 				continue
 			}
 
-			if b.GetValue() >= amount {
+			if b.availableValue() >= amount {
 				b.SubstractValue(amount)
 				cd.MaxCostSoFar += amount
 				inc.BalanceInfo.Monetary = &MonetaryInfo{
@@ -785,6 +796,7 @@ type BalanceSummary struct {
 	Initial  float64 // initial value before the debit operation
 	Value    float64
 	Disabled bool
+	Held     float64 `json:",omitempty"` // amount reserved by the holds out of the Value
 }
 
 // BalanceSummaries is a list of BalanceSummaries
@@ -821,6 +833,8 @@ func (bl *BalanceSummary) FieldAsInterface(fldPath []string) (val any, err error
 		return bl.Disabled, nil
 	case utils.Initial:
 		return bl.Initial, nil
+	case utils.Held:
+		return bl.Held, nil
 	}
 }
 
@@ -828,7 +842,7 @@ func (bl *BalanceSummary) FieldAsInterface(fldPath []string) (val any, err error
 // returns the amount debited within cc
 func (b *Balance) debit(cd *CallDescriptor, ub *Account, moneyBalances Balances,
 	count, dryRun, debitConnectFee, isUnitBal bool, fltrS *FilterS) (cc *CallCost, err error) {
-	if !b.IsActiveAt(cd.TimeStart) || b.GetValue() <= 0 {
+	if !b.IsActiveAt(cd.TimeStart) || b.availableValue() <= 0 {
 		return
 	}
 	tor := cd.ToR
@@ -879,7 +893,7 @@ func (b *Balance) debit(cd *CallDescriptor, ub *Account, moneyBalances Balances,
 				amount = utils.Round(amount/b.Factor.GetValue(tor),
 					globalRoundingDecimals, utils.MetaRoundingUp)
 			}
-			if b.GetValue() >= amount {
+			if b.availableValue() >= amount {
 				b.SubstractValue(amount)
 				inc.BalanceInfo.Unit = &UnitInfo{
 					UUID:          b.Uuid,
@@ -955,9 +969,11 @@ func (b *Balance) debit(cd *CallDescriptor, ub *Account, moneyBalances Balances,
 				Cost:     ts.RateInterval.Rating.ConnectFee,
 				BalanceInfo: &DebitInfo{
 					Monetary: &MonetaryInfo{
-						UUID:  debitedConnectFeeBalance.Uuid,
-						ID:    debitedConnectFeeBalance.ID,
-						Value: debitedConnectFeeBalance.Value,
+						UUID:         debitedConnectFeeBalance.Uuid,
+						ID:           debitedConnectFeeBalance.ID,
+						Value:        debitedConnectFeeBalance.Value,
+						Currency:     debitedConnectFeeBalance.Currency,
+						ExchangeRate: debitedConnectFeeBalance.exchangeRate,
 					},
 					AccountID: ub.ID,
 				},
@@ -1014,15 +1030,16 @@ func (b *Balance) debit(cd *CallDescriptor, ub *Account, moneyBalances Balances,
 			// debit minutes and money
 			amount := float64(inc.Duration)
 			cost := inc.Cost
+			blcCost := b.convertCost(cost) // the cost in the currency of the balance
 
-			canDebitCost := b.GetValue() >= cost
+			canDebitCost := b.availableValue() >= blcCost
 			var moneyBal *Balance
 			if isUnitBal {
 				if b.Factor != nil {
 					amount = utils.Round(amount/b.Factor.GetValue(cd.ToR), globalRoundingDecimals, utils.MetaRoundingUp)
 				}
 				for _, mb := range moneyBalances {
-					if mb.GetValue() >= cost {
+					if mb.availableValue() >= mb.convertCost(cost) {
 						moneyBal = mb
 						break
 					}
@@ -1031,7 +1048,7 @@ func (b *Balance) debit(cd *CallDescriptor, ub *Account, moneyBalances Balances,
 					utils.Logger.Warning(fmt.Sprintf("<RALs> Going negative on account %s with AllowNegative: false", cd.GetAccountKey()))
 					moneyBal = ub.GetDefaultMoneyBalance()
 				}
-				canDebitCost = b.GetValue() >= amount && (moneyBal != nil || cost == 0)
+				canDebitCost = b.availableValue() >= amount && (moneyBal != nil || cost == 0)
 			}
 			if !canDebitCost {
 				// delete the rest of the unpaid increments/timespans
@@ -1061,34 +1078,39 @@ func (b *Balance) debit(cd *CallDescriptor, ub *Account, moneyBalances Balances,
 				}
 				inc.BalanceInfo.AccountID = ub.ID
 				if cost != 0 {
-					moneyBal.SubstractValue(cost)
+					blcCost = moneyBal.convertCost(cost)
+					moneyBal.SubstractValue(blcCost)
 					inc.BalanceInfo.Monetary = &MonetaryInfo{
-						UUID:  moneyBal.Uuid,
-						ID:    moneyBal.ID,
-						Value: moneyBal.Value,
+						UUID:         moneyBal.Uuid,
+						ID:           moneyBal.ID,
+						Value:        moneyBal.Value,
+						Currency:     moneyBal.Currency,
+						ExchangeRate: moneyBal.exchangeRate,
 					}
 					cd.MaxCostSoFar += cost
 				}
 				if count {
 					ub.countUnits(amount, cc.ToR, cc, b, fltrS)
 					if cost != 0 {
-						ub.countUnits(cost, utils.MetaMonetary, cc, moneyBal, fltrS)
+						ub.countUnits(blcCost, utils.MetaMonetary, cc, moneyBal, fltrS)
 					}
 				}
 			} else { // monetary balance
-				b.SubstractValue(cost)
+				b.SubstractValue(blcCost)
 				cd.MaxCostSoFar += cost
 				inc.BalanceInfo.Monetary = &MonetaryInfo{
-					UUID:  b.Uuid,
-					ID:    b.ID,
-					Value: b.Value,
+					UUID:         b.Uuid,
+					ID:           b.ID,
+					Value:        b.Value,
+					Currency:     b.Currency,
+					ExchangeRate: b.exchangeRate,
 				}
 				inc.BalanceInfo.AccountID = ub.ID
 				if b.RatingSubject != "" {
 					inc.BalanceInfo.Monetary.RateInterval = ts.RateInterval
 				}
 				if count {
-					ub.countUnits(cost, utils.MetaMonetary, cc, b, fltrS)
+					ub.countUnits(blcCost, utils.MetaMonetary, cc, b, fltrS)
 				}
 			}
 		}
@@ -1282,6 +1304,11 @@ func (b *Balance) FieldAsInterface(fldPath []string) (val any, err error) {
 			return nil, utils.ErrNotFound
 		}
 		return b.Blocker, nil
+	case utils.Currency:
+		if len(fldPath) != 1 {
+			return nil, utils.ErrNotFound
+		}
+		return b.Currency, nil
 	}
 }
 
@@ -24,7 +24,9 @@ import (
 	"fmt"
 	"net/url"
 	"runtime"
+	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/cgrates/birpc/context"
@@ -67,6 +69,13 @@ func init() {
 	gob.Register(new(DispatcherHost))
 	gob.Register(new(DispatcherHostProfile))
 	gob.Register(new(DispatcherHostWithAPIOpts))
+	// RALs
+	gob.Register(new(ExchangeRate))
+	gob.Register(new(ExchangeRateWithAPIOpts))
+	gob.Register(new(Calendar))
+	gob.Register(new(CalendarWithAPIOpts))
+	gob.Register(new(TaxProfile))
+	gob.Register(new(TaxProfileWithAPIOpts))
 
 	// CDRs
 	gob.Register(new(EventCost))
@@ -82,6 +91,7 @@ func init() {
 	gob.Register(new(StatSum))
 	gob.Register(new(StatAverage))
 	gob.Register(new(StatDistinct))
+	gob.Register(new(StatPercentile))
 
 	// others
 	gob.Register([]any{})
@@ -132,10 +142,16 @@ func NewCacheS(cfg *config.CGRConfig, dm *DataManager, cpS *CapsStats) (c *Cache
 		dm:      dm,
 		pcItems: make(map[string]chan struct{}),
 		tCache:  ltcache.NewTransCache(tCache),
+		hits:    make(map[string]*atomic.Uint64, len(tCache)),
+		misses:  make(map[string]*atomic.Uint64, len(tCache)),
 	}
 	for cacheID := range cfg.CacheCfg().Partitions {
 		c.pcItems[cacheID] = make(chan struct{})
 	}
+	for cacheID := range tCache {
+		c.hits[cacheID] = new(atomic.Uint64)
+		c.misses[cacheID] = new(atomic.Uint64)
+	}
 	return
 }
 
@@ -145,6 +161,8 @@ type CacheS struct {
 	dm      *DataManager
 	pcItems map[string]chan struct{} // signal precaching
 	tCache  *ltcache.TransCache
+	hits    map[string]*atomic.Uint64 // per partition, fixed at creation so no lock needed
+	misses  map[string]*atomic.Uint64
 }
 
 // Set is an exported method from TransCache
@@ -197,14 +215,59 @@ func (chS *CacheS) HasItem(chID, itmID string) (has bool) {
 }
 
 // Get is an exported method from TransCache
-func (chS *CacheS) Get(chID, itmID string) (any, bool) {
-	return chS.tCache.Get(chID, itmID)
+func (chS *CacheS) Get(chID, itmID string) (itm any, has bool) {
+	itm, has = chS.tCache.Get(chID, itmID)
+	chS.countGet(chID, has)
+	return
+}
+
+// countGet updates the hits and misses of the partition
+func (chS *CacheS) countGet(chID string, has bool) {
+	cntrs := chS.misses
+	if has {
+		cntrs = chS.hits
+	}
+	if cntr, ok := cntrs[chID]; ok {
+		cntr.Add(1)
+	}
+}
+
+// CollectMetrics implements MetricsCollector
+func (chS *CacheS) CollectMetrics(mw *MetricsWriter) {
+	cs := chS.tCache.GetCacheStats(nil)
+	chIDs := make([]string, 0, len(cs))
+	for chID := range cs {
+		chIDs = append(chIDs, chID)
+	}
+	sort.Strings(chIDs)
+	mw.Family("cache_items", MetricTypeGauge, "Number of items in the cache partition.")
+	for _, chID := range chIDs {
+		mw.Sample("cache_items", float64(cs[chID].Items), "partition", chID)
+	}
+	mw.Family("cache_groups", MetricTypeGauge, "Number of groups in the cache partition.")
+	for _, chID := range chIDs {
+		mw.Sample("cache_groups", float64(cs[chID].Groups), "partition", chID)
+	}
+	mw.Family("cache_hits_total", MetricTypeCounter, "Number of lookups finding the item in the cache partition.")
+	for _, chID := range chIDs {
+		if cntr, has := chS.hits[chID]; has {
+			mw.Sample("cache_hits_total", float64(cntr.Load()), "partition", chID)
+		}
+	}
+	mw.Family("cache_misses_total", MetricTypeCounter, "Number of lookups not finding the item in the cache partition.")
+	for _, chID := range chIDs {
+		if cntr, has := chS.misses[chID]; has {
+			mw.Sample("cache_misses_total", float64(cntr.Load()), "partition", chID)
+		}
+	}
 }
 
 // GetWithRemote queries locally the cache, followed by remotes
 func (chS *CacheS) GetWithRemote(args *utils.ArgsGetCacheItemWithAPIOpts) (itm any, err error) {
 	var has bool
-	if itm, has = chS.tCache.Get(args.CacheID, args.ItemID); has {
+	itm, has = chS.tCache.Get(args.CacheID, args.ItemID)
+	chS.countGet(args.CacheID, has)
+	if has {
 		return
 	}
 	if len(chS.cfg.CacheCfg().RemoteConns) == 0 ||
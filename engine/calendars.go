@@ -0,0 +1,139 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+	"github.com/gorhill/cronexpr"
+)
+
+const (
+	holidayLayout       = "2006-01-02"
+	yearlyHolidayLayout = "01-02"
+)
+
+// Calendar defines the active hours and the holidays checked by the *calendar filters
+type Calendar struct {
+	Tenant   string
+	ID       string
+	Timezone string   // location of the calendar, empty for the general default_timezone
+	Hours    []string // cron expressions (minute hour monthday month weekday) of the active periods, empty for always active
+	Holidays []string // inactive days as YYYY-MM-DD or MM-DD for the ones repeating yearly
+}
+
+// CalendarWithAPIOpts is used in replicatorV1 for dispatcher
+type CalendarWithAPIOpts struct {
+	*Calendar
+	APIOpts map[string]any
+}
+
+// TenantID returns the concatenated key between tenant and ID
+func (cal *Calendar) TenantID() string {
+	return utils.ConcatenatedKey(cal.Tenant, cal.ID)
+}
+
+// Validate checks the timezone, the hours and the holidays of the calendar
+func (cal *Calendar) Validate() (err error) {
+	if cal.ID == utils.EmptyString {
+		return utils.NewErrMandatoryIeMissing(utils.ID)
+	}
+	if cal.Timezone != utils.EmptyString {
+		if _, err = time.LoadLocation(cal.Timezone); err != nil {
+			return fmt.Errorf("invalid timezone <%s> for calendar <%s>: %v",
+				cal.Timezone, cal.TenantID(), err)
+		}
+	}
+	for _, hrs := range cal.Hours {
+		if _, err = cronexpr.Parse(hrs); err != nil {
+			return fmt.Errorf("invalid hours <%s> for calendar <%s>: %v",
+				hrs, cal.TenantID(), err)
+		}
+	}
+	for _, day := range cal.Holidays {
+		if !validHoliday(day) {
+			return fmt.Errorf("invalid holiday <%s> for calendar <%s>",
+				day, cal.TenantID())
+		}
+	}
+	return
+}
+
+// IsActiveAt returns true if the time is not on a holiday and matches the calendar hours
+func (cal *Calendar) IsActiveAt(t time.Time) (bool, error) {
+	loc, err := calendarLocation(cal.Timezone)
+	if err != nil {
+		return false, err
+	}
+	t = t.In(loc)
+	for _, day := range cal.Holidays {
+		if isHoliday(day, t) {
+			return false, nil
+		}
+	}
+	if len(cal.Hours) == 0 {
+		return true, nil
+	}
+	for _, hrs := range cal.Hours {
+		if match, err := cronMatches(hrs, t); err != nil || match {
+			return match, err
+		}
+	}
+	return false, nil
+}
+
+// calendarLocation loads the timezone defaulting to the general one
+func calendarLocation(tz string) (*time.Location, error) {
+	if tz == utils.EmptyString {
+		tz = config.CgrConfig().GeneralCfg().DefaultTimezone
+	}
+	return time.LoadLocation(tz)
+}
+
+// validHoliday checks the day to be in one of the holiday layouts
+func validHoliday(day string) bool {
+	for _, layout := range []string{holidayLayout, yearlyHolidayLayout} {
+		if len(day) == len(layout) {
+			_, err := time.Parse(layout, day)
+			return err == nil
+		}
+	}
+	return false
+}
+
+// isHoliday checks if t falls on the given holiday
+func isHoliday(day string, t time.Time) bool {
+	if len(day) == len(yearlyHolidayLayout) {
+		return t.Format(yearlyHolidayLayout) == day
+	}
+	return t.Format(holidayLayout) == day
+}
+
+// cronMatches checks if the minute of t is matched by the cron expression
+func cronMatches(expr string, t time.Time) (bool, error) {
+	cExpr, err := cronexpr.Parse(expr)
+	if err != nil {
+		return false, err
+	}
+	tMin := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), 0, 0, t.Location())
+	return cExpr.Next(tMin.Add(-time.Second)).Equal(tMin), nil
+}
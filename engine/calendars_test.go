@@ -0,0 +1,145 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestCalendarValidate(t *testing.T) {
+	cal := &Calendar{
+		Tenant:   "cgrates.org",
+		ID:       "BUSINESS_HOURS",
+		Timezone: "Europe/Berlin",
+		Hours:    []string{"* 9-17 * * 1-5"},
+		Holidays: []string{"12-25", "2026-04-06"},
+	}
+	if err := cal.Validate(); err != nil {
+		t.Error(err)
+	}
+	if cal.TenantID() != "cgrates.org:BUSINESS_HOURS" {
+		t.Errorf("Unexpected TenantID: %s", cal.TenantID())
+	}
+	for _, inv := range []*Calendar{
+		{ID: "CAL", Timezone: "Europe/Nowhere"},
+		{ID: "CAL", Hours: []string{"* 25 * * *"}},
+		{ID: "CAL", Holidays: []string{"2026-13-01"}},
+		{ID: "CAL", Holidays: []string{"25.12"}},
+	} {
+		if err := inv.Validate(); err == nil {
+			t.Errorf("Expected error for %s", utils.ToJSON(inv))
+		}
+	}
+	if err := new(Calendar).Validate(); err == nil ||
+		err.Error() != utils.NewErrMandatoryIeMissing(utils.ID).Error() {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestCalendarIsActiveAt(t *testing.T) {
+	cal := &Calendar{
+		Tenant:   "cgrates.org",
+		ID:       "BUSINESS_HOURS",
+		Timezone: "Europe/Berlin",
+		Hours:    []string{"* 9-16 * * 1-5", "0-29 17 * * 1-5"},
+		Holidays: []string{"12-25", "2026-04-06"},
+	}
+	for tm, exp := range map[time.Time]bool{
+		time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC):  true,  // Monday 12:00 in Berlin
+		time.Date(2026, 10, 19, 15, 29, 0, 0, time.UTC): true,  // Monday 17:29 in Berlin
+		time.Date(2026, 10, 19, 15, 30, 0, 0, time.UTC): false, // Monday 17:30 in Berlin
+		time.Date(2026, 10, 19, 6, 59, 0, 0, time.UTC):  false, // Monday 08:59 in Berlin
+		time.Date(2026, 10, 18, 10, 0, 0, 0, time.UTC):  false, // Sunday
+		time.Date(2026, 12, 25, 10, 0, 0, 0, time.UTC):  false, // yearly holiday
+		time.Date(2027, 12, 25, 10, 0, 0, 0, time.UTC):  false, // yearly holiday
+		time.Date(2026, 4, 6, 10, 0, 0, 0, time.UTC):    false, // Easter Monday
+		time.Date(2027, 4, 6, 10, 0, 0, 0, time.UTC):    true,  // not a holiday next year
+	} {
+		if active, err := cal.IsActiveAt(tm); err != nil {
+			t.Error(err)
+		} else if active != exp {
+			t.Errorf("At %s expected %v, received %v", tm, exp, active)
+		}
+	}
+	// no hours means active for the whole day
+	cal.Hours = nil
+	if active, err := cal.IsActiveAt(time.Date(2026, 10, 18, 23, 0, 0, 0, time.UTC)); err != nil || !active {
+		t.Errorf("Expected active, received %v, err: %v", active, err)
+	}
+	if active, err := cal.IsActiveAt(time.Date(2026, 12, 25, 0, 30, 0, 0, time.UTC)); err != nil || active {
+		t.Errorf("Expected inactive, received %v, err: %v", active, err)
+	}
+}
+
+func TestFilterPassCalendar(t *testing.T) {
+	tmpCfg := config.CgrConfig()
+	defer config.SetCgrConfig(tmpCfg)
+	cfg := config.NewDefaultCGRConfig()
+	cfg.GeneralCfg().DefaultTimezone = "UTC"
+	config.SetCgrConfig(cfg)
+	if err := dm.SetCalendar(&Calendar{
+		Tenant:   "cgrates.org",
+		ID:       "BUSINESS_HOURS",
+		Timezone: "Europe/Berlin",
+		Hours:    []string{"* 9-17 * * 1-5"},
+		Holidays: []string{"12-25"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		dm.RemoveCalendar("cgrates.org", "BUSINESS_HOURS")
+		Cache.Clear([]string{utils.CacheCalendars})
+	}()
+	filterS := NewFilterS(cfg, nil, dm)
+	for tm, exp := range map[time.Time][]bool{ // the *calendar, the inline cron and the *notcalendar results
+		time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC):  {true, true, false},
+		time.Date(2026, 10, 19, 16, 30, 0, 0, time.UTC): {false, true, true}, // 18:30 in Berlin
+		time.Date(2026, 12, 25, 10, 0, 0, 0, time.UTC):  {false, true, true},
+		time.Date(2026, 10, 18, 10, 0, 0, 0, time.UTC):  {false, false, true},
+	} {
+		ev := utils.MapStorage{utils.MetaReq: utils.MapStorage{utils.AnswerTime: tm}}
+		for i, fltr := range []string{
+			"*calendar:~*req.AnswerTime:BUSINESS_HOURS",
+			"*calendar:~*req.AnswerTime:* 9-17 * * 1-5",
+			"*notcalendar:~*req.AnswerTime:BUSINESS_HOURS",
+		} {
+			if pass, err := filterS.Pass("cgrates.org", []string{fltr}, ev); err != nil {
+				t.Errorf("%s: %v", fltr, err)
+			} else if pass != exp[i] {
+				t.Errorf("%s at %s: expected %v, received %v", fltr, tm, exp[i], pass)
+			}
+		}
+	}
+	ev := utils.MapStorage{utils.MetaReq: utils.MapStorage{}}
+	if pass, err := filterS.Pass("cgrates.org", []string{"*calendar:*now:* * * * *"}, ev); err != nil || !pass {
+		t.Errorf("Expected *now to pass, received %v, err: %v", pass, err)
+	}
+	if pass, err := filterS.Pass("cgrates.org", []string{"*calendar:~*req.AnswerTime:BUSINESS_HOURS"}, ev); err != nil || pass {
+		t.Errorf("Expected missing field not to pass, received %v, err: %v", pass, err)
+	}
+	ev = utils.MapStorage{utils.MetaReq: utils.MapStorage{utils.AnswerTime: "2026-10-19T10:00:00Z"}}
+	if _, err := filterS.Pass("cgrates.org", []string{"*calendar:~*req.AnswerTime:MISSING"}, ev); err == nil ||
+		err.Error() != utils.ErrPrefixNotFound("MISSING").Error() {
+		t.Errorf("Expected error: %v, received: %v", utils.ErrPrefixNotFound("MISSING"), err)
+	}
+}
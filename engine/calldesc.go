@@ -847,6 +847,7 @@ func (cd *CallDescriptor) MaxDebit(fltrS *FilterS) (cc *CallCost, err error) {
 // returns the updated account referenced by the CallDescriptor
 func (cd *CallDescriptor) refundIncrements(fltrS *FilterS) (acnt *Account, err error) {
 	accountsCache := make(map[string]*Account)
+	ldgSnap := newBalanceLedgerSnapshot()
 	for _, increment := range cd.Increments {
 		// work around for the refund from CDRServer:
 		// for the calls with Cost 0 but with at least a TimeSpan it will make the information
@@ -862,6 +863,7 @@ func (cd *CallDescriptor) refundIncrements(fltrS *FilterS) (acnt *Account, err e
 				accountsCache[increment.BalanceInfo.AccountID] = account
 				// will save the account only once at the end of the function
 				defer dm.SetAccount(account)
+				ldgSnap.add(account)
 			}
 		}
 		if account == nil {
@@ -886,10 +888,12 @@ func (cd *CallDescriptor) refundIncrements(fltrS *FilterS) (acnt *Account, err e
 				utils.Logger.Warning(fmt.Sprintf("Could not get the balnce: <%s> to be refunded for account: <%s>", increment.BalanceInfo.Monetary.UUID, increment.BalanceInfo.AccountID))
 				continue
 			}
-			balance.AddValue(increment.Cost)
-			account.countUnits(-increment.Cost, utils.MetaMonetary, cc, balance, fltrS)
+			blcCost := convertCost(increment.Cost, increment.BalanceInfo.Monetary.ExchangeRate)
+			balance.AddValue(blcCost)
+			account.countUnits(-blcCost, utils.MetaMonetary, cc, balance, fltrS)
 		}
 	}
+	ldgSnap.store(utils.MetaRefund, cd.CgrID)
 	acnt = accountsCache[utils.ConcatenatedKey(cd.Tenant, cd.Account)]
 	return
 
@@ -919,6 +923,8 @@ func (cd *CallDescriptor) refundRounding(old *Account, fltrS *FilterS) (accounts
 	// get account list for locking
 	// all must be locked in order to use cache
 	accountsCache = make(map[string]*Account)
+	ldgSnap := newBalanceLedgerSnapshot(old)
+	defer ldgSnap.store(utils.MetaDebit, cd.CgrID)
 	if old != nil {
 		accountsCache[old.ID] = old
 		defer dm.SetAccount(old)
@@ -931,6 +937,7 @@ func (cd *CallDescriptor) refundRounding(old *Account, fltrS *FilterS) (accounts
 				accountsCache[increment.BalanceInfo.AccountID] = account
 				// will save the account only once at the end of the function
 				defer dm.SetAccount(account)
+				ldgSnap.add(account)
 			}
 		}
 		if account == nil {
@@ -21,6 +21,7 @@ package engine
 import (
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/cgrates/cgrates/utils"
@@ -30,6 +31,8 @@ import (
 type Caps struct {
 	strategy string
 	aReqs    chan struct{}
+	rejected atomic.Uint64 // requests refused with the *busy strategy
+	limiter  *CapsLimiter  // limits applied per key of the request
 }
 
 // NewCaps creates a new caps
@@ -40,6 +43,16 @@ func NewCaps(reqs int, strategy string) *Caps {
 	}
 }
 
+// SetLimiter applies the limiter on top of the caps, needs to be called before serving requests
+func (cR *Caps) SetLimiter(lmtr *CapsLimiter) {
+	cR.limiter = lmtr
+}
+
+// Limiter returns the limiter applied on top of the caps, nil if not configured
+func (cR *Caps) Limiter() *CapsLimiter {
+	return cR.limiter
+}
+
 // IsLimited returns true if the limit is not 0
 func (cR *Caps) IsLimited() bool {
 	return cap(cR.aReqs) != 0
@@ -55,6 +68,7 @@ func (cR *Caps) Allocate() (err error) {
 	switch cR.strategy {
 	case utils.MetaBusy:
 		if len(cR.aReqs) == cap(cR.aReqs) {
+			cR.rejected.Add(1)
 			return utils.ErrMaxConcurrentRPCExceededNoCaps
 		}
 		fallthrough
@@ -69,6 +83,24 @@ func (cR *Caps) Deallocate() {
 	<-cR.aReqs
 }
 
+// Rejected returns the number of requests refused since start
+func (cR *Caps) Rejected() uint64 {
+	return cR.rejected.Load()
+}
+
+// CollectMetrics implements MetricsCollector
+func (cR *Caps) CollectMetrics(mw *MetricsWriter) {
+	mw.Family("caps_limit", MetricTypeGauge, "Maximum number of concurrent API requests, 0 if not limited.")
+	mw.Sample("caps_limit", float64(cap(cR.aReqs)))
+	mw.Family("caps_allocated", MetricTypeGauge, "Number of API requests actively serviced.")
+	mw.Sample("caps_allocated", float64(cR.Allocated()))
+	mw.Family("caps_rejected_total", MetricTypeCounter, "Number of API requests refused because the caps were reached.")
+	mw.Sample("caps_rejected_total", float64(cR.Rejected()))
+	if cR.limiter != nil {
+		cR.limiter.CollectMetrics(mw)
+	}
+}
+
 // NewCapsStats returns the stats for the caps
 func NewCapsStats(sampleinterval time.Duration, caps *Caps, stopChan chan struct{}) (cs *CapsStats) {
 	st, _ := NewStatAverage(1, utils.MetaDynReq, nil)
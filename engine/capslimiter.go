@@ -0,0 +1,247 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"math"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// NewCapsLimiter returns the limiter for the configured caps limits
+func NewCapsLimiter(lmtsCfg []*config.CapsLimitCfg) (cL *CapsLimiter) {
+	cL = &CapsLimiter{lmts: make([]*capsLimit, len(lmtsCfg))}
+	for i, lmtCfg := range lmtsCfg {
+		cL.lmts[i] = &capsLimit{
+			cfg:      lmtCfg,
+			perConn:  slices.Contains(lmtCfg.Keys, utils.MetaConnection),
+			counters: make(map[string]*capsCounter),
+		}
+	}
+	return
+}
+
+// CapsLimiter applies the caps limits on the API requests,
+// counting them per tenant, API method and client connection
+type CapsLimiter struct {
+	lmts []*capsLimit
+}
+
+// capsLimit holds the counters of one configured limit
+type capsLimit struct {
+	sync.Mutex
+	cfg      *config.CapsLimitCfg
+	perConn  bool
+	counters map[string]*capsCounter
+}
+
+// capsCounter counts the requests for one key of the limit
+type capsCounter struct {
+	conn     string
+	active   int
+	peak     int
+	tokens   float64
+	filled   time.Time
+	allowed  uint64
+	rejected uint64
+}
+
+// CapsLimitStats are the counters for one key of a caps limit
+type CapsLimitStats struct {
+	Active   int
+	Peak     int
+	Allowed  uint64
+	Rejected uint64
+}
+
+// matches returns true if the limit applies to the request
+func (cl *capsLimit) matches(tenant, method string) bool {
+	if len(cl.cfg.Tenants) != 0 && !cl.cfg.Tenants.Has(tenant) {
+		return false
+	}
+	if len(cl.cfg.APIMethods) == 0 || cl.cfg.APIMethods.Has(method) {
+		return true
+	}
+	srv, _, has := strings.Cut(method, utils.NestingSep)
+	return has && cl.cfg.APIMethods.Has(srv+utils.NestingSep+utils.Meta)
+}
+
+// key returns the key the request is counted on
+func (cl *capsLimit) key(tenant, method, conn string) string {
+	if len(cl.cfg.Keys) == 0 {
+		return utils.MetaAny
+	}
+	vals := make([]string, len(cl.cfg.Keys))
+	for i, key := range cl.cfg.Keys {
+		switch key {
+		case utils.MetaTenant:
+			vals[i] = tenant
+		case utils.MetaAPIMethod:
+			vals[i] = method
+		case utils.MetaConnection:
+			vals[i] = conn
+		}
+	}
+	return utils.ConcatenatedKey(vals...)
+}
+
+// burst returns the maximum number of tokens in the bucket
+func (cl *capsLimit) burst() float64 {
+	if cl.cfg.Burst != 0 {
+		return float64(cl.cfg.Burst)
+	}
+	return math.Max(1, math.Ceil(cl.cfg.RequestsPerSecond))
+}
+
+// counter returns the counter for the key, creating it if missing
+func (cl *capsLimit) counter(key, conn string) (cntr *capsCounter) {
+	var has bool
+	if cntr, has = cl.counters[key]; !has {
+		cntr = &capsCounter{
+			conn:   conn,
+			tokens: cl.burst(),
+			filled: time.Now(),
+		}
+		cl.counters[key] = cntr
+	}
+	return
+}
+
+// check returns the error if the request is over the limit for the counter
+func (cl *capsLimit) check(cntr *capsCounter) error {
+	if cl.cfg.ConcurrentRequests != 0 &&
+		cntr.active >= cl.cfg.ConcurrentRequests {
+		return utils.ErrMaxConcurrentRPCExceeded
+	}
+	if cl.cfg.RequestsPerSecond != 0 {
+		now := time.Now()
+		cntr.tokens = math.Min(cl.burst(),
+			cntr.tokens+now.Sub(cntr.filled).Seconds()*cl.cfg.RequestsPerSecond)
+		cntr.filled = now
+		if cntr.tokens < 1 {
+			return utils.ErrMaxRPCRateExceeded
+		}
+	}
+	return nil
+}
+
+// Allocate reserves the request on all the limits matching it, returning the
+// function that needs to be called once the request was processed
+func (cL *CapsLimiter) Allocate(tenant, method, conn string) (release func(), err error) {
+	lmts := make([]*capsLimit, 0, len(cL.lmts))
+	for _, lmt := range cL.lmts {
+		if lmt.matches(tenant, method) {
+			lmts = append(lmts, lmt)
+		}
+	}
+	if len(lmts) == 0 {
+		return func() {}, nil
+	}
+	// lock all the limits so the request is either allocated on all of them or on none
+	cntrs := make([]*capsCounter, len(lmts))
+	for i, lmt := range lmts {
+		lmt.Lock()
+		cntrs[i] = lmt.counter(lmt.key(tenant, method, conn), conn)
+	}
+	defer func() {
+		for _, lmt := range lmts {
+			lmt.Unlock()
+		}
+	}()
+	for i, lmt := range lmts {
+		if err = lmt.check(cntrs[i]); err != nil {
+			cntrs[i].rejected++
+			return
+		}
+	}
+	for i, lmt := range lmts {
+		cntrs[i].allowed++
+		if lmt.cfg.RequestsPerSecond != 0 {
+			cntrs[i].tokens--
+		}
+		if cntrs[i].active++; cntrs[i].active > cntrs[i].peak {
+			cntrs[i].peak = cntrs[i].active
+		}
+	}
+	return func() {
+		for i, lmt := range lmts {
+			lmt.Lock()
+			cntrs[i].active--
+			lmt.Unlock()
+		}
+	}, nil
+}
+
+// CloseConnection removes the counters of the limits keyed on the closed client connection
+func (cL *CapsLimiter) CloseConnection(conn string) {
+	for _, lmt := range cL.lmts {
+		if !lmt.perConn {
+			continue
+		}
+		lmt.Lock()
+		for key, cntr := range lmt.counters {
+			if cntr.conn == conn && cntr.active == 0 {
+				delete(lmt.counters, key)
+			}
+		}
+		lmt.Unlock()
+	}
+}
+
+// Stats returns the counters of each limit, indexed on the limit ID and the key
+func (cL *CapsLimiter) Stats() (stats map[string]map[string]*CapsLimitStats) {
+	stats = make(map[string]map[string]*CapsLimitStats)
+	for _, lmt := range cL.lmts {
+		lmt.Lock()
+		lmtStats := make(map[string]*CapsLimitStats, len(lmt.counters))
+		for key, cntr := range lmt.counters {
+			lmtStats[key] = &CapsLimitStats{
+				Active:   cntr.active,
+				Peak:     cntr.peak,
+				Allowed:  cntr.allowed,
+				Rejected: cntr.rejected,
+			}
+		}
+		lmt.Unlock()
+		stats[lmt.cfg.ID] = lmtStats
+	}
+	return
+}
+
+// CollectMetrics implements MetricsCollector
+func (cL *CapsLimiter) CollectMetrics(mw *MetricsWriter) {
+	stats := cL.Stats()
+	mw.Family("caps_limit_active", MetricTypeGauge, "Number of API requests actively serviced for each key of the caps limits.")
+	for lmtID, lmtStats := range stats {
+		for key, st := range lmtStats {
+			mw.Sample("caps_limit_active", float64(st.Active), "limit", lmtID, "key", key)
+		}
+	}
+	mw.Family("caps_limit_rejected_total", MetricTypeCounter, "Number of API requests refused because of the caps limits.")
+	for lmtID, lmtStats := range stats {
+		for key, st := range lmtStats {
+			mw.Sample("caps_limit_rejected_total", float64(st.Rejected), "limit", lmtID, "key", key)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestCapsLimiterConcurrent(t *testing.T) {
+	cL := NewCapsLimiter([]*config.CapsLimitCfg{{
+		ID:                 "SESSIONS",
+		Tenants:            utils.NewStringSet([]string{"cgrates.org"}),
+		APIMethods:         utils.NewStringSet([]string{utils.SessionSv1AuthorizeEvent}),
+		Keys:               []string{utils.MetaTenant},
+		ConcurrentRequests: 1,
+	}})
+	release, err := cL.Allocate("cgrates.org", utils.SessionSv1AuthorizeEvent, "127.0.0.1:1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = cL.Allocate("cgrates.org", utils.SessionSv1AuthorizeEvent, "127.0.0.1:2"); err != utils.ErrMaxConcurrentRPCExceeded {
+		t.Errorf("Expected error: %v, received: %v", utils.ErrMaxConcurrentRPCExceeded, err)
+	}
+	// not matching the limit
+	if _, err = cL.Allocate("itsyscom.com", utils.SessionSv1AuthorizeEvent, "127.0.0.1:2"); err != nil {
+		t.Error(err)
+	}
+	if _, err = cL.Allocate("cgrates.org", utils.CoreSv1Ping, "127.0.0.1:2"); err != nil {
+		t.Error(err)
+	}
+	release()
+	if _, err = cL.Allocate("cgrates.org", utils.SessionSv1AuthorizeEvent, "127.0.0.1:2"); err != nil {
+		t.Error(err)
+	}
+	exp := map[string]map[string]*CapsLimitStats{
+		"SESSIONS": {
+			"cgrates.org": {Active: 1, Peak: 1, Allowed: 2, Rejected: 1},
+		},
+	}
+	if rcv := cL.Stats(); !reflect.DeepEqual(exp, rcv) {
+		t.Errorf("Expected: %s, received: %s", utils.ToJSON(exp), utils.ToJSON(rcv))
+	}
+}
+
+func TestCapsLimiterRate(t *testing.T) {
+	cL := NewCapsLimiter([]*config.CapsLimitCfg{{
+		ID:                "APIER",
+		APIMethods:        utils.NewStringSet([]string{"APIerSv1.*"}),
+		Keys:              []string{utils.MetaAPIMethod, utils.MetaConnection},
+		RequestsPerSecond: 0.001,
+		Burst:             2,
+	}})
+	for i := 0; i < 2; i++ {
+		if release, err := cL.Allocate("cgrates.org", utils.APIerSv1GetAccount, "127.0.0.1:1"); err != nil {
+			t.Fatal(err)
+		} else {
+			release()
+		}
+	}
+	if _, err := cL.Allocate("cgrates.org", utils.APIerSv1GetAccount, "127.0.0.1:1"); err != utils.ErrMaxRPCRateExceeded {
+		t.Errorf("Expected error: %v, received: %v", utils.ErrMaxRPCRateExceeded, err)
+	}
+	// other connection has its own bucket
+	if _, err := cL.Allocate("cgrates.org", utils.APIerSv1GetAccount, "127.0.0.1:2"); err != nil {
+		t.Error(err)
+	}
+	exp := map[string]map[string]*CapsLimitStats{
+		"APIER": {
+			utils.ConcatenatedKey(utils.APIerSv1GetAccount, "127.0.0.1:1"): {Peak: 1, Allowed: 2, Rejected: 1},
+			utils.ConcatenatedKey(utils.APIerSv1GetAccount, "127.0.0.1:2"): {Active: 1, Peak: 1, Allowed: 1},
+		},
+	}
+	if rcv := cL.Stats(); !reflect.DeepEqual(exp, rcv) {
+		t.Errorf("Expected: %s, received: %s", utils.ToJSON(exp), utils.ToJSON(rcv))
+	}
+	// only the counters not in use are removed
+	cL.CloseConnection("127.0.0.1:1")
+	cL.CloseConnection("127.0.0.1:2")
+	delete(exp["APIER"], utils.ConcatenatedKey(utils.APIerSv1GetAccount, "127.0.0.1:1"))
+	if rcv := cL.Stats(); !reflect.DeepEqual(exp, rcv) {
+		t.Errorf("Expected: %s, received: %s", utils.ToJSON(exp), utils.ToJSON(rcv))
+	}
+}
+
+func TestCapsLimiterAllOrNone(t *testing.T) {
+	cL := NewCapsLimiter([]*config.CapsLimitCfg{
+		{
+			ID:                 "ALL",
+			ConcurrentRequests: 2,
+		},
+		{
+			ID:                 "PER_TENANT",
+			Keys:               []string{utils.MetaTenant},
+			ConcurrentRequests: 1,
+		},
+	})
+	if _, err := cL.Allocate("cgrates.org", utils.CoreSv1Ping, utils.EmptyString); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cL.Allocate("cgrates.org", utils.CoreSv1Ping, utils.EmptyString); err != utils.ErrMaxConcurrentRPCExceeded {
+		t.Errorf("Expected error: %v, received: %v", utils.ErrMaxConcurrentRPCExceeded, err)
+	}
+	// the refused request did not count on the first limit
+	if _, err := cL.Allocate("itsyscom.com", utils.CoreSv1Ping, utils.EmptyString); err != nil {
+		t.Error(err)
+	}
+	exp := map[string]map[string]*CapsLimitStats{
+		"ALL": {
+			utils.MetaAny: {Active: 2, Peak: 2, Allowed: 2},
+		},
+		"PER_TENANT": {
+			"cgrates.org":  {Active: 1, Peak: 1, Allowed: 1, Rejected: 1},
+			"itsyscom.com": {Active: 1, Peak: 1, Allowed: 1},
+		},
+	}
+	if rcv := cL.Stats(); !reflect.DeepEqual(exp, rcv) {
+		t.Errorf("Expected: %s, received: %s", utils.ToJSON(exp), utils.ToJSON(rcv))
+	}
+}
+
+func TestCapsCollectMetricsWithLimiter(t *testing.T) {
+	caps := NewCaps(0, utils.MetaBusy)
+	caps.SetLimiter(NewCapsLimiter([]*config.CapsLimitCfg{{
+		ID:                 "ALL",
+		ConcurrentRequests: 1,
+	}}))
+	caps.Limiter().Allocate("cgrates.org", utils.CoreSv1Ping, utils.EmptyString)
+	caps.Limiter().Allocate("cgrates.org", utils.CoreSv1Ping, utils.EmptyString)
+	mw := NewMetricsWriter()
+	caps.CollectMetrics(mw)
+	rcv := string(mw.Bytes())
+	for _, exp := range []string{
+		`cgrates_caps_limit_active{limit="ALL",key="*any"} 1`,
+		`cgrates_caps_limit_rejected_total{limit="ALL",key="*any"} 1`,
+	} {
+		if !strings.Contains(rcv, exp) {
+			t.Errorf("Expected %q in:\n%s", exp, rcv)
+		}
+	}
+}
@@ -351,6 +351,30 @@ func (cdrS *CDRServer) refundEventCost(ec *EventCost, reqType, tor string) (rfnd
 	return true, nil
 }
 
+// applyTaxes records on the CostDetails of the rated CDR the taxes out of the matching TaxProfiles
+func (cdrS *CDRServer) applyTaxes(cdr *CDR, opts map[string]any) (err error) {
+	if cdr.CostDetails == nil || cdr.Cost < 0 { // not rated
+		return
+	}
+	atTime := cdr.AnswerTime
+	if atTime.IsZero() {
+		atTime = cdr.SetupTime
+	}
+	var tps TaxProfiles
+	if tps, err = matchingTaxProfilesForEvent(cdrS.dm, cdrS.filterS, cdrS.cgrCfg.TaxSCfg(), cdr.Tenant,
+		utils.MapStorage{
+			utils.MetaReq:  cdr.AsMapStringIface(),
+			utils.MetaOpts: opts,
+		}, atTime); err != nil {
+		if err != utils.ErrNotFound {
+			return
+		}
+		err = nil // no taxes for this CDR
+	}
+	cdr.CostDetails.Taxes = tps.computeTaxes(cdr.Cost)
+	return
+}
+
 // chrgrSProcessEvent forks CGREventWithOpts into multiples based on matching ChargerS profiles
 func (cdrS *CDRServer) chrgrSProcessEvent(cgrEv *utils.CGREvent) (cgrEvs []*utils.CGREvent, err error) {
 	var chrgrs []*ChrgSProcessEventReply
@@ -440,7 +464,7 @@ func (cdrS *CDRServer) eeSProcessEvent(cgrEv *CGREventWithEeIDs) (err error) {
 // processEvent processes a CGREvent based on arguments
 // in case of partially executed, both error and evs will be returned
 func (cdrS *CDRServer) processEvents(evs []*utils.CGREvent,
-	chrgS, attrS, refund, ralS, store, reRate, export, thdS, stS bool) (outEvs []*utils.EventWithFlags, err error) {
+	chrgS, attrS, refund, ralS, store, reRate, export, thdS, stS, taxS bool) (outEvs []*utils.EventWithFlags, err error) {
 	if reRate {
 		refund = true
 	}
@@ -500,7 +524,7 @@ func (cdrS *CDRServer) processEvents(evs []*utils.CGREvent,
 	}
 	// Populate CDR list out of events
 	cdrs := make([]*CDR, len(cgrEvs))
-	if refund || ralS || store || reRate || export {
+	if refund || ralS || store || reRate || export || taxS {
 		for i, cgrEv := range cgrEvs {
 			if refund {
 				if _, has := cgrEv.Event[utils.CostDetails]; !has {
@@ -572,6 +596,19 @@ func (cdrS *CDRServer) processEvents(evs []*utils.CGREvent,
 			}
 		}
 	}
+	if taxS {
+		for i, cdr := range cdrs {
+			if errTx := cdrS.applyTaxes(cdr, cgrEvs[i].APIOpts); errTx != nil {
+				utils.Logger.Warning(
+					fmt.Sprintf("<%s> error: <%s> applying taxes on CDR %+v",
+						utils.CDRs, errTx.Error(), utils.ToJSON(cdr)))
+				continue
+			}
+			if cdr.CostDetails != nil {
+				cgrEvs[i].Event[utils.CostDetails] = cdr.CostDetails
+			}
+		}
+	}
 	if store {
 		refundCDRCosts := func() { // will be used to refund all CDRs on errors
 			for _, cdr := range cdrs { // refund what we have charged since duplicates are not allowed
@@ -727,7 +764,8 @@ func (cdrS *CDRServer) V1ProcessCDR(ctx *context.Context, cdr *CDRWithAPIOpts, r
 		false, // no rerate
 		len(cdrS.cgrCfg.CdrsCfg().OnlineCDRExports) != 0 || len(cdrS.cgrCfg.CdrsCfg().EEsConns) != 0,
 		len(cdrS.cgrCfg.CdrsCfg().ThresholdSConns) != 0,
-		len(cdrS.cgrCfg.CdrsCfg().StatSConns) != 0); err != nil {
+		len(cdrS.cgrCfg.CdrsCfg().StatSConns) != 0,
+		cdrS.cgrCfg.CdrsCfg().Taxes); err != nil {
 		return
 	}
 	*reply = utils.OK
@@ -872,10 +910,19 @@ func (cdrS *CDRServer) V1ProcessEvent(ctx *context.Context, arg *ArgV1ProcessEve
 	if flgs.Has(utils.MetaRefund) {
 		refund = flgs.GetBool(utils.MetaRefund)
 	}
+	taxS := cdrS.cgrCfg.CdrsCfg().Taxes
+	if v, has := arg.APIOpts[utils.OptsTaxS]; has {
+		if taxS, err = utils.IfaceAsBool(v); err != nil {
+			return
+		}
+	}
+	if flgs.Has(utils.MetaTaxes) {
+		taxS = flgs.GetBool(utils.MetaTaxes)
+	}
 	// end of processing options
 
 	if _, err = cdrS.processEvents([]*utils.CGREvent{&arg.CGREvent}, chrgS, attrS, refund,
-		ralS, store, reRate, export, thdS, stS); err != nil {
+		ralS, store, reRate, export, thdS, stS, taxS); err != nil {
 		return
 	}
 	*reply = utils.OK
@@ -948,11 +995,15 @@ func (cdrS *CDRServer) V2ProcessEvent(ctx *context.Context, arg *ArgV1ProcessEve
 	if flgs.Has(utils.MetaRefund) {
 		refund = flgs.GetBool(utils.MetaRefund)
 	}
+	taxS := cdrS.cgrCfg.CdrsCfg().Taxes
+	if flgs.Has(utils.MetaTaxes) {
+		taxS = flgs.GetBool(utils.MetaTaxes)
+	}
 	// end of processing options
 
 	var procEvs []*utils.EventWithFlags
 	if procEvs, err = cdrS.processEvents([]*utils.CGREvent{&arg.CGREvent}, chrgS, attrS, refund,
-		ralS, store, reRate, export, thdS, stS); err != nil {
+		ralS, store, reRate, export, thdS, stS, taxS); err != nil {
 		return
 	}
 	*evs = procEvs
@@ -1111,6 +1162,10 @@ func (cdrS *CDRServer) V1RateCDRs(ctx *context.Context, arg *ArgRateCDRs, reply
 	if flgs.Has(utils.MetaRerate) {
 		reRate = flgs.GetBool(utils.MetaRerate)
 	}
+	taxS := cdrS.cgrCfg.CdrsCfg().Taxes
+	if flgs.Has(utils.MetaTaxes) {
+		taxS = flgs.GetBool(utils.MetaTaxes)
+	}
 
 	if chrgS && len(cdrS.cgrCfg.CdrsCfg().ChargerSConns) == 0 {
 		return utils.NewErrNotConnected(utils.ChargerS)
@@ -1122,7 +1177,7 @@ func (cdrS *CDRServer) V1RateCDRs(ctx *context.Context, arg *ArgRateCDRs, reply
 		cgrEvs[i].APIOpts = arg.APIOpts
 	}
 	if _, err = cdrS.processEvents(cgrEvs, chrgS, attrS, false,
-		true, store, reRate, export, thdS, statS); err != nil {
+		true, store, reRate, export, thdS, statS, taxS); err != nil {
 		return utils.NewErrServerError(err)
 	}
 
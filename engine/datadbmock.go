@@ -463,6 +463,54 @@ func (dbM *DataDBMock) RemoveDispatcherHostDrv(string, string) error {
 	return utils.ErrNotImplemented
 }
 
+func (dbM *DataDBMock) GetTaxProfileDrv(string, string) (*TaxProfile, error) {
+	return nil, utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) SetTaxProfileDrv(*TaxProfile) error {
+	return utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) RemoveTaxProfileDrv(string, string) error {
+	return utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) GetExchangeRateDrv(string, string) (*ExchangeRate, error) {
+	return nil, utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) SetExchangeRateDrv(*ExchangeRate) error {
+	return utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) RemoveExchangeRateDrv(string, string) error {
+	return utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) GetCalendarDrv(string, string) (*Calendar, error) {
+	return nil, utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) SetCalendarDrv(*Calendar) error {
+	return utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) RemoveCalendarDrv(string, string) error {
+	return utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) GetSessionsBackupDrv(string) ([]*StoredSession, error) {
+	return nil, utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) SetSessionBackupDrv(*StoredSession) error {
+	return utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) RemoveSessionBackupDrv(string, string) error {
+	return utils.ErrNotImplemented
+}
+
 func (dbM *DataDBMock) SetVersions(vrs Versions, overwrite bool) (err error) {
 	return utils.ErrNotImplemented
 }
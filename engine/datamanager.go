@@ -39,6 +39,7 @@ var (
 		utils.ThresholdFilterIndexes:  {},
 		utils.RouteFilterIndexes:      {},
 		utils.ChargerFilterIndexes:    {},
+		utils.TaxFilterIndexes:        {},
 		utils.DispatcherFilterIndexes: {},
 		utils.ActionPlanIndexes:       {},
 		utils.FilterIndexPrfx:         {},
@@ -66,6 +67,9 @@ var (
 		utils.ChargerProfilePrefix:     {},
 		utils.DispatcherProfilePrefix:  {},
 		utils.DispatcherHostPrefix:     {},
+		utils.ExchangeRatePrefix:       {},
+		utils.TaxProfilePrefix:         {},
+		utils.CalendarPrefix:           {},
 		utils.MetaDispatchers:          {}, // not realy a prefix as this is not stored in DB
 		utils.AttributeFilterIndexes:   {},
 		utils.ResourceFilterIndexes:    {},
@@ -73,6 +77,7 @@ var (
 		utils.ThresholdFilterIndexes:   {},
 		utils.RouteFilterIndexes:       {},
 		utils.ChargerFilterIndexes:     {},
+		utils.TaxFilterIndexes:         {},
 		utils.DispatcherFilterIndexes:  {},
 		utils.FilterIndexPrfx:          {},
 		utils.MetaAPIBan:               {}, // not realy a prefix as this is not stored in DB
@@ -217,6 +222,15 @@ func (dm *DataManager) CacheDataFromDB(prfx string, ids []string, mustBeCached b
 		case utils.DispatcherHostPrefix:
 			tntID := utils.NewTenantID(dataID)
 			_, err = dm.GetDispatcherHost(tntID.Tenant, tntID.ID, false, true, utils.NonTransactional)
+		case utils.ExchangeRatePrefix:
+			tntID := utils.NewTenantID(dataID)
+			_, err = dm.GetExchangeRate(tntID.Tenant, tntID.ID, false, true, utils.NonTransactional)
+		case utils.TaxProfilePrefix:
+			tntID := utils.NewTenantID(dataID)
+			_, err = dm.GetTaxProfile(tntID.Tenant, tntID.ID, false, true, utils.NonTransactional)
+		case utils.CalendarPrefix:
+			tntID := utils.NewTenantID(dataID)
+			_, err = dm.GetCalendar(tntID.Tenant, tntID.ID, false, true, utils.NonTransactional)
 		case utils.AttributeFilterIndexes:
 			var tntCtx, idxKey string
 			if tntCtx, idxKey, err = splitFilterIndex(dataID); err != nil {
@@ -253,6 +267,12 @@ func (dm *DataManager) CacheDataFromDB(prfx string, ids []string, mustBeCached b
 				return
 			}
 			_, err = dm.GetIndexes(utils.CacheChargerFilterIndexes, tntCtx, idxKey, false, true)
+		case utils.TaxFilterIndexes:
+			var tntCtx, idxKey string
+			if tntCtx, idxKey, err = splitFilterIndex(dataID); err != nil {
+				return
+			}
+			_, err = dm.GetIndexes(utils.CacheTaxFilterIndexes, tntCtx, idxKey, false, true)
 		case utils.DispatcherFilterIndexes:
 			var tntCtx, idxKey string
 			if tntCtx, idxKey, err = splitFilterIndex(dataID); err != nil {
@@ -2769,6 +2789,132 @@ func (dm *DataManager) RemoveChargerProfile(tenant, id string, withIndex bool) (
 	return
 }
 
+func (dm *DataManager) GetTaxProfile(tenant, id string, cacheRead, cacheWrite bool,
+	transactionID string) (tp *TaxProfile, err error) {
+	tntID := utils.ConcatenatedKey(tenant, id)
+	if cacheRead {
+		if x, ok := Cache.Get(utils.CacheTaxProfiles, tntID); ok {
+			if x == nil {
+				return nil, utils.ErrNotFound
+			}
+			return x.(*TaxProfile), nil
+		}
+	}
+	if dm == nil {
+		err = utils.ErrNoDatabaseConn
+		return
+	}
+	tp, err = dm.dataDB.GetTaxProfileDrv(tenant, id)
+	if err != nil {
+		if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaTaxProfiles]; err == utils.ErrNotFound && itm.Remote {
+			if err = dm.connMgr.Call(context.TODO(), config.CgrConfig().DataDbCfg().RmtConns,
+				utils.ReplicatorSv1GetTaxProfile,
+				&utils.TenantIDWithAPIOpts{
+					TenantID: &utils.TenantID{Tenant: tenant, ID: id},
+					APIOpts: utils.GenerateDBItemOpts(itm.APIKey, itm.RouteID, utils.EmptyString,
+						utils.FirstNonEmpty(config.CgrConfig().DataDbCfg().RmtConnID,
+							config.CgrConfig().GeneralCfg().NodeID)),
+				}, &tp); err == nil {
+				err = dm.dataDB.SetTaxProfileDrv(tp)
+			}
+		}
+		if err != nil {
+			err = utils.CastRPCErr(err)
+			if err == utils.ErrNotFound && cacheWrite {
+				if errCh := Cache.Set(utils.CacheTaxProfiles, tntID, nil, nil,
+					cacheCommit(transactionID), transactionID); errCh != nil {
+					return nil, errCh
+				}
+			}
+			return nil, err
+		}
+	}
+	if cacheWrite {
+		if errCh := Cache.Set(utils.CacheTaxProfiles, tntID, tp, nil,
+			cacheCommit(transactionID), transactionID); errCh != nil {
+			return nil, errCh
+		}
+	}
+	return
+}
+
+func (dm *DataManager) SetTaxProfile(tp *TaxProfile, withIndex bool) (err error) {
+	if dm == nil {
+		return utils.ErrNoDatabaseConn
+	}
+	if withIndex {
+		if err = dm.checkFilters(tp.Tenant, tp.FilterIDs); err != nil {
+			// if we get a broken filter do not set the profile
+			return fmt.Errorf("%+s for item with ID: %+v",
+				err, tp.TenantID())
+		}
+	}
+	oldTp, err := dm.GetTaxProfile(tp.Tenant, tp.ID, true, false, utils.NonTransactional)
+	if err != nil && err != utils.ErrNotFound {
+		return err
+	}
+	if err = dm.DataDB().SetTaxProfileDrv(tp); err != nil {
+		return err
+	}
+	if withIndex {
+		var oldFiltersIDs *[]string
+		if oldTp != nil {
+			oldFiltersIDs = &oldTp.FilterIDs
+		}
+		if err := updatedIndexes(dm, utils.CacheTaxFilterIndexes, tp.Tenant,
+			utils.EmptyString, tp.ID, oldFiltersIDs, tp.FilterIDs, false); err != nil {
+			return err
+		}
+	}
+	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaTaxProfiles]; itm.Replicate {
+		err = replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
+			config.CgrConfig().DataDbCfg().RplFiltered,
+			utils.TaxProfilePrefix, tp.TenantID(), // this are used to get the host IDs from cache
+			utils.ReplicatorSv1SetTaxProfile,
+			&TaxProfileWithAPIOpts{
+				TaxProfile: tp,
+				APIOpts: utils.GenerateDBItemOpts(itm.APIKey, itm.RouteID,
+					config.CgrConfig().DataDbCfg().RplCache, utils.EmptyString)})
+	}
+	return
+}
+
+func (dm *DataManager) RemoveTaxProfile(tenant, id string, withIndex bool) (err error) {
+	if dm == nil {
+		return utils.ErrNoDatabaseConn
+	}
+	oldTp, err := dm.GetTaxProfile(tenant, id, true, false, utils.NonTransactional)
+	if err != nil && err != utils.ErrNotFound {
+		return err
+	}
+	if err = dm.DataDB().RemoveTaxProfileDrv(tenant, id); err != nil {
+		return
+	}
+	if oldTp == nil {
+		return utils.ErrNotFound
+	}
+	if withIndex {
+		if err = removeIndexFiltersItem(dm, utils.CacheTaxFilterIndexes, tenant, id, oldTp.FilterIDs); err != nil {
+			return
+		}
+		if err = removeItemFromFilterIndex(dm, utils.CacheTaxFilterIndexes,
+			tenant, utils.EmptyString, id, oldTp.FilterIDs); err != nil {
+			return
+		}
+	}
+	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaTaxProfiles]; itm.Replicate {
+		replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
+			config.CgrConfig().DataDbCfg().RplFiltered,
+			utils.TaxProfilePrefix, utils.ConcatenatedKey(tenant, id), // this are used to get the host IDs from cache
+			utils.ReplicatorSv1RemoveTaxProfile,
+			&utils.TenantIDWithAPIOpts{
+				TenantID: &utils.TenantID{Tenant: tenant, ID: id},
+				APIOpts: utils.GenerateDBItemOpts(itm.APIKey, itm.RouteID,
+					config.CgrConfig().DataDbCfg().RplCache, utils.EmptyString)})
+	}
+	return
+}
+
 func (dm *DataManager) GetDispatcherProfile(tenant, id string, cacheRead, cacheWrite bool,
 	transactionID string) (dpp *DispatcherProfile, err error) {
 	tntID := utils.ConcatenatedKey(tenant, id)
@@ -2999,6 +3145,224 @@ func (dm *DataManager) RemoveDispatcherHost(tenant, id string) (err error) {
 	return
 }
 
+// GetExchangeRate returns the exchange rate with the id FromCurrency:ToCurrency
+func (dm *DataManager) GetExchangeRate(tenant, id string, cacheRead, cacheWrite bool,
+	transactionID string) (er *ExchangeRate, err error) {
+	tntID := utils.ConcatenatedKey(tenant, id)
+	if cacheRead {
+		if x, ok := Cache.Get(utils.CacheExchangeRates, tntID); ok {
+			if x == nil {
+				return nil, utils.ErrNotFound
+			}
+			return x.(*ExchangeRate), nil
+		}
+	}
+	if dm == nil {
+		err = utils.ErrNoDatabaseConn
+		return
+	}
+	er, err = dm.dataDB.GetExchangeRateDrv(tenant, id)
+	if err != nil {
+		if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaExchangeRates]; err == utils.ErrNotFound && itm.Remote {
+			if err = dm.connMgr.Call(context.TODO(), config.CgrConfig().DataDbCfg().RmtConns,
+				utils.ReplicatorSv1GetExchangeRate,
+				&utils.TenantIDWithAPIOpts{
+					TenantID: &utils.TenantID{Tenant: tenant, ID: id},
+					APIOpts: utils.GenerateDBItemOpts(itm.APIKey, itm.RouteID, utils.EmptyString,
+						utils.FirstNonEmpty(config.CgrConfig().DataDbCfg().RmtConnID,
+							config.CgrConfig().GeneralCfg().NodeID)),
+				}, &er); err == nil {
+				err = dm.dataDB.SetExchangeRateDrv(er)
+			}
+		}
+		if err != nil {
+			err = utils.CastRPCErr(err)
+			if err == utils.ErrNotFound && cacheWrite {
+				if errCh := Cache.Set(utils.CacheExchangeRates, tntID, nil, nil,
+					cacheCommit(transactionID), transactionID); errCh != nil {
+					return nil, errCh
+				}
+			}
+			return nil, err
+		}
+	}
+	if cacheWrite {
+		if err = Cache.Set(utils.CacheExchangeRates, tntID, er, nil,
+			cacheCommit(transactionID), transactionID); err != nil {
+			return nil, err
+		}
+	}
+	return
+}
+
+func (dm *DataManager) SetExchangeRate(er *ExchangeRate) (err error) {
+	if dm == nil {
+		return utils.ErrNoDatabaseConn
+	}
+	if err = dm.DataDB().SetExchangeRateDrv(er); err != nil {
+		return
+	}
+	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaExchangeRates]; itm.Replicate {
+		err = replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
+			config.CgrConfig().DataDbCfg().RplFiltered,
+			utils.ExchangeRatePrefix, er.TenantID(), // this are used to get the exchange rate IDs from cache
+			utils.ReplicatorSv1SetExchangeRate,
+			&ExchangeRateWithAPIOpts{
+				ExchangeRate: er,
+				APIOpts: utils.GenerateDBItemOpts(itm.APIKey, itm.RouteID,
+					config.CgrConfig().DataDbCfg().RplCache, utils.EmptyString)})
+	}
+	return
+}
+
+func (dm *DataManager) RemoveExchangeRate(tenant, id string) (err error) {
+	if dm == nil {
+		return utils.ErrNoDatabaseConn
+	}
+	oldEr, err := dm.GetExchangeRate(tenant, id, true, false, utils.NonTransactional)
+	if err != nil && err != utils.ErrNotFound {
+		return err
+	}
+	if err = dm.DataDB().RemoveExchangeRateDrv(tenant, id); err != nil {
+		return
+	}
+	if oldEr == nil {
+		return utils.ErrNotFound
+	}
+	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaExchangeRates]; itm.Replicate {
+		replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
+			config.CgrConfig().DataDbCfg().RplFiltered,
+			utils.ExchangeRatePrefix, utils.ConcatenatedKey(tenant, id), // this are used to get the exchange rate IDs from cache
+			utils.ReplicatorSv1RemoveExchangeRate,
+			&utils.TenantIDWithAPIOpts{
+				TenantID: &utils.TenantID{Tenant: tenant, ID: id},
+				APIOpts: utils.GenerateDBItemOpts(itm.APIKey, itm.RouteID,
+					config.CgrConfig().DataDbCfg().RplCache, utils.EmptyString)})
+	}
+	return
+}
+
+// GetCalendar returns the calendar used by the *calendar filters
+func (dm *DataManager) GetCalendar(tenant, id string, cacheRead, cacheWrite bool,
+	transactionID string) (cal *Calendar, err error) {
+	tntID := utils.ConcatenatedKey(tenant, id)
+	if cacheRead {
+		if x, ok := Cache.Get(utils.CacheCalendars, tntID); ok {
+			if x == nil {
+				return nil, utils.ErrNotFound
+			}
+			return x.(*Calendar), nil
+		}
+	}
+	if dm == nil {
+		err = utils.ErrNoDatabaseConn
+		return
+	}
+	cal, err = dm.dataDB.GetCalendarDrv(tenant, id)
+	if err != nil {
+		if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaCalendars]; err == utils.ErrNotFound && itm.Remote {
+			if err = dm.connMgr.Call(context.TODO(), config.CgrConfig().DataDbCfg().RmtConns,
+				utils.ReplicatorSv1GetCalendar,
+				&utils.TenantIDWithAPIOpts{
+					TenantID: &utils.TenantID{Tenant: tenant, ID: id},
+					APIOpts: utils.GenerateDBItemOpts(itm.APIKey, itm.RouteID, utils.EmptyString,
+						utils.FirstNonEmpty(config.CgrConfig().DataDbCfg().RmtConnID,
+							config.CgrConfig().GeneralCfg().NodeID)),
+				}, &cal); err == nil {
+				err = dm.dataDB.SetCalendarDrv(cal)
+			}
+		}
+		if err != nil {
+			err = utils.CastRPCErr(err)
+			if err == utils.ErrNotFound && cacheWrite {
+				if errCh := Cache.Set(utils.CacheCalendars, tntID, nil, nil,
+					cacheCommit(transactionID), transactionID); errCh != nil {
+					return nil, errCh
+				}
+			}
+			return nil, err
+		}
+	}
+	if cacheWrite {
+		if err = Cache.Set(utils.CacheCalendars, tntID, cal, nil,
+			cacheCommit(transactionID), transactionID); err != nil {
+			return nil, err
+		}
+	}
+	return
+}
+
+func (dm *DataManager) SetCalendar(cal *Calendar) (err error) {
+	if dm == nil {
+		return utils.ErrNoDatabaseConn
+	}
+	if err = dm.DataDB().SetCalendarDrv(cal); err != nil {
+		return
+	}
+	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaCalendars]; itm.Replicate {
+		err = replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
+			config.CgrConfig().DataDbCfg().RplFiltered,
+			utils.CalendarPrefix, cal.TenantID(), // this are used to get the calendar IDs from cache
+			utils.ReplicatorSv1SetCalendar,
+			&CalendarWithAPIOpts{
+				Calendar: cal,
+				APIOpts: utils.GenerateDBItemOpts(itm.APIKey, itm.RouteID,
+					config.CgrConfig().DataDbCfg().RplCache, utils.EmptyString)})
+	}
+	return
+}
+
+func (dm *DataManager) RemoveCalendar(tenant, id string) (err error) {
+	if dm == nil {
+		return utils.ErrNoDatabaseConn
+	}
+	oldCal, err := dm.GetCalendar(tenant, id, true, false, utils.NonTransactional)
+	if err != nil && err != utils.ErrNotFound {
+		return err
+	}
+	if err = dm.DataDB().RemoveCalendarDrv(tenant, id); err != nil {
+		return
+	}
+	if oldCal == nil {
+		return utils.ErrNotFound
+	}
+	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaCalendars]; itm.Replicate {
+		replicate(dm.connMgr, config.CgrConfig().DataDbCfg().RplConns,
+			config.CgrConfig().DataDbCfg().RplFiltered,
+			utils.CalendarPrefix, utils.ConcatenatedKey(tenant, id), // this are used to get the calendar IDs from cache
+			utils.ReplicatorSv1RemoveCalendar,
+			&utils.TenantIDWithAPIOpts{
+				TenantID: &utils.TenantID{Tenant: tenant, ID: id},
+				APIOpts: utils.GenerateDBItemOpts(itm.APIKey, itm.RouteID,
+					config.CgrConfig().DataDbCfg().RplCache, utils.EmptyString)})
+	}
+	return
+}
+
+// GetSessionsBackup returns the sessions backed up by the node
+func (dm *DataManager) GetSessionsBackup(nodeID string) (sss []*StoredSession, err error) {
+	if dm == nil {
+		return nil, utils.ErrNoDatabaseConn
+	}
+	return dm.DataDB().GetSessionsBackupDrv(nodeID)
+}
+
+// SetSessionBackup stores the session, replacing the previous backup
+func (dm *DataManager) SetSessionBackup(ss *StoredSession) (err error) {
+	if dm == nil {
+		return utils.ErrNoDatabaseConn
+	}
+	return dm.DataDB().SetSessionBackupDrv(ss)
+}
+
+// RemoveSessionBackup removes the backup of the session, not found errors are ignored
+func (dm *DataManager) RemoveSessionBackup(nodeID, cgrID string) (err error) {
+	if dm == nil {
+		return utils.ErrNoDatabaseConn
+	}
+	return dm.DataDB().RemoveSessionBackupDrv(nodeID, cgrID)
+}
+
 func (dm *DataManager) GetItemLoadIDs(itemIDPrefix string, cacheWrite bool) (loadIDs map[string]int64, err error) {
 	if dm == nil {
 		err = utils.ErrNoDatabaseConn
@@ -3072,11 +3436,21 @@ func (dm *DataManager) SetLoadIDs(loadIDs map[string]int64) (err error) {
 
 // Reconnect reconnects to the DB when the config was changed
 func (dm *DataManager) Reconnect(marshaller string, newcfg *config.DataDbCfg, itmsCfg map[string]*config.ItemOpt) (err error) {
+	iDB, isInternal := dm.dataDB.(*InternalDB)
+	if isInternal && iDB.db.dmp != nil {
+		// write the last dump before the new connection restores it
+		if err = iDB.Dump(); err != nil {
+			return
+		}
+	}
 	d, err := NewDataDBConn(newcfg.Type, newcfg.Host, newcfg.Port, newcfg.Name,
 		newcfg.User, newcfg.Password, marshaller, newcfg.Opts, itmsCfg)
 	if err != nil {
 		return
 	}
+	if isInternal { // the files are taken over by the new connection so no final dump
+		iDB.StopDumper()
+	}
 	// ToDo: consider locking
 	dm.dataDB.Close()
 	dm.dataDB = d
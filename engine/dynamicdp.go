@@ -20,6 +20,8 @@ package engine
 
 import (
 	"fmt"
+	"math"
+	"strings"
 
 	"github.com/nyaruka/phonenumbers"
 
@@ -28,12 +30,14 @@ import (
 	"github.com/cgrates/cgrates/utils"
 )
 
-func newDynamicDP(resConns, stsConns, apiConns []string,
+func newDynamicDP(resConns, stsConns, apiConns, thdConns, rtsConns []string,
 	tenant string, initialDP utils.DataProvider) *dynamicDP {
 	return &dynamicDP{
 		resConns:  resConns,
 		stsConns:  stsConns,
 		apiConns:  apiConns,
+		thdConns:  thdConns,
+		rtsConns:  rtsConns,
 		tenant:    tenant,
 		initialDP: initialDP,
 		cache:     utils.MapStorage{},
@@ -44,6 +48,8 @@ type dynamicDP struct {
 	resConns  []string
 	stsConns  []string
 	apiConns  []string
+	thdConns  []string
+	rtsConns  []string
 	tenant    string
 	initialDP utils.DataProvider
 
@@ -81,6 +87,42 @@ func (dDP *dynamicDP) FieldAsInterface(fldPath []string) (val any, err error) {
 	return
 }
 
+// routesEvent builds the CGREvent used to query the routes out of the *req
+// and *opts of the initial DataProvider
+func (dDP *dynamicDP) routesEvent() (ev *utils.CGREvent, err error) {
+	ev = &utils.CGREvent{
+		Tenant:  dDP.tenant,
+		ID:      utils.UUIDSha1Prefix(),
+		APIOpts: make(map[string]any),
+	}
+	var req any
+	if req, err = dDP.initialDP.FieldAsInterface([]string{utils.MetaReq}); err != nil {
+		return
+	}
+	switch evReq := req.(type) {
+	case utils.MapStorage:
+		ev.Event = evReq.Clone()
+	case map[string]any:
+		ev.Event = utils.MapStorage(evReq).Clone()
+	default:
+		return nil, fmt.Errorf("unsupported event type <%T> for %s", req, utils.MetaRoutes)
+	}
+	if opts, err := dDP.initialDP.FieldAsInterface([]string{utils.MetaOpts}); err == nil {
+		switch evOpts := opts.(type) {
+		case utils.MapStorage:
+			for k, v := range evOpts {
+				ev.APIOpts[k] = v
+			}
+		case map[string]any:
+			for k, v := range evOpts {
+				ev.APIOpts[k] = v
+			}
+		}
+	}
+	ev.APIOpts[utils.OptsRoutesProfileCount] = math.MaxInt32 // query all the matching profiles so we can return the requested one
+	return
+}
+
 func (dDP *dynamicDP) fieldAsInterface(fldPath []string) (val any, err error) {
 	if len(fldPath) < 2 {
 		return nil, fmt.Errorf("invalid fieldname <%s>", fldPath)
@@ -122,6 +164,38 @@ func (dDP *dynamicDP) fieldAsInterface(fldPath []string) (val any, err error) {
 			dDP.cache.Set([]string{utils.MetaStats, fldPath[1], k}, v)
 		}
 		return dDP.cache.FieldAsInterface(fldPath)
+	case utils.MetaThresholds:
+		// sample of fieldName : ~*thresholds.ThresholdID.Hits
+		var thd Threshold
+		if err := connMgr.Call(context.TODO(), dDP.thdConns, utils.ThresholdSv1GetThreshold,
+			&utils.TenantIDWithAPIOpts{TenantID: &utils.TenantID{Tenant: dDP.tenant, ID: fldPath[1]}}, &thd); err != nil {
+			return nil, err
+		}
+		dp := config.NewObjectDP(&thd)
+		dDP.cache.Set(fldPath[:2], dp)
+		return dp.FieldAsInterface(fldPath[2:])
+	case utils.MetaRoutes:
+		// sample of fieldName : ~*routes.RouteProfileID.RouteID.Cost
+		// the routes are sorted for the event, the route fields coming out of the SortingData
+		ev, err := dDP.routesEvent()
+		if err != nil {
+			return nil, err
+		}
+		var sRoutes SortedRoutesList
+		if err := connMgr.Call(context.TODO(), dDP.rtsConns, utils.RouteSv1GetRoutes,
+			ev, &sRoutes); err != nil {
+			return nil, err
+		}
+		for _, sRts := range sRoutes {
+			for _, sRt := range sRts.Routes {
+				rt := utils.MapStorage{utils.RouteParameters: sRt.RouteParameters}
+				for k, v := range sRt.SortingData {
+					rt[k] = v
+				}
+				dDP.cache.Set([]string{utils.MetaRoutes, sRts.ProfileID, sRt.RouteID}, rt)
+			}
+		}
+		return dDP.cache.FieldAsInterface(fldPath)
 	case utils.MetaLibPhoneNumber:
 		// sample of fieldName ~*libphonenumber.<~*req.Destination>
 		// or ~*libphonenumber.<~*req.Destination>.Carrier
@@ -143,11 +217,94 @@ func (dDP *dynamicDP) fieldAsInterface(fldPath []string) (val any, err error) {
 		}
 		dDP.cache.Set(fldPath[:1], acntSummary)
 		return acntSummary.FieldAsInterface(fldPath[1:])
+	case utils.MetaHTTP:
+		// sample of fieldName ~*http.MNP.Carrier
+		// where MNP is the ID of the http profile from the filters config
+		dp := NewHTTPLookupDP(dDP)
+		dDP.cache.Set(fldPath[:1], dp)
+		return dp.FieldAsInterface(fldPath[1:])
+	case utils.MetaGeoIP:
+		// sample of fieldName ~*geoip.<~*req.SourceIP>.Country
+		dp := NewGeoIPDP()
+		dDP.cache.Set(fldPath[:1], dp)
+		return dp.FieldAsInterface(fldPath[1:])
 	default: // in case of constant we give an empty DataProvider ( empty navigable map )
 	}
 	return nil, utils.ErrNotFound
 }
 
+// NewHTTPLookupDP returns the DataProvider used for the *http lookups,
+// building the URLs of the http profiles out of the given DataProvider
+func NewHTTPLookupDP(dP utils.DataProvider) utils.DataProvider {
+	return &httpLookupDP{initialDP: dP, cache: make(utils.MapStorage)}
+}
+
+type httpLookupDP struct {
+	initialDP utils.DataProvider
+	cache     utils.MapStorage
+}
+
+func (hDP *httpLookupDP) String() string { return hDP.cache.String() }
+
+func (hDP *httpLookupDP) FieldAsString(fldPath []string) (string, error) {
+	val, err := hDP.FieldAsInterface(fldPath)
+	if err != nil {
+		return "", err
+	}
+	return utils.IfaceAsString(val), nil
+}
+
+// FieldAsInterface queries the http profile at the first use and
+// navigates the remaining path into its reply
+func (hDP *httpLookupDP) FieldAsInterface(fldPath []string) (val any, err error) {
+	if len(fldPath) == 0 {
+		return nil, utils.ErrNotFound
+	}
+	if _, has := hDP.cache[fldPath[0]]; !has {
+		if val, err = httpLookup(fldPath[0], hDP.initialDP); err != nil {
+			return
+		}
+		hDP.cache[fldPath[0]] = val
+	}
+	return hDP.cache.FieldAsInterface(fldPath)
+}
+
+// NewGeoIPDP returns the DataProvider used for the *geoip lookups
+func NewGeoIPDP() utils.DataProvider {
+	return &geoIPDP{cache: make(map[string]utils.MapStorage)}
+}
+
+type geoIPDP struct {
+	cache map[string]utils.MapStorage
+}
+
+func (gDP *geoIPDP) String() string { return utils.ToJSON(gDP.cache) }
+
+func (gDP *geoIPDP) FieldAsString(fldPath []string) (string, error) {
+	val, err := gDP.FieldAsInterface(fldPath)
+	if err != nil {
+		return "", err
+	}
+	return utils.IfaceAsString(val), nil
+}
+
+// FieldAsInterface looks up the IP at the first use, the IPv4 addresses being
+// split on dots the IP is the whole path up to the queried field
+func (gDP *geoIPDP) FieldAsInterface(fldPath []string) (val any, err error) {
+	if len(fldPath) < 2 {
+		return nil, fmt.Errorf("invalid geoip field path <%+v>", fldPath)
+	}
+	ip := strings.Join(fldPath[:len(fldPath)-1], utils.NestingSep)
+	geo, has := gDP.cache[ip]
+	if !has {
+		if geo, err = GetGeoIP(ip); err != nil {
+			return
+		}
+		gDP.cache[ip] = geo
+	}
+	return geo.FieldAsInterface(fldPath[len(fldPath)-1:])
+}
+
 func newLibPhoneNumberDP(number string) (dp utils.DataProvider, err error) {
 	num, err := phonenumbers.ParseAndKeepRawInput(number, utils.EmptyString)
 	if err != nil {
@@ -19,11 +19,20 @@ package engine
 
 import (
 	"bytes"
+	"encoding/binary"
+	"fmt"
 	"log"
+	"math"
+	"net"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path"
 	"reflect"
+	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/cgrates/birpc"
 	"github.com/cgrates/birpc/context"
@@ -35,7 +44,7 @@ import (
 func TestDynamicDpFieldAsInterface(t *testing.T) {
 	cfg := config.NewDefaultCGRConfig()
 	ms := utils.MapStorage{}
-	dDp := newDynamicDP([]string{}, []string{utils.ConcatenatedKey(utils.MetaInternal, utils.StatSConnsCfg)}, []string{}, "cgrates.org", ms)
+	dDp := newDynamicDP([]string{}, []string{utils.ConcatenatedKey(utils.MetaInternal, utils.StatSConnsCfg)}, []string{}, nil, nil, "cgrates.org", ms)
 	clientconn := make(chan birpc.ClientConnector, 1)
 	clientconn <- &ccMock{
 		calls: map[string]func(ctx *context.Context, args any, reply any) error{
@@ -271,3 +280,345 @@ func TestNewLibPhoneNumberDPErr(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestDynamicDPHTTPLookup(t *testing.T) {
+	var hits int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		if r.Header.Get(utils.AuthorizationHdr) != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Query().Get("number") {
+		case "1002":
+			w.Write([]byte(`{"Carrier":"CARRIER1","Ported":true,"Ranges":[{"Prefix":"49"}]}`))
+		case "1003":
+			w.Write([]byte("gold\n"))
+		case "+49 30&x=1#2":
+			w.Write([]byte("silver"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+	tmpCfg, tmpCache := config.CgrConfig(), Cache
+	defer func() {
+		config.SetCgrConfig(tmpCfg)
+		Cache = tmpCache
+	}()
+	cfg := config.NewDefaultCGRConfig()
+	cfg.FilterSCfg().HTTPProfiles = map[string]*config.HTTPProfile{
+		"MNP": {
+			URL:     config.NewRSRParsersMustCompile(ts.URL+"/mnp?number=;~*req.Destination", utils.InfieldSep),
+			Headers: map[string]string{utils.AuthorizationHdr: "Bearer token"},
+		},
+	}
+	config.SetCgrConfig(cfg)
+	Cache = NewCacheS(cfg, nil, nil)
+
+	dDP := newDynamicDP(nil, nil, nil, nil, nil, "cgrates.org",
+		utils.MapStorage{utils.MetaReq: utils.MapStorage{utils.Destination: "1002"}})
+	if val, err := dDP.FieldAsString([]string{utils.MetaHTTP, "MNP", "Carrier"}); err != nil {
+		t.Error(err)
+	} else if val != "CARRIER1" {
+		t.Errorf("Expected CARRIER1, received %q", val)
+	}
+	if val, err := dDP.FieldAsInterface([]string{utils.MetaHTTP, "MNP", "Ranges[0]", "Prefix"}); err != nil {
+		t.Error(err)
+	} else if val != "49" {
+		t.Errorf("Expected 49, received %v", val)
+	}
+	if _, err := dDP.FieldAsInterface([]string{utils.MetaHTTP, "MNP", "Missing"}); err != utils.ErrNotFound {
+		t.Errorf("Expected %v, received %v", utils.ErrNotFound, err)
+	}
+	if _, err := dDP.FieldAsInterface([]string{utils.MetaHTTP, "UNKNOWN", "Carrier"}); err == nil {
+		t.Error("Expected error for the unknown profile")
+	}
+	// a new event for the same URL is served out of the *http_lookups cache
+	dDP = newDynamicDP(nil, nil, nil, nil, nil, "cgrates.org",
+		utils.MapStorage{utils.MetaReq: utils.MapStorage{utils.Destination: "1002"}})
+	if val, err := dDP.FieldAsInterface([]string{utils.MetaHTTP, "MNP", "Ported"}); err != nil {
+		t.Error(err)
+	} else if val != true {
+		t.Errorf("Expected true, received %v", val)
+	}
+	if hits != 1 {
+		t.Errorf("Expected 1 request, received %d", hits)
+	}
+
+	dDP = newDynamicDP(nil, nil, nil, nil, nil, "cgrates.org",
+		utils.MapStorage{utils.MetaReq: utils.MapStorage{utils.Destination: "1003"}})
+	if val, err := dDP.FieldAsInterface([]string{utils.MetaHTTP, "MNP"}); err != nil {
+		t.Error(err)
+	} else if val != "gold" {
+		t.Errorf("Expected gold, received %v", val)
+	}
+	// the event values are escaped inside the URL
+	dDP = newDynamicDP(nil, nil, nil, nil, nil, "cgrates.org",
+		utils.MapStorage{utils.MetaReq: utils.MapStorage{utils.Destination: "+49 30&x=1#2"}})
+	if val, err := dDP.FieldAsInterface([]string{utils.MetaHTTP, "MNP"}); err != nil {
+		t.Error(err)
+	} else if val != "silver" {
+		t.Errorf("Expected silver, received %v", val)
+	}
+	dDP = newDynamicDP(nil, nil, nil, nil, nil, "cgrates.org",
+		utils.MapStorage{utils.MetaReq: utils.MapStorage{utils.Destination: "1004"}})
+	for i := 0; i < 2; i++ {
+		if _, err := dDP.FieldAsInterface([]string{utils.MetaHTTP, "MNP", "Carrier"}); err != utils.ErrNotFound {
+			t.Errorf("Expected %v, received %v", utils.ErrNotFound, err)
+		}
+	}
+	if hits != 4 {
+		t.Errorf("Expected 4 requests, received %d", hits)
+	}
+
+	dm := NewDataManager(NewInternalDB(nil, nil, true, cfg.DataDbCfg().Items), cfg.CacheCfg(), nil)
+	fltrS := NewFilterS(cfg, nil, dm)
+	if pass, err := fltrS.Pass("cgrates.org", []string{"*string:~*http.MNP.Carrier:CARRIER1"},
+		utils.MapStorage{utils.MetaReq: utils.MapStorage{utils.Destination: "1002"}}); err != nil {
+		t.Error(err)
+	} else if !pass {
+		t.Error("Expected the filter to pass")
+	}
+}
+
+func TestDynamicDPThresholdsRoutes(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	thdConn := utils.ConcatenatedKey(utils.MetaInternal, utils.MetaThresholds)
+	rtsConn := utils.ConcatenatedKey(utils.MetaInternal, utils.MetaRoutes)
+	snooze := time.Date(2021, 1, 1, 10, 0, 0, 0, time.UTC)
+	var rtsCalls int
+	clientConn := make(chan birpc.ClientConnector, 1)
+	clientConn <- &ccMock{
+		calls: map[string]func(ctx *context.Context, args any, reply any) error{
+			utils.ThresholdSv1GetThreshold: func(ctx *context.Context, args, reply any) error {
+				if tntID := args.(*utils.TenantIDWithAPIOpts); tntID.ID != "THD_1" {
+					return utils.ErrNotFound
+				}
+				*reply.(*Threshold) = Threshold{Tenant: "cgrates.org", ID: "THD_1", Hits: 3, Snooze: snooze}
+				return nil
+			},
+			utils.RouteSv1GetRoutes: func(ctx *context.Context, args, reply any) error {
+				rtsCalls++
+				ev := args.(*utils.CGREvent)
+				if ev.Event[utils.Destination] != "1002" {
+					return utils.ErrNotFound
+				}
+				if ev.APIOpts[utils.OptsRoutesProfileCount] != math.MaxInt32 {
+					return fmt.Errorf("unexpected profile count: %v", ev.APIOpts[utils.OptsRoutesProfileCount])
+				}
+				*reply.(*SortedRoutesList) = SortedRoutesList{
+					{ProfileID: "ROUTE_LCR", Sorting: utils.MetaLC, Routes: []*SortedRoute{
+						{RouteID: "route1", RouteParameters: "param1", SortingData: map[string]any{utils.Cost: 0.1, utils.Weight: 10.}},
+						{RouteID: "route2", SortingData: map[string]any{utils.Cost: 0.2, utils.Weight: 20.}},
+					}},
+				}
+				return nil
+			},
+		},
+	}
+	tmpConnMgr := connMgr
+	defer SetConnManager(tmpConnMgr)
+	SetConnManager(NewConnManager(cfg, map[string]chan birpc.ClientConnector{
+		thdConn: clientConn,
+		rtsConn: clientConn,
+	}))
+
+	dDP := newDynamicDP(nil, nil, nil, []string{thdConn}, []string{rtsConn}, "cgrates.org",
+		utils.MapStorage{utils.MetaReq: map[string]any{utils.Destination: "1002"}})
+	if val, err := dDP.FieldAsInterface([]string{utils.MetaThresholds, "THD_1", "Hits"}); err != nil {
+		t.Error(err)
+	} else if val != 3 {
+		t.Errorf("Expected 3, received %v", val)
+	}
+	if val, err := dDP.FieldAsInterface([]string{utils.MetaThresholds, "THD_1", "Snooze"}); err != nil {
+		t.Error(err)
+	} else if val != snooze {
+		t.Errorf("Expected %v, received %v", snooze, val)
+	}
+	if _, err := dDP.FieldAsInterface([]string{utils.MetaThresholds, "THD_2", "Hits"}); err != utils.ErrNotFound {
+		t.Errorf("Expected %v, received %v", utils.ErrNotFound, err)
+	}
+	if val, err := dDP.FieldAsInterface([]string{utils.MetaRoutes, "ROUTE_LCR", "route1", utils.Cost}); err != nil {
+		t.Error(err)
+	} else if val != 0.1 {
+		t.Errorf("Expected 0.1, received %v", val)
+	}
+	if val, err := dDP.FieldAsString([]string{utils.MetaRoutes, "ROUTE_LCR", "route1", utils.RouteParameters}); err != nil {
+		t.Error(err)
+	} else if val != "param1" {
+		t.Errorf("Expected param1, received %v", val)
+	}
+	if val, err := dDP.FieldAsInterface([]string{utils.MetaRoutes, "ROUTE_LCR", "route2", utils.Weight}); err != nil {
+		t.Error(err)
+	} else if val != 20. {
+		t.Errorf("Expected 20, received %v", val)
+	}
+	if rtsCalls != 1 {
+		t.Errorf("Expected the routes to be queried once, received %d", rtsCalls)
+	}
+
+	dDP = newDynamicDP(nil, nil, nil, []string{thdConn}, []string{rtsConn}, "cgrates.org",
+		utils.MapStorage{utils.MetaReq: map[string]any{utils.Destination: "1003"}})
+	if _, err := dDP.FieldAsInterface([]string{utils.MetaRoutes, "ROUTE_LCR", "route1", utils.Cost}); err != utils.ErrNotFound {
+		t.Errorf("Expected %v, received %v", utils.ErrNotFound, err)
+	}
+}
+
+// mmdbEncode writes the value in the data section format of the MaxMind DB files
+func mmdbEncode(buf *bytes.Buffer, val any) {
+	ctrl := func(typ, size int) {
+		if size < 29 {
+			buf.WriteByte(byte(typ<<5 | size))
+			return
+		}
+		buf.WriteByte(byte(typ<<5 | 29)) // sizes up to 284 are stored in the next byte
+		buf.WriteByte(byte(size - 29))
+	}
+	switch v := val.(type) {
+	case string:
+		ctrl(2, len(v))
+		buf.WriteString(v)
+	case float64:
+		ctrl(3, 8)
+		binary.Write(buf, binary.BigEndian, v)
+	case uint32:
+		ctrl(6, 4)
+		binary.Write(buf, binary.BigEndian, v)
+	case map[string]any:
+		ctrl(7, len(v))
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			mmdbEncode(buf, k)
+			mmdbEncode(buf, v[k])
+		}
+	}
+}
+
+// writeTestGeoIPDB writes an IPv4 MaxMind DB with 32 bits records
+// holding the given records for their networks
+func writeTestGeoIPDB(t *testing.T, fPath string, recs map[string]map[string]any) {
+	t.Helper()
+	// the children are 0 for empty, the index of the next node or -(data offset+1)
+	nodes := [][2]int{{}}
+	data := new(bytes.Buffer)
+	for cidr, rec := range recs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ones, _ := ipNet.Mask.Size()
+		ip := ipNet.IP.To4()
+		var node int
+		for i := 0; i < ones; i++ {
+			bit := int(ip[i/8]>>(7-i%8)) & 1
+			if i == ones-1 {
+				nodes[node][bit] = -(data.Len() + 1)
+				break
+			}
+			if nodes[node][bit] == 0 {
+				nodes = append(nodes, [2]int{})
+				nodes[node][bit] = len(nodes) - 1
+			}
+			node = nodes[node][bit]
+		}
+		mmdbEncode(data, rec)
+	}
+	buf := new(bytes.Buffer)
+	for _, node := range nodes {
+		for _, child := range node {
+			switch {
+			case child == 0:
+				child = len(nodes)
+			case child < 0:
+				child = len(nodes) + 16 - child - 1
+			}
+			binary.Write(buf, binary.BigEndian, uint32(child))
+		}
+	}
+	buf.Write(make([]byte, 16))
+	buf.Write(data.Bytes())
+	buf.WriteString("\xAB\xCD\xEFMaxMind.com")
+	mmdbEncode(buf, map[string]any{
+		"binary_format_major_version": uint32(2),
+		"database_type":               "Test-City",
+		"ip_version":                  uint32(4),
+		"node_count":                  uint32(len(nodes)),
+		"record_size":                 uint32(32),
+	})
+	if err := os.WriteFile(fPath, buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDynamicDPGeoIP(t *testing.T) {
+	tmpCfg, tmpGeoIP := config.CgrConfig(), geoIP
+	defer func() {
+		config.SetCgrConfig(tmpCfg)
+		geoIP = tmpGeoIP
+	}()
+	geoIP = new(geoIPDB)
+	cfg := config.NewDefaultCGRConfig()
+	config.SetCgrConfig(cfg)
+	dDP := newDynamicDP(nil, nil, nil, nil, nil, "cgrates.org",
+		utils.MapStorage{utils.MetaReq: utils.MapStorage{"SourceIP": "1.1.1.7"}})
+	if _, err := dDP.FieldAsInterface([]string{utils.MetaGeoIP, "1", "1", "1", "7", "Country"}); err == nil ||
+		err.Error() != "no geoip_db_path configured" {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	dbPath := path.Join(t.TempDir(), "GeoIP2-City.mmdb")
+	writeTestGeoIPDB(t, dbPath, map[string]map[string]any{
+		"1.1.1.0/24": {
+			"country":                        map[string]any{"iso_code": "DE"},
+			"continent":                      map[string]any{"code": "EU"},
+			"city":                           map[string]any{"names": map[string]any{"en": "Berlin"}},
+			"location":                       map[string]any{"latitude": 52.52, "longitude": 13.405, "time_zone": "Europe/Berlin"},
+			"autonomous_system_number":       uint32(3320),
+			"autonomous_system_organization": "Deutsche Telekom AG",
+		},
+		"2.2.0.0/16": {
+			"country": map[string]any{"iso_code": "AT"},
+		},
+	})
+	cfg.FilterSCfg().GeoIPDBPath = dbPath
+	for fld, exp := range map[string]string{
+		"~*geoip.<~*req.SourceIP>.Country":        "DE",
+		"~*geoip.<~*req.SourceIP>.City":           "Berlin",
+		"~*geoip.<~*req.SourceIP>.ASN":            "3320",
+		"~*geoip.<~*req.SourceIP>.ASOrganization": "Deutsche Telekom AG",
+		"~*geoip.<~*req.SourceIP>.TimeZone":       "Europe/Berlin",
+		"~*geoip.2.2.200.1.Country":               "AT",
+	} {
+		if rcv, err := config.NewRSRParserMustCompile(fld).ParseDataProvider(dDP); err != nil {
+			t.Errorf("%s: %v", fld, err)
+		} else if rcv != exp {
+			t.Errorf("%s: expected %q, received %q", fld, exp, rcv)
+		}
+	}
+	if _, err := dDP.FieldAsInterface([]string{utils.MetaGeoIP, "2", "2", "200", "1", "City"}); err != utils.ErrNotFound {
+		t.Errorf("Expected %v, received %v", utils.ErrNotFound, err)
+	}
+	if _, err := dDP.FieldAsInterface([]string{utils.MetaGeoIP, "3", "3", "3", "3", "Country"}); err != utils.ErrNotFound {
+		t.Errorf("Expected %v, received %v", utils.ErrNotFound, err)
+	}
+
+	// replacing the file reloads the database
+	writeTestGeoIPDB(t, dbPath, map[string]map[string]any{
+		"1.1.0.0/16": {"country": map[string]any{"iso_code": "FR"}},
+	})
+	modTime := time.Now().Add(time.Minute)
+	if err := os.Chtimes(dbPath, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+	dDP = newDynamicDP(nil, nil, nil, nil, nil, "cgrates.org", utils.MapStorage{})
+	if rcv, err := dDP.FieldAsString([]string{utils.MetaGeoIP, "1", "1", "1", "7", "Country"}); err != nil {
+		t.Error(err)
+	} else if rcv != "FR" {
+		t.Errorf("Expected FR, received %q", rcv)
+	}
+}
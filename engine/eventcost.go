@@ -98,10 +98,11 @@ func (ec *EventCost) newChargingIncrement(incr *Increment, rf RatingMatchedFilte
 				rateID = ec.ratingIDForRateInterval(incr.BalanceInfo.Monetary.RateInterval, rf, isPause)
 			}
 			bc := &BalanceCharge{
-				AccountID:   incr.BalanceInfo.AccountID,
-				BalanceUUID: incr.BalanceInfo.Monetary.UUID,
-				Units:       incr.Cost,
-				RatingID:    rateID,
+				AccountID:    incr.BalanceInfo.AccountID,
+				BalanceUUID:  incr.BalanceInfo.Monetary.UUID,
+				Units:        incr.Cost,
+				RatingID:     rateID,
+				ExchangeRate: incr.BalanceInfo.Monetary.ExchangeRate,
 			}
 			if isPause {
 				ecUUID = utils.MetaPause
@@ -131,10 +132,11 @@ func (ec *EventCost) newChargingIncrement(incr *Increment, rf RatingMatchedFilte
 			rateID = ec.ratingIDForRateInterval(incr.BalanceInfo.Monetary.RateInterval, rf, isPause)
 		}
 		bc := &BalanceCharge{
-			AccountID:   incr.BalanceInfo.AccountID,
-			BalanceUUID: incr.BalanceInfo.Monetary.UUID,
-			Units:       incr.Cost,
-			RatingID:    rateID,
+			AccountID:    incr.BalanceInfo.AccountID,
+			BalanceUUID:  incr.BalanceInfo.Monetary.UUID,
+			Units:        incr.Cost,
+			RatingID:     rateID,
+			ExchangeRate: incr.BalanceInfo.Monetary.ExchangeRate,
 		}
 		if isPause {
 			cIt.AccountingID = utils.MetaPause
@@ -160,6 +162,7 @@ type EventCost struct {
 	RatingFilters  RatingFilters
 	Rates          ChargedRates
 	Timings        ChargedTimings
+	Taxes          ECTaxes `json:",omitempty"` // the taxes applied on the Cost
 
 	cache *utils.SecureMapStorage
 }
@@ -281,6 +284,7 @@ func (ec *EventCost) Clone() (cln *EventCost) {
 	if ec.Timings != nil {
 		cln.Timings = ec.Timings.Clone()
 	}
+	cln.Taxes = ec.Taxes.Clone()
 	return
 }
 
@@ -371,7 +375,8 @@ func (ec *EventCost) AsRefundIncrements(tor string) (cd *CallDescriptor) {
 					}
 					blncSmry := ec.AccountSummary.BalanceSummaries.BalanceSummaryWithUUD(ec.Accounting[cIcrm.AccountingID].BalanceUUID)
 					if blncSmry.Type == utils.MetaMonetary {
-						cd.Increments[iIdx].BalanceInfo.Monetary = &MonetaryInfo{UUID: blncSmry.UUID}
+						cd.Increments[iIdx].BalanceInfo.Monetary = &MonetaryInfo{UUID: blncSmry.UUID,
+							ExchangeRate: ec.Accounting[cIcrm.AccountingID].ExchangeRate}
 					} else if utils.NonMonetaryBalances.Has(blncSmry.Type) {
 						cd.Increments[iIdx].BalanceInfo.Unit = &UnitInfo{UUID: blncSmry.UUID}
 					}
@@ -384,7 +389,8 @@ func (ec *EventCost) AsRefundIncrements(tor string) (cd *CallDescriptor) {
 					extraSmry := ec.AccountSummary.BalanceSummaries.BalanceSummaryWithUUD(
 						ec.Accounting[ec.Accounting[cIcrm.AccountingID].ExtraChargeID].BalanceUUID)
 					if extraSmry.Type == utils.MetaMonetary {
-						cd.Increments[iIdx].BalanceInfo.Monetary = &MonetaryInfo{UUID: extraSmry.UUID}
+						cd.Increments[iIdx].BalanceInfo.Monetary = &MonetaryInfo{UUID: extraSmry.UUID,
+							ExchangeRate: ec.Accounting[ec.Accounting[cIcrm.AccountingID].ExtraChargeID].ExchangeRate}
 					} else if utils.NonMonetaryBalances.Has(blncSmry.Type) {
 						cd.Increments[iIdx].BalanceInfo.Unit = &UnitInfo{UUID: extraSmry.UUID}
 					}
@@ -484,7 +490,7 @@ func (ec *EventCost) newIntervalFromCharge(cInc *ChargingIncrement) (incr *Incre
 		}
 	}
 	if cBC.ExtraChargeID != utils.MetaNone {
-		incr.BalanceInfo.Monetary = &MonetaryInfo{UUID: cBC.BalanceUUID}
+		incr.BalanceInfo.Monetary = &MonetaryInfo{UUID: cBC.BalanceUUID, ExchangeRate: cBC.ExchangeRate}
 		incr.BalanceInfo.Monetary.RateInterval = ec.rateIntervalForRatingID(cBC.RatingID)
 	}
 	return
@@ -979,6 +985,12 @@ func (ec *EventCost) fieldAsInterface(fldPath []string) (val any, err error) {
 	switch fldPath[0] {
 	default: // "Charges[1]"
 		opath, indx := utils.GetPathIndex(fldPath[0])
+		if opath == utils.Taxes && indx != nil { // "Taxes[0]"
+			if len(ec.Taxes) <= *indx {
+				return nil, utils.ErrNotFound
+			}
+			return ec.Taxes[*indx].FieldAsInterface(fldPath[1:])
+		}
 		if opath != utils.Charges {
 			return nil, fmt.Errorf("unsupported field prefix: <%s>", opath)
 		}
@@ -1024,6 +1036,16 @@ func (ec *EventCost) fieldAsInterface(fldPath []string) (val any, err error) {
 			return nil, nil
 		}
 		return *ec.Cost, nil
+	case utils.Taxes:
+		if len(fldPath) != 1 { // slice has no members
+			return nil, utils.ErrNotFound
+		}
+		return ec.Taxes, nil
+	case utils.Tax:
+		if len(fldPath) != 1 {
+			return nil, utils.ErrNotFound
+		}
+		return ec.Taxes.Total(), nil
 	case utils.AccountSummary:
 		if len(fldPath) == 1 {
 			return ec.AccountSummary, nil
@@ -0,0 +1,148 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// ExchangeRate converts one unit of FromCurrency into Rate units of ToCurrency
+type ExchangeRate struct {
+	Tenant       string
+	FromCurrency string
+	ToCurrency   string
+	Rate         float64
+}
+
+// ExchangeRateWithAPIOpts is used in the APIs and replicatorV1 for the exchange rates
+type ExchangeRateWithAPIOpts struct {
+	*ExchangeRate
+	APIOpts map[string]any
+}
+
+// ID returns the ID of the exchange rate within the tenant
+func (er *ExchangeRate) ID() string {
+	return utils.ConcatenatedKey(er.FromCurrency, er.ToCurrency)
+}
+
+// TenantID returns the tenant concatenated with the ID
+func (er *ExchangeRate) TenantID() string {
+	return utils.ConcatenatedKey(er.Tenant, er.FromCurrency, er.ToCurrency)
+}
+
+// Validate checks the exchange rate before storing it
+func (er *ExchangeRate) Validate() error {
+	if er.FromCurrency == utils.EmptyString {
+		return utils.NewErrMandatoryIeMissing(utils.FromCurrency)
+	}
+	if er.ToCurrency == utils.EmptyString {
+		return utils.NewErrMandatoryIeMissing(utils.ToCurrency)
+	}
+	if er.Rate <= 0 {
+		return fmt.Errorf("invalid exchange rate: %v", er.Rate)
+	}
+	return nil
+}
+
+// getExchangeRate returns the rate converting fromCurrency into toCurrency,
+// using the inverse of the opposite rate if the direct one is not defined
+func getExchangeRate(tenant, fromCurrency, toCurrency string) (rate float64, err error) {
+	if fromCurrency == toCurrency {
+		return 1, nil
+	}
+	var er *ExchangeRate
+	if er, err = dm.GetExchangeRate(tenant, utils.ConcatenatedKey(fromCurrency, toCurrency),
+		true, true, utils.NonTransactional); err == nil {
+		return er.Rate, nil
+	} else if err != utils.ErrNotFound {
+		return
+	}
+	if er, err = dm.GetExchangeRate(tenant, utils.ConcatenatedKey(toCurrency, fromCurrency),
+		true, true, utils.NonTransactional); err != nil {
+		return
+	}
+	return 1 / er.Rate, nil
+}
+
+// setExchangeRate computes the rate used to debit the balance, returning false
+// if the balance cannot be used since its currency cannot be converted
+func (b *Balance) setExchangeRate(tenant string) bool {
+	b.exchangeRate = 0
+	dfltCurrency := config.CgrConfig().RalsCfg().DefaultCurrency
+	if b.Currency == utils.EmptyString || dfltCurrency == utils.EmptyString ||
+		b.Currency == dfltCurrency {
+		return true
+	}
+	rate, err := getExchangeRate(tenant, dfltCurrency, b.Currency)
+	if err != nil {
+		utils.Logger.Warning(fmt.Sprintf("<%s> ignoring balance <%s> of account <%s>, error: <%s> getting the exchange rate from <%s> to <%s>",
+			utils.RALService, b.ID, b.account.ID, err.Error(), dfltCurrency, b.Currency))
+		return false
+	}
+	b.exchangeRate = rate
+	return true
+}
+
+// defaultMoneyBalanceWithRate returns the *default monetary balance, used when going
+// negative, with the exchange rate set out of the rating currency; without a rate
+// the cost is debited unconverted as the debit cannot be skipped
+func (acc *Account) defaultMoneyBalanceWithRate() (b *Balance) {
+	b = acc.GetDefaultMoneyBalance()
+	b.account = acc
+	if !b.setExchangeRate(utils.NewTenantID(acc.ID).Tenant) {
+		utils.Logger.Warning(fmt.Sprintf("<%s> debiting unconverted the *default balance of account <%s>",
+			utils.RALService, acc.ID))
+	}
+	return
+}
+
+// convertCost returns the cost in the currency of the balance
+func (b *Balance) convertCost(cost float64) float64 {
+	return convertCost(cost, b.exchangeRate)
+}
+
+// creditValue returns the value available on the balance in the rating currency
+func (b *Balance) creditValue() float64 {
+	if b.exchangeRate == 0 {
+		return b.availableValue()
+	}
+	return utils.Round(b.availableValue()/b.exchangeRate, globalRoundingDecimals, utils.MetaRoundingMiddle)
+}
+
+// getTotalCredit returns the total value of the balances in the rating currency
+func (bc Balances) getTotalCredit() (total float64) {
+	for _, b := range bc {
+		if !b.IsExpiredAt(time.Now()) && b.IsActive() {
+			total += b.creditValue()
+		}
+	}
+	return utils.Round(total, globalRoundingDecimals, utils.MetaRoundingMiddle)
+}
+
+// convertCost converts the cost with the exchange rate, 0 meaning no conversion
+func convertCost(cost, rate float64) float64 {
+	if rate == 0 || rate == 1 {
+		return cost
+	}
+	return utils.Round(cost*rate, globalRoundingDecimals, utils.MetaRoundingMiddle)
+}
@@ -0,0 +1,234 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// setDefaultCurrency enables the exchange rates with EUR as rating currency and the EUR:USD rate
+func setDefaultCurrency(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	cfg.RalsCfg().DefaultCurrency = "EUR"
+	config.SetCgrConfig(cfg)
+	if err := dm.SetExchangeRate(&ExchangeRate{Tenant: "cgrates.org", FromCurrency: "EUR", ToCurrency: "USD", Rate: 1.1}); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		config.SetCgrConfig(config.NewDefaultCGRConfig())
+		dm.RemoveExchangeRate("cgrates.org", "EUR:USD")
+		Cache.Clear([]string{utils.CacheExchangeRates})
+	})
+}
+
+func TestExchangeRateValidate(t *testing.T) {
+	er := &ExchangeRate{Tenant: "cgrates.org", FromCurrency: "EUR", ToCurrency: "USD", Rate: 1.1}
+	if err := er.Validate(); err != nil {
+		t.Error(err)
+	}
+	if er.TenantID() != "cgrates.org:EUR:USD" || er.ID() != "EUR:USD" {
+		t.Errorf("Unexpected IDs: %s, %s", er.TenantID(), er.ID())
+	}
+	er.Rate = 0
+	if err := er.Validate(); err == nil {
+		t.Error("Expected error for the invalid rate")
+	}
+	er.ToCurrency = utils.EmptyString
+	if err := er.Validate(); err == nil || err.Error() != utils.NewErrMandatoryIeMissing(utils.ToCurrency).Error() {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestGetExchangeRate(t *testing.T) {
+	setDefaultCurrency(t)
+	if rate, err := getExchangeRate("cgrates.org", "EUR", "USD"); err != nil {
+		t.Error(err)
+	} else if rate != 1.1 {
+		t.Errorf("Expected rate: 1.1, received: %v", rate)
+	}
+	// the inverse of the opposite rate
+	if rate, err := getExchangeRate("cgrates.org", "USD", "EUR"); err != nil {
+		t.Error(err)
+	} else if rate != 1/1.1 {
+		t.Errorf("Expected rate: %v, received: %v", 1/1.1, rate)
+	}
+	if rate, err := getExchangeRate("cgrates.org", "GBP", "GBP"); err != nil || rate != 1 {
+		t.Errorf("Expected rate: 1, received: %v, err: %v", rate, err)
+	}
+	if _, err := getExchangeRate("cgrates.org", "EUR", "GBP"); err != utils.ErrNotFound {
+		t.Errorf("Expected error: %v, received: %v", utils.ErrNotFound, err)
+	}
+}
+
+func TestExchangeRateBalances(t *testing.T) {
+	setDefaultCurrency(t)
+	acc := &Account{
+		ID: "cgrates.org:exr",
+		BalanceMap: map[string]Balances{
+			utils.MetaMonetary: {
+				{Uuid: "uuid1", ID: "BAL_EUR", Value: 10, Currency: "EUR"},
+				{Uuid: "uuid2", ID: "BAL_USD", Value: 11, Currency: "USD"},
+				{Uuid: "uuid3", ID: "BAL_GBP", Value: 10, Currency: "GBP"},
+			},
+		},
+	}
+	// the balance without exchange rate is ignored
+	blcs := acc.getBalancesForPrefix("0723", utils.Call, utils.MetaMonetary, utils.EmptyString, time.Now())
+	var ids []string
+	for _, b := range blcs {
+		ids = append(ids, b.ID)
+	}
+	if exp := []string{"BAL_EUR", "BAL_USD"}; !reflect.DeepEqual(exp, ids) {
+		t.Errorf("Expected: %v, received: %v", exp, ids)
+	}
+	if credit := blcs.getTotalCredit(); credit != 20 {
+		t.Errorf("Expected credit: 20, received: %v", credit)
+	}
+	if cost := blcs[1].convertCost(2); cost != 2.2 {
+		t.Errorf("Expected cost: 2.2, received: %v", cost)
+	}
+	if cost := blcs[0].convertCost(2); cost != 2 {
+		t.Errorf("Expected cost: 2, received: %v", cost)
+	}
+}
+
+func TestExchangeRateDebitAndRefund(t *testing.T) {
+	setDefaultCurrency(t)
+	acc := &Account{
+		ID: "cgrates.org:exr_debit",
+		BalanceMap: map[string]Balances{
+			utils.MetaMonetary: {{Uuid: "uuid1", ID: "BAL_USD", Value: 10, Currency: "USD"}},
+		},
+	}
+	if err := dm.SetAccount(acc); err != nil {
+		t.Fatal(err)
+	}
+	cd := &CallDescriptor{
+		Category:    "0",
+		Tenant:      "vdf",
+		Subject:     "rif",
+		Account:     "exr_debit",
+		Destination: "0256",
+		ToR:         utils.MetaVoice,
+		TimeStart:   time.Date(2013, 10, 4, 15, 46, 0, 0, time.UTC),
+		TimeEnd:     time.Date(2013, 10, 4, 15, 46, 5, 0, time.UTC),
+	}
+	cc, err := acc.debitCreditBalance(cd, false, false, true, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cc.Timespans.Decompress()
+	var debited float64
+	for _, ts := range cc.Timespans {
+		for _, inc := range ts.Increments {
+			if inc.BalanceInfo.Monetary == nil || inc.BalanceInfo.Monetary.ExchangeRate != 1.1 {
+				t.Fatalf("Unexpected increment: %s", utils.ToJSON(inc))
+			}
+			debited += convertCost(inc.Cost, inc.BalanceInfo.Monetary.ExchangeRate)
+			cd.Increments = append(cd.Increments, inc)
+		}
+	}
+	if debited == 0 {
+		t.Fatalf("Expected a cost, received: %s", utils.ToJSON(cc))
+	}
+	debited = utils.Round(debited, globalRoundingDecimals, utils.MetaRoundingMiddle)
+	if val := acc.BalanceMap[utils.MetaMonetary][0].GetValue(); utils.Round(10-val, globalRoundingDecimals,
+		utils.MetaRoundingMiddle) != debited {
+		t.Errorf("Expected debited: %v, received balance value: %v", debited, val)
+	}
+	// the refund adds back the value in the balance currency
+	if err = dm.SetAccount(acc); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = cd.refundIncrements(nil); err != nil {
+		t.Fatal(err)
+	}
+	if acc, err = dm.GetAccount("cgrates.org:exr_debit"); err != nil {
+		t.Fatal(err)
+	} else if val := acc.BalanceMap[utils.MetaMonetary][0].GetValue(); val != 10 {
+		t.Errorf("Expected the balance refunded to 10, received: %v", val)
+	}
+}
+
+func TestExchangeRateDebitNegative(t *testing.T) {
+	setDefaultCurrency(t)
+	acc := &Account{
+		ID: "cgrates.org:exr_negative",
+		BalanceMap: map[string]Balances{
+			utils.MetaMonetary: {{Uuid: "uuid1", ID: utils.MetaDefault, Value: 0, Currency: "USD"}},
+		},
+	}
+	cd := &CallDescriptor{
+		Category:    "0",
+		Tenant:      "vdf",
+		Subject:     "rif",
+		Account:     "exr_negative",
+		Destination: "0256",
+		ToR:         utils.MetaVoice,
+		TimeStart:   time.Date(2013, 10, 4, 15, 46, 0, 0, time.UTC),
+		TimeEnd:     time.Date(2013, 10, 4, 15, 46, 5, 0, time.UTC),
+	}
+	cc, err := acc.debitCreditBalance(cd, false, false, true, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cc.Timespans.Decompress()
+	var debited float64
+	for _, ts := range cc.Timespans {
+		for _, inc := range ts.Increments {
+			if inc.BalanceInfo.Monetary == nil || inc.BalanceInfo.Monetary.ExchangeRate != 1.1 ||
+				inc.BalanceInfo.Monetary.Currency != "USD" {
+				t.Fatalf("Unexpected increment: %s", utils.ToJSON(inc))
+			}
+			debited += convertCost(inc.Cost, inc.BalanceInfo.Monetary.ExchangeRate)
+		}
+	}
+	if debited == 0 {
+		t.Fatalf("Expected a cost, received: %s", utils.ToJSON(cc))
+	}
+	if val := acc.BalanceMap[utils.MetaMonetary][0].GetValue(); math.Abs(val+debited) > 0.0001 {
+		t.Errorf("Expected balance value: %v, received: %v", -debited, val)
+	}
+}
+
+func TestExchangeRateDebitConnectFeeNegative(t *testing.T) {
+	setDefaultCurrency(t)
+	acc := &Account{
+		ID: "cgrates.org:exr_connect_fee",
+		BalanceMap: map[string]Balances{
+			utils.MetaMonetary: {{Uuid: "uuid1", ID: utils.MetaDefault, Value: 0, Currency: "USD"}},
+		},
+	}
+	cc := &CallCost{deductConnectFee: true,
+		Timespans: TimeSpans{{RateInterval: &RateInterval{Rating: &RIRate{ConnectFee: 2}}}}}
+	if ok, debitedBalance := acc.DebitConnectionFee(cc, nil, false, false, nil); !ok {
+		t.Fatal("Expected the connect fee to be debited")
+	} else if debitedBalance.exchangeRate != 1.1 {
+		t.Errorf("Expected the exchange rate 1.1, received: %v", debitedBalance.exchangeRate)
+	}
+	if val := acc.BalanceMap[utils.MetaMonetary][0].GetValue(); val != -2.2 {
+		t.Errorf("Expected balance value: -2.2, received: %v", val)
+	}
+}
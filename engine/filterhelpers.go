@@ -23,8 +23,15 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/oschwald/maxminddb-golang"
 
 	"github.com/cgrates/cgrates/config"
 	"github.com/cgrates/cgrates/guardian"
@@ -246,3 +253,181 @@ func getHTTP(method, url string, payload io.Reader, headers map[string][]string)
 	req.Header = headers
 	return http.DefaultClient.Do(req)
 }
+
+// httpLookup queries the service of the HTTP profile with the URL built out of
+// the event, caching the decoded response in the *http_lookups partition
+func httpLookup(prfID string, dP utils.DataProvider) (val any, err error) {
+	prf, has := config.CgrConfig().FilterSCfg().HTTPProfiles[prfID]
+	if !has {
+		return nil, fmt.Errorf("unknown http profile <%s>", prfID)
+	}
+	var reqURL string
+	for _, prsr := range prf.URL {
+		var out string
+		if out, err = prsr.ParseDataProvider(dP); err != nil {
+			return
+		}
+		if strings.HasPrefix(prsr.Rules, utils.DynamicDataPrefix) { // values out of the event are escaped
+			out = url.QueryEscape(out)
+		}
+		reqURL += out
+	}
+	cacheKey := utils.ConcatenatedKey(prfID, reqURL)
+	if x, ok := Cache.Get(utils.CacheHTTPLookups, cacheKey); ok {
+		if x == nil {
+			return nil, utils.ErrNotFound
+		}
+		return x, nil
+	}
+	timeout := prf.Timeout
+	if timeout == 0 {
+		timeout = config.CgrConfig().GeneralCfg().ReplyTimeout
+	}
+	var req *http.Request
+	if req, err = http.NewRequest(http.MethodGet, reqURL, nil); err != nil {
+		return
+	}
+	for hdr, hdrVal := range prf.Headers {
+		req.Header.Set(hdr, hdrVal)
+	}
+	client := &http.Client{Transport: httpPstrTransport, Timeout: timeout}
+	var resp *http.Response
+	if resp, err = client.Do(req); err != nil {
+		return
+	}
+	defer resp.Body.Close()
+	switch {
+	case resp.StatusCode == http.StatusNotFound: // cache the miss so we do not query again for the same URL
+		if err = Cache.Set(utils.CacheHTTPLookups, cacheKey, nil,
+			nil, true, utils.NonTransactional); err != nil {
+			return
+		}
+		return nil, utils.ErrNotFound
+	case resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices:
+		return nil, fmt.Errorf("http profile <%s> got unexpected status <%s>", prfID, resp.Status)
+	}
+	var body []byte
+	if body, err = io.ReadAll(resp.Body); err != nil {
+		return
+	}
+	if err = json.Unmarshal(body, &val); err != nil { // not a JSON reply, keep it as a plain string
+		val, err = string(bytes.TrimSpace(body)), nil
+	}
+	err = Cache.Set(utils.CacheHTTPLookups, cacheKey, val,
+		nil, true, utils.NonTransactional)
+	return
+}
+
+// geoIPDB is the MaxMind database used by the *geoip filters and dynamic fields,
+// loaded in memory and reloaded once the configured path or the file changes
+type geoIPDB struct {
+	sync.RWMutex
+	path    string
+	modTime time.Time
+	reader  *maxminddb.Reader
+}
+
+var geoIP = new(geoIPDB)
+
+// getReader returns the reader of the database found at path, reloading it
+// if the file was replaced since the last load
+func (db *geoIPDB) getReader(path string) (reader *maxminddb.Reader, err error) {
+	if path == utils.EmptyString {
+		return nil, fmt.Errorf("no %s configured", utils.GeoIPDBPathCfg)
+	}
+	var fi os.FileInfo
+	if fi, err = os.Stat(path); err != nil {
+		return
+	}
+	db.RLock()
+	if db.reader != nil && db.path == path && db.modTime.Equal(fi.ModTime()) {
+		reader = db.reader
+		db.RUnlock()
+		return
+	}
+	db.RUnlock()
+	db.Lock()
+	defer db.Unlock()
+	if db.reader != nil && db.path == path && db.modTime.Equal(fi.ModTime()) { // loaded meanwhile
+		return db.reader, nil
+	}
+	var buf []byte
+	if buf, err = os.ReadFile(path); err != nil {
+		return
+	}
+	// keep the database in memory instead of mapping the file so the old
+	// reader stays usable by the ongoing lookups after a reload
+	if reader, err = maxminddb.FromBytes(buf); err != nil {
+		return
+	}
+	db.path, db.modTime, db.reader = path, fi.ModTime(), reader
+	utils.Logger.Info(fmt.Sprintf("<%s> loaded geoip database from <%s>", utils.FilterS, path))
+	return
+}
+
+// geoIPRecord holds the fields we use out of the City, Country and ASN databases
+type geoIPRecord struct {
+	Country struct {
+		ISOCode string `maxminddb:"iso_code"`
+	} `maxminddb:"country"`
+	Continent struct {
+		Code string `maxminddb:"code"`
+	} `maxminddb:"continent"`
+	City struct {
+		Names map[string]string `maxminddb:"names"`
+	} `maxminddb:"city"`
+	Location struct {
+		Latitude  *float64 `maxminddb:"latitude"`
+		Longitude *float64 `maxminddb:"longitude"`
+		TimeZone  string   `maxminddb:"time_zone"`
+	} `maxminddb:"location"`
+	ASN            uint   `maxminddb:"autonomous_system_number"`
+	ASOrganization string `maxminddb:"autonomous_system_organization"`
+}
+
+// GetGeoIP returns the geo-location of the IP out of the configured database
+// with only the fields populated by it: Country, Continent, City, Latitude,
+// Longitude, TimeZone, ASN and ASOrganization
+func GetGeoIP(ipStr string) (geo utils.MapStorage, err error) {
+	ip := net.ParseIP(ipStr)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid IP address <%s>", ipStr)
+	}
+	var reader *maxminddb.Reader
+	if reader, err = geoIP.getReader(config.CgrConfig().FilterSCfg().GeoIPDBPath); err != nil {
+		return
+	}
+	var rec geoIPRecord
+	var found bool
+	if _, found, err = reader.LookupNetwork(ip, &rec); err != nil {
+		return
+	} else if !found {
+		return nil, utils.ErrNotFound
+	}
+	geo = make(utils.MapStorage)
+	if rec.Country.ISOCode != utils.EmptyString {
+		geo["Country"] = rec.Country.ISOCode
+	}
+	if rec.Continent.Code != utils.EmptyString {
+		geo["Continent"] = rec.Continent.Code
+	}
+	if city, has := rec.City.Names["en"]; has {
+		geo["City"] = city
+	}
+	if rec.Location.Latitude != nil {
+		geo["Latitude"] = *rec.Location.Latitude
+	}
+	if rec.Location.Longitude != nil {
+		geo["Longitude"] = *rec.Location.Longitude
+	}
+	if rec.Location.TimeZone != utils.EmptyString {
+		geo["TimeZone"] = rec.Location.TimeZone
+	}
+	if rec.ASN != 0 {
+		geo["ASN"] = rec.ASN
+	}
+	if rec.ASOrganization != utils.EmptyString {
+		geo["ASOrganization"] = rec.ASOrganization
+	}
+	return
+}
@@ -58,7 +58,8 @@ func (fS *FilterS) Pass(tenant string, filterIDs []string,
 		return true, nil
 	}
 	dDP := newDynamicDP(fS.cfg.FilterSCfg().ResourceSConns, fS.cfg.FilterSCfg().StatSConns,
-		fS.cfg.FilterSCfg().ApierSConns, tenant, ev)
+		fS.cfg.FilterSCfg().ApierSConns, fS.cfg.FilterSCfg().ThresholdSConns, fS.cfg.FilterSCfg().RouteSConns,
+		tenant, ev)
 	for _, fltrID := range filterIDs {
 		f, err := fS.dm.GetFilter(tenant, fltrID,
 			true, true, utils.NonTransactional)
@@ -123,7 +124,8 @@ func (fS *FilterS) LazyPass(tenant string, filterIDs []string,
 	}
 	pass = true
 	dDP := newDynamicDP(fS.cfg.FilterSCfg().ResourceSConns, fS.cfg.FilterSCfg().StatSConns,
-		fS.cfg.FilterSCfg().ApierSConns, tenant, ev)
+		fS.cfg.FilterSCfg().ApierSConns, fS.cfg.FilterSCfg().ThresholdSConns, fS.cfg.FilterSCfg().RouteSConns,
+		tenant, ev)
 	for _, fltrID := range filterIDs {
 		var f *Filter
 		f, err = fS.dm.GetFilter(tenant, fltrID,
@@ -229,20 +231,20 @@ var supportedFiltersType utils.StringSet = utils.NewStringSet([]string{
 	utils.MetaTimings, utils.MetaRSR, utils.MetaDestinations,
 	utils.MetaEmpty, utils.MetaExists, utils.MetaLessThan, utils.MetaLessOrEqual,
 	utils.MetaGreaterThan, utils.MetaGreaterOrEqual, utils.MetaEqual,
-	utils.MetaIPNet, utils.MetaAPIBan, utils.MetaSentryPeer, utils.MetaActivationInterval,
-	utils.MetaRegex})
+	utils.MetaIPNet, utils.MetaAPIBan, utils.MetaSentryPeer, utils.MetaGeoIP, utils.MetaActivationInterval,
+	utils.MetaCalendar, utils.MetaRegex})
 var needsFieldName utils.StringSet = utils.NewStringSet([]string{
 	utils.MetaString, utils.MetaPrefix, utils.MetaSuffix,
 	utils.MetaTimings, utils.MetaRSR, utils.MetaDestinations, utils.MetaLessThan,
 	utils.MetaEmpty, utils.MetaExists, utils.MetaLessOrEqual, utils.MetaGreaterThan,
 	utils.MetaGreaterOrEqual, utils.MetaEqual, utils.MetaIPNet, utils.MetaAPIBan, utils.MetaSentryPeer,
-	utils.MetaActivationInterval,
+	utils.MetaGeoIP, utils.MetaActivationInterval, utils.MetaCalendar,
 	utils.MetaRegex})
 var needsValues utils.StringSet = utils.NewStringSet([]string{utils.MetaString, utils.MetaPrefix,
 	utils.MetaSuffix, utils.MetaTimings, utils.MetaRSR, utils.MetaDestinations,
 	utils.MetaLessThan, utils.MetaLessOrEqual, utils.MetaGreaterThan, utils.MetaGreaterOrEqual,
-	utils.MetaEqual, utils.MetaIPNet, utils.MetaAPIBan, utils.MetaSentryPeer, utils.MetaActivationInterval,
-	utils.MetaRegex})
+	utils.MetaEqual, utils.MetaIPNet, utils.MetaAPIBan, utils.MetaSentryPeer, utils.MetaGeoIP,
+	utils.MetaActivationInterval, utils.MetaCalendar, utils.MetaRegex})
 
 // NewFilterRule returns a new filter
 func NewFilterRule(rfType, fieldName string, vals []string) (*FilterRule, error) {
@@ -354,8 +356,12 @@ func (fltr *FilterRule) Pass(dDP utils.DataProvider) (result bool, err error) {
 		result, err = fltr.passAPIBan(dDP)
 	case utils.MetaSentryPeer, utils.MetaNotSentryPeer:
 		result, err = fltr.passSentryPeer(dDP)
+	case utils.MetaGeoIP, utils.MetaNotGeoIP:
+		result, err = fltr.passGeoIP(dDP)
 	case utils.MetaActivationInterval, utils.MetaNotActivationInterval:
 		result, err = fltr.passActivationInterval(dDP)
+	case utils.MetaCalendar, utils.MetaNotCalendar:
+		result, err = fltr.passCalendar(dDP)
 	case utils.MetaRegex, utils.MetaNotRegex:
 		result, err = fltr.passRegex(dDP)
 	default:
@@ -667,6 +673,94 @@ func (fltr *FilterRule) passSentryPeer(dDP utils.DataProvider) (bool, error) {
 	return GetSentryPeer(strVal, config.CgrConfig().SentryPeerCfg(), fltr.Values[0])
 }
 
+func (fltr *FilterRule) passGeoIP(dDP utils.DataProvider) (bool, error) {
+	strVal, err := fltr.rsrElement.ParseDataProvider(dDP)
+	if err != nil {
+		if err == utils.ErrNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	if net.ParseIP(strVal) == nil {
+		return false, nil
+	}
+	geo, err := GetGeoIP(strVal)
+	if err != nil {
+		if err == utils.ErrNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	country, err := geo.FieldAsString([]string{"Country"})
+	if err != nil {
+		if err == utils.ErrNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	for _, val := range fltr.rsrValues {
+		sval, err := val.ParseDataProvider(dDP)
+		if err != nil {
+			continue
+		}
+		for _, code := range strings.Split(sval, utils.InfieldSep) { // allow DE;AT lists inside one value
+			if strings.EqualFold(country, code) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// passCalendar checks the time against calendar IDs or inline cron expressions
+func (fltr *FilterRule) passCalendar(dDP utils.DataProvider) (bool, error) {
+	tmVal, err := fltr.rsrElement.ParseDataProviderWithInterfaces(dDP)
+	if err != nil {
+		if err == utils.ErrNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	tmTime, err := utils.IfaceAsTime(tmVal, config.CgrConfig().GeneralCfg().DefaultTimezone)
+	if err != nil {
+		return false, err
+	}
+	tnt := config.CgrConfig().GeneralCfg().DefaultTenant
+	if dynDP, canCast := dDP.(*dynamicDP); canCast {
+		tnt = dynDP.tenant
+	}
+	for _, val := range fltr.rsrValues {
+		sval, err := val.ParseDataProvider(dDP)
+		if err != nil {
+			return false, err
+		}
+		var active bool
+		if strings.Contains(sval, utils.SepCgr) { // inline cron expression in the default timezone
+			var loc *time.Location
+			if loc, err = calendarLocation(utils.EmptyString); err != nil {
+				return false, err
+			}
+			active, err = cronMatches(sval, tmTime.In(loc))
+		} else {
+			var cal *Calendar
+			if cal, err = dm.GetCalendar(tnt, sval, true, true, utils.NonTransactional); err != nil {
+				if err == utils.ErrNotFound {
+					err = utils.ErrPrefixNotFound(sval)
+				}
+				return false, err
+			}
+			active, err = cal.IsActiveAt(tmTime)
+		}
+		if err != nil {
+			return false, err
+		}
+		if active {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func parseTime(rsr *config.RSRParser, dDp utils.DataProvider) (_ time.Time, err error) {
 	var str string
 	if str, err = rsr.ParseDataProvider(dDp); err != nil {
@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"path"
 	"reflect"
 	"testing"
 	"time"
@@ -2642,3 +2643,53 @@ func TestFilterPassTiming(t *testing.T) {
 	}
 
 }
+
+func TestFilterPassGeoIP(t *testing.T) {
+	tmpCfg, tmpGeoIP := config.CgrConfig(), geoIP
+	defer func() {
+		config.SetCgrConfig(tmpCfg)
+		geoIP = tmpGeoIP
+	}()
+	geoIP = new(geoIPDB)
+	cfg := config.NewDefaultCGRConfig()
+	cfg.FilterSCfg().GeoIPDBPath = path.Join(t.TempDir(), "GeoLite2-Country.mmdb")
+	writeTestGeoIPDB(t, cfg.FilterSCfg().GeoIPDBPath, map[string]map[string]any{
+		"1.1.1.0/24": {"country": map[string]any{"iso_code": "DE"}},
+		"2.2.0.0/16": {"country": map[string]any{"iso_code": "AT"}},
+		"4.4.0.0/16": {"country": map[string]any{"iso_code": "US"}},
+	})
+	config.SetCgrConfig(cfg)
+	dm := NewDataManager(NewInternalDB(nil, nil, true, cfg.DataDbCfg().Items), cfg.CacheCfg(), nil)
+	filterS := NewFilterS(cfg, nil, dm)
+	for ip, exp := range map[string]bool{
+		"1.1.1.7":   true,
+		"2.2.3.4":   true,
+		"4.4.4.4":   false,
+		"3.3.3.3":   false, // not in the database
+		"not_an_ip": false,
+	} {
+		ev := utils.MapStorage{utils.MetaReq: utils.MapStorage{"SourceIP": ip}}
+		for _, fltr := range []string{"*geoip:~*req.SourceIP:DE;AT", "*geoip:~*req.SourceIP:DE|AT"} {
+			if pass, err := filterS.Pass("cgrates.org", []string{fltr}, ev); err != nil {
+				t.Errorf("%s: %v", ip, err)
+			} else if pass != exp {
+				t.Errorf("%s with %s: expected %v, received %v", ip, fltr, exp, pass)
+			}
+		}
+	}
+	ev := utils.MapStorage{utils.MetaReq: utils.MapStorage{"SourceIP": "4.4.4.4"}}
+	if pass, err := filterS.Pass("cgrates.org", []string{"*notgeoip:~*req.SourceIP:DE;AT"}, ev); err != nil {
+		t.Error(err)
+	} else if !pass {
+		t.Error("Expected the filter to pass")
+	}
+	if pass, err := filterS.Pass("cgrates.org", []string{"*string:~*geoip.<~*req.SourceIP>.Country:US"}, ev); err != nil {
+		t.Error(err)
+	} else if !pass {
+		t.Error("Expected the filter to pass")
+	}
+	if _, err := NewFilterRule(utils.MetaGeoIP, "~*req.SourceIP", nil); err == nil ||
+		err.Error() != "Values is mandatory for Type: *geoip" {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,520 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// InvoiceLineItem is one charge of the invoice
+type InvoiceLineItem struct {
+	Type     string        // *usage for the rated CDRs or *recurring for the action plan charges
+	GroupKey string        // the destination or category of the CDRs, the actions ID for the recurring charges
+	Quantity int64         // number of CDRs or of recurring charges
+	Usage    time.Duration // total usage of the CDRs
+	Amount   float64
+}
+
+// Invoice aggregates the charges of an account over a billing period
+type Invoice struct {
+	ID          string
+	Tenant      string
+	Account     string
+	PeriodStart time.Time // inclusive
+	PeriodEnd   time.Time // exclusive
+	Currency    string
+	LineItems   []*InvoiceLineItem
+	Subtotal    float64
+	Discount    float64
+	Tax         float64
+	Total       float64
+	CreatedAt   time.Time
+}
+
+// invoiceID is the same for the invoices of an account over the same period
+// so billing again the period replaces the old invoice
+func invoiceID(tnt, acnt string, start, end time.Time) string {
+	return utils.Sha1(tnt, acnt, start.UTC().String(), end.UTC().String())
+}
+
+// AsCGREvent converts the invoice into a CGREvent, used for the export
+func (inv *Invoice) AsCGREvent() *utils.CGREvent {
+	return &utils.CGREvent{
+		Tenant: inv.Tenant,
+		ID:     utils.UUIDSha1Prefix(),
+		Event: map[string]any{
+			utils.EventSource:  utils.InvoiceS,
+			utils.InvoiceID:    inv.ID,
+			utils.Tenant:       inv.Tenant,
+			utils.AccountField: inv.Account,
+			utils.PeriodStart:  inv.PeriodStart,
+			utils.PeriodEnd:    inv.PeriodEnd,
+			utils.Currency:     inv.Currency,
+			utils.LineItems:    utils.ToJSON(inv.LineItems),
+			utils.Subtotal:     inv.Subtotal,
+			utils.Discount:     inv.Discount,
+			utils.Tax:          inv.Tax,
+			utils.Total:        inv.Total,
+			utils.CreatedAt:    inv.CreatedAt,
+		},
+		APIOpts: map[string]any{
+			utils.MetaEventType: utils.Invoice,
+		},
+	}
+}
+
+// computeTotals sums the line items, applying the discount and then the tax
+func (inv *Invoice) computeTotals(discountPercent, taxPercent float64) {
+	var subtotal float64
+	for _, li := range inv.LineItems {
+		subtotal += li.Amount
+	}
+	inv.Subtotal = utils.Round(subtotal, globalRoundingDecimals, utils.MetaRoundingMiddle)
+	inv.Discount = utils.Round(inv.Subtotal*discountPercent/100, globalRoundingDecimals, utils.MetaRoundingMiddle)
+	inv.Tax = utils.Round((inv.Subtotal-inv.Discount)*taxPercent/100, globalRoundingDecimals, utils.MetaRoundingMiddle)
+	inv.Total = utils.Round(inv.Subtotal-inv.Discount+inv.Tax, globalRoundingDecimals, utils.MetaRoundingMiddle)
+}
+
+// InvoiceFilter selects the invoices, ordered by the start of the period
+type InvoiceFilter struct {
+	IDs         []string
+	Tenants     []string
+	Accounts    []string   // account IDs without the tenant
+	PeriodStart *time.Time // invoices with the period starting at or after this time
+	PeriodEnd   *time.Time // invoices with the period ending at or before this time
+	utils.Paginator
+}
+
+// Pass returns true if the invoice matches the filter, ignoring the pagination
+func (inf *InvoiceFilter) Pass(inv *Invoice) bool {
+	return (len(inf.IDs) == 0 || slices.Contains(inf.IDs, inv.ID)) &&
+		(len(inf.Tenants) == 0 || slices.Contains(inf.Tenants, inv.Tenant)) &&
+		(len(inf.Accounts) == 0 || slices.Contains(inf.Accounts, inv.Account)) &&
+		(inf.PeriodStart == nil || !inv.PeriodStart.Before(*inf.PeriodStart)) &&
+		(inf.PeriodEnd == nil || !inv.PeriodEnd.After(*inf.PeriodEnd))
+}
+
+// NewInvoiceService returns the service building the invoices
+func NewInvoiceService(cfg *config.CGRConfig, dm *DataManager, storDBChan chan StorDB,
+	connMgr *ConnManager) *InvoiceService {
+	return &InvoiceService{
+		cfg:        cfg,
+		dm:         dm,
+		cdrDB:      <-storDBChan,
+		storDBChan: storDBChan,
+		connMgr:    connMgr,
+	}
+}
+
+// InvoiceService aggregates the rated CDRs and the recurring charges into invoices
+type InvoiceService struct {
+	sync.RWMutex
+	cfg        *config.CGRConfig
+	dm         *DataManager
+	cdrDB      CdrStorage
+	storDBChan chan StorDB
+	connMgr    *ConnManager
+}
+
+// ListenAndServe runs the periodic bill runs, listening for the StorDB and config reloads
+func (invS *InvoiceService) ListenAndServe(stopChan, rldChan chan struct{}) {
+	ticker, tick := invS.newTicker()
+	defer func() {
+		if ticker != nil {
+			ticker.Stop()
+		}
+	}()
+	for {
+		select {
+		case <-stopChan:
+			return
+		case stordb, ok := <-invS.storDBChan:
+			if !ok { // the chanel was closed by the shutdown of stordbService
+				return
+			}
+			invS.Lock()
+			invS.cdrDB = stordb
+			invS.Unlock()
+		case <-rldChan: // the run_interval may have changed
+			if ticker != nil {
+				ticker.Stop()
+			}
+			ticker, tick = invS.newTicker()
+		case end := <-tick:
+			invS.runScheduled(end)
+		}
+	}
+}
+
+// newTicker returns the ticker for the periodic bill runs, nil if they are disabled
+func (invS *InvoiceService) newTicker() (ticker *time.Ticker, tick <-chan time.Time) {
+	if runInterval := invS.cfg.InvoiceSCfg().RunInterval; runInterval > 0 {
+		ticker = time.NewTicker(runInterval)
+		tick = ticker.C
+	}
+	return
+}
+
+// runScheduled bills the configured tenants for the last run_interval
+func (invS *InvoiceService) runScheduled(end time.Time) {
+	invCfg := invS.cfg.InvoiceSCfg()
+	tnts := invCfg.Tenants
+	if len(tnts) == 0 {
+		tnts = []string{invS.cfg.GeneralCfg().DefaultTenant}
+	}
+	start := end.Add(-invCfg.RunInterval)
+	for _, tnt := range tnts {
+		invs, err := invS.billRun(&ArgsBillRun{
+			Tenant:      tnt,
+			PeriodStart: &start,
+			PeriodEnd:   &end,
+		})
+		if err != nil {
+			if err == utils.ErrNotFound { // nothing to bill
+				continue
+			}
+			utils.Logger.Warning(fmt.Sprintf("<%s> error: <%s> billing tenant <%s> for the period <%s - %s>",
+				utils.InvoiceS, err.Error(), tnt, start, end))
+			continue
+		}
+		if len(invCfg.EEsConns) == 0 {
+			continue
+		}
+		if err = invS.exportInvoices(invs, invCfg.ExporterIDs, false, nil); err != nil {
+			utils.Logger.Warning(fmt.Sprintf("<%s> error: <%s> exporting the invoices of tenant <%s>",
+				utils.InvoiceS, err.Error(), tnt))
+		}
+	}
+}
+
+// ArgsBillRun selects the accounts and the billing period
+type ArgsBillRun struct {
+	Tenant      string
+	Accounts    []string   // empty to bill all the accounts of the tenant
+	PeriodStart *time.Time // defaults to PeriodEnd minus the run_interval
+	PeriodEnd   *time.Time // defaults to now
+	GroupBy     string     // overwrites the group_by from config
+	DryRun      bool       // build the invoices without storing them
+	Export      bool       // send the invoices to EEs
+	ExporterIDs []string
+	APIOpts     map[string]any
+}
+
+// billRun builds and stores the invoices of the accounts with charges in the period
+func (invS *InvoiceService) billRun(args *ArgsBillRun) (invs []*Invoice, err error) {
+	invCfg := invS.cfg.InvoiceSCfg()
+	tnt := args.Tenant
+	if tnt == utils.EmptyString {
+		tnt = invS.cfg.GeneralCfg().DefaultTenant
+	}
+	end := time.Now()
+	if args.PeriodEnd != nil {
+		end = *args.PeriodEnd
+	}
+	var start time.Time
+	if args.PeriodStart != nil {
+		start = *args.PeriodStart
+	} else if invCfg.RunInterval != 0 {
+		start = end.Add(-invCfg.RunInterval)
+	} else {
+		return nil, utils.NewErrMandatoryIeMissing(utils.PeriodStart)
+	}
+	if !start.Before(end) {
+		return nil, fmt.Errorf("invalid billing period: <%s - %s>", start, end)
+	}
+	groupBy := invCfg.GroupBy
+	if args.GroupBy != utils.EmptyString {
+		groupBy = args.GroupBy
+	}
+	if groupBy != utils.MetaDestination && groupBy != utils.MetaCategory {
+		return nil, fmt.Errorf("unsupported group by: <%s>", groupBy)
+	}
+	acnts := args.Accounts
+	if len(acnts) == 0 {
+		prfx := utils.AccountPrefix + tnt + utils.ConcatenatedKeySep
+		var keys []string
+		if keys, err = invS.dm.DataDB().GetKeysForPrefix(prfx); err != nil {
+			return
+		}
+		acnts = make([]string, len(keys))
+		for i, key := range keys {
+			acnts[i] = key[len(prfx):]
+		}
+		slices.Sort(acnts)
+	}
+	invS.RLock()
+	cdrDB := invS.cdrDB
+	invS.RUnlock()
+	createdAt := time.Now()
+	for _, acnt := range acnts {
+		inv := &Invoice{
+			ID:          invoiceID(tnt, acnt, start, end),
+			Tenant:      tnt,
+			Account:     acnt,
+			PeriodStart: start,
+			PeriodEnd:   end,
+			Currency:    invS.cfg.RalsCfg().DefaultCurrency,
+			CreatedAt:   createdAt,
+		}
+		var lis []*InvoiceLineItem
+		if lis, err = usageLineItems(cdrDB, tnt, acnt, start, end, groupBy); err != nil {
+			return nil, err
+		}
+		inv.LineItems = append(inv.LineItems, lis...)
+		if lis, err = recurringLineItems(invS.dm, tnt, acnt, start, end); err != nil {
+			return nil, err
+		}
+		inv.LineItems = append(inv.LineItems, lis...)
+		if len(inv.LineItems) == 0 { // nothing to bill
+			continue
+		}
+		inv.computeTotals(invCfg.DiscountPercent, invCfg.TaxPercent)
+		if !args.DryRun {
+			if err = cdrDB.SetInvoice(inv); err != nil {
+				return nil, err
+			}
+		}
+		invs = append(invs, inv)
+	}
+	if len(invs) == 0 {
+		return nil, utils.ErrNotFound
+	}
+	return
+}
+
+// usageLineItems groups the costs of the rated CDRs answered in the period,
+// leaving out the ones logged by the actions (*cdrlog) as billed with the recurring items
+func usageLineItems(cdrDB CdrStorage, tnt, acnt string, start, end time.Time,
+	groupBy string) (lis []*InvoiceLineItem, err error) {
+	cdrs, _, err := cdrDB.GetCDRs(&utils.CDRsFilter{
+		Tenants:         []string{tnt},
+		Accounts:        []string{acnt},
+		NotRunIDs:       []string{utils.MetaRaw},
+		NotSources:      []string{utils.CDRLog},
+		AnswerTimeStart: &start,
+		AnswerTimeEnd:   &end,
+		MinCost:         utils.Float64Pointer(0),
+	}, false)
+	if err != nil {
+		if err == utils.ErrNotFound {
+			err = nil
+		}
+		return
+	}
+	items := make(map[string]*InvoiceLineItem)
+	for _, cdr := range cdrs {
+		if !cdr.AnswerTime.Before(end) { // not all the StorDBs exclude the end of the interval
+			continue
+		}
+		key := cdr.Destination
+		if groupBy == utils.MetaCategory {
+			key = cdr.Category
+		}
+		li, has := items[key]
+		if !has {
+			li = &InvoiceLineItem{Type: utils.MetaUsage, GroupKey: key}
+			items[key] = li
+			lis = append(lis, li)
+		}
+		li.Quantity++
+		li.Usage += cdr.Usage
+		li.Amount = utils.Round(li.Amount+cdr.Cost, globalRoundingDecimals, utils.MetaRoundingMiddle)
+	}
+	sort.Slice(lis, func(i, j int) bool {
+		return lis[i].GroupKey < lis[j].GroupKey
+	})
+	return
+}
+
+// recurringLineItems sums the monetary debits scheduled by the action plans of the account in the period
+func recurringLineItems(dm *DataManager, tnt, acnt string, start, end time.Time) (lis []*InvoiceLineItem, err error) {
+	apIDs, err := dm.GetAccountActionPlans(utils.ConcatenatedKey(tnt, acnt), true, true, utils.NonTransactional)
+	if err != nil {
+		if err == utils.ErrNotFound {
+			err = nil
+		}
+		return
+	}
+	items := make(map[string]*InvoiceLineItem)
+	for _, apID := range apIDs {
+		var ap *ActionPlan
+		if ap, err = dm.GetActionPlan(apID, true, true, utils.NonTransactional); err != nil {
+			if err == utils.ErrNotFound {
+				err = nil
+				continue
+			}
+			return
+		}
+		for _, apAt := range ap.ActionTimings {
+			if apAt.Timing == nil || apAt.IsASAP() {
+				continue
+			}
+			var amount float64
+			var acts Actions
+			if acts, err = dm.GetActions(apAt.ActionsID, false, utils.NonTransactional); err != nil {
+				if err == utils.ErrNotFound {
+					err = nil
+					continue
+				}
+				return
+			}
+			for _, act := range acts {
+				if (act.ActionType == utils.MetaDebit || act.ActionType == utils.MetaDebitReset) &&
+					act.Balance != nil && act.Balance.GetType() == utils.MetaMonetary {
+					amount += act.Balance.GetValue()
+				}
+			}
+			if amount == 0 {
+				continue
+			}
+			// work on a copy since the next start time is cached in the action timing
+			at := apAt.Clone()
+			var occurrences int64
+			for t := start.Add(-time.Nanosecond); ; {
+				at.ResetStartTimeCache()
+				if t = at.GetNextStartTime(t); t.IsZero() || !t.Before(end) {
+					break
+				}
+				occurrences++
+			}
+			if occurrences == 0 {
+				continue
+			}
+			li, has := items[at.ActionsID]
+			if !has {
+				li = &InvoiceLineItem{Type: utils.MetaRecurring, GroupKey: at.ActionsID}
+				items[at.ActionsID] = li
+				lis = append(lis, li)
+			}
+			li.Quantity += occurrences
+			li.Amount = utils.Round(li.Amount+amount*float64(occurrences),
+				globalRoundingDecimals, utils.MetaRoundingMiddle)
+		}
+	}
+	sort.Slice(lis, func(i, j int) bool {
+		return lis[i].GroupKey < lis[j].GroupKey
+	})
+	return
+}
+
+// exportInvoices sends the invoices to EEs
+func (invS *InvoiceService) exportInvoices(invs []*Invoice, exporterIDs []string, verbose bool,
+	reply *map[string]any) (err error) {
+	withErrors := false
+	var rplyEv map[string]map[string]any
+	for _, inv := range invs {
+		argEv := &CGREventWithEeIDs{
+			EeIDs:    exporterIDs,
+			CGREvent: inv.AsCGREvent(),
+		}
+		if verbose {
+			argEv.CGREvent.APIOpts[utils.OptsEEsVerbose] = struct{}{}
+		}
+		if err := invS.connMgr.Call(context.TODO(), invS.cfg.InvoiceSCfg().EEsConns,
+			utils.EeSv1ProcessEvent, argEv, &rplyEv); err != nil {
+			utils.Logger.Warning(fmt.Sprintf("<%s> error: <%s> processing event: <%s> with <%s>",
+				utils.InvoiceS, err.Error(), utils.ToJSON(argEv.CGREvent), utils.EEs))
+			withErrors = true
+		}
+	}
+	if withErrors {
+		return utils.ErrPartiallyExecuted
+	}
+	if reply == nil {
+		return
+	}
+	if *reply == nil {
+		*reply = make(map[string]any)
+	}
+	// we consider only the last reply because it should have the metrics updated
+	for exporterID, metrics := range rplyEv {
+		(*reply)[exporterID] = metrics
+	}
+	return
+}
+
+// V1BillRun builds the invoices of the accounts for the billing period
+func (invS *InvoiceService) V1BillRun(ctx *context.Context, args *ArgsBillRun, reply *[]*Invoice) (err error) {
+	if args.Export && len(invS.cfg.InvoiceSCfg().EEsConns) == 0 {
+		return utils.NewErrNotConnected(utils.EEs)
+	}
+	var invs []*Invoice
+	if invs, err = invS.billRun(args); err != nil {
+		if err != utils.ErrNotFound {
+			err = utils.NewErrServerError(err)
+		}
+		return
+	}
+	if args.Export {
+		if err = invS.exportInvoices(invs, args.ExporterIDs, false, nil); err != nil {
+			return
+		}
+	}
+	*reply = invs
+	return
+}
+
+// V1GetInvoices returns the stored invoices matching the filter
+func (invS *InvoiceService) V1GetInvoices(ctx *context.Context, args *InvoiceFilter, reply *[]*Invoice) (err error) {
+	invS.RLock()
+	cdrDB := invS.cdrDB
+	invS.RUnlock()
+	var invs []*Invoice
+	if invs, err = cdrDB.GetInvoices(args); err != nil {
+		if err != utils.ErrNotFound {
+			err = utils.NewErrServerError(err)
+		}
+		return
+	}
+	*reply = invs
+	return
+}
+
+// ArgExportInvoices selects the invoices to be exported and the exporters used
+type ArgExportInvoices struct {
+	InvoiceFilter
+	ExporterIDs []string
+	Verbose     bool
+}
+
+// V1ExportInvoices sends the stored invoices to EEs
+func (invS *InvoiceService) V1ExportInvoices(ctx *context.Context, args *ArgExportInvoices, reply *map[string]any) (err error) {
+	if len(invS.cfg.InvoiceSCfg().EEsConns) == 0 {
+		return utils.NewErrNotConnected(utils.EEs)
+	}
+	invS.RLock()
+	cdrDB := invS.cdrDB
+	invS.RUnlock()
+	var invs []*Invoice
+	if invs, err = cdrDB.GetInvoices(&args.InvoiceFilter); err != nil {
+		if err != utils.ErrNotFound {
+			err = utils.NewErrServerError(err)
+		}
+		return
+	}
+	return invS.exportInvoices(invs, args.ExporterIDs, args.Verbose, reply)
+}
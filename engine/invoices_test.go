@@ -0,0 +1,233 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestInvoiceComputeTotals(t *testing.T) {
+	inv := &Invoice{
+		LineItems: []*InvoiceLineItem{
+			{Type: utils.MetaUsage, GroupKey: "DST_DE", Quantity: 2, Amount: 60},
+			{Type: utils.MetaRecurring, GroupKey: "ACT_FEE", Quantity: 1, Amount: 40},
+		},
+	}
+	inv.computeTotals(10, 19)
+	if inv.Subtotal != 100 || inv.Discount != 10 || inv.Tax != 17.1 || inv.Total != 107.1 {
+		t.Errorf("Unexpected totals: %s", utils.ToJSON(inv))
+	}
+}
+
+func TestInternalDBInvoices(t *testing.T) {
+	stordb := NewInternalDB(nil, nil, false, config.CgrConfig().StorDbCfg().Items)
+	jan := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	feb := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	mar := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	invs := []*Invoice{
+		{ID: invoiceID("cgrates.org", "1002", feb, mar), Tenant: "cgrates.org", Account: "1002", PeriodStart: feb, PeriodEnd: mar, Total: 3},
+		{ID: invoiceID("cgrates.org", "1001", feb, mar), Tenant: "cgrates.org", Account: "1001", PeriodStart: feb, PeriodEnd: mar, Total: 2},
+		{ID: invoiceID("cgrates.org", "1001", jan, feb), Tenant: "cgrates.org", Account: "1001", PeriodStart: jan, PeriodEnd: feb, Total: 1},
+	}
+	for _, inv := range invs {
+		if err := stordb.SetInvoice(inv); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// billing again the period replaces the invoice
+	updated := *invs[2]
+	updated.Total = 10
+	if err := stordb.SetInvoice(&updated); err != nil {
+		t.Fatal(err)
+	}
+	if rcv, err := stordb.GetInvoices(&InvoiceFilter{
+		Tenants:  []string{"cgrates.org"},
+		Accounts: []string{"1001"},
+	}); err != nil {
+		t.Error(err)
+	} else if exp := []*Invoice{&updated, invs[1]}; !reflect.DeepEqual(exp, rcv) {
+		t.Errorf("Expected: %s, received: %s", utils.ToJSON(exp), utils.ToJSON(rcv))
+	}
+	if rcv, err := stordb.GetInvoices(&InvoiceFilter{PeriodStart: &feb}); err != nil {
+		t.Error(err)
+	} else if len(rcv) != 2 || rcv[0].PeriodStart != feb || rcv[1].PeriodStart != feb {
+		t.Errorf("Unexpected invoices: %s", utils.ToJSON(rcv))
+	}
+	if rcv, err := stordb.GetInvoices(&InvoiceFilter{PeriodEnd: &feb}); err != nil {
+		t.Error(err)
+	} else if exp := []*Invoice{&updated}; !reflect.DeepEqual(exp, rcv) {
+		t.Errorf("Expected: %s, received: %s", utils.ToJSON(exp), utils.ToJSON(rcv))
+	}
+	if rcv, err := stordb.GetInvoices(&InvoiceFilter{
+		Paginator: utils.Paginator{Limit: utils.IntPointer(1), Offset: utils.IntPointer(2)},
+	}); err != nil {
+		t.Error(err)
+	} else if len(rcv) != 1 {
+		t.Errorf("Unexpected invoices: %s", utils.ToJSON(rcv))
+	}
+	if _, err := stordb.GetInvoices(&InvoiceFilter{Accounts: []string{"1003"}}); err != utils.ErrNotFound {
+		t.Errorf("Expected error: %v, received: %v", utils.ErrNotFound, err)
+	}
+}
+
+func TestInvoiceServiceBillRun(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	cfg.InvoiceSCfg().TaxPercent = 20
+	stordb := NewInternalDB(nil, nil, false, cfg.StorDbCfg().Items)
+	jan := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	mar := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	for i, cdr := range []*CDR{
+		{RunID: utils.MetaDefault, Destination: "+4986517174963", Category: "call",
+			AnswerTime: jan.Add(time.Hour), Usage: time.Minute, Cost: 1.5},
+		{RunID: utils.MetaDefault, Destination: "+4986517174963", Category: "call",
+			AnswerTime: jan.Add(48 * time.Hour), Usage: 2 * time.Minute, Cost: 3},
+		{RunID: utils.MetaDefault, Destination: "+4312345", Category: "sms",
+			AnswerTime: jan.Add(72 * time.Hour), Usage: 1, Cost: 0.5},
+		{RunID: utils.MetaRaw, Destination: "+4312345", Category: "sms", // not rated
+			AnswerTime: jan.Add(72 * time.Hour), Usage: 1, Cost: -1},
+		{RunID: utils.MetaDefault, Destination: "+4312345", Category: "sms", // outside the period
+			AnswerTime: mar, Usage: 1, Cost: 0.5},
+		{RunID: utils.MetaDebit, Source: utils.CDRLog, // billed with the recurring items
+			AnswerTime: jan.Add(24 * time.Hour), Cost: 10},
+	} {
+		cdr.CGRID = utils.Sha1(utils.IfaceAsString(i))
+		cdr.OriginID = cdr.CGRID
+		cdr.Tenant = "cgrates.org"
+		cdr.Account = "inv1001"
+		if err := stordb.SetCDR(cdr, false); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := dm.SetActions("ACT_INV_FEE", Actions{{
+		Id:         "ACT_INV_FEE",
+		ActionType: utils.MetaDebit,
+		Balance: &BalanceFilter{
+			Type:  utils.StringPointer(utils.MetaMonetary),
+			Value: &utils.ValueFormula{Static: 10},
+		},
+	}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := dm.SetActionPlan("AP_INV_MONTHLY", &ActionPlan{
+		Id:         "AP_INV_MONTHLY",
+		AccountIDs: utils.StringMap{"cgrates.org:inv1001": true},
+		ActionTimings: []*ActionTiming{{
+			Uuid:      "uuid_inv",
+			ActionsID: "ACT_INV_FEE",
+			Timing: &RateInterval{
+				Timing: &RITiming{
+					MonthDays: utils.MonthDays{1},
+					StartTime: "00:00:00",
+				},
+			},
+		}},
+	}, true, utils.NonTransactional); err != nil {
+		t.Fatal(err)
+	}
+	if err := dm.SetAccountActionPlans("cgrates.org:inv1001", []string{"AP_INV_MONTHLY"}, true); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		dm.RemoveActions("ACT_INV_FEE")
+		dm.RemoveActionPlan("AP_INV_MONTHLY", utils.NonTransactional)
+		dm.RemAccountActionPlans("cgrates.org:inv1001", nil)
+	})
+	storDBChan := make(chan StorDB, 1)
+	storDBChan <- stordb
+	invS := NewInvoiceService(cfg, dm, storDBChan, nil)
+
+	var reply []*Invoice
+	if err := invS.V1BillRun(nil, &ArgsBillRun{
+		Tenant:      "cgrates.org",
+		Accounts:    []string{"inv1001"},
+		PeriodStart: &jan,
+		PeriodEnd:   &mar,
+	}, &reply); err != nil {
+		t.Fatal(err)
+	}
+	if len(reply) != 1 {
+		t.Fatalf("Unexpected invoices: %s", utils.ToJSON(reply))
+	}
+	inv := reply[0]
+	expLis := []*InvoiceLineItem{
+		{Type: utils.MetaUsage, GroupKey: "+4312345", Quantity: 1, Usage: 1, Amount: 0.5},
+		{Type: utils.MetaUsage, GroupKey: "+4986517174963", Quantity: 2, Usage: 3 * time.Minute, Amount: 4.5},
+		{Type: utils.MetaRecurring, GroupKey: "ACT_INV_FEE", Quantity: 2, Amount: 20},
+	}
+	if !reflect.DeepEqual(expLis, inv.LineItems) {
+		t.Errorf("Expected: %s, received: %s", utils.ToJSON(expLis), utils.ToJSON(inv.LineItems))
+	}
+	if inv.ID != invoiceID("cgrates.org", "inv1001", jan, mar) ||
+		inv.Subtotal != 25 || inv.Tax != 5 || inv.Total != 30 {
+		t.Errorf("Unexpected invoice: %s", utils.ToJSON(inv))
+	}
+	var stored []*Invoice
+	if err := invS.V1GetInvoices(nil, &InvoiceFilter{Accounts: []string{"inv1001"}}, &stored); err != nil {
+		t.Error(err)
+	} else if !reflect.DeepEqual(reply, stored) {
+		t.Errorf("Expected: %s, received: %s", utils.ToJSON(reply), utils.ToJSON(stored))
+	}
+
+	// grouped by category, without storing the invoice
+	if err := invS.V1BillRun(nil, &ArgsBillRun{
+		Tenant:      "cgrates.org",
+		Accounts:    []string{"inv1001"},
+		PeriodStart: &jan,
+		PeriodEnd:   &mar,
+		GroupBy:     utils.MetaCategory,
+		DryRun:      true,
+	}, &reply); err != nil {
+		t.Fatal(err)
+	}
+	if keys := []string{reply[0].LineItems[0].GroupKey, reply[0].LineItems[1].GroupKey}; !reflect.DeepEqual([]string{"call", "sms"}, keys) {
+		t.Errorf("Unexpected line items: %s", utils.ToJSON(reply[0].LineItems))
+	}
+	if err := invS.V1GetInvoices(nil, &InvoiceFilter{Accounts: []string{"inv1001"}}, &stored); err != nil {
+		t.Error(err)
+	} else if len(stored) != 1 || stored[0].LineItems[0].GroupKey != "+4312345" {
+		t.Errorf("Expected the dry run to not replace the invoice: %s", utils.ToJSON(stored))
+	}
+
+	// nothing to bill
+	start, end := jan.Add(96*time.Hour), jan.Add(240*time.Hour)
+	if err := invS.V1BillRun(nil, &ArgsBillRun{
+		Tenant:      "cgrates.org",
+		Accounts:    []string{"inv1001"},
+		PeriodStart: &start,
+		PeriodEnd:   &end,
+	}, &reply); err != utils.ErrNotFound {
+		t.Errorf("Expected error: %v, received: %v", utils.ErrNotFound, err)
+	}
+	if err := invS.V1BillRun(nil, &ArgsBillRun{
+		Accounts:    []string{"inv1001"},
+		PeriodStart: &mar,
+		PeriodEnd:   &jan,
+	}, &reply); err == nil {
+		t.Error("Expected error for the invalid period")
+	}
+	if err := invS.V1BillRun(nil, &ArgsBillRun{Export: true}, &reply); err == nil ||
+		err.Error() != utils.NewErrNotConnected(utils.EEs).Error() {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
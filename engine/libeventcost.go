@@ -190,6 +190,7 @@ type BalanceCharge struct {
 	RatingID      string  // special price applied on this balance
 	Units         float64 // number of units charged
 	ExtraChargeID string  // used in cases when paying *voice with *monetary
+	ExchangeRate  float64 `json:",omitempty"` // converting the Units into the currency of the balance
 }
 
 // FieldAsInterface func to help EventCost FieldAsInterface
@@ -210,6 +211,8 @@ func (bc *BalanceCharge) FieldAsInterface(fldPath []string) (val any, err error)
 		return bc.Units, nil
 	case utils.ExtraChargeID:
 		return bc.ExtraChargeID, nil
+	case utils.ExchangeRate:
+		return bc.ExchangeRate, nil
 	}
 }
 
@@ -227,6 +230,7 @@ func (bc *BalanceCharge) Equals(oBC *BalanceCharge) bool {
 		bc.BalanceUUID == oBC.BalanceUUID &&
 		bc.RatingID == oBC.RatingID &&
 		bc.Units == oBC.Units &&
+		bc.ExchangeRate == oBC.ExchangeRate &&
 		bcExtraChargeID == oBCExtraChargerID
 }
 
@@ -34,8 +34,12 @@ var (
 		utils.DynamicDataPrefix + utils.MetaAccounts,
 		utils.DynamicDataPrefix + utils.MetaStats,
 		utils.DynamicDataPrefix + utils.MetaResources,
+		utils.DynamicDataPrefix + utils.MetaThresholds,
+		utils.DynamicDataPrefix + utils.MetaRoutes,
 		utils.DynamicDataPrefix + utils.MetaLibPhoneNumber,
 		utils.DynamicDataPrefix + utils.MetaAsm,
+		utils.DynamicDataPrefix + utils.MetaHTTP,
+		utils.DynamicDataPrefix + utils.MetaGeoIP,
 	}
 )
 
@@ -719,6 +723,26 @@ func UpdateFilterIndex(dm *DataManager, oldFlt, newFlt *Filter) (err error) {
 				}, newFlt); err != nil && err != utils.ErrNotFound {
 				return utils.APIErrorHandler(err)
 			}
+		case utils.CacheTaxFilterIndexes:
+			if err = removeFilterIndexesForFilter(dm, idxItmType, newFlt.Tenant, // remove the indexes for the filter
+				removeIndexKeys, indx); err != nil {
+				return
+			}
+			idxSlice := indx.AsSlice()
+			if _, err = ComputeIndexes(dm, newFlt.Tenant, utils.EmptyString, idxItmType, // compute all the indexes for afected items
+				&idxSlice, utils.NonTransactional, func(tnt, id, ctx string) (*[]string, error) {
+					tp, e := dm.GetTaxProfile(tnt, id, true, false, utils.NonTransactional)
+					if e != nil {
+						return nil, e
+					}
+					fltrIDs := make([]string, len(tp.FilterIDs))
+					for i, fltrID := range tp.FilterIDs {
+						fltrIDs[i] = fltrID
+					}
+					return &fltrIDs, nil
+				}, newFlt); err != nil && err != utils.ErrNotFound {
+				return utils.APIErrorHandler(err)
+			}
 		case utils.CacheAttributeFilterIndexes:
 			for itemID := range indx {
 				var ap *AttributeProfile
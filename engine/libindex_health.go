@@ -341,6 +341,12 @@ func getFiltersAndContexts(dm *DataManager, indxType, tnt, id string) (filterIDs
 			return
 		}
 		filterIDs = ch.FilterIDs
+	case utils.CacheTaxFilterIndexes:
+		var tp *TaxProfile
+		if tp, err = dm.GetTaxProfile(tnt, id, true, false, utils.NonTransactional); err != nil {
+			return
+		}
+		filterIDs = tp.FilterIDs
 	case utils.CacheDispatcherFilterIndexes:
 		var ds *DispatcherProfile
 		if ds, err = dm.GetDispatcherProfile(tnt, id, true, false, utils.NonTransactional); err != nil {
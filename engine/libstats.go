@@ -306,7 +306,8 @@ func (sq *StatQueue) addStatEvent(tnt, evID string, filterS *FilterS, evNm utils
 	var pass bool
 	// recreate the request without *opts
 	dDP := newDynamicDP(config.CgrConfig().FilterSCfg().ResourceSConns, config.CgrConfig().FilterSCfg().StatSConns,
-		config.CgrConfig().FilterSCfg().ApierSConns, tnt, utils.MapStorage{utils.MetaReq: evNm[utils.MetaReq]})
+		config.CgrConfig().FilterSCfg().ApierSConns, config.CgrConfig().FilterSCfg().ThresholdSConns,
+		config.CgrConfig().FilterSCfg().RouteSConns, tnt, utils.MapStorage{utils.MetaReq: evNm[utils.MetaReq]})
 	for metricID, metric := range sq.SQMetrics {
 		if pass, err = filterS.Pass(tnt, metric.GetFilterIDs(),
 			evNm); err != nil {
@@ -450,6 +451,9 @@ func (sq *StatQueue) UnmarshalJSON(data []byte) (err error) {
 			metric = new(StatAverage)
 		case utils.MetaDistinct:
 			metric = new(StatDistinct)
+		case utils.MetaP50, utils.MetaP95, utils.MetaP99,
+			utils.MetaMax, utils.MetaMin:
+			metric = new(StatPercentile)
 		default:
 			return fmt.Errorf("unsupported metric type <%s>", metricSplit[0])
 		}
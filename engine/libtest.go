@@ -273,6 +273,11 @@ cgrates.org,ALS1,con2;con3,,,,*req.Field2,*variable,Sub2,true,20
 	ChargersCSVContent = `
 #Tenant,ID,FilterIDs,ActivationInterval,RunID,AttributeIDs,Weight
 cgrates.org,Charger1,*string:~*req.Account:1001,2014-07-29T15:00:00Z,*rated,ATTR_1001_SIMPLEAUTH,20
+`
+	TaxProfilesCSVContent = `
+#Tenant,ID,FilterIDs,ActivationInterval,Weight,ComponentID,Jurisdiction,Type,Value,Compound
+cgrates.org,TAX_DE,*string:~*req.Account:1001,2014-07-29T15:00:00Z,20,VAT,DE,*percent,19,false
+cgrates.org,TAX_DE,,,,FEE,DE,*flat,0.1,false
 `
 	DispatcherCSVContent = `
 #Tenant,ID,FilterIDs,ActivationInterval,Strategy,Hosts,Weight
@@ -525,11 +530,14 @@ func GetDefaultEmptyCacheStats() map[string]*ltcache.CacheStats {
 		utils.CacheSTIR:                    {},
 		utils.CacheRouteFilterIndexes:      {},
 		utils.CacheRouteProfiles:           {},
+		utils.CacheTaxFilterIndexes:        {},
+		utils.CacheTaxProfiles:             {},
 		utils.CacheThresholdFilterIndexes:  {},
 		utils.CacheThresholdProfiles:       {},
 		utils.CacheThresholds:              {},
 		utils.CacheTimings:                 {},
 		utils.CacheDiameterMessages:        {},
+		utils.CacheRadiusPackets:           {},
 		utils.CacheClosedSessions:          {},
 		utils.CacheLoadIDs:                 {},
 		utils.CacheRPCConnections:          {},
@@ -540,6 +548,7 @@ func GetDefaultEmptyCacheStats() map[string]*ltcache.CacheStats {
 		utils.CacheReverseFilterIndexes:    {},
 		utils.MetaAPIBan:                   {},
 		utils.MetaSentryPeer:               {},
+		utils.CacheHTTPLookups:             {},
 		utils.CacheCapsEvents:              {},
 		utils.CacheReplicationHosts:        {},
 	}
@@ -569,6 +578,7 @@ func LoadAllDataDBToCache(dm *DataManager) (err error) {
 		utils.RouteProfilePrefix:       {utils.MetaAny},
 		utils.AttributeProfilePrefix:   {utils.MetaAny},
 		utils.ChargerProfilePrefix:     {utils.MetaAny},
+		utils.TaxProfilePrefix:         {utils.MetaAny},
 		utils.DispatcherProfilePrefix:  {utils.MetaAny},
 		utils.DispatcherHostPrefix:     {utils.MetaAny},
 		utils.TimingsPrefix:            {utils.MetaAny},
@@ -578,6 +588,7 @@ func LoadAllDataDBToCache(dm *DataManager) (err error) {
 		utils.ThresholdFilterIndexes:   {utils.MetaAny},
 		utils.RouteFilterIndexes:       {utils.MetaAny},
 		utils.ChargerFilterIndexes:     {utils.MetaAny},
+		utils.TaxFilterIndexes:         {utils.MetaAny},
 		utils.DispatcherFilterIndexes:  {utils.MetaAny},
 		utils.FilterIndexPrfx:          {utils.MetaAny},
 	} {
@@ -41,7 +41,7 @@ func init() {
 		RatingPlansCSVContent, RatingProfilesCSVContent, SharedGroupsCSVContent,
 		ActionsCSVContent, ActionPlansCSVContent, ActionTriggersCSVContent, AccountActionsCSVContent,
 		ResourcesCSVContent, StatsCSVContent, ThresholdsCSVContent, FiltersCSVContent,
-		RoutesCSVContent, AttributesCSVContent, ChargersCSVContent, DispatcherCSVContent,
+		RoutesCSVContent, AttributesCSVContent, ChargersCSVContent, TaxProfilesCSVContent, DispatcherCSVContent,
 		DispatcherHostCSVContent), testTPID, "", nil, nil, false)
 	if err != nil {
 		log.Print("error when creating TpReader:", err)
@@ -100,6 +100,9 @@ func init() {
 	if err := csvr.LoadChargerProfiles(); err != nil {
 		log.Print("error in LoadChargerProfiles:", err)
 	}
+	if err := csvr.LoadTaxProfiles(); err != nil {
+		log.Print("error in LoadTaxProfiles:", err)
+	}
 	if err := csvr.LoadDispatcherProfiles(); err != nil {
 		log.Print("error in LoadDispatcherProfiles:", err)
 	}
@@ -1346,6 +1349,29 @@ func TestLoadChargerProfiles(t *testing.T) {
 	}
 }
 
+func TestLoadTaxProfiles(t *testing.T) {
+	eTaxProfile := &utils.TPTaxProfile{
+		TPid:      testTPID,
+		Tenant:    "cgrates.org",
+		ID:        "TAX_DE",
+		FilterIDs: []string{"*string:~*req.Account:1001"},
+		ActivationInterval: &utils.TPActivationInterval{
+			ActivationTime: "2014-07-29T15:00:00Z",
+		},
+		Weight: 20,
+		Components: []*utils.TPTaxComponent{
+			{ID: "VAT", Jurisdiction: "DE", Type: utils.MetaPercent, Value: 19},
+			{ID: "FEE", Jurisdiction: "DE", Type: utils.MetaFlat, Value: 0.1},
+		},
+	}
+	txpKey := utils.TenantID{Tenant: "cgrates.org", ID: "TAX_DE"}
+	if len(csvr.taxProfiles) != 1 {
+		t.Errorf("Failed to load taxProfiles: %s", utils.ToIJSON(csvr.taxProfiles))
+	} else if !reflect.DeepEqual(eTaxProfile, csvr.taxProfiles[txpKey]) {
+		t.Errorf("Expecting: %+v, received: %+v", utils.ToJSON(eTaxProfile), utils.ToJSON(csvr.taxProfiles[txpKey]))
+	}
+}
+
 func TestLoadDispatcherProfiles(t *testing.T) {
 	eDispatcherProfiles := &utils.TPDispatcherProfile{
 		TPid:       testTPID,
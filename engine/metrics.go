@@ -0,0 +1,218 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/cgrates/cgrates/utils"
+)
+
+// Metrics is the registry used by the subsystems to expose their metrics on the metrics_url
+var Metrics = NewMetricsRegistry()
+
+const (
+	metricsPrefix      = "cgrates_"
+	metricsContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+	MetricTypeCounter = "counter"
+	MetricTypeGauge   = "gauge"
+)
+
+// MetricsCollector is implemented by the subsystems exposing metrics
+type MetricsCollector interface {
+	CollectMetrics(mw *MetricsWriter)
+}
+
+// NewMetricsRegistry constructs a MetricsRegistry
+func NewMetricsRegistry() *MetricsRegistry {
+	return &MetricsRegistry{collectors: make(map[string]MetricsCollector)}
+}
+
+// MetricsRegistry holds the collectors of the running subsystems
+type MetricsRegistry struct {
+	sync.RWMutex
+	collectors map[string]MetricsCollector
+}
+
+// Register adds the collector, replacing the one with the same ID
+func (mr *MetricsRegistry) Register(id string, mc MetricsCollector) {
+	mr.Lock()
+	mr.collectors[id] = mc
+	mr.Unlock()
+}
+
+// Unregister removes the collector, used when the subsystem is stopped
+func (mr *MetricsRegistry) Unregister(id string) {
+	mr.Lock()
+	delete(mr.collectors, id)
+	mr.Unlock()
+}
+
+// WriteMetrics writes the metrics from all the collectors, ordered by collector ID
+func (mr *MetricsRegistry) WriteMetrics(mw *MetricsWriter) {
+	mr.RLock()
+	ids := make([]string, 0, len(mr.collectors))
+	for id := range mr.collectors {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	cols := make([]MetricsCollector, len(ids))
+	for i, id := range ids {
+		cols[i] = mr.collectors[id]
+	}
+	mr.RUnlock()
+	for _, mc := range cols {
+		mc.CollectMetrics(mw)
+	}
+}
+
+// ServeHTTP exposes the metrics in the Prometheus text format
+func (mr *MetricsRegistry) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	mw := NewMetricsWriter()
+	mr.WriteMetrics(mw)
+	w.Header().Set(utils.ContentType, metricsContentType)
+	if _, err := w.Write(mw.Bytes()); err != nil {
+		utils.Logger.Warning(fmt.Sprintf("<%s> failed writing the metrics, error: %s",
+			utils.HTTPMetricsURLCfg, err.Error()))
+	}
+}
+
+// NewMetricsWriter constructs a MetricsWriter
+func NewMetricsWriter() *MetricsWriter {
+	return &MetricsWriter{families: make(utils.StringSet)}
+}
+
+// MetricsWriter builds the Prometheus text exposition of the metrics
+// the samples of one family need to be written after its header
+type MetricsWriter struct {
+	buf      bytes.Buffer
+	families utils.StringSet
+}
+
+// Family writes the HELP and TYPE lines of a metric, only once per family
+func (mw *MetricsWriter) Family(name, typ, help string) {
+	name = metricsPrefix + name
+	if mw.families.Has(name) {
+		return
+	}
+	mw.families.Add(name)
+	fmt.Fprintf(&mw.buf, "# HELP %s %s\n# TYPE %s %s\n",
+		name, strings.NewReplacer(`\`, `\\`, "\n", `\n`).Replace(help), name, typ)
+}
+
+// Sample writes one value of a metric, the labels are given as name, value pairs
+func (mw *MetricsWriter) Sample(name string, value float64, labels ...string) {
+	mw.buf.WriteString(metricsPrefix)
+	mw.buf.WriteString(name)
+	if len(labels) > 1 {
+		mw.buf.WriteByte('{')
+		for i := 0; i+1 < len(labels); i += 2 {
+			if i != 0 {
+				mw.buf.WriteByte(',')
+			}
+			mw.buf.WriteString(labels[i])
+			mw.buf.WriteString(`="`)
+			mw.buf.WriteString(escapeMetricLabel(labels[i+1]))
+			mw.buf.WriteByte('"')
+		}
+		mw.buf.WriteByte('}')
+	}
+	mw.buf.WriteByte(' ')
+	mw.buf.WriteString(formatMetricValue(value))
+	mw.buf.WriteByte('\n')
+}
+
+// Bytes returns the metrics written so far
+func (mw *MetricsWriter) Bytes() []byte {
+	return mw.buf.Bytes()
+}
+
+func escapeMetricLabel(val string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(val)
+}
+
+func formatMetricValue(val float64) string {
+	switch {
+	case math.IsInf(val, 1):
+		return "+Inf"
+	case math.IsInf(val, -1):
+		return "-Inf"
+	case math.IsNaN(val):
+		return "NaN"
+	}
+	return strconv.FormatFloat(val, 'f', -1, 64)
+}
+
+// NewMetricCounters constructs MetricCounters
+func NewMetricCounters() *MetricCounters {
+	return &MetricCounters{cntrs: make(map[string]*atomic.Uint64)}
+}
+
+// MetricCounters keeps counters for a dynamic set of keys(e.g. per reader, per host)
+type MetricCounters struct {
+	sync.RWMutex
+	cntrs map[string]*atomic.Uint64
+}
+
+// Inc increments the counter for key
+func (mc *MetricCounters) Inc(key string) {
+	mc.RLock()
+	cntr, has := mc.cntrs[key]
+	mc.RUnlock()
+	if !has {
+		mc.Lock()
+		if cntr, has = mc.cntrs[key]; !has {
+			cntr = new(atomic.Uint64)
+			mc.cntrs[key] = cntr
+		}
+		mc.Unlock()
+	}
+	cntr.Add(1)
+}
+
+// Get returns the counter value for key
+func (mc *MetricCounters) Get(key string) uint64 {
+	mc.RLock()
+	defer mc.RUnlock()
+	if cntr, has := mc.cntrs[key]; has {
+		return cntr.Load()
+	}
+	return 0
+}
+
+// Keys returns the sorted keys with counters
+func (mc *MetricCounters) Keys() (keys []string) {
+	mc.RLock()
+	keys = make([]string, 0, len(mc.cntrs))
+	for key := range mc.cntrs {
+		keys = append(keys, key)
+	}
+	mc.RUnlock()
+	sort.Strings(keys)
+	return
+}
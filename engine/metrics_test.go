@@ -0,0 +1,138 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+package engine
+
+import (
+	"math"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+type mockMetricsCollector func(mw *MetricsWriter)
+
+func (mc mockMetricsCollector) CollectMetrics(mw *MetricsWriter) { mc(mw) }
+
+func TestMetricsWriter(t *testing.T) {
+	mw := NewMetricsWriter()
+	mw.Family("requests_total", MetricTypeCounter, "Number of requests.")
+	mw.Sample("requests_total", 3, "host", `HOST"1`)
+	mw.Family("requests_total", MetricTypeCounter, "Number of requests.")
+	mw.Sample("requests_total", 2, "host", "HOST2", "tenant", "cgrates.org")
+	mw.Family("value", MetricTypeGauge, "Value\nof the metric.")
+	mw.Sample("value", 0.5)
+	mw.Sample("value", math.Inf(1))
+	mw.Sample("value", math.NaN())
+	exp := `# HELP cgrates_requests_total Number of requests.
+# TYPE cgrates_requests_total counter
+cgrates_requests_total{host="HOST\"1"} 3
+cgrates_requests_total{host="HOST2",tenant="cgrates.org"} 2
+# HELP cgrates_value Value\nof the metric.
+# TYPE cgrates_value gauge
+cgrates_value 0.5
+cgrates_value +Inf
+cgrates_value NaN
+`
+	if rcv := string(mw.Bytes()); rcv != exp {
+		t.Errorf("Expected %q, received %q", exp, rcv)
+	}
+}
+
+func TestMetricsRegistryServeHTTP(t *testing.T) {
+	mr := NewMetricsRegistry()
+	caps := NewCaps(1, utils.MetaBusy)
+	if err := caps.Allocate(); err != nil {
+		t.Fatal(err)
+	}
+	if err := caps.Allocate(); err != utils.ErrMaxConcurrentRPCExceededNoCaps {
+		t.Errorf("Expected error <%v>, received <%v>", utils.ErrMaxConcurrentRPCExceededNoCaps, err)
+	}
+	mr.Register(utils.CoreS, caps)
+	mr.Register(utils.AttributeS, mockMetricsCollector(func(mw *MetricsWriter) {
+		mw.Family("attributes", MetricTypeGauge, "Attributes.")
+		mw.Sample("attributes", 1)
+	}))
+	mr.Register(utils.StatS, mockMetricsCollector(func(mw *MetricsWriter) {
+		mw.Family("stats", MetricTypeGauge, "Stats.")
+	}))
+	mr.Unregister(utils.StatS)
+
+	rec := httptest.NewRecorder()
+	mr.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	if ct := rec.Header().Get(utils.ContentType); ct != metricsContentType {
+		t.Errorf("Expected content type %q, received %q", metricsContentType, ct)
+	}
+	exp := `# HELP cgrates_attributes Attributes.
+# TYPE cgrates_attributes gauge
+cgrates_attributes 1
+# HELP cgrates_caps_limit Maximum number of concurrent API requests, 0 if not limited.
+# TYPE cgrates_caps_limit gauge
+cgrates_caps_limit 1
+# HELP cgrates_caps_allocated Number of API requests actively serviced.
+# TYPE cgrates_caps_allocated gauge
+cgrates_caps_allocated 1
+# HELP cgrates_caps_rejected_total Number of API requests refused because the caps were reached.
+# TYPE cgrates_caps_rejected_total counter
+cgrates_caps_rejected_total 1
+`
+	if rcv := rec.Body.String(); rcv != exp {
+		t.Errorf("Expected %q, received %q", exp, rcv)
+	}
+}
+
+func TestMetricCounters(t *testing.T) {
+	mc := NewMetricCounters()
+	mc.Inc("RDR2")
+	mc.Inc("RDR1")
+	mc.Inc("RDR2")
+	if rcv := mc.Keys(); len(rcv) != 2 || rcv[0] != "RDR1" || rcv[1] != "RDR2" {
+		t.Errorf("Unexpected keys: %v", rcv)
+	}
+	if rcv := mc.Get("RDR2"); rcv != 2 {
+		t.Errorf("Expected 2, received %d", rcv)
+	}
+	if rcv := mc.Get("RDR3"); rcv != 0 {
+		t.Errorf("Expected 0, received %d", rcv)
+	}
+}
+
+func TestCacheSCollectMetrics(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	chS := NewCacheS(cfg, nil, nil)
+	if err := chS.Set(utils.CacheAttributeProfiles, "cgrates.org:ATTR_1", nil, nil, true, utils.NonTransactional); err != nil {
+		t.Fatal(err)
+	}
+	chS.Get(utils.CacheAttributeProfiles, "cgrates.org:ATTR_1")
+	chS.Get(utils.CacheAttributeProfiles, "cgrates.org:ATTR_2")
+	chS.Get(utils.CacheAttributeProfiles, "cgrates.org:ATTR_3")
+	mw := NewMetricsWriter()
+	chS.CollectMetrics(mw)
+	rcv := string(mw.Bytes())
+	for _, exp := range []string{
+		`cgrates_cache_items{partition="*attribute_profiles"} 1`,
+		`cgrates_cache_hits_total{partition="*attribute_profiles"} 1`,
+		`cgrates_cache_misses_total{partition="*attribute_profiles"} 2`,
+	} {
+		if !strings.Contains(rcv, exp+"\n") {
+			t.Errorf("Expected %q in %q", exp, rcv)
+		}
+	}
+}
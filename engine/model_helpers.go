@@ -500,6 +500,29 @@ func MapTPRatingPlanBindings(s []*utils.TPRatingPlan) map[string][]*utils.TPRati
 	return result
 }
 
+// APItoRatingPlan converts the TP rating plan bindings with the same tag into a RatingPlan,
+// getTiming and getDestRate resolve the timings and the destination rates (with their rates attached)
+func APItoRatingPlan(tag string, rplBnds []*utils.TPRatingPlanBinding,
+	getTiming func(string) (*utils.TPTiming, error),
+	getDestRate func(string) (*utils.TPDestinationRate, error)) (rpl *RatingPlan, err error) {
+	rpl = &RatingPlan{Id: tag}
+	for _, rplBnd := range rplBnds {
+		var t *utils.TPTiming
+		if t, err = getTiming(rplBnd.TimingId); err != nil {
+			return nil, err
+		}
+		rplBnd.SetTiming(t)
+		var drs *utils.TPDestinationRate
+		if drs, err = getDestRate(rplBnd.DestinationRatesId); err != nil {
+			return nil, err
+		}
+		for _, dr := range drs.DestinationRates {
+			rpl.AddRateInterval(dr.DestinationId, GetRateInterval(rplBnd, dr))
+		}
+	}
+	return
+}
+
 func APItoModelRatingPlan(rp *utils.TPRatingPlan) (result RatingPlanMdls) {
 	if rp != nil {
 		for _, rpb := range rp.RatingPlanBindings {
@@ -574,6 +597,25 @@ func MapTPRatingProfiles(s []*utils.TPRatingProfile) (map[string]*utils.TPRating
 	return result, nil
 }
 
+// APItoRatingProfile converts the TP rating profile into a RatingProfile
+func APItoRatingProfile(tpRpf *utils.TPRatingProfile, timezone string) (rpf *RatingProfile, err error) {
+	rpf = &RatingProfile{Id: tpRpf.KeyId()}
+	for _, tpRa := range tpRpf.RatingPlanActivations {
+		at, err := utils.ParseTimeDetectLayout(tpRa.ActivationTime, timezone)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse activation time from %v", tpRa.ActivationTime)
+		}
+		rpf.RatingPlanActivations = append(rpf.RatingPlanActivations,
+			&RatingPlanActivation{
+				ActivationTime: at,
+				RatingPlanId:   tpRa.RatingPlanId,
+				FallbackKeys: utils.FallbackSubjKeys(tpRpf.Tenant,
+					tpRpf.Category, tpRa.FallbackSubjects),
+			})
+	}
+	return
+}
+
 func APItoModelRatingProfile(rp *utils.TPRatingProfile) (result RatingProfileMdls) {
 	if rp != nil {
 		for _, rpa := range rp.RatingPlanActivations {
@@ -739,6 +781,107 @@ func MapTPActions(s []*utils.TPActions) map[string][]*utils.TPAction {
 	return result
 }
 
+// APItoActions converts the TP actions with the same tag into Actions,
+// getTiming resolves the timings referenced by the balance TimingTags
+func APItoActions(tag string, tpActs []*utils.TPAction,
+	getTiming func(string) (*utils.TPTiming, error)) (acts Actions, err error) {
+	acts = make(Actions, len(tpActs))
+	for idx, tpact := range tpActs {
+		// check filter field
+		var fltrs []string
+		if len(tpact.Filters) > 0 {
+			fltrs = strings.Split(tpact.Filters, utils.InfieldSep)
+		}
+		if err = verifyInlineFilterS(fltrs); err != nil {
+			return nil, fmt.Errorf("error parsing action %s filter field: %v", tag, err)
+		}
+		acts[idx] = &Action{
+			Id:               tag,
+			ActionType:       tpact.Identifier,
+			Weight:           tpact.Weight,
+			ExtraParameters:  tpact.ExtraParameters,
+			ExpirationString: tpact.ExpiryTime,
+			Filters:          fltrs,
+			Balance:          &BalanceFilter{},
+		}
+		if tpact.BalanceId != "" && tpact.BalanceId != utils.MetaAny {
+			acts[idx].Balance.ID = utils.StringPointer(tpact.BalanceId)
+		}
+		if tpact.BalanceType != "" && tpact.BalanceType != utils.MetaAny {
+			acts[idx].Balance.Type = utils.StringPointer(tpact.BalanceType)
+		}
+
+		if tpact.Units != "" && tpact.Units != utils.MetaAny {
+			vf, err := utils.ParseBalanceFilterValue(tpact.BalanceType, tpact.Units)
+			if err != nil {
+				return nil, err
+			}
+			acts[idx].Balance.Value = vf
+		}
+
+		if tpact.BalanceWeight != "" && tpact.BalanceWeight != utils.MetaAny {
+			u, err := strconv.ParseFloat(tpact.BalanceWeight, 64)
+			if err != nil {
+				return nil, err
+			}
+			acts[idx].Balance.Weight = utils.Float64Pointer(u)
+		}
+
+		if tpact.RatingSubject != "" && tpact.RatingSubject != utils.MetaAny {
+			acts[idx].Balance.RatingSubject = utils.StringPointer(tpact.RatingSubject)
+		}
+
+		if tpact.Categories != "" && tpact.Categories != utils.MetaAny {
+			acts[idx].Balance.Categories = utils.StringMapPointer(utils.ParseStringMap(tpact.Categories))
+		}
+		if tpact.DestinationIds != "" && tpact.DestinationIds != utils.MetaAny {
+			acts[idx].Balance.DestinationIDs = utils.StringMapPointer(utils.ParseStringMap(tpact.DestinationIds))
+		}
+		if tpact.SharedGroups != "" && tpact.SharedGroups != utils.MetaAny {
+			acts[idx].Balance.SharedGroups = utils.StringMapPointer(utils.ParseStringMap(tpact.SharedGroups))
+		}
+		if tpact.TimingTags != "" && tpact.TimingTags != utils.MetaAny {
+			acts[idx].Balance.TimingIDs = utils.StringMapPointer(utils.ParseStringMap(tpact.TimingTags))
+		}
+		if tpact.BalanceBlocker != "" && tpact.BalanceBlocker != utils.MetaAny {
+			u, err := strconv.ParseBool(tpact.BalanceBlocker)
+			if err != nil {
+				return nil, err
+			}
+			acts[idx].Balance.Blocker = utils.BoolPointer(u)
+		}
+		if tpact.BalanceDisabled != "" && tpact.BalanceDisabled != utils.MetaAny {
+			u, err := strconv.ParseBool(tpact.BalanceDisabled)
+			if err != nil {
+				return nil, err
+			}
+			acts[idx].Balance.Disabled = utils.BoolPointer(u)
+		}
+
+		// load action timings from tags
+		if tpact.TimingTags != "" {
+			timingIds := strings.Split(tpact.TimingTags, utils.InfieldSep)
+			for _, timingID := range timingIds {
+				timing, err := getTiming(timingID)
+				if err != nil {
+					return nil, fmt.Errorf("error: %v querying timing with id: %q",
+						err.Error(), timingID)
+				}
+				acts[idx].Balance.Timings = append(acts[idx].Balance.Timings, &RITiming{
+					ID:        timingID,
+					Years:     timing.Years,
+					Months:    timing.Months,
+					MonthDays: timing.MonthDays,
+					WeekDays:  timing.WeekDays,
+					StartTime: timing.StartTime,
+					EndTime:   timing.EndTime,
+				})
+			}
+		}
+	}
+	return
+}
+
 func APItoModelAction(as *utils.TPActions) (result ActionMdls) {
 	if as != nil {
 		for _, a := range as.Actions {
@@ -825,6 +968,35 @@ func MapTPActionTimings(s []*utils.TPActionPlan) map[string][]*utils.TPActionTim
 	return result
 }
 
+// APItoActionPlan converts the TP action timings with the same tag into an ActionPlan,
+// getTiming resolves the timings referenced by the action timings
+func APItoActionPlan(tag string, tpAts []*utils.TPActionTiming,
+	getTiming func(string) (*utils.TPTiming, error)) (actPln *ActionPlan, err error) {
+	actPln = &ActionPlan{Id: tag}
+	for _, at := range tpAts {
+		var t *utils.TPTiming
+		if t, err = getTiming(at.TimingId); err != nil {
+			return nil, err
+		}
+		actPln.ActionTimings = append(actPln.ActionTimings, &ActionTiming{
+			Uuid:   utils.GenUUID(),
+			Weight: at.Weight,
+			Timing: &RateInterval{
+				Timing: &RITiming{
+					ID:        at.TimingId,
+					Years:     t.Years,
+					Months:    t.Months,
+					MonthDays: t.MonthDays,
+					WeekDays:  t.WeekDays,
+					StartTime: t.StartTime,
+				},
+			},
+			ActionsID: at.ActionsId,
+		})
+	}
+	return
+}
+
 func APItoModelActionPlan(a *utils.TPActionPlan) (result ActionPlanMdls) {
 	if a != nil {
 		for _, ap := range a.ActionPlan {
@@ -916,6 +1088,95 @@ func MapTPActionTriggers(s []*utils.TPActionTriggers) map[string][]*utils.TPActi
 	return result
 }
 
+// APItoActionTriggers converts the TP action triggers with the same tag into ActionTriggers
+func APItoActionTriggers(tag string, tpAtrs []*utils.TPActionTrigger,
+	timezone string) (atrs ActionTriggers, err error) {
+	atrs = make(ActionTriggers, len(tpAtrs))
+	for idx, atr := range tpAtrs {
+		expirationDate, err := utils.ParseTimeDetectLayout(atr.ExpirationDate, timezone)
+		if err != nil {
+			return nil, err
+		}
+		activationDate, err := utils.ParseTimeDetectLayout(atr.ActivationDate, timezone)
+		if err != nil {
+			return nil, err
+		}
+		minSleep, err := utils.ParseDurationWithNanosecs(atr.MinSleep)
+		if err != nil {
+			return nil, err
+		}
+		if atr.UniqueID == "" {
+			atr.UniqueID = utils.GenUUID()
+		}
+		atrs[idx] = &ActionTrigger{
+			ID:             tag,
+			UniqueID:       atr.UniqueID,
+			ThresholdType:  atr.ThresholdType,
+			ThresholdValue: atr.ThresholdValue,
+			Recurrent:      atr.Recurrent,
+			MinSleep:       minSleep,
+			ExpirationDate: expirationDate,
+			ActivationDate: activationDate,
+			Balance:        &BalanceFilter{},
+			Weight:         atr.Weight,
+			ActionsID:      atr.ActionsId,
+		}
+		if atr.BalanceId != "" && atr.BalanceId != utils.MetaAny {
+			atrs[idx].Balance.ID = utils.StringPointer(atr.BalanceId)
+		}
+
+		if atr.BalanceType != "" && atr.BalanceType != utils.MetaAny {
+			atrs[idx].Balance.Type = utils.StringPointer(atr.BalanceType)
+		}
+
+		if atr.BalanceWeight != "" && atr.BalanceWeight != utils.MetaAny {
+			u, err := strconv.ParseFloat(atr.BalanceWeight, 64)
+			if err != nil {
+				return nil, err
+			}
+			atrs[idx].Balance.Weight = utils.Float64Pointer(u)
+		}
+		if atr.BalanceExpirationDate != "" && atr.BalanceExpirationDate != utils.MetaAny && atr.ExpirationDate != utils.MetaUnlimited {
+			u, err := utils.ParseTimeDetectLayout(atr.BalanceExpirationDate, timezone)
+			if err != nil {
+				return nil, err
+			}
+			atrs[idx].Balance.ExpirationDate = utils.TimePointer(u)
+		}
+		if atr.BalanceRatingSubject != "" && atr.BalanceRatingSubject != utils.MetaAny {
+			atrs[idx].Balance.RatingSubject = utils.StringPointer(atr.BalanceRatingSubject)
+		}
+
+		if atr.BalanceCategories != "" && atr.BalanceCategories != utils.MetaAny {
+			atrs[idx].Balance.Categories = utils.StringMapPointer(utils.ParseStringMap(atr.BalanceCategories))
+		}
+		if atr.BalanceDestinationIds != "" && atr.BalanceDestinationIds != utils.MetaAny {
+			atrs[idx].Balance.DestinationIDs = utils.StringMapPointer(utils.ParseStringMap(atr.BalanceDestinationIds))
+		}
+		if atr.BalanceSharedGroups != "" && atr.BalanceSharedGroups != utils.MetaAny {
+			atrs[idx].Balance.SharedGroups = utils.StringMapPointer(utils.ParseStringMap(atr.BalanceSharedGroups))
+		}
+		if atr.BalanceTimingTags != "" && atr.BalanceTimingTags != utils.MetaAny {
+			atrs[idx].Balance.TimingIDs = utils.StringMapPointer(utils.ParseStringMap(atr.BalanceTimingTags))
+		}
+		if atr.BalanceBlocker != "" && atr.BalanceBlocker != utils.MetaAny {
+			u, err := strconv.ParseBool(atr.BalanceBlocker)
+			if err != nil {
+				return nil, err
+			}
+			atrs[idx].Balance.Blocker = utils.BoolPointer(u)
+		}
+		if atr.BalanceDisabled != "" && atr.BalanceDisabled != utils.MetaAny {
+			u, err := strconv.ParseBool(atr.BalanceDisabled)
+			if err != nil {
+				return nil, err
+			}
+			atrs[idx].Balance.Disabled = utils.BoolPointer(u)
+		}
+	}
+	return
+}
+
 func APItoModelActionTrigger(ats *utils.TPActionTriggers) (result ActionTriggerMdls) {
 	if ats != nil {
 		for _, at := range ats.ActionTriggers {
@@ -2495,6 +2756,168 @@ func ChargerProfileToAPI(chargerPrf *ChargerProfile) (tpCharger *utils.TPCharger
 	return
 }
 
+type TaxProfileMdls []*TaxProfileMdl
+
+// CSVHeader return the header for csv fields as a slice of string
+func (tps TaxProfileMdls) CSVHeader() (result []string) {
+	return []string{"#" + utils.Tenant, utils.ID, utils.FilterIDs, utils.ActivationIntervalString,
+		utils.Weight, utils.ComponentID, utils.Jurisdiction, utils.Type, utils.Value, utils.Compound}
+}
+
+// AsTPTaxProfiles groups the rows into profiles, keeping the order of the components
+func (tps TaxProfileMdls) AsTPTaxProfiles() (result []*utils.TPTaxProfile) {
+	mst := make(map[string]*utils.TPTaxProfile)
+	filterMap := make(map[string]utils.StringSet)
+	for _, tp := range tps {
+		tntID := (&utils.TenantID{Tenant: tp.Tenant, ID: tp.ID}).TenantID()
+		tpTxp, found := mst[tntID]
+		if !found {
+			tpTxp = &utils.TPTaxProfile{
+				TPid:   tp.Tpid,
+				Tenant: tp.Tenant,
+				ID:     tp.ID,
+			}
+		}
+		if tp.Weight != 0 {
+			tpTxp.Weight = tp.Weight
+		}
+		if len(tp.ActivationInterval) != 0 {
+			tpTxp.ActivationInterval = new(utils.TPActivationInterval)
+			aiSplt := strings.Split(tp.ActivationInterval, utils.InfieldSep)
+			if len(aiSplt) == 2 {
+				tpTxp.ActivationInterval.ActivationTime = aiSplt[0]
+				tpTxp.ActivationInterval.ExpiryTime = aiSplt[1]
+			} else if len(aiSplt) == 1 {
+				tpTxp.ActivationInterval.ActivationTime = aiSplt[0]
+			}
+		}
+		if tp.FilterIDs != utils.EmptyString {
+			if _, has := filterMap[tntID]; !has {
+				filterMap[tntID] = make(utils.StringSet)
+			}
+			filterMap[tntID].AddSlice(strings.Split(tp.FilterIDs, utils.InfieldSep))
+		}
+		if tp.ComponentID != utils.EmptyString {
+			tpTxp.Components = append(tpTxp.Components, &utils.TPTaxComponent{
+				ID:           tp.ComponentID,
+				Jurisdiction: tp.Jurisdiction,
+				Type:         tp.Type,
+				Value:        tp.Value,
+				Compound:     tp.Compound,
+			})
+		}
+		mst[tntID] = tpTxp
+	}
+	result = make([]*utils.TPTaxProfile, len(mst))
+	i := 0
+	for tntID, tp := range mst {
+		result[i] = tp
+		result[i].FilterIDs = filterMap[tntID].AsSlice()
+		i++
+	}
+	return
+}
+
+func APItoModelTPTaxProfile(tpTxp *utils.TPTaxProfile) (mdls TaxProfileMdls) {
+	if tpTxp == nil {
+		return
+	}
+	var actInterval string
+	if tpTxp.ActivationInterval != nil {
+		if tpTxp.ActivationInterval.ActivationTime != utils.EmptyString {
+			actInterval = tpTxp.ActivationInterval.ActivationTime
+		}
+		if tpTxp.ActivationInterval.ExpiryTime != utils.EmptyString {
+			actInterval += utils.InfieldSep + tpTxp.ActivationInterval.ExpiryTime
+		}
+	}
+	rows := len(tpTxp.Components)
+	if rows == 0 {
+		rows = 1
+	}
+	for i := 0; i < rows; i++ {
+		mdl := &TaxProfileMdl{
+			Tpid:   tpTxp.TPid,
+			Tenant: tpTxp.Tenant,
+			ID:     tpTxp.ID,
+		}
+		if i == 0 {
+			mdl.FilterIDs = strings.Join(tpTxp.FilterIDs, utils.InfieldSep)
+			mdl.ActivationInterval = actInterval
+			mdl.Weight = tpTxp.Weight
+		}
+		if i < len(tpTxp.Components) {
+			mdl.ComponentID = tpTxp.Components[i].ID
+			mdl.Jurisdiction = tpTxp.Components[i].Jurisdiction
+			mdl.Type = tpTxp.Components[i].Type
+			mdl.Value = tpTxp.Components[i].Value
+			mdl.Compound = tpTxp.Components[i].Compound
+		}
+		mdls = append(mdls, mdl)
+	}
+	return
+}
+
+func APItoTaxProfile(tpTxp *utils.TPTaxProfile, timezone string) (txp *TaxProfile, err error) {
+	txp = &TaxProfile{
+		Tenant:     tpTxp.Tenant,
+		ID:         tpTxp.ID,
+		Weight:     tpTxp.Weight,
+		FilterIDs:  make([]string, len(tpTxp.FilterIDs)),
+		Components: make([]*TaxComponent, len(tpTxp.Components)),
+	}
+	for i, fli := range tpTxp.FilterIDs {
+		txp.FilterIDs[i] = fli
+	}
+	for i, tc := range tpTxp.Components {
+		txp.Components[i] = &TaxComponent{
+			ID:           tc.ID,
+			Jurisdiction: tc.Jurisdiction,
+			Type:         tc.Type,
+			Value:        tc.Value,
+			Compound:     tc.Compound,
+		}
+	}
+	if tpTxp.ActivationInterval != nil {
+		if txp.ActivationInterval, err = tpTxp.ActivationInterval.AsActivationInterval(timezone); err != nil {
+			return nil, err
+		}
+	}
+	return txp, nil
+}
+
+func TaxProfileToAPI(txp *TaxProfile) (tpTxp *utils.TPTaxProfile) {
+	tpTxp = &utils.TPTaxProfile{
+		Tenant:             txp.Tenant,
+		ID:                 txp.ID,
+		FilterIDs:          make([]string, len(txp.FilterIDs)),
+		ActivationInterval: new(utils.TPActivationInterval),
+		Weight:             txp.Weight,
+		Components:         make([]*utils.TPTaxComponent, len(txp.Components)),
+	}
+	for i, fli := range txp.FilterIDs {
+		tpTxp.FilterIDs[i] = fli
+	}
+	for i, tc := range txp.Components {
+		tpTxp.Components[i] = &utils.TPTaxComponent{
+			ID:           tc.ID,
+			Jurisdiction: tc.Jurisdiction,
+			Type:         tc.Type,
+			Value:        tc.Value,
+			Compound:     tc.Compound,
+		}
+	}
+	if txp.ActivationInterval != nil {
+		if !txp.ActivationInterval.ActivationTime.IsZero() {
+			tpTxp.ActivationInterval.ActivationTime = txp.ActivationInterval.ActivationTime.Format(time.RFC3339)
+		}
+		if !txp.ActivationInterval.ExpiryTime.IsZero() {
+			tpTxp.ActivationInterval.ExpiryTime = txp.ActivationInterval.ExpiryTime.Format(time.RFC3339)
+		}
+	}
+	return
+}
+
 type DispatcherProfileMdls []*DispatcherProfileMdl
 
 // CSVHeader return the header for csv fields as a slice of string
@@ -398,6 +398,45 @@ func (t SessionCostsSQL) TableName() string {
 	return utils.SessionCostsTBL
 }
 
+type BalanceLedgerSQL struct {
+	ID          int64
+	Tenant      string
+	Account     string
+	BalanceType string
+	BalanceID   string
+	BalanceUUID string
+	OldValue    float64
+	NewValue    float64
+	Delta       float64
+	Cause       string
+	CauseID     string
+	Time        time.Time
+}
+
+func (t BalanceLedgerSQL) TableName() string {
+	return utils.BalanceLedgerTBL
+}
+
+type InvoiceSQL struct {
+	ID          int64
+	InvoiceID   string
+	Tenant      string
+	Account     string
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+	Currency    string
+	LineItems   string
+	Subtotal    float64
+	Discount    float64
+	Tax         float64
+	Total       float64
+	CreatedAt   time.Time
+}
+
+func (t InvoiceSQL) TableName() string {
+	return utils.InvoicesTBL
+}
+
 type TBLVersion struct {
 	ID      uint
 	Item    string
@@ -472,6 +511,26 @@ func (ChargerMdl) TableName() string {
 	return utils.TBLTPChargers
 }
 
+type TaxProfileMdl struct {
+	PK                 uint `gorm:"primary_key"`
+	Tpid               string
+	Tenant             string  `index:"0" re:".*"`
+	ID                 string  `index:"1" re:".*"`
+	FilterIDs          string  `index:"2" re:".*"`
+	ActivationInterval string  `index:"3" re:".*"`
+	Weight             float64 `index:"4" re:".*"`
+	ComponentID        string  `index:"5" re:".*"`
+	Jurisdiction       string  `index:"6" re:".*"`
+	Type               string  `index:"7" re:".*"`
+	Value              float64 `index:"8" re:".*"`
+	Compound           bool    `index:"9" re:".*"`
+	CreatedAt          time.Time
+}
+
+func (TaxProfileMdl) TableName() string {
+	return utils.TBLTPTaxProfiles
+}
+
 type DispatcherProfileMdl struct {
 	PK                 uint    `gorm:"primary_key"`
 	Tpid               string  //
@@ -0,0 +1,63 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"time"
+
+	"github.com/cgrates/cgrates/utils"
+)
+
+// StoredSession is an active session of SessionS as backed up in DataDB
+type StoredSession struct {
+	NodeID        string // the node which owns the session, restored only by the same node
+	CGRID         string
+	Tenant        string
+	ResourceID    string
+	ClientConnID  string
+	EventStart    MapEvent
+	DebitInterval time.Duration
+	Chargeable    bool
+	SRuns         []*StoredSRun
+	OptsStart     MapEvent
+
+	// the session timeout, restarted on restore
+	TTL          time.Duration
+	TTLLastUsed  *time.Duration
+	TTLUsage     *time.Duration
+	TTLLastUsage *time.Duration
+
+	UpdatedAt time.Time
+}
+
+// StoredSRun is the stored version of a session run, with the state of its debit loop
+type StoredSRun struct {
+	Event         MapEvent
+	CD            *CallDescriptor
+	EventCost     *EventCost
+	ExtraDuration time.Duration
+	LastUsage     time.Duration
+	TotalUsage    time.Duration
+	NextAutoDebit *time.Time
+}
+
+// BackupID returns the key of the session in DataDB
+func (ss *StoredSession) BackupID() string {
+	return utils.ConcatenatedKey(ss.NodeID, ss.CGRID)
+}
@@ -20,6 +20,8 @@ package engine
 
 import (
 	"fmt"
+	"math"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -54,6 +56,11 @@ func NewStatMetric(metricID string, minItems int, filterIDs []string) (sm StatMe
 		utils.MetaSum:      NewStatSum,
 		utils.MetaAverage:  NewStatAverage,
 		utils.MetaDistinct: NewStatDistinct,
+		utils.MetaP50:      NewStatP50,
+		utils.MetaP95:      NewStatP95,
+		utils.MetaP99:      NewStatP99,
+		utils.MetaMax:      NewStatMax,
+		utils.MetaMin:      NewStatMin,
 	}
 	// split the metricID
 	// in case of *sum we have *sum#~*req.FieldName
@@ -1457,3 +1464,218 @@ func (dst *StatDistinct) GetCompressFactor(events map[string]int) map[string]int
 	}
 	return events
 }
+
+func NewStatP50(minItems int, extraParams string, filterIDs []string) (StatMetric, error) {
+	return newStatPercentile(50, minItems, extraParams, filterIDs), nil
+}
+
+func NewStatP95(minItems int, extraParams string, filterIDs []string) (StatMetric, error) {
+	return newStatPercentile(95, minItems, extraParams, filterIDs), nil
+}
+
+func NewStatP99(minItems int, extraParams string, filterIDs []string) (StatMetric, error) {
+	return newStatPercentile(99, minItems, extraParams, filterIDs), nil
+}
+
+// NewStatMax is the 100th percentile
+func NewStatMax(minItems int, extraParams string, filterIDs []string) (StatMetric, error) {
+	return newStatPercentile(100, minItems, extraParams, filterIDs), nil
+}
+
+// NewStatMin is the 0th percentile
+func NewStatMin(minItems int, extraParams string, filterIDs []string) (StatMetric, error) {
+	return newStatPercentile(0, minItems, extraParams, filterIDs), nil
+}
+
+func newStatPercentile(percentile float64, minItems int, extraParams string, filterIDs []string) *StatPercentile {
+	return &StatPercentile{Events: make(map[string][]float64),
+		MinItems: minItems, FieldName: extraParams, FilterIDs: filterIDs,
+		Percentile: percentile}
+}
+
+// StatPercentile implements the *p50, *p95, *p99, *max and *min metrics
+// using the nearest-rank method over the values of a field in the queue
+type StatPercentile struct {
+	FilterIDs    []string
+	Percentile   float64
+	Count        int64
+	Events       map[string][]float64 // map[EventTenantID]values
+	Compressed   []*StatWithCompress  `json:",omitempty"` // rounded values of the compressed events, sorted
+	CompressedID string               `json:",omitempty"` // the event ID holding the compressed values
+	MinItems     int
+	FieldName    string
+	val          *float64 // cached percentile value
+}
+
+// getValue returns pct.val
+func (pct *StatPercentile) getValue(roundingDecimal int) float64 {
+	if pct.val == nil {
+		if pct.Count == 0 || pct.Count < int64(pct.MinItems) {
+			pct.val = utils.Float64Pointer(utils.StatsNA)
+		} else {
+			vals := make([]*StatWithCompress, 0, len(pct.Compressed)+int(pct.Count))
+			vals = append(vals, pct.Compressed...)
+			for _, evVals := range pct.Events {
+				for _, val := range evVals {
+					vals = append(vals, &StatWithCompress{Stat: val, CompressFactor: 1})
+				}
+			}
+			sort.Slice(vals, func(i, j int) bool { return vals[i].Stat < vals[j].Stat })
+			rank := int64(math.Ceil(pct.Percentile / 100 * float64(pct.Count)))
+			if rank < 1 {
+				rank = 1
+			}
+			var val float64
+			var count int64
+			for _, v := range vals {
+				val = v.Stat
+				if count += int64(v.CompressFactor); count >= rank {
+					break
+				}
+			}
+			pct.val = utils.Float64Pointer(utils.Round(val,
+				roundingDecimal, utils.MetaRoundingMiddle))
+		}
+	}
+	return *pct.val
+}
+
+func (pct *StatPercentile) GetStringValue(roundingDecimal int) (valStr string) {
+	if val := pct.getValue(roundingDecimal); val == utils.StatsNA {
+		valStr = utils.NotAvailable
+	} else {
+		valStr = strconv.FormatFloat(val, 'f', -1, 64)
+	}
+	return
+}
+
+func (pct *StatPercentile) GetValue(roundingDecimal int) (v any) {
+	return pct.getValue(roundingDecimal)
+}
+
+func (pct *StatPercentile) GetFloat64Value(roundingDecimal int) (v float64) {
+	return pct.getValue(roundingDecimal)
+}
+
+func (pct *StatPercentile) AddEvent(evID string, ev utils.DataProvider) (err error) {
+	var val float64
+	var ival any
+	if ival, err = utils.DPDynamicInterface(pct.FieldName, ev); err != nil {
+		if err == utils.ErrNotFound {
+			err = utils.ErrPrefix(err, pct.FieldName)
+		}
+		return
+	} else if val, err = utils.IfaceAsFloat64(ival); err != nil {
+		return
+	}
+	pct.Events[evID] = append(pct.Events[evID], val)
+	pct.Count++
+	pct.val = nil
+	return
+}
+
+// RemEvent removes the oldest value added for the event, for the compressed
+// event the median value is removed as the order of the values is lost
+func (pct *StatPercentile) RemEvent(evID string) (err error) {
+	if vals, has := pct.Events[evID]; has {
+		if len(vals) <= 1 {
+			delete(pct.Events, evID)
+		} else {
+			pct.Events[evID] = vals[1:]
+		}
+	} else if evID == pct.CompressedID && len(pct.Compressed) != 0 {
+		pct.remCompressed()
+	} else {
+		return utils.ErrNotFound
+	}
+	pct.Count--
+	pct.val = nil
+	return
+}
+
+// remCompressed removes the median out of the compressed values
+func (pct *StatPercentile) remCompressed() {
+	var total int
+	for _, v := range pct.Compressed {
+		total += v.CompressFactor
+	}
+	var count int
+	for i, v := range pct.Compressed {
+		if count += v.CompressFactor; count < (total+1)/2 {
+			continue
+		}
+		if v.CompressFactor--; v.CompressFactor == 0 {
+			pct.Compressed = append(pct.Compressed[:i], pct.Compressed[i+1:]...)
+		}
+		break
+	}
+	if len(pct.Compressed) == 0 {
+		pct.Compressed = nil
+		pct.CompressedID = utils.EmptyString
+	}
+}
+
+func (pct *StatPercentile) Marshal(ms Marshaler) (marshaled []byte, err error) {
+	return ms.Marshal(pct)
+}
+
+func (pct *StatPercentile) LoadMarshaled(ms Marshaler, marshaled []byte) (err error) {
+	return ms.Unmarshal(marshaled, pct)
+}
+
+// GetFilterIDs is part of StatMetric interface
+func (pct *StatPercentile) GetFilterIDs() []string {
+	return pct.FilterIDs
+}
+
+// GetMinItems returns the minim items for the metric
+func (pct *StatPercentile) GetMinItems() (minIts int) { return pct.MinItems }
+
+// Compress is part of StatMetric interface
+// the values are rounded and kept with the number of their occurrences under the defaultID
+func (pct *StatPercentile) Compress(queueLen int64, defaultID string, roundingDecimal int) (eventIDs []string) {
+	if pct.Count < queueLen {
+		for id := range pct.Events {
+			eventIDs = append(eventIDs, id)
+		}
+		if _, has := pct.Events[pct.CompressedID]; !has && len(pct.Compressed) != 0 {
+			eventIDs = append(eventIDs, pct.CompressedID)
+		}
+		return
+	}
+	cmpVals := make(map[float64]int)
+	for _, v := range pct.Compressed {
+		cmpVals[v.Stat] += v.CompressFactor
+	}
+	for _, evVals := range pct.Events {
+		for _, val := range evVals {
+			cmpVals[utils.Round(val, roundingDecimal, utils.MetaRoundingMiddle)]++
+		}
+	}
+	pct.Compressed = make([]*StatWithCompress, 0, len(cmpVals))
+	for val, cf := range cmpVals {
+		pct.Compressed = append(pct.Compressed, &StatWithCompress{Stat: val, CompressFactor: cf})
+	}
+	sort.Slice(pct.Compressed, func(i, j int) bool { return pct.Compressed[i].Stat < pct.Compressed[j].Stat })
+	pct.Events = make(map[string][]float64)
+	pct.CompressedID = defaultID
+	pct.val = nil
+	return []string{defaultID}
+}
+
+// GetCompressFactor is part of StatMetric interface
+func (pct *StatPercentile) GetCompressFactor(events map[string]int) map[string]int {
+	cfs := make(map[string]int)
+	for id, vals := range pct.Events {
+		cfs[id] = len(vals)
+	}
+	for _, v := range pct.Compressed {
+		cfs[pct.CompressedID] += v.CompressFactor
+	}
+	for id, cf := range cfs {
+		if events[id] < cf {
+			events[id] = cf
+		}
+	}
+	return events
+}
@@ -18,6 +18,8 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>
 package engine
 
 import (
+	"encoding/json"
+	"fmt"
 	"net"
 	"reflect"
 	"sort"
@@ -4177,3 +4179,193 @@ func TestStatMetricsStatASRAddEventErr3(t *testing.T) {
 		t.Errorf("\nExpecting <%+v>,\n Recevied <%+v>", utils.ErrAccountNotFound, err)
 	}
 }
+
+func TestStatPercentileGetFloat64Value(t *testing.T) {
+	p95, _ := NewStatMetric(utils.MetaP95+utils.HashtagSep+"~*req.PDD", 2, []string{})
+	if v := p95.GetFloat64Value(config.CgrConfig().GeneralCfg().RoundingDecimals); v != utils.StatsNA {
+		t.Errorf("expecting: %v, received: %v", utils.StatsNA, v)
+	}
+	for i := 1; i <= 20; i++ {
+		ev := utils.MapStorage{utils.MetaReq: map[string]any{utils.PDD: i}}
+		if err := p95.AddEvent(fmt.Sprintf("EVENT_%d", i), ev); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if v := p95.GetFloat64Value(config.CgrConfig().GeneralCfg().RoundingDecimals); v != 19 {
+		t.Errorf("expecting: 19, received: %v", v)
+	}
+	p95.AddEvent("EVENT_21", utils.MapStorage{utils.MetaReq: map[string]any{utils.PDD: 100}})
+	if v := p95.GetStringValue(config.CgrConfig().GeneralCfg().RoundingDecimals); v != "20" {
+		t.Errorf("expecting: 20, received: %v", v)
+	}
+	if err := p95.RemEvent("EVENT_21"); err != nil {
+		t.Error(err)
+	} else if v := p95.GetValue(config.CgrConfig().GeneralCfg().RoundingDecimals); v != 19.0 {
+		t.Errorf("expecting: 19, received: %v", v)
+	}
+	if err := p95.RemEvent("EVENT_21"); err != utils.ErrNotFound {
+		t.Errorf("expecting: %v, received: %v", utils.ErrNotFound, err)
+	}
+	if err := p95.AddEvent("EVENT_22", utils.MapStorage{utils.MetaReq: map[string]any{}}); err == nil ||
+		err.Error() != "NOT_FOUND:~*req.PDD" {
+		t.Errorf("expecting: NOT_FOUND:~*req.PDD, received: %v", err)
+	}
+}
+
+func TestStatPercentileMinMax(t *testing.T) {
+	statMax, _ := NewStatMax(0, "~*req.Usage", []string{})
+	statMin, _ := NewStatMin(0, "~*req.Usage", []string{})
+	for i, usage := range []time.Duration{time.Minute, 10 * time.Second, 2 * time.Minute, 30 * time.Second} {
+		ev := utils.MapStorage{utils.MetaReq: map[string]any{utils.Usage: usage}}
+		if err := statMax.AddEvent(fmt.Sprintf("EVENT_%d", i), ev); err != nil {
+			t.Fatal(err)
+		}
+		if err := statMin.AddEvent(fmt.Sprintf("EVENT_%d", i), ev); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if v := statMax.GetFloat64Value(4); v != float64(2*time.Minute) {
+		t.Errorf("expecting: %v, received: %v", float64(2*time.Minute), v)
+	}
+	if v := statMin.GetFloat64Value(4); v != float64(10*time.Second) {
+		t.Errorf("expecting: %v, received: %v", float64(10*time.Second), v)
+	}
+	statMax.RemEvent("EVENT_2")
+	statMin.RemEvent("EVENT_1")
+	if v := statMax.GetFloat64Value(4); v != float64(time.Minute) {
+		t.Errorf("expecting: %v, received: %v", float64(time.Minute), v)
+	}
+	if v := statMin.GetFloat64Value(4); v != float64(30*time.Second) {
+		t.Errorf("expecting: %v, received: %v", float64(30*time.Second), v)
+	}
+}
+
+func TestStatPercentileCompress(t *testing.T) {
+	p50, _ := NewStatP50(0, "~*req.Cost", []string{})
+	p50.AddEvent("EVENT_1", utils.MapStorage{utils.MetaReq: map[string]any{utils.Cost: 1}})
+	p50.AddEvent("EVENT_1", utils.MapStorage{utils.MetaReq: map[string]any{utils.Cost: 2.00001}})
+	p50.AddEvent("EVENT_2", utils.MapStorage{utils.MetaReq: map[string]any{utils.Cost: 3}})
+	// shorter than the queue, nothing is compressed
+	ids := p50.Compress(10, "EVENT_3", 4)
+	sort.Strings(ids)
+	if exp := []string{"EVENT_1", "EVENT_2"}; !reflect.DeepEqual(exp, ids) {
+		t.Errorf("expecting: %v, received: %v", exp, ids)
+	}
+	exp := map[string]int{"EVENT_1": 2, "EVENT_2": 1}
+	if rcv := p50.GetCompressFactor(make(map[string]int)); !reflect.DeepEqual(exp, rcv) {
+		t.Errorf("expecting: %v, received: %v", exp, rcv)
+	}
+	if ids = p50.Compress(3, "EVENT_3", 4); !reflect.DeepEqual([]string{"EVENT_3"}, ids) {
+		t.Errorf("expecting: %v, received: %v", []string{"EVENT_3"}, ids)
+	}
+	pct := p50.(*StatPercentile)
+	if expCmp := []*StatWithCompress{{Stat: 1, CompressFactor: 1}, {Stat: 2, CompressFactor: 1},
+		{Stat: 3, CompressFactor: 1}}; !reflect.DeepEqual(expCmp, pct.Compressed) || len(pct.Events) != 0 {
+		t.Errorf("expecting: %s, received: %s", utils.ToJSON(expCmp), utils.ToJSON(pct))
+	}
+	exp = map[string]int{"EVENT_3": 3}
+	if rcv := p50.GetCompressFactor(make(map[string]int)); !reflect.DeepEqual(exp, rcv) {
+		t.Errorf("expecting: %v, received: %v", exp, rcv)
+	}
+	if v := p50.GetFloat64Value(4); v != 2 {
+		t.Errorf("expecting: 2, received: %v", v)
+	}
+	p50.AddEvent("EVENT_4", utils.MapStorage{utils.MetaReq: map[string]any{utils.Cost: 5}})
+	ids = p50.Compress(10, "EVENT_4", 4)
+	sort.Strings(ids)
+	if exp := []string{"EVENT_3", "EVENT_4"}; !reflect.DeepEqual(exp, ids) {
+		t.Errorf("expecting: %v, received: %v", exp, ids)
+	}
+	// the median of the compressed values is removed
+	if err := p50.RemEvent("EVENT_3"); err != nil {
+		t.Error(err)
+	} else if v := p50.GetFloat64Value(4); v != 3 {
+		t.Errorf("expecting: 3, received: %v", v)
+	}
+	p50.Compress(3, "EVENT_4", 4)
+	exp = map[string]int{"EVENT_4": 3}
+	if rcv := p50.GetCompressFactor(make(map[string]int)); !reflect.DeepEqual(exp, rcv) {
+		t.Errorf("expecting: %v, received: %v", exp, rcv)
+	}
+	for i := 0; i < 3; i++ {
+		if err := p50.RemEvent("EVENT_4"); err != nil {
+			t.Error(err)
+		}
+	}
+	if err := p50.RemEvent("EVENT_4"); err != utils.ErrNotFound {
+		t.Errorf("expecting: %v, received: %v", utils.ErrNotFound, err)
+	}
+	if pct.Count != 0 || pct.CompressedID != utils.EmptyString {
+		t.Errorf("unexpected metric: %s", utils.ToJSON(pct))
+	}
+}
+
+func TestStatPercentileQueueCompress(t *testing.T) {
+	metricID := utils.MetaMax + utils.HashtagSep + "~*req.PDD"
+	sq, err := NewStatQueue("cgrates.org", "SQ_PCT", []*MetricWithFilters{{MetricID: metricID}}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, pdd := range []int{5, 9, 7, 3} {
+		evID := fmt.Sprintf("EVENT_%d", i)
+		sq.SQMetrics[metricID].AddEvent(evID, utils.MapStorage{utils.MetaReq: map[string]any{utils.PDD: pdd}})
+		sq.SQItems = append(sq.SQItems, SQItem{EventID: evID})
+	}
+	if !sq.Compress(4, 4) {
+		t.Fatal("expecting the queue to be compressed")
+	}
+	if exp := []SQItem{{EventID: "EVENT_3"}}; !reflect.DeepEqual(exp, sq.SQItems) {
+		t.Errorf("expecting: %s, received: %s", utils.ToJSON(exp), utils.ToJSON(sq.SQItems))
+	}
+	sq.Expand()
+	if len(sq.SQItems) != 4 {
+		t.Errorf("expecting 4 items, received: %s", utils.ToJSON(sq.SQItems))
+	}
+	if v := sq.SQMetrics[metricID].GetFloat64Value(4); v != 9 {
+		t.Errorf("expecting: 9, received: %v", v)
+	}
+}
+
+func TestStatPercentileMarshal(t *testing.T) {
+	p99, _ := NewStatP99(2, "~*req.Cost", []string{})
+	p99.AddEvent("EVENT_1", utils.MapStorage{utils.MetaReq: map[string]any{utils.Cost: "20"}})
+	expected := []byte(`{"FilterIDs":[],"Percentile":99,"Count":1,"Events":{"EVENT_1":[20]},"MinItems":2,"FieldName":"~*req.Cost"}`)
+	var nP99 StatPercentile
+	if b, err := p99.Marshal(&jMarshaler); err != nil {
+		t.Error(err)
+	} else if !reflect.DeepEqual(expected, b) {
+		t.Errorf("Expected: %s , received: %s", string(expected), string(b))
+	} else if err := nP99.LoadMarshaled(&jMarshaler, b); err != nil {
+		t.Error(err)
+	} else if !reflect.DeepEqual(p99, &nP99) {
+		t.Errorf("Expected: %s , received: %s", utils.ToJSON(p99), utils.ToJSON(nP99))
+	}
+}
+
+func TestStatPercentileStoredStatQueue(t *testing.T) {
+	metricID := utils.MetaP95 + utils.HashtagSep + "~*req.PDD"
+	sq, err := NewStatQueue("cgrates.org", "SQ_PCT", []*MetricWithFilters{{MetricID: metricID}}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sq.SQMetrics[metricID].AddEvent("EVENT_1", utils.MapStorage{utils.MetaReq: map[string]any{utils.PDD: 5}})
+	sq.SQMetrics[metricID].AddEvent("EVENT_2", utils.MapStorage{utils.MetaReq: map[string]any{utils.PDD: 7}})
+	sq.SQItems = []SQItem{{EventID: "EVENT_1"}, {EventID: "EVENT_2"}}
+	ssq, err := NewStoredStatQueue(sq, &jMarshaler)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rcv, err := ssq.AsStatQueue(&jMarshaler)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := rcv.SQMetrics[metricID].GetFloat64Value(4); v != 7 {
+		t.Errorf("expecting: 7, received: %v", v)
+	}
+	var jsq StatQueue
+	if err = json.Unmarshal([]byte(utils.ToJSON(sq)), &jsq); err != nil {
+		t.Fatal(err)
+	} else if v := jsq.SQMetrics[metricID].GetFloat64Value(4); v != 7 {
+		t.Errorf("expecting: 7, received: %v", v)
+	}
+}
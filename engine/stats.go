@@ -21,6 +21,7 @@ package engine
 import (
 	"fmt"
 	"runtime"
+	"sort"
 	"sync"
 	"time"
 
@@ -519,6 +520,34 @@ func (sS *StatService) V1GetQueueIDs(ctx *context.Context, tenant string, qIDs *
 	return
 }
 
+// CollectMetrics implements MetricsCollector, exposing the float value of each metric in the queues
+func (sS *StatService) CollectMetrics(mw *MetricsWriter) {
+	keys, err := sS.dm.DataDB().GetKeysForPrefix(utils.StatQueuePrefix)
+	if err != nil {
+		utils.Logger.Warning(fmt.Sprintf("<%s> failed querying the StatQueue keys for metrics, error: %s",
+			utils.StatS, err.Error()))
+		return
+	}
+	sort.Strings(keys)
+	mw.Family("stats_metric_value", MetricTypeGauge, "Current value of the StatQueue metric.")
+	for _, key := range keys {
+		tntID := utils.NewTenantID(key[len(utils.StatQueuePrefix):])
+		var metrics map[string]float64
+		if err := sS.V1GetQueueFloatMetrics(context.TODO(), tntID, &metrics); err != nil {
+			continue // the queue could have been removed in the meantime
+		}
+		metricIDs := make([]string, 0, len(metrics))
+		for metricID := range metrics {
+			metricIDs = append(metricIDs, metricID)
+		}
+		sort.Strings(metricIDs)
+		for _, metricID := range metricIDs {
+			mw.Sample("stats_metric_value", metrics[metricID],
+				"tenant", tntID.Tenant, "queue", tntID.ID, "metric", metricID)
+		}
+	}
+}
+
 // V1ResetStatQueue resets the stat queue
 func (sS *StatService) V1ResetStatQueue(ctx *context.Context, tntID *utils.TenantID, rply *string) (err error) {
 	if missing := utils.MissingStructFields(tntID, []string{utils.ID}); len(missing) != 0 { //Params missing
@@ -62,6 +62,7 @@ type CSVStorage struct {
 	routeProfilesFn          []string
 	attributeProfilesFn      []string
 	chargerProfilesFn        []string
+	taxProfilesFn            []string
 	dispatcherProfilesFn     []string
 	dispatcherHostsFn        []string
 }
@@ -72,7 +73,7 @@ func NewCSVStorage(sep rune,
 	destinationratetimingsFn, ratingprofilesFn, sharedgroupsFn,
 	actionsFn, actiontimingsFn, actiontriggersFn, accountactionsFn,
 	resProfilesFn, statsFn, thresholdsFn, filterFn, routeProfilesFn,
-	attributeProfilesFn, chargerProfilesFn, dispatcherProfilesFn, dispatcherHostsFn []string) *CSVStorage {
+	attributeProfilesFn, chargerProfilesFn, taxProfilesFn, dispatcherProfilesFn, dispatcherHostsFn []string) *CSVStorage {
 	return &CSVStorage{
 		sep:                      sep,
 		generator:                NewCsvFile,
@@ -94,6 +95,7 @@ func NewCSVStorage(sep rune,
 		routeProfilesFn:          routeProfilesFn,
 		attributeProfilesFn:      attributeProfilesFn,
 		chargerProfilesFn:        chargerProfilesFn,
+		taxProfilesFn:            taxProfilesFn,
 		dispatcherProfilesFn:     dispatcherProfilesFn,
 		dispatcherHostsFn:        dispatcherHostsFn,
 	}
@@ -123,6 +125,7 @@ func NewFileCSVStorage(sep rune, dataPath string) (*CSVStorage, error) {
 	routesPaths := appendName(allFoldersPath, utils.RoutesCsv)
 	attributesPaths := appendName(allFoldersPath, utils.AttributesCsv)
 	chargersPaths := appendName(allFoldersPath, utils.ChargersCsv)
+	taxProfilesPaths := appendName(allFoldersPath, utils.TaxProfilesCsv)
 	dispatcherprofilesPaths := appendName(allFoldersPath, utils.DispatcherProfilesCsv)
 	dispatcherhostsPaths := appendName(allFoldersPath, utils.DispatcherHostsCsv)
 	return NewCSVStorage(sep,
@@ -144,6 +147,7 @@ func NewFileCSVStorage(sep rune, dataPath string) (*CSVStorage, error) {
 		routesPaths,
 		attributesPaths,
 		chargersPaths,
+		taxProfilesPaths,
 		dispatcherprofilesPaths,
 		dispatcherhostsPaths,
 	), nil
@@ -155,14 +159,14 @@ func NewStringCSVStorage(sep rune,
 	destinationratetimingsFn, ratingprofilesFn, sharedgroupsFn,
 	actionsFn, actiontimingsFn, actiontriggersFn, accountactionsFn,
 	resProfilesFn, statsFn, thresholdsFn, filterFn, routeProfilesFn,
-	attributeProfilesFn, chargerProfilesFn, dispatcherProfilesFn, dispatcherHostsFn string) *CSVStorage {
+	attributeProfilesFn, chargerProfilesFn, taxProfilesFn, dispatcherProfilesFn, dispatcherHostsFn string) *CSVStorage {
 	c := NewCSVStorage(sep, []string{destinationsFn}, []string{timingsFn},
 		[]string{ratesFn}, []string{destinationratesFn}, []string{destinationratetimingsFn},
 		[]string{ratingprofilesFn}, []string{sharedgroupsFn}, []string{actionsFn},
 		[]string{actiontimingsFn}, []string{actiontriggersFn}, []string{accountactionsFn},
 		[]string{resProfilesFn}, []string{statsFn}, []string{thresholdsFn}, []string{filterFn},
 		[]string{routeProfilesFn}, []string{attributeProfilesFn}, []string{chargerProfilesFn},
-		[]string{dispatcherProfilesFn}, []string{dispatcherHostsFn})
+		[]string{taxProfilesFn}, []string{dispatcherProfilesFn}, []string{dispatcherHostsFn})
 	c.generator = NewCsvString
 	return c
 }
@@ -202,6 +206,7 @@ func NewGoogleCSVStorage(sep rune, spreadsheetID string) (*CSVStorage, error) {
 		getIfExist(utils.Routes),
 		getIfExist(utils.Attributes),
 		getIfExist(utils.Chargers),
+		getIfExist(utils.TaxProfiles),
 		getIfExist(utils.DispatcherProfiles),
 		getIfExist(utils.DispatcherHosts),
 	)
@@ -234,6 +239,7 @@ func NewURLCSVStorage(sep rune, dataPath string) *CSVStorage {
 	var routesPaths []string
 	var attributesPaths []string
 	var chargersPaths []string
+	var taxProfilesPaths []string
 	var dispatcherprofilesPaths []string
 	var dispatcherhostsPaths []string
 
@@ -257,6 +263,7 @@ func NewURLCSVStorage(sep rune, dataPath string) *CSVStorage {
 			routesPaths = append(routesPaths, joinURL(baseURL, utils.RoutesCsv))
 			attributesPaths = append(attributesPaths, joinURL(baseURL, utils.AttributesCsv))
 			chargersPaths = append(chargersPaths, joinURL(baseURL, utils.ChargersCsv))
+			taxProfilesPaths = append(taxProfilesPaths, joinURL(baseURL, utils.TaxProfilesCsv))
 			dispatcherprofilesPaths = append(dispatcherprofilesPaths, joinURL(baseURL, utils.DispatcherProfilesCsv))
 			dispatcherhostsPaths = append(dispatcherhostsPaths, joinURL(baseURL, utils.DispatcherHostsCsv))
 			continue
@@ -298,6 +305,8 @@ func NewURLCSVStorage(sep rune, dataPath string) *CSVStorage {
 			attributesPaths = append(attributesPaths, baseURL)
 		case strings.HasSuffix(baseURL, utils.ChargersCsv):
 			chargersPaths = append(chargersPaths, baseURL)
+		case strings.HasSuffix(baseURL, utils.TaxProfilesCsv):
+			taxProfilesPaths = append(taxProfilesPaths, baseURL)
 		case strings.HasSuffix(baseURL, utils.DispatcherProfilesCsv):
 			dispatcherprofilesPaths = append(dispatcherprofilesPaths, baseURL)
 		case strings.HasSuffix(baseURL, utils.DispatcherHostsCsv):
@@ -324,6 +333,7 @@ func NewURLCSVStorage(sep rune, dataPath string) *CSVStorage {
 		routesPaths,
 		attributesPaths,
 		chargersPaths,
+		taxProfilesPaths,
 		dispatcherprofilesPaths,
 		dispatcherhostsPaths,
 	)
@@ -616,6 +626,18 @@ func (csvs *CSVStorage) GetTPChargers(tpid, tenant, id string) ([]*utils.TPCharg
 	return tpCPPs.AsTPChargers(), nil
 }
 
+func (csvs *CSVStorage) GetTPTaxProfiles(tpid, tenant, id string) ([]*utils.TPTaxProfile, error) {
+	var tpTxps TaxProfileMdls
+	if err := csvs.proccesData(TaxProfileMdl{}, csvs.taxProfilesFn, func(tp any) {
+		txp := tp.(TaxProfileMdl)
+		txp.Tpid = tpid
+		tpTxps = append(tpTxps, &txp)
+	}); err != nil {
+		return nil, err
+	}
+	return tpTxps.AsTPTaxProfiles(), nil
+}
+
 func (csvs *CSVStorage) GetTPDispatcherProfiles(tpid, tenant, id string) ([]*utils.TPDispatcherProfile, error) {
 	var tpDPPs DispatcherProfileMdls
 	if err := csvs.proccesData(DispatcherProfileMdl{}, csvs.dispatcherProfilesFn, func(tp any) {
@@ -119,6 +119,9 @@ type DataDB interface {
 	GetChargerProfileDrv(string, string) (*ChargerProfile, error)
 	SetChargerProfileDrv(*ChargerProfile) error
 	RemoveChargerProfileDrv(string, string) error
+	GetTaxProfileDrv(string, string) (*TaxProfile, error)
+	SetTaxProfileDrv(*TaxProfile) error
+	RemoveTaxProfileDrv(string, string) error
 	GetDispatcherProfileDrv(string, string) (*DispatcherProfile, error)
 	SetDispatcherProfileDrv(*DispatcherProfile) error
 	RemoveDispatcherProfileDrv(string, string) error
@@ -128,6 +131,15 @@ type DataDB interface {
 	GetDispatcherHostDrv(string, string) (*DispatcherHost, error)
 	SetDispatcherHostDrv(*DispatcherHost) error
 	RemoveDispatcherHostDrv(string, string) error
+	GetExchangeRateDrv(string, string) (*ExchangeRate, error)
+	SetExchangeRateDrv(*ExchangeRate) error
+	RemoveExchangeRateDrv(string, string) error
+	GetCalendarDrv(string, string) (*Calendar, error)
+	SetCalendarDrv(*Calendar) error
+	RemoveCalendarDrv(string, string) error
+	GetSessionsBackupDrv(string) ([]*StoredSession, error)
+	SetSessionBackupDrv(*StoredSession) error
+	RemoveSessionBackupDrv(string, string) error
 }
 
 type StorDB interface {
@@ -144,6 +156,10 @@ type CdrStorage interface {
 	RemoveSMCost(*SMCost) error
 	RemoveSMCosts(qryFltr *utils.SMCostFilter) error
 	GetCDRs(*utils.CDRsFilter, bool) ([]*CDR, int64, error)
+	SetBalanceLedgerEntries([]*BalanceLedgerEntry) error
+	GetBalanceLedgerEntries(*BalanceLedgerFilter) ([]*BalanceLedgerEntry, error)
+	SetInvoice(*Invoice) error
+	GetInvoices(*InvoiceFilter) ([]*Invoice, error)
 }
 
 type LoadStorage interface {
@@ -175,6 +191,7 @@ type LoadReader interface {
 	GetTPRoutes(string, string, string) ([]*utils.TPRouteProfile, error)
 	GetTPAttributes(string, string, string) ([]*utils.TPAttributeProfile, error)
 	GetTPChargers(string, string, string) ([]*utils.TPChargerProfile, error)
+	GetTPTaxProfiles(string, string, string) ([]*utils.TPTaxProfile, error)
 	GetTPDispatcherProfiles(string, string, string) ([]*utils.TPDispatcherProfile, error)
 	GetTPDispatcherHosts(string, string, string) ([]*utils.TPDispatcherHost, error)
 }
@@ -199,6 +216,7 @@ type LoadWriter interface {
 	SetTPRoutes([]*utils.TPRouteProfile) error
 	SetTPAttributes([]*utils.TPAttributeProfile) error
 	SetTPChargers([]*utils.TPChargerProfile) error
+	SetTPTaxProfiles([]*utils.TPTaxProfile) error
 	SetTPDispatcherProfiles([]*utils.TPDispatcherProfile) error
 	SetTPDispatcherHosts([]*utils.TPDispatcherHost) error
 }
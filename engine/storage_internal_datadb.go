@@ -40,7 +40,7 @@ type InternalDB struct {
 	indexedFieldsMutex  sync.RWMutex   // used for reload
 	cnter               *utils.Counter // used for OrderID for cdr
 	ms                  Marshaler
-	db                  *ltcache.TransCache
+	db                  *internalDBCache
 	isDataDB            bool
 }
 
@@ -61,7 +61,7 @@ func NewInternalDB(stringIndexedFields, prefixIndexedFields []string, isDataDB b
 		prefixIndexedFields: prefixIndexedFields,
 		cnter:               utils.NewCounter(time.Now().UnixNano(), 0),
 		ms:                  ms,
-		db:                  ltcache.NewTransCache(tcCfg),
+		db:                  &internalDBCache{TransCache: ltcache.NewTransCache(tcCfg)},
 		isDataDB:            isDataDB,
 	}
 }
@@ -81,7 +81,16 @@ func (iDB *InternalDB) SetPrefixIndexedFields(prefixIndexedFields []string) {
 }
 
 // Close only to implement Storage interface
-func (iDB *InternalDB) Close() {}
+// when the dumper is started it will also write the final dump
+func (iDB *InternalDB) Close() {
+	if iDB.db.dmp == nil {
+		return
+	}
+	if err := iDB.db.dmp.close(); err != nil {
+		utils.Logger.Warning(fmt.Sprintf("<%s> failed dumping on close, error: %s",
+			utils.MetaInternal, err.Error()))
+	}
+}
 
 // Flush clears the cache
 func (iDB *InternalDB) Flush(string) error {
@@ -207,7 +216,8 @@ func (iDB *InternalDB) HasDataDrv(category, subject, tenant string) (bool, error
 	case utils.ResourcesPrefix, utils.ResourceProfilesPrefix, utils.StatQueuePrefix,
 		utils.StatQueueProfilePrefix, utils.ThresholdPrefix, utils.ThresholdProfilePrefix,
 		utils.FilterPrefix, utils.RouteProfilePrefix, utils.AttributeProfilePrefix,
-		utils.ChargerProfilePrefix, utils.DispatcherProfilePrefix, utils.DispatcherHostPrefix:
+		utils.ChargerProfilePrefix, utils.DispatcherProfilePrefix, utils.DispatcherHostPrefix,
+		utils.ExchangeRatePrefix, utils.CalendarPrefix, utils.TaxProfilePrefix:
 		return iDB.db.HasItem(utils.CachePrefixToInstance[category], utils.ConcatenatedKey(tenant, subject)), nil
 	}
 	return false, errors.New("Unsupported HasData category")
@@ -723,6 +733,26 @@ func (iDB *InternalDB) RemoveChargerProfileDrv(tenant, id string) (err error) {
 	return
 }
 
+func (iDB *InternalDB) GetTaxProfileDrv(tenant, id string) (tp *TaxProfile, err error) {
+	x, ok := iDB.db.Get(utils.CacheTaxProfiles, utils.ConcatenatedKey(tenant, id))
+	if !ok || x == nil {
+		return nil, utils.ErrNotFound
+	}
+	return x.(*TaxProfile), nil
+}
+
+func (iDB *InternalDB) SetTaxProfileDrv(tp *TaxProfile) (err error) {
+	iDB.db.Set(utils.CacheTaxProfiles, tp.TenantID(), tp, nil,
+		true, utils.NonTransactional)
+	return
+}
+
+func (iDB *InternalDB) RemoveTaxProfileDrv(tenant, id string) (err error) {
+	iDB.db.Remove(utils.CacheTaxProfiles, utils.ConcatenatedKey(tenant, id),
+		true, utils.NonTransactional)
+	return
+}
+
 func (iDB *InternalDB) GetDispatcherProfileDrv(tenant, id string) (dpp *DispatcherProfile, err error) {
 	x, ok := iDB.db.Get(utils.CacheDispatcherProfiles, utils.ConcatenatedKey(tenant, id))
 	if !ok || x == nil {
@@ -781,6 +811,70 @@ func (iDB *InternalDB) RemoveDispatcherHostDrv(tenant, id string) (err error) {
 	return
 }
 
+func (iDB *InternalDB) GetExchangeRateDrv(tenant, id string) (er *ExchangeRate, err error) {
+	x, ok := iDB.db.Get(utils.CacheExchangeRates, utils.ConcatenatedKey(tenant, id))
+	if !ok || x == nil {
+		return nil, utils.ErrNotFound
+	}
+	return x.(*ExchangeRate), nil
+}
+
+func (iDB *InternalDB) SetExchangeRateDrv(er *ExchangeRate) (err error) {
+	iDB.db.Set(utils.CacheExchangeRates, er.TenantID(), er, nil,
+		true, utils.NonTransactional)
+	return
+}
+
+func (iDB *InternalDB) RemoveExchangeRateDrv(tenant, id string) (err error) {
+	iDB.db.Remove(utils.CacheExchangeRates, utils.ConcatenatedKey(tenant, id),
+		true, utils.NonTransactional)
+	return
+}
+
+func (iDB *InternalDB) GetCalendarDrv(tenant, id string) (cal *Calendar, err error) {
+	x, ok := iDB.db.Get(utils.CacheCalendars, utils.ConcatenatedKey(tenant, id))
+	if !ok || x == nil {
+		return nil, utils.ErrNotFound
+	}
+	return x.(*Calendar), nil
+}
+
+func (iDB *InternalDB) SetCalendarDrv(cal *Calendar) (err error) {
+	iDB.db.Set(utils.CacheCalendars, cal.TenantID(), cal, nil,
+		true, utils.NonTransactional)
+	return
+}
+
+func (iDB *InternalDB) RemoveCalendarDrv(tenant, id string) (err error) {
+	iDB.db.Remove(utils.CacheCalendars, utils.ConcatenatedKey(tenant, id),
+		true, utils.NonTransactional)
+	return
+}
+
+func (iDB *InternalDB) GetSessionsBackupDrv(nodeID string) (sss []*StoredSession, err error) {
+	for _, key := range iDB.db.GetItemIDs(utils.CacheSessionsBackup, nodeID+utils.ConcatenatedKeySep) {
+		if x, ok := iDB.db.Get(utils.CacheSessionsBackup, key); ok && x != nil {
+			sss = append(sss, x.(*StoredSession))
+		}
+	}
+	if len(sss) == 0 {
+		return nil, utils.ErrNotFound
+	}
+	return
+}
+
+func (iDB *InternalDB) SetSessionBackupDrv(ss *StoredSession) (err error) {
+	iDB.db.Set(utils.CacheSessionsBackup, ss.BackupID(), ss, nil,
+		true, utils.NonTransactional)
+	return
+}
+
+func (iDB *InternalDB) RemoveSessionBackupDrv(nodeID, cgrID string) (err error) {
+	iDB.db.Remove(utils.CacheSessionsBackup, utils.ConcatenatedKey(nodeID, cgrID),
+		true, utils.NonTransactional)
+	return
+}
+
 func (iDB *InternalDB) RemoveLoadIDsDrv() (err error) {
 	return utils.ErrNotImplemented
 }
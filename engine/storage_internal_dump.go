@@ -0,0 +1,491 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"bufio"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/cgrates/cgrates/utils"
+	"github.com/cgrates/ltcache"
+)
+
+func init() {
+	// Register the objects stored by InternalDB which are not already registered for cache replication
+	// DataDB
+	gob.Register(new(Account))
+	gob.Register(new(ActionPlan))
+	gob.Register(Actions{})
+	gob.Register(ActionTriggers{})
+	gob.Register(new(ChargerProfile))
+	gob.Register(new(Destination))
+	gob.Register(new(DispatcherProfile))
+	gob.Register(new(RatingPlan))
+	gob.Register(new(RatingProfile))
+	gob.Register(new(SharedGroup))
+	gob.Register(new(StoredSession))
+	gob.Register(new(utils.TPTiming))
+	gob.Register(Versions{})
+	gob.Register(map[string]int64{})
+	gob.Register([]string{})
+	// StorDB
+	gob.Register(new(CDR))
+	gob.Register(new(SMCost))
+	gob.Register(new(BalanceLedgerEntry))
+	gob.Register(new(Invoice))
+	gob.Register(new(utils.ApierTPTiming))
+	gob.Register(new(utils.TPDestination))
+	gob.Register(new(utils.TPRateRALs))
+	gob.Register(new(utils.TPDestinationRate))
+	gob.Register(new(utils.TPRatingPlan))
+	gob.Register(new(utils.TPRatingProfile))
+	gob.Register(new(utils.TPSharedGroups))
+	gob.Register(new(utils.TPActions))
+	gob.Register(new(utils.TPActionPlan))
+	gob.Register(new(utils.TPActionTriggers))
+	gob.Register(new(utils.TPAccountActions))
+	gob.Register(new(utils.TPResourceProfile))
+	gob.Register(new(utils.TPStatProfile))
+	gob.Register(new(utils.TPThresholdProfile))
+	gob.Register(new(utils.TPFilterProfile))
+	gob.Register(new(utils.TPRouteProfile))
+	gob.Register(new(utils.TPAttributeProfile))
+	gob.Register(new(utils.TPChargerProfile))
+	gob.Register(new(utils.TPTaxProfile))
+	gob.Register(new(utils.TPDispatcherProfile))
+	gob.Register(new(utils.TPDispatcherHost))
+}
+
+const (
+	internalDBDumpExt     = ".dump"
+	internalDBWriteLogExt = ".log"
+	internalDBTmpExt      = ".tmp"
+)
+
+var errInternalDBDumpNotConfigured = errors.New("internalDBDumpPath not configured")
+
+// internalDBOp is the write operation persisted for InternalDB
+type internalDBOp uint8
+
+const (
+	internalDBOpSet internalDBOp = iota
+	internalDBOpRemove
+	internalDBOpRemoveGroup
+	internalDBOpClear
+)
+
+// internalDBRecord is the unit written both in the dump and in the write log
+type internalDBRecord struct {
+	Op       internalDBOp
+	CacheID  string
+	ItemID   string // the group ID for internalDBOpRemoveGroup
+	Value    any
+	GroupIDs []string // the cache IDs for internalDBOpClear
+}
+
+// internalDBCache is the storage used by InternalDB
+// with the dumper enabled the writes are tracked so they can be persisted on disk
+type internalDBCache struct {
+	*ltcache.TransCache
+	dmp *internalDBDumper
+}
+
+// Set is a wrapper over TransCache.Set
+func (c *internalDBCache) Set(chID, itmID string, value any,
+	groupIDs []string, commit bool, transID string) {
+	if c.dmp == nil {
+		c.TransCache.Set(chID, itmID, value, groupIDs, commit, transID)
+		return
+	}
+	c.dmp.Lock()
+	c.TransCache.Set(chID, itmID, value, groupIDs, commit, transID)
+	c.dmp.trackSet(chID, itmID, groupIDs)
+	c.dmp.logRecord(&internalDBRecord{Op: internalDBOpSet,
+		CacheID: chID, ItemID: itmID, Value: value, GroupIDs: groupIDs})
+	c.dmp.Unlock()
+}
+
+// Remove is a wrapper over TransCache.Remove
+func (c *internalDBCache) Remove(chID, itmID string, commit bool, transID string) {
+	if c.dmp == nil {
+		c.TransCache.Remove(chID, itmID, commit, transID)
+		return
+	}
+	c.dmp.Lock()
+	c.TransCache.Remove(chID, itmID, commit, transID)
+	c.dmp.trackRemove(chID, itmID)
+	c.dmp.logRecord(&internalDBRecord{Op: internalDBOpRemove,
+		CacheID: chID, ItemID: itmID})
+	c.dmp.Unlock()
+}
+
+// RemoveGroup is a wrapper over TransCache.RemoveGroup
+func (c *internalDBCache) RemoveGroup(chID, grpID string, commit bool, transID string) {
+	if c.dmp == nil {
+		c.TransCache.RemoveGroup(chID, grpID, commit, transID)
+		return
+	}
+	c.dmp.Lock()
+	c.dmp.trackRemoveGroup(chID, grpID)
+	c.TransCache.RemoveGroup(chID, grpID, commit, transID)
+	c.dmp.logRecord(&internalDBRecord{Op: internalDBOpRemoveGroup,
+		CacheID: chID, ItemID: grpID})
+	c.dmp.Unlock()
+}
+
+// Clear is a wrapper over TransCache.Clear
+func (c *internalDBCache) Clear(chIDs []string) {
+	if c.dmp == nil {
+		c.TransCache.Clear(chIDs)
+		return
+	}
+	c.dmp.Lock()
+	c.TransCache.Clear(chIDs)
+	c.dmp.trackClear(chIDs)
+	c.dmp.logRecord(&internalDBRecord{Op: internalDBOpClear,
+		GroupIDs: chIDs})
+	c.dmp.Unlock()
+}
+
+// partitionIDs returns the IDs of the partitions created so far
+func (c *internalDBCache) partitionIDs() (chIDs []string) {
+	for chID := range c.GetCacheStats(nil) {
+		chIDs = append(chIDs, chID)
+	}
+	return
+}
+
+// newInternalDBDumper constructs the internalDBDumper, creating the folder if missing
+func newInternalDBDumper(tc *ltcache.TransCache, chIDs []string,
+	path, name string, writeLog bool) (dmp *internalDBDumper, err error) {
+	if err = os.MkdirAll(path, 0755); err != nil {
+		return
+	}
+	dmp = &internalDBDumper{
+		tc:       tc,
+		dumpPath: filepath.Join(path, name+internalDBDumpExt),
+		writeLog: writeLog,
+		chIDs:    utils.NewStringSet(chIDs),
+		groups:   make(map[string]map[string][]string),
+		stopChan: make(chan struct{}),
+	}
+	if writeLog {
+		dmp.logPath = filepath.Join(path, name+internalDBWriteLogExt)
+	}
+	return
+}
+
+// internalDBDumper persists the InternalDB content on disk
+// as a full dump optionally followed by the log of the writes done after it
+type internalDBDumper struct {
+	sync.Mutex
+	tc       *ltcache.TransCache
+	dumpPath string
+	logPath  string
+	writeLog bool
+	logFile  *os.File
+	logEnc   *gob.Encoder
+	chIDs    utils.StringSet                // the partitions written so far
+	groups   map[string]map[string][]string // the groups of the items since ltcache does not expose them
+	stopOnce sync.Once
+	stopChan chan struct{}
+	stopped  bool // the files were taken over by another dumper
+}
+
+func (dmp *internalDBDumper) trackSet(chID, itmID string, groupIDs []string) {
+	dmp.chIDs.Add(chID)
+	if len(groupIDs) == 0 {
+		if grps, has := dmp.groups[chID]; has {
+			delete(grps, itmID)
+		}
+		return
+	}
+	if _, has := dmp.groups[chID]; !has {
+		dmp.groups[chID] = make(map[string][]string)
+	}
+	dmp.groups[chID][itmID] = groupIDs
+}
+
+func (dmp *internalDBDumper) trackRemove(chID, itmID string) {
+	if grps, has := dmp.groups[chID]; has {
+		delete(grps, itmID)
+	}
+}
+
+func (dmp *internalDBDumper) trackRemoveGroup(chID, grpID string) {
+	grps, has := dmp.groups[chID]
+	if !has {
+		return
+	}
+	for _, itmID := range dmp.tc.GetGroupItemIDs(chID, grpID) {
+		delete(grps, itmID)
+	}
+}
+
+func (dmp *internalDBDumper) trackClear(chIDs []string) {
+	if chIDs == nil {
+		dmp.groups = make(map[string]map[string][]string)
+		return
+	}
+	for _, chID := range chIDs {
+		delete(dmp.groups, chID)
+	}
+}
+
+// logRecord appends the record to the write log, if enabled
+func (dmp *internalDBDumper) logRecord(rec *internalDBRecord) {
+	if dmp.logEnc == nil {
+		return
+	}
+	if err := dmp.logEnc.Encode(rec); err != nil {
+		utils.Logger.Warning(fmt.Sprintf("<%s> failed writing log for item <%s:%s>, error: %s",
+			utils.MetaInternal, rec.CacheID, rec.ItemID, err.Error()))
+	}
+}
+
+// dump writes the full content in a new dump file and starts a new write log
+func (dmp *internalDBDumper) dump() (err error) {
+	dmp.Lock()
+	defer dmp.Unlock()
+	if dmp.stopped {
+		return
+	}
+	tmpPath := dmp.dumpPath + internalDBTmpExt
+	var f *os.File
+	if f, err = os.Create(tmpPath); err != nil {
+		return
+	}
+	w := bufio.NewWriter(f)
+	enc := gob.NewEncoder(w)
+	for chID := range dmp.chIDs {
+		for _, itmID := range dmp.tc.GetItemIDs(chID, utils.EmptyString) {
+			val, has := dmp.tc.Get(chID, itmID)
+			if !has {
+				continue
+			}
+			if err = enc.Encode(&internalDBRecord{Op: internalDBOpSet,
+				CacheID: chID, ItemID: itmID, Value: val,
+				GroupIDs: dmp.groups[chID][itmID]}); err != nil {
+				f.Close()
+				os.Remove(tmpPath)
+				return fmt.Errorf("failed dumping item <%s:%s>, error: %s", chID, itmID, err.Error())
+			}
+		}
+	}
+	if err = w.Flush(); err == nil {
+		err = f.Sync()
+	}
+	if cErr := f.Close(); err == nil {
+		err = cErr
+	}
+	if err != nil {
+		os.Remove(tmpPath)
+		return
+	}
+	if err = os.Rename(tmpPath, dmp.dumpPath); err != nil {
+		return
+	}
+	if !dmp.writeLog {
+		return
+	}
+	// the writes so far are in the dump so start a new log
+	if dmp.logFile != nil {
+		dmp.logFile.Close()
+	}
+	if dmp.logFile, err = os.Create(dmp.logPath); err != nil {
+		dmp.logEnc = nil
+		return
+	}
+	dmp.logEnc = gob.NewEncoder(dmp.logFile)
+	return
+}
+
+// restore replaces the content with the one from the dump and the write log
+func (dmp *internalDBDumper) restore() (err error) {
+	dmp.Lock()
+	defer dmp.Unlock()
+	dmp.tc.Clear(nil)
+	dmp.trackClear(nil)
+	if err = dmp.replay(dmp.dumpPath); err != nil {
+		return
+	}
+	if dmp.writeLog {
+		err = dmp.replay(dmp.logPath)
+	}
+	return
+}
+
+// replay applies the records from file, a truncated last record is ignored
+func (dmp *internalDBDumper) replay(path string) (err error) {
+	var f *os.File
+	if f, err = os.Open(path); err != nil {
+		if os.IsNotExist(err) {
+			err = nil
+		}
+		return
+	}
+	defer f.Close()
+	dec := gob.NewDecoder(bufio.NewReader(f))
+	for {
+		rec := new(internalDBRecord)
+		if err = dec.Decode(rec); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			if errors.Is(err, io.ErrUnexpectedEOF) {
+				utils.Logger.Warning(fmt.Sprintf("<%s> ignoring truncated record at the end of <%s>",
+					utils.MetaInternal, path))
+				return nil
+			}
+			return fmt.Errorf("failed restoring from <%s>, error: %s", path, err.Error())
+		}
+		switch rec.Op {
+		case internalDBOpSet:
+			// the compiled fields are not exported so rebuild them as the Set*Drv did
+			if cmp, canCompile := rec.Value.(interface{ Compile() error }); canCompile {
+				if err = cmp.Compile(); err != nil {
+					return fmt.Errorf("failed compiling item <%s:%s>, error: %s",
+						rec.CacheID, rec.ItemID, err.Error())
+				}
+			}
+			dmp.tc.Set(rec.CacheID, rec.ItemID, rec.Value, rec.GroupIDs,
+				true, utils.NonTransactional)
+			dmp.trackSet(rec.CacheID, rec.ItemID, rec.GroupIDs)
+		case internalDBOpRemove:
+			dmp.tc.Remove(rec.CacheID, rec.ItemID,
+				true, utils.NonTransactional)
+			dmp.trackRemove(rec.CacheID, rec.ItemID)
+		case internalDBOpRemoveGroup:
+			dmp.trackRemoveGroup(rec.CacheID, rec.ItemID)
+			dmp.tc.RemoveGroup(rec.CacheID, rec.ItemID,
+				true, utils.NonTransactional)
+		case internalDBOpClear:
+			dmp.tc.Clear(rec.GroupIDs)
+			dmp.trackClear(rec.GroupIDs)
+		}
+	}
+}
+
+// loop dumps periodically until stopped
+func (dmp *internalDBDumper) loop(interval time.Duration) {
+	tkr := time.NewTicker(interval)
+	defer tkr.Stop()
+	for {
+		select {
+		case <-dmp.stopChan:
+			return
+		case <-tkr.C:
+			if err := dmp.dump(); err != nil {
+				utils.Logger.Warning(fmt.Sprintf("<%s> failed dumping to <%s>, error: %s",
+					utils.MetaInternal, dmp.dumpPath, err.Error()))
+			}
+		}
+	}
+}
+
+// close stops the periodic dumps and does a final one
+func (dmp *internalDBDumper) close() (err error) {
+	dmp.stopOnce.Do(func() {
+		close(dmp.stopChan)
+		err = dmp.dump()
+		dmp.Lock()
+		dmp.closeLog()
+		dmp.Unlock()
+	})
+	return
+}
+
+// stop ends the periodic dumps and the write log without a final dump
+func (dmp *internalDBDumper) stop() {
+	dmp.stopOnce.Do(func() {
+		close(dmp.stopChan)
+		dmp.Lock()
+		dmp.stopped = true
+		dmp.closeLog()
+		dmp.Unlock()
+	})
+}
+
+// closeLog closes the write log file (not thread safe)
+func (dmp *internalDBDumper) closeLog() {
+	if dmp.logFile != nil {
+		dmp.logFile.Close()
+		dmp.logFile = nil
+		dmp.logEnc = nil
+	}
+}
+
+// StartDumper enables persisting the InternalDB content in the given folder
+// the content is restored from the existing files and dumped again every interval(if not 0) and on Close
+func (iDB *InternalDB) StartDumper(path string, interval time.Duration, writeLog bool) (err error) {
+	if iDB.db.dmp != nil {
+		return fmt.Errorf("dumper already started in <%s>", filepath.Dir(iDB.db.dmp.dumpPath))
+	}
+	name := utils.StorDB
+	if iDB.isDataDB {
+		name = utils.DataDB
+	}
+	var dmp *internalDBDumper
+	if dmp, err = newInternalDBDumper(iDB.db.TransCache, iDB.db.partitionIDs(),
+		path, name, writeLog); err != nil {
+		return
+	}
+	if err = dmp.restore(); err != nil {
+		return
+	}
+	if err = dmp.dump(); err != nil {
+		return
+	}
+	iDB.db.dmp = dmp
+	if interval > 0 {
+		go dmp.loop(interval)
+	}
+	return
+}
+
+// Dump writes the content of the InternalDB on disk
+func (iDB *InternalDB) Dump() error {
+	if iDB.db.dmp == nil {
+		return errInternalDBDumpNotConfigured
+	}
+	return iDB.db.dmp.dump()
+}
+
+// StopDumper stops persisting the InternalDB content without a final dump
+// used once a new connection restored the dump and took over its files
+func (iDB *InternalDB) StopDumper() {
+	if iDB.db.dmp != nil {
+		iDB.db.dmp.stop()
+	}
+}
+
+// Restore replaces the content of the InternalDB with the one from disk
+func (iDB *InternalDB) Restore() error {
+	if iDB.db.dmp == nil {
+		return errInternalDBDumpNotConfigured
+	}
+	return iDB.db.dmp.restore()
+}
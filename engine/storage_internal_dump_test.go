@@ -0,0 +1,205 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+package engine
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestInternalDBDumpNotConfigured(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	iDB := NewInternalDB(nil, nil, true, cfg.DataDbCfg().Items)
+	if err := iDB.Dump(); err != errInternalDBDumpNotConfigured {
+		t.Errorf("Expected error <%v>, received <%v>", errInternalDBDumpNotConfigured, err)
+	}
+	if err := iDB.Restore(); err != errInternalDBDumpNotConfigured {
+		t.Errorf("Expected error <%v>, received <%v>", errInternalDBDumpNotConfigured, err)
+	}
+}
+
+func TestInternalDBDumpRestore(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	path := t.TempDir()
+	iDB := NewInternalDB(nil, nil, true, cfg.DataDbCfg().Items)
+	if err := iDB.StartDumper(path, 0, false); err != nil {
+		t.Fatal(err)
+	}
+	dst := &Destination{Id: "DST_1002", Prefixes: []string{"1002", "1003"}}
+	if err := iDB.SetDestinationDrv(dst, utils.NonTransactional); err != nil {
+		t.Fatal(err)
+	}
+	fltr := &Filter{
+		Tenant: "cgrates.org",
+		ID:     "FLTR_1",
+		Rules: []*FilterRule{{
+			Type:    utils.MetaString,
+			Element: "~*req.Account",
+			Values:  []string{"1001"},
+		}},
+	}
+	if err := iDB.SetFilterDrv(fltr); err != nil {
+		t.Fatal(err)
+	}
+	idxs := map[string]utils.StringSet{
+		"*string:*req.Account:1001": utils.NewStringSet([]string{"ATTR_1"}),
+		"*string:*req.Account:1002": utils.NewStringSet([]string{"ATTR_2"}),
+	}
+	if err := iDB.SetIndexesDrv(utils.CacheAttributeFilterIndexes, "cgrates.org:*any",
+		idxs, true, utils.NonTransactional); err != nil {
+		t.Fatal(err)
+	}
+	if err := iDB.Dump(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(path, utils.DataDB+internalDBDumpExt)); err != nil {
+		t.Fatal(err)
+	}
+
+	// changes after the dump are lost without the write log
+	if err := iDB.RemoveDestinationDrv(dst.Id, utils.NonTransactional); err != nil {
+		t.Fatal(err)
+	}
+	if err := iDB.Restore(); err != nil {
+		t.Fatal(err)
+	}
+	if rcv, err := iDB.GetDestinationDrv(dst.Id, utils.EmptyString); err != nil {
+		t.Error(err)
+	} else if !reflect.DeepEqual(dst, rcv) {
+		t.Errorf("Expected %s, received %s", utils.ToJSON(dst), utils.ToJSON(rcv))
+	}
+
+	// a new instance restores on start
+	iDB2 := NewInternalDB(nil, nil, true, cfg.DataDbCfg().Items)
+	if err := iDB2.StartDumper(path, 0, false); err != nil {
+		t.Fatal(err)
+	}
+	if rcv, err := iDB2.GetDestinationDrv(dst.Id, utils.EmptyString); err != nil {
+		t.Error(err)
+	} else if !reflect.DeepEqual(dst, rcv) {
+		t.Errorf("Expected %s, received %s", utils.ToJSON(dst), utils.ToJSON(rcv))
+	}
+	if rcv, err := iDB2.GetFilterDrv(fltr.Tenant, fltr.ID); err != nil {
+		t.Error(err)
+	} else if !reflect.DeepEqual(fltr, rcv) {
+		t.Errorf("Expected %s, received %s", utils.ToJSON(fltr), utils.ToJSON(rcv))
+	}
+	// the groups are restored so the indexes can be queried and removed by tenant
+	if rcv, err := iDB2.GetIndexesDrv(utils.CacheAttributeFilterIndexes,
+		"cgrates.org:*any", utils.EmptyString); err != nil {
+		t.Error(err)
+	} else if !reflect.DeepEqual(idxs, rcv) {
+		t.Errorf("Expected %s, received %s", utils.ToJSON(idxs), utils.ToJSON(rcv))
+	}
+	if err := iDB2.RemoveIndexesDrv(utils.CacheAttributeFilterIndexes,
+		"cgrates.org:*any", utils.EmptyString); err != nil {
+		t.Fatal(err)
+	}
+	iDB2.Close()
+
+	iDB3 := NewInternalDB(nil, nil, true, cfg.DataDbCfg().Items)
+	if err := iDB3.StartDumper(path, 0, false); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := iDB3.GetIndexesDrv(utils.CacheAttributeFilterIndexes,
+		"cgrates.org:*any", utils.EmptyString); err != utils.ErrNotFound {
+		t.Errorf("Expected error <%v>, received <%v>", utils.ErrNotFound, err)
+	}
+}
+
+func TestInternalDBDumpWriteLog(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	path := t.TempDir()
+	iDB := NewInternalDB(nil, nil, false, cfg.StorDbCfg().Items)
+	if err := iDB.StartDumper(path, time.Hour, true); err != nil {
+		t.Fatal(err)
+	}
+	cdr := &CDR{
+		CGRID:       "CGRID_1",
+		RunID:       utils.MetaDefault,
+		OriginHost:  "127.0.0.1",
+		OriginID:    "ORIGIN_1",
+		Tenant:      "cgrates.org",
+		Category:    "call",
+		Account:     "1001",
+		Destination: "1002",
+		SetupTime:   time.Date(2021, 1, 1, 10, 0, 0, 0, time.UTC),
+		AnswerTime:  time.Date(2021, 1, 1, 10, 0, 1, 0, time.UTC),
+		Usage:       time.Minute,
+		Cost:        1.2,
+		ExtraFields: map[string]string{},
+	}
+	if err := iDB.SetCDR(cdr, false); err != nil {
+		t.Fatal(err)
+	}
+	// no dump done after the CDR was stored so it comes only from the write log
+	iDB2 := NewInternalDB(nil, nil, false, cfg.StorDbCfg().Items)
+	if err := iDB2.StartDumper(path, 0, true); err != nil {
+		t.Fatal(err)
+	}
+	if rcv, _, err := iDB2.GetCDRs(&utils.CDRsFilter{CGRIDs: []string{cdr.CGRID}}, false); err != nil {
+		t.Error(err)
+	} else if len(rcv) != 1 || !reflect.DeepEqual(cdr, rcv[0]) {
+		t.Errorf("Expected %s, received %s", utils.ToJSON(cdr), utils.ToJSON(rcv))
+	}
+	iDB.Close()
+}
+
+func TestInternalDBStopDumper(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	path := t.TempDir()
+	iDB := NewInternalDB(nil, nil, false, cfg.StorDbCfg().Items)
+	if err := iDB.StartDumper(path, time.Hour, true); err != nil {
+		t.Fatal(err)
+	}
+	cdr1 := &CDR{CGRID: "CGRID_1", RunID: utils.MetaDefault, Tenant: "cgrates.org"}
+	if err := iDB.SetCDR(cdr1, false); err != nil {
+		t.Fatal(err)
+	}
+	// a new connection takes over the files so the old one stops without a final dump
+	iDB2 := NewInternalDB(nil, nil, false, cfg.StorDbCfg().Items)
+	if err := iDB2.StartDumper(path, time.Hour, true); err != nil {
+		t.Fatal(err)
+	}
+	iDB.StopDumper()
+	iDB.Close()
+	if err := iDB.Dump(); err != nil {
+		t.Error(err)
+	}
+	cdr2 := &CDR{CGRID: "CGRID_2", RunID: utils.MetaDefault, Tenant: "cgrates.org"}
+	if err := iDB2.SetCDR(cdr2, false); err != nil {
+		t.Fatal(err)
+	}
+	iDB3 := NewInternalDB(nil, nil, false, cfg.StorDbCfg().Items)
+	if err := iDB3.StartDumper(path, 0, true); err != nil {
+		t.Fatal(err)
+	}
+	if rcv, _, err := iDB3.GetCDRs(&utils.CDRsFilter{}, false); err != nil {
+		t.Error(err)
+	} else if len(rcv) != 2 {
+		t.Errorf("Expected both CDRs, received %s", utils.ToJSON(rcv))
+	}
+	iDB2.Close()
+	iDB3.Close()
+}
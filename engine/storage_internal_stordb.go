@@ -518,6 +518,28 @@ func (iDB *InternalDB) GetTPChargers(tpid, tenant, id string) (cpps []*utils.TPC
 	return
 }
 
+func (iDB *InternalDB) GetTPTaxProfiles(tpid, tenant, id string) (txps []*utils.TPTaxProfile, err error) {
+	key := tpid
+	if tenant != utils.EmptyString {
+		key += utils.ConcatenatedKeySep + tenant
+	}
+	if id != utils.EmptyString {
+		key += utils.ConcatenatedKeySep + id
+	}
+	ids := iDB.db.GetItemIDs(utils.CacheTBLTPTaxProfiles, key)
+	for _, id := range ids {
+		x, ok := iDB.db.Get(utils.CacheTBLTPTaxProfiles, id)
+		if !ok || x == nil {
+			return nil, utils.ErrNotFound
+		}
+		txps = append(txps, x.(*utils.TPTaxProfile))
+	}
+	if len(txps) == 0 {
+		return nil, utils.ErrNotFound
+	}
+	return
+}
+
 func (iDB *InternalDB) GetTPDispatcherProfiles(tpid, tenant, id string) (dpps []*utils.TPDispatcherProfile, err error) {
 	key := tpid
 	if tenant != utils.EmptyString {
@@ -793,6 +815,17 @@ func (iDB *InternalDB) SetTPChargers(cpps []*utils.TPChargerProfile) (err error)
 	}
 	return
 }
+func (iDB *InternalDB) SetTPTaxProfiles(txps []*utils.TPTaxProfile) (err error) {
+	if len(txps) == 0 {
+		return nil
+	}
+
+	for _, txp := range txps {
+		iDB.db.Set(utils.CacheTBLTPTaxProfiles, utils.ConcatenatedKey(txp.TPid, txp.Tenant, txp.ID), txp, nil,
+			cacheCommit(utils.NonTransactional), utils.NonTransactional)
+	}
+	return
+}
 func (iDB *InternalDB) SetTPDispatcherProfiles(dpps []*utils.TPDispatcherProfile) (err error) {
 	if len(dpps) == 0 {
 		return nil
@@ -1469,3 +1502,107 @@ func (iDB *InternalDB) SetSMCost(smCost *SMCost) (err error) {
 		cacheCommit(utils.NonTransactional), utils.NonTransactional)
 	return err
 }
+
+// SetBalanceLedgerEntries appends the entries to the ledger, the key keeping the insert order
+func (iDB *InternalDB) SetBalanceLedgerEntries(entries []*BalanceLedgerEntry) (err error) {
+	for _, le := range entries {
+		iDB.db.Set(utils.CacheBalanceLedgerTBL, fmt.Sprintf("%020d", iDB.cnter.Next()), le,
+			[]string{utils.ConcatenatedKey(utils.AccountField, le.Tenant, le.Account)},
+			cacheCommit(utils.NonTransactional), utils.NonTransactional)
+	}
+	return
+}
+
+// GetBalanceLedgerEntries returns the entries matching the filter, ordered by time
+func (iDB *InternalDB) GetBalanceLedgerEntries(fltr *BalanceLedgerFilter) (entries []*BalanceLedgerEntry, err error) {
+	var keys []string
+	if len(fltr.Tenants) != 0 && len(fltr.Accounts) != 0 { // use the account index
+		for _, tnt := range fltr.Tenants {
+			for _, acnt := range fltr.Accounts {
+				keys = append(keys, iDB.db.GetGroupItemIDs(utils.CacheBalanceLedgerTBL,
+					utils.ConcatenatedKey(utils.AccountField, tnt, acnt))...)
+			}
+		}
+	} else {
+		keys = iDB.db.GetItemIDs(utils.CacheBalanceLedgerTBL, utils.EmptyString)
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		x, ok := iDB.db.Get(utils.CacheBalanceLedgerTBL, key)
+		if !ok || x == nil {
+			continue
+		}
+		if le := x.(*BalanceLedgerEntry); fltr.Pass(le) {
+			entries = append(entries, le)
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Time.Before(entries[j].Time)
+	})
+	if fltr.Offset != nil {
+		if *fltr.Offset >= len(entries) {
+			entries = nil
+		} else {
+			entries = entries[*fltr.Offset:]
+		}
+	}
+	if fltr.Limit != nil && *fltr.Limit < len(entries) {
+		entries = entries[:*fltr.Limit]
+	}
+	if len(entries) == 0 {
+		return nil, utils.ErrNotFound
+	}
+	return
+}
+
+// SetInvoice stores the invoice, replacing the one with the same ID
+func (iDB *InternalDB) SetInvoice(inv *Invoice) (err error) {
+	iDB.db.Set(utils.CacheInvoicesTBL, inv.ID, inv,
+		[]string{utils.ConcatenatedKey(utils.AccountField, inv.Tenant, inv.Account)},
+		cacheCommit(utils.NonTransactional), utils.NonTransactional)
+	return
+}
+
+// GetInvoices returns the invoices matching the filter, ordered by the start of the period
+func (iDB *InternalDB) GetInvoices(fltr *InvoiceFilter) (invs []*Invoice, err error) {
+	var keys []string
+	if len(fltr.Tenants) != 0 && len(fltr.Accounts) != 0 { // use the account index
+		for _, tnt := range fltr.Tenants {
+			for _, acnt := range fltr.Accounts {
+				keys = append(keys, iDB.db.GetGroupItemIDs(utils.CacheInvoicesTBL,
+					utils.ConcatenatedKey(utils.AccountField, tnt, acnt))...)
+			}
+		}
+	} else {
+		keys = iDB.db.GetItemIDs(utils.CacheInvoicesTBL, utils.EmptyString)
+	}
+	for _, key := range keys {
+		x, ok := iDB.db.Get(utils.CacheInvoicesTBL, key)
+		if !ok || x == nil {
+			continue
+		}
+		if inv := x.(*Invoice); fltr.Pass(inv) {
+			invs = append(invs, inv)
+		}
+	}
+	sort.Slice(invs, func(i, j int) bool {
+		if !invs[i].PeriodStart.Equal(invs[j].PeriodStart) {
+			return invs[i].PeriodStart.Before(invs[j].PeriodStart)
+		}
+		return invs[i].ID < invs[j].ID
+	})
+	if fltr.Offset != nil {
+		if *fltr.Offset >= len(invs) {
+			invs = nil
+		} else {
+			invs = invs[*fltr.Offset:]
+		}
+	}
+	if fltr.Limit != nil && *fltr.Limit < len(invs) {
+		invs = invs[:*fltr.Limit]
+	}
+	if len(invs) == 0 {
+		return nil, utils.ErrNotFound
+	}
+	return
+}
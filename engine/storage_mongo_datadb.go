@@ -74,6 +74,10 @@ const (
 	ColCpp  = "charger_profiles"
 	ColDpp  = "dispatcher_profiles"
 	ColDph  = "dispatcher_hosts"
+	ColExr  = "exchange_rates"
+	ColCal  = "calendars"
+	ColTxp  = "tax_profiles"
+	ColSbk  = "sessions_backup"
 	ColLID  = "load_ids"
 )
 
@@ -99,6 +103,8 @@ var (
 	DestinationLow = strings.ToLower(utils.Destination)
 	CostLow        = strings.ToLower(utils.Cost)
 	CostSourceLow  = strings.ToLower(utils.CostSource)
+	TimeLow        = strings.ToLower(utils.Time)
+	PeriodStartLow = strings.ToLower(utils.PeriodStart)
 )
 
 func decimalEncoder(ec bsoncodec.EncodeContext, vw bsonrw.ValueWriter, val reflect.Value) error {
@@ -299,9 +305,9 @@ func (ms *MongoStorage) ensureIndexesForCol(col string) error { // exported for
 		return err
 	}
 	switch col {
-	case ColAct, ColApl, ColAAp, ColAtr, ColRpl, ColDst, ColRds, ColLht, ColIndx:
+	case ColAct, ColApl, ColAAp, ColAtr, ColRpl, ColDst, ColRds, ColLht, ColIndx, ColSbk:
 		err = ms.enusureIndex(col, true, "key")
-	case ColRsP, ColRes, ColSqs, ColSqp, ColTps, ColThs, ColRts, ColAttr, ColFlt, ColCpp, ColDpp, ColDph:
+	case ColRsP, ColRes, ColSqs, ColSqp, ColTps, ColThs, ColRts, ColAttr, ColFlt, ColCpp, ColDpp, ColDph, ColExr, ColCal, ColTxp:
 		err = ms.enusureIndex(col, true, "tenant", "id")
 	case ColRpf, ColShg, ColAcc:
 		err = ms.enusureIndex(col, true, "id")
@@ -311,7 +317,7 @@ func (ms *MongoStorage) ensureIndexesForCol(col string) error { // exported for
 		utils.TBLTPSharedGroups, utils.TBLTPActions,
 		utils.TBLTPActionPlans, utils.TBLTPActionTriggers,
 		utils.TBLTPStats, utils.TBLTPResources, utils.TBLTPDispatchers,
-		utils.TBLTPDispatcherHosts, utils.TBLTPChargers,
+		utils.TBLTPDispatcherHosts, utils.TBLTPChargers, utils.TBLTPTaxProfiles,
 		utils.TBLTPRoutes, utils.TBLTPThresholds:
 		err = ms.enusureIndex(col, true, "tpid", "id")
 	case utils.TBLTPRatingProfiles:
@@ -325,6 +331,12 @@ func (ms *MongoStorage) ensureIndexesForCol(col string) error { // exported for
 		if err == nil {
 			err = ms.enusureIndex(col, false, RunIDLow, OriginIDLow)
 		}
+	case utils.BalanceLedgerTBL:
+		err = ms.enusureIndex(col, false, TenantLow, AccountLow, TimeLow)
+	case utils.InvoicesTBL:
+		if err = ms.enusureIndex(col, true, "id"); err == nil {
+			err = ms.enusureIndex(col, false, TenantLow, AccountLow, PeriodStartLow)
+		}
 	case utils.CDRsTBL:
 		err = ms.enusureIndex(col, true, CGRIDLow, RunIDLow,
 			OriginIDLow)
@@ -347,14 +359,14 @@ func (ms *MongoStorage) EnsureIndexes(cols ...string) error {
 			cols = []string{
 				ColAct, ColApl, ColAAp, ColAtr, ColRpl, ColDst, ColRds, ColLht, ColIndx,
 				ColRsP, ColRes, ColSqs, ColSqp, ColTps, ColThs, ColRts, ColAttr, ColFlt, ColCpp,
-				ColDpp, ColRpf, ColShg, ColAcc,
+				ColDpp, ColRpf, ColShg, ColAcc, ColExr, ColCal, ColTxp, ColSbk,
 			}
 		} else {
 			cols = []string{
 				utils.TBLTPTimings, utils.TBLTPDestinations, utils.TBLTPDestinationRates,
 				utils.TBLTPRatingPlans, utils.TBLTPSharedGroups, utils.TBLTPActions, utils.TBLTPActionPlans,
 				utils.TBLTPActionTriggers, utils.TBLTPStats, utils.TBLTPResources, utils.TBLTPRatingProfiles,
-				utils.CDRsTBL, utils.SessionCostsTBL,
+				utils.CDRsTBL, utils.SessionCostsTBL, utils.BalanceLedgerTBL, utils.InvoicesTBL,
 			}
 		}
 	}
@@ -621,6 +633,12 @@ func (ms *MongoStorage) GetKeysForPrefix(prefix string) (keys []string, err erro
 			keys, qryErr = ms.getAllKeysMatchingTenantID(sctx, ColDpp, utils.DispatcherProfilePrefix, subject, tntID)
 		case utils.DispatcherHostPrefix:
 			keys, qryErr = ms.getAllKeysMatchingTenantID(sctx, ColDph, utils.DispatcherHostPrefix, subject, tntID)
+		case utils.ExchangeRatePrefix:
+			keys, qryErr = ms.getAllKeysMatchingTenantID(sctx, ColExr, utils.ExchangeRatePrefix, subject, tntID)
+		case utils.CalendarPrefix:
+			keys, qryErr = ms.getAllKeysMatchingTenantID(sctx, ColCal, utils.CalendarPrefix, subject, tntID)
+		case utils.TaxProfilePrefix:
+			keys, qryErr = ms.getAllKeysMatchingTenantID(sctx, ColTxp, utils.TaxProfilePrefix, subject, tntID)
 		case utils.AttributeFilterIndexes:
 			keys, qryErr = ms.getAllIndexKeys(sctx, utils.AttributeFilterIndexes)
 		case utils.ResourceFilterIndexes:
@@ -633,6 +651,8 @@ func (ms *MongoStorage) GetKeysForPrefix(prefix string) (keys []string, err erro
 			keys, qryErr = ms.getAllIndexKeys(sctx, utils.RouteFilterIndexes)
 		case utils.ChargerFilterIndexes:
 			keys, qryErr = ms.getAllIndexKeys(sctx, utils.ChargerFilterIndexes)
+		case utils.TaxFilterIndexes:
+			keys, qryErr = ms.getAllIndexKeys(sctx, utils.TaxFilterIndexes)
 		case utils.DispatcherFilterIndexes:
 			keys, qryErr = ms.getAllIndexKeys(sctx, utils.DispatcherFilterIndexes)
 		case utils.ActionPlanIndexes:
@@ -687,6 +707,12 @@ func (ms *MongoStorage) HasDataDrv(category, subject, tenant string) (has bool,
 			count, err = ms.getCol(ColDpp).CountDocuments(sctx, bson.M{"tenant": tenant, "id": subject})
 		case utils.DispatcherHostPrefix:
 			count, err = ms.getCol(ColDph).CountDocuments(sctx, bson.M{"tenant": tenant, "id": subject})
+		case utils.ExchangeRatePrefix:
+			count, err = ms.getCol(ColExr).CountDocuments(sctx, bson.M{"tenant": tenant, "id": subject})
+		case utils.CalendarPrefix:
+			count, err = ms.getCol(ColCal).CountDocuments(sctx, bson.M{"tenant": tenant, "id": subject})
+		case utils.TaxProfilePrefix:
+			count, err = ms.getCol(ColTxp).CountDocuments(sctx, bson.M{"tenant": tenant, "id": subject})
 		default:
 			err = fmt.Errorf("unsupported category in HasData: %s", category)
 		}
@@ -1720,6 +1746,39 @@ func (ms *MongoStorage) RemoveChargerProfileDrv(tenant, id string) error {
 	})
 }
 
+func (ms *MongoStorage) GetTaxProfileDrv(tenant, id string) (*TaxProfile, error) {
+	tp := new(TaxProfile)
+	err := ms.query(func(sctx mongo.SessionContext) error {
+		sr := ms.getCol(ColTxp).FindOne(sctx, bson.M{"tenant": tenant, "id": id})
+		decodeErr := sr.Decode(tp)
+		if errors.Is(decodeErr, mongo.ErrNoDocuments) {
+			return utils.ErrNotFound
+		}
+		return decodeErr
+	})
+	return tp, err
+}
+
+func (ms *MongoStorage) SetTaxProfileDrv(tp *TaxProfile) error {
+	return ms.query(func(sctx mongo.SessionContext) error {
+		_, err := ms.getCol(ColTxp).UpdateOne(sctx, bson.M{"tenant": tp.Tenant, "id": tp.ID},
+			bson.M{"$set": tp},
+			options.Update().SetUpsert(true),
+		)
+		return err
+	})
+}
+
+func (ms *MongoStorage) RemoveTaxProfileDrv(tenant, id string) error {
+	return ms.query(func(sctx mongo.SessionContext) error {
+		dr, err := ms.getCol(ColTxp).DeleteOne(sctx, bson.M{"tenant": tenant, "id": id})
+		if dr.DeletedCount == 0 {
+			return utils.ErrNotFound
+		}
+		return err
+	})
+}
+
 func (ms *MongoStorage) GetDispatcherProfileDrv(tenant, id string) (*DispatcherProfile, error) {
 	dspProfile := new(DispatcherProfile)
 	err := ms.query(func(sctx mongo.SessionContext) error {
@@ -1786,6 +1845,133 @@ func (ms *MongoStorage) RemoveDispatcherHostDrv(tenant, id string) error {
 	})
 }
 
+func (ms *MongoStorage) GetExchangeRateDrv(tenant, id string) (*ExchangeRate, error) {
+	er := new(ExchangeRate)
+	err := ms.query(func(sctx mongo.SessionContext) error {
+		sr := ms.getCol(ColExr).FindOne(sctx, bson.M{"tenant": tenant, "id": id})
+		decodeErr := sr.Decode(er)
+		if errors.Is(decodeErr, mongo.ErrNoDocuments) {
+			return utils.ErrNotFound
+		}
+		return decodeErr
+	})
+	return er, err
+}
+
+// SetExchangeRateDrv stores the exchange rate together with its ID, used for the key queries
+func (ms *MongoStorage) SetExchangeRateDrv(er *ExchangeRate) error {
+	return ms.query(func(sctx mongo.SessionContext) error {
+		_, err := ms.getCol(ColExr).UpdateOne(sctx, bson.M{"tenant": er.Tenant, "id": er.ID()},
+			bson.M{"$set": bson.M{
+				"tenant":       er.Tenant,
+				"id":           er.ID(),
+				"fromcurrency": er.FromCurrency,
+				"tocurrency":   er.ToCurrency,
+				"rate":         er.Rate,
+			}},
+			options.Update().SetUpsert(true),
+		)
+		return err
+	})
+}
+
+func (ms *MongoStorage) RemoveExchangeRateDrv(tenant, id string) error {
+	return ms.query(func(sctx mongo.SessionContext) error {
+		dr, err := ms.getCol(ColExr).DeleteOne(sctx, bson.M{"tenant": tenant, "id": id})
+		if dr.DeletedCount == 0 {
+			return utils.ErrNotFound
+		}
+		return err
+	})
+}
+
+func (ms *MongoStorage) GetCalendarDrv(tenant, id string) (*Calendar, error) {
+	cal := new(Calendar)
+	err := ms.query(func(sctx mongo.SessionContext) error {
+		sr := ms.getCol(ColCal).FindOne(sctx, bson.M{"tenant": tenant, "id": id})
+		decodeErr := sr.Decode(cal)
+		if errors.Is(decodeErr, mongo.ErrNoDocuments) {
+			return utils.ErrNotFound
+		}
+		return decodeErr
+	})
+	return cal, err
+}
+
+func (ms *MongoStorage) SetCalendarDrv(cal *Calendar) error {
+	return ms.query(func(sctx mongo.SessionContext) error {
+		_, err := ms.getCol(ColCal).UpdateOne(sctx, bson.M{"tenant": cal.Tenant, "id": cal.ID},
+			bson.M{"$set": cal},
+			options.Update().SetUpsert(true),
+		)
+		return err
+	})
+}
+
+func (ms *MongoStorage) RemoveCalendarDrv(tenant, id string) error {
+	return ms.query(func(sctx mongo.SessionContext) error {
+		dr, err := ms.getCol(ColCal).DeleteOne(sctx, bson.M{"tenant": tenant, "id": id})
+		if dr.DeletedCount == 0 {
+			return utils.ErrNotFound
+		}
+		return err
+	})
+}
+
+func (ms *MongoStorage) GetSessionsBackupDrv(nodeID string) (sss []*StoredSession, err error) {
+	err = ms.query(func(sctx mongo.SessionContext) (qryErr error) {
+		iter, qryErr := ms.getCol(ColSbk).Find(sctx, bson.M{"nodeid": nodeID})
+		if qryErr != nil {
+			return
+		}
+		for iter.Next(sctx) {
+			var kv struct {
+				Key   string
+				Value []byte
+			}
+			if qryErr = iter.Decode(&kv); qryErr != nil {
+				return
+			}
+			var ss *StoredSession
+			if qryErr = ms.ms.Unmarshal(kv.Value, &ss); qryErr != nil {
+				return
+			}
+			sss = append(sss, ss)
+		}
+		return iter.Close(sctx)
+	})
+	if err == nil && len(sss) == 0 {
+		err = utils.ErrNotFound
+	}
+	return
+}
+
+// SetSessionBackupDrv stores the session marshaled, the NodeID is kept apart for the queries
+func (ms *MongoStorage) SetSessionBackupDrv(ss *StoredSession) error {
+	result, err := ms.ms.Marshal(ss)
+	if err != nil {
+		return err
+	}
+	return ms.query(func(sctx mongo.SessionContext) error {
+		_, err := ms.getCol(ColSbk).UpdateOne(sctx, bson.M{"key": ss.BackupID()},
+			bson.M{"$set": struct {
+				Key    string
+				NodeID string
+				Value  []byte
+			}{Key: ss.BackupID(), NodeID: ss.NodeID, Value: result}},
+			options.Update().SetUpsert(true),
+		)
+		return err
+	})
+}
+
+func (ms *MongoStorage) RemoveSessionBackupDrv(nodeID, cgrID string) error {
+	return ms.query(func(sctx mongo.SessionContext) error {
+		_, err := ms.getCol(ColSbk).DeleteOne(sctx, bson.M{"key": utils.ConcatenatedKey(nodeID, cgrID)})
+		return err
+	})
+}
+
 func (ms *MongoStorage) GetItemLoadIDsDrv(itemIDPrefix string) (map[string]int64, error) {
 	fop := options.FindOne()
 	if itemIDPrefix != "" {
@@ -1457,6 +1457,54 @@ func (ms *MongoStorage) SetTPChargers(tpCPP []*utils.TPChargerProfile) (err erro
 	})
 }
 
+func (ms *MongoStorage) GetTPTaxProfiles(tpid, tenant, id string) ([]*utils.TPTaxProfile, error) {
+	filter := bson.M{"tpid": tpid}
+	if id != "" {
+		filter["id"] = id
+	}
+	if tenant != "" {
+		filter["tenant"] = tenant
+	}
+	var results []*utils.TPTaxProfile
+	err := ms.query(func(sctx mongo.SessionContext) (err error) {
+		cur, err := ms.getCol(utils.TBLTPTaxProfiles).Find(sctx, filter)
+		if err != nil {
+			return err
+		}
+		for cur.Next(sctx) {
+			var tp utils.TPTaxProfile
+			err := cur.Decode(&tp)
+			if err != nil {
+				return err
+			}
+			results = append(results, &tp)
+		}
+		if len(results) == 0 {
+			return utils.ErrNotFound
+		}
+		return cur.Close(sctx)
+	})
+	return results, err
+}
+
+func (ms *MongoStorage) SetTPTaxProfiles(tpTxps []*utils.TPTaxProfile) (err error) {
+	if len(tpTxps) == 0 {
+		return
+	}
+	return ms.query(func(sctx mongo.SessionContext) (err error) {
+		for _, tp := range tpTxps {
+			_, err = ms.getCol(utils.TBLTPTaxProfiles).UpdateOne(sctx, bson.M{"tpid": tp.TPid, "id": tp.ID},
+				bson.M{"$set": tp},
+				options.Update().SetUpsert(true),
+			)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 func (ms *MongoStorage) GetTPDispatcherProfiles(tpid, tenant, id string) ([]*utils.TPDispatcherProfile, error) {
 	filter := bson.M{"tpid": tpid}
 	if id != "" {
@@ -1626,3 +1674,102 @@ func (ms *MongoStorage) RemoveVersions(vrs Versions) error {
 func (ms *MongoStorage) GetStorageType() string {
 	return utils.MetaMongo
 }
+
+// SetBalanceLedgerEntries appends the entries to the ledger
+func (ms *MongoStorage) SetBalanceLedgerEntries(entries []*BalanceLedgerEntry) error {
+	docs := make([]any, len(entries))
+	for i, le := range entries {
+		docs[i] = le
+	}
+	return ms.query(func(sctx mongo.SessionContext) (err error) {
+		_, err = ms.getCol(utils.BalanceLedgerTBL).InsertMany(sctx, docs)
+		return err
+	})
+}
+
+// GetBalanceLedgerEntries returns the entries matching the filter, ordered by time
+func (ms *MongoStorage) GetBalanceLedgerEntries(fltr *BalanceLedgerFilter) (entries []*BalanceLedgerEntry, err error) {
+	filters := bson.M{
+		TenantLow:     bson.M{"$in": fltr.Tenants},
+		AccountLow:    bson.M{"$in": fltr.Accounts},
+		"balancetype": bson.M{"$in": fltr.BalanceTypes},
+		"balanceid":   bson.M{"$in": fltr.BalanceIDs},
+		"cause":       bson.M{"$in": fltr.Causes},
+		"causeid":     bson.M{"$in": fltr.CauseIDs},
+		TimeLow:       bson.M{"$gte": fltr.TimeStart, "$lt": fltr.TimeEnd},
+	}
+	ms.cleanEmptyFilters(filters)
+	fop := options.Find().SetSort(bson.D{{Key: TimeLow, Value: 1}, {Key: "_id", Value: 1}})
+	if fltr.Limit != nil {
+		fop = fop.SetLimit(int64(*fltr.Limit))
+	}
+	if fltr.Offset != nil {
+		fop = fop.SetSkip(int64(*fltr.Offset))
+	}
+	err = ms.query(func(sctx mongo.SessionContext) (err error) {
+		cur, err := ms.getCol(utils.BalanceLedgerTBL).Find(sctx, filters, fop)
+		if err != nil {
+			return err
+		}
+		for cur.Next(sctx) {
+			var le BalanceLedgerEntry
+			if err = cur.Decode(&le); err != nil {
+				cur.Close(sctx)
+				return err
+			}
+			entries = append(entries, &le)
+		}
+		return cur.Close(sctx)
+	})
+	if err == nil && len(entries) == 0 {
+		err = utils.ErrNotFound
+	}
+	return
+}
+
+// SetInvoice stores the invoice, replacing the one with the same ID
+func (ms *MongoStorage) SetInvoice(inv *Invoice) error {
+	return ms.query(func(sctx mongo.SessionContext) (err error) {
+		_, err = ms.getCol(utils.InvoicesTBL).ReplaceOne(sctx, bson.M{"id": inv.ID}, inv,
+			options.Replace().SetUpsert(true))
+		return err
+	})
+}
+
+// GetInvoices returns the invoices matching the filter, ordered by the start of the period
+func (ms *MongoStorage) GetInvoices(fltr *InvoiceFilter) (invs []*Invoice, err error) {
+	filters := bson.M{
+		"id":           bson.M{"$in": fltr.IDs},
+		TenantLow:      bson.M{"$in": fltr.Tenants},
+		AccountLow:     bson.M{"$in": fltr.Accounts},
+		PeriodStartLow: bson.M{"$gte": fltr.PeriodStart},
+		"periodend":    bson.M{"$lte": fltr.PeriodEnd},
+	}
+	ms.cleanEmptyFilters(filters)
+	fop := options.Find().SetSort(bson.D{{Key: PeriodStartLow, Value: 1}, {Key: "id", Value: 1}})
+	if fltr.Limit != nil {
+		fop = fop.SetLimit(int64(*fltr.Limit))
+	}
+	if fltr.Offset != nil {
+		fop = fop.SetSkip(int64(*fltr.Offset))
+	}
+	err = ms.query(func(sctx mongo.SessionContext) (err error) {
+		cur, err := ms.getCol(utils.InvoicesTBL).Find(sctx, filters, fop)
+		if err != nil {
+			return err
+		}
+		for cur.Next(sctx) {
+			var inv Invoice
+			if err = cur.Decode(&inv); err != nil {
+				cur.Close(sctx)
+				return err
+			}
+			invs = append(invs, &inv)
+		}
+		return cur.Close(sctx)
+	})
+	if err == nil && len(invs) == 0 {
+		err = utils.ErrNotFound
+	}
+	return
+}
@@ -26,8 +26,10 @@ import (
 	"errors"
 	"io"
 	"os"
+	"reflect"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/cgrates/cgrates/config"
@@ -37,8 +39,10 @@ import (
 )
 
 type RedisStorage struct {
-	client radix.Client
-	ms     Marshaler
+	client     radix.Client
+	ms         Marshaler
+	readPolicy string         // where the reads done by Get*Drv are sent
+	replicas   *redisReplicas // used for reads when not in sentinel or cluster mode
 }
 
 // Redis commands
@@ -66,6 +70,7 @@ const (
 	redis_HGET     = "HGET"
 	redis_RENAME   = "RENAME"
 	redis_HMSET    = "HMSET"
+	redis_READONLY = "READONLY"
 
 	redisLoadError = "Redis is loading the dataset in memory"
 	RedisLimit     = 524287 // https://github.com/StackExchange/StackExchange.Redis/issues/201#issuecomment-98639005
@@ -74,7 +79,8 @@ const (
 func NewRedisStorage(address string, db int, user, pass, mrshlerStr string,
 	maxConns, attempts int, sentinelName string, isCluster bool, clusterSync,
 	clusterOnDownDelay time.Duration, connTimeout, readTimeout, writeTimeout time.Duration,
-	tlsConn bool, tlsClientCert, tlsClientKey, tlsCACert string) (_ *RedisStorage, err error) {
+	tlsConn bool, tlsClientCert, tlsClientKey, tlsCACert string,
+	readPolicy string, readReplicas []string) (_ *RedisStorage, err error) {
 	var ms Marshaler
 	if ms, err = NewMarshaler(mrshlerStr); err != nil {
 		return
@@ -124,16 +130,28 @@ func NewRedisStorage(address string, db int, user, pass, mrshlerStr string,
 		radix.DialWriteTimeout(writeTimeout),
 		radix.DialConnectTimeout(connTimeout))
 
+	readFromReplicas := readPolicy == utils.MetaReplica ||
+		readPolicy == utils.MetaReplicaPreferred
 	var client radix.Client
 	if client, err = newRedisClient(address, sentinelName,
-		isCluster, clusterSync, clusterOnDownDelay,
+		isCluster, readFromReplicas, clusterSync, clusterOnDownDelay,
 		maxConns, attempts, dialOpts); err != nil {
 		return
 	}
-	return &RedisStorage{
-		ms:     ms,
-		client: client,
-	}, nil
+	rs := &RedisStorage{
+		ms:         ms,
+		client:     client,
+		readPolicy: readPolicy,
+	}
+	if readFromReplicas && !isCluster &&
+		sentinelName == utils.EmptyString {
+		if rs.replicas, err = newRedisReplicas(readReplicas,
+			maxConns, attempts, dialOpts); err != nil {
+			client.Close()
+			return
+		}
+	}
+	return rs, nil
 }
 
 func redisDial(network, addr string, attempts int, opts ...radix.DialOpt) (conn radix.Conn, err error) {
@@ -148,7 +166,7 @@ func redisDial(network, addr string, attempts int, opts ...radix.DialOpt) (conn
 	return
 }
 
-func newRedisClient(address, sentinelName string, isCluster bool,
+func newRedisClient(address, sentinelName string, isCluster, readOnly bool,
 	clusterSync, clusterOnDownDelay time.Duration, maxConns, attempts int, dialOpts []radix.DialOpt) (radix.Client, error) {
 	dialFunc := func(network, addr string) (radix.Conn, error) {
 		return redisDial(network, addr, attempts, dialOpts...)
@@ -158,12 +176,25 @@ func newRedisClient(address, sentinelName string, isCluster bool,
 	}
 	switch {
 	case isCluster:
+		clusterDialFunc := dialFuncAuthOnly
+		if readOnly { // allow the reads on the replicas
+			clusterDialFunc = func(network, addr string) (conn radix.Conn, err error) {
+				if conn, err = dialFuncAuthOnly(network, addr); err != nil {
+					return
+				}
+				if err = conn.Do(radix.Cmd(nil, redis_READONLY)); err != nil {
+					conn.Close()
+					return nil, err
+				}
+				return
+			}
+		}
 		return radix.NewCluster(utils.InfieldSplit(address),
 			radix.ClusterSyncEvery(clusterSync),
 			radix.ClusterOnDownDelayActionsBy(clusterOnDownDelay),
 			radix.ClusterPoolFunc(func(network, addr string) (radix.Client, error) {
 				// in cluster enviorment do not select the DB as we expect to have only one DB
-				return radix.NewPool(network, addr, maxConns, radix.PoolConnFunc(dialFuncAuthOnly))
+				return radix.NewPool(network, addr, maxConns, radix.PoolConnFunc(clusterDialFunc))
 			}))
 	case sentinelName != utils.EmptyString:
 		return radix.NewSentinel(sentinelName, utils.InfieldSplit(address),
@@ -176,6 +207,68 @@ func newRedisClient(address, sentinelName string, isCluster bool,
 	}
 }
 
+func newRedisReplicas(addresses []string, maxConns, attempts int,
+	dialOpts []radix.DialOpt) (r *redisReplicas, err error) {
+	dialFunc := func(network, addr string) (radix.Conn, error) {
+		return redisDial(network, addr, attempts, dialOpts...)
+	}
+	r = &redisReplicas{clients: make([]radix.Client, 0, len(addresses))}
+	for _, addr := range addresses {
+		var client radix.Client
+		if client, err = radix.NewPool(utils.TCP, addr, maxConns,
+			radix.PoolConnFunc(dialFunc)); err != nil {
+			r.Close()
+			return nil, err
+		}
+		r.clients = append(r.clients, client)
+	}
+	return
+}
+
+// redisReplicas distributes the reads between the replicas of a standalone Redis
+type redisReplicas struct {
+	clients []radix.Client
+	next    uint64
+}
+
+// Do executes the action on the next replica, trying the others in case of error
+func (r *redisReplicas) Do(a radix.Action) (err error) {
+	idx := atomic.AddUint64(&r.next, 1)
+	for i := range r.clients {
+		if err = r.clients[(idx+uint64(i))%uint64(len(r.clients))].Do(a); err == nil {
+			return
+		}
+	}
+	return
+}
+
+func (r *redisReplicas) Close() {
+	for _, client := range r.clients {
+		client.Close()
+	}
+}
+
+// isEmptyRedisReply checks if the reply decoded in rcv has no data
+func isEmptyRedisReply(rcv any) bool {
+	v := reflect.ValueOf(rcv)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return false
+	}
+	v = v.Elem()
+	switch v.Kind() {
+	case reflect.Slice: // HMGET replies with an empty value for each missing field
+		for i := 0; i < v.Len(); i++ {
+			if !v.Index(i).IsZero() {
+				return false
+			}
+		}
+		return true
+	case reflect.Map:
+		return v.Len() == 0
+	}
+	return v.IsZero()
+}
+
 // Cmd function get a connection from the pool.
 // Handles automatic failover in case of network disconnects
 func (rs *RedisStorage) Cmd(rcv any, cmd string, args ...string) error {
@@ -188,10 +281,41 @@ func (rs *RedisStorage) FlatCmd(rcv any, cmd, key string, args ...any) error {
 	return rs.client.Do(radix.FlatCmd(rcv, cmd, key, args...))
 }
 
+// readCmd is used by the Get*Drv for the data that is not updated based on its previous value
+// the command is sent to the primary or to the replicas based on the read policy
+func (rs *RedisStorage) readCmd(rcv any, cmd string, args ...string) (err error) {
+	switch rs.readPolicy {
+	case utils.MetaReplica:
+		return rs.doSecondary(radix.Cmd(rcv, cmd, args...))
+	case utils.MetaReplicaPreferred:
+		// fallback on primary in case the replica is not reachable
+		// or did not receive yet the data because of the replication lag
+		if err = rs.doSecondary(radix.Cmd(rcv, cmd, args...)); err == nil &&
+			!isEmptyRedisReply(rcv) {
+			return
+		}
+	}
+	return rs.Cmd(rcv, cmd, args...)
+}
+
+// doSecondary executes the action on one of the replicas
+func (rs *RedisStorage) doSecondary(a radix.Action) error {
+	if rs.replicas != nil {
+		return rs.replicas.Do(a)
+	}
+	if sc, canSecondary := rs.client.(interface{ DoSecondary(radix.Action) error }); canSecondary {
+		return sc.DoSecondary(a)
+	}
+	return rs.client.Do(a)
+}
+
 func (rs *RedisStorage) Close() {
 	if rs.client != nil {
 		rs.client.Close()
 	}
+	if rs.replicas != nil {
+		rs.replicas.Close()
+	}
 }
 
 func (rs *RedisStorage) Flush(ignore string) error {
@@ -288,7 +412,8 @@ func (rs *RedisStorage) HasDataDrv(category, subject, tenant string) (exists boo
 	case utils.ResourcesPrefix, utils.ResourceProfilesPrefix, utils.StatQueuePrefix,
 		utils.StatQueueProfilePrefix, utils.ThresholdPrefix, utils.ThresholdProfilePrefix,
 		utils.FilterPrefix, utils.RouteProfilePrefix, utils.AttributeProfilePrefix,
-		utils.ChargerProfilePrefix, utils.DispatcherProfilePrefix, utils.DispatcherHostPrefix:
+		utils.ChargerProfilePrefix, utils.DispatcherProfilePrefix, utils.DispatcherHostPrefix,
+		utils.ExchangeRatePrefix, utils.CalendarPrefix, utils.TaxProfilePrefix:
 		err := rs.Cmd(&i, redis_EXISTS, category+utils.ConcatenatedKey(tenant, subject))
 		return i == 1, err
 	}
@@ -298,7 +423,7 @@ func (rs *RedisStorage) HasDataDrv(category, subject, tenant string) (exists boo
 func (rs *RedisStorage) GetRatingPlanDrv(key string) (rp *RatingPlan, err error) {
 	key = utils.RatingPlanPrefix + key
 	var values []byte
-	if err = rs.Cmd(&values, redis_GET, key); err != nil {
+	if err = rs.readCmd(&values, redis_GET, key); err != nil {
 		return
 	} else if len(values) == 0 {
 		err = utils.ErrNotFound
@@ -347,7 +472,7 @@ func (rs *RedisStorage) RemoveRatingPlanDrv(key string) (err error) {
 func (rs *RedisStorage) GetRatingProfileDrv(key string) (rpf *RatingProfile, err error) {
 	key = utils.RatingProfilePrefix + key
 	var values []byte
-	if err = rs.Cmd(&values, redis_GET, key); err != nil {
+	if err = rs.readCmd(&values, redis_GET, key); err != nil {
 		return
 	} else if len(values) == 0 {
 		err = utils.ErrNotFound
@@ -383,7 +508,7 @@ func (rs *RedisStorage) RemoveRatingProfileDrv(key string) (err error) {
 // GetDestination retrieves a destination with id from  tp_db
 func (rs *RedisStorage) GetDestinationDrv(key, transactionID string) (dest *Destination, err error) {
 	var values []byte
-	if err = rs.Cmd(&values, redis_GET, utils.DestinationPrefix+key); err != nil {
+	if err = rs.readCmd(&values, redis_GET, utils.DestinationPrefix+key); err != nil {
 		return
 	} else if len(values) == 0 {
 		err = utils.ErrNotFound
@@ -417,7 +542,7 @@ func (rs *RedisStorage) SetDestinationDrv(dest *Destination, transactionID strin
 }
 
 func (rs *RedisStorage) GetReverseDestinationDrv(key, transactionID string) (ids []string, err error) {
-	if err = rs.Cmd(&ids, redis_SMEMBERS, utils.ReverseDestinationPrefix+key); err != nil {
+	if err = rs.readCmd(&ids, redis_SMEMBERS, utils.ReverseDestinationPrefix+key); err != nil {
 		return
 	}
 	if len(ids) == 0 {
@@ -445,7 +570,7 @@ func (rs *RedisStorage) RemoveReverseDestinationDrv(dstID, prfx, transactionID s
 
 func (rs *RedisStorage) GetActionsDrv(key string) (as Actions, err error) {
 	var values []byte
-	if err = rs.Cmd(&values, redis_GET, utils.ActionPrefix+key); err != nil {
+	if err = rs.readCmd(&values, redis_GET, utils.ActionPrefix+key); err != nil {
 		return
 	} else if len(values) == 0 {
 		err = utils.ErrNotFound
@@ -469,7 +594,7 @@ func (rs *RedisStorage) RemoveActionsDrv(key string) (err error) {
 
 func (rs *RedisStorage) GetSharedGroupDrv(key string) (sg *SharedGroup, err error) {
 	var values []byte
-	if err = rs.Cmd(&values, redis_GET, utils.SharedGroupPrefix+key); err != nil {
+	if err = rs.readCmd(&values, redis_GET, utils.SharedGroupPrefix+key); err != nil {
 		return
 	} else if len(values) == 0 {
 		err = utils.ErrNotFound
@@ -614,7 +739,7 @@ func (rs *RedisStorage) AddLoadHistory(ldInst *utils.LoadInstance, loadHistSize
 
 func (rs *RedisStorage) GetActionTriggersDrv(key string) (atrs ActionTriggers, err error) {
 	var values []byte
-	if err = rs.Cmd(&values, redis_GET, utils.ActionTriggerPrefix+key); err != nil {
+	if err = rs.readCmd(&values, redis_GET, utils.ActionTriggerPrefix+key); err != nil {
 		return
 	} else if len(values) == 0 {
 		err = utils.ErrNotFound
@@ -749,7 +874,7 @@ func (rs *RedisStorage) PopTask() (t *Task, err error) {
 
 func (rs *RedisStorage) GetResourceProfileDrv(tenant, id string) (rsp *ResourceProfile, err error) {
 	var values []byte
-	if err = rs.Cmd(&values, redis_GET, utils.ResourceProfilesPrefix+utils.ConcatenatedKey(tenant, id)); err != nil {
+	if err = rs.readCmd(&values, redis_GET, utils.ResourceProfilesPrefix+utils.ConcatenatedKey(tenant, id)); err != nil {
 		return
 	} else if len(values) == 0 {
 		err = utils.ErrNotFound
@@ -797,7 +922,7 @@ func (rs *RedisStorage) RemoveResourceDrv(tenant, id string) (err error) {
 
 func (rs *RedisStorage) GetTimingDrv(id string) (t *utils.TPTiming, err error) {
 	var values []byte
-	if err = rs.Cmd(&values, redis_GET, utils.TimingsPrefix+id); err != nil {
+	if err = rs.readCmd(&values, redis_GET, utils.TimingsPrefix+id); err != nil {
 		return
 	} else if len(values) == 0 {
 		err = utils.ErrNotFound
@@ -868,7 +993,7 @@ func (rs *RedisStorage) RemoveVersions(vrs Versions) (err error) {
 // GetStatQueueProfileDrv retrieves a StatQueueProfile from dataDB
 func (rs *RedisStorage) GetStatQueueProfileDrv(tenant string, id string) (sq *StatQueueProfile, err error) {
 	var values []byte
-	if err = rs.Cmd(&values, redis_GET, utils.StatQueueProfilePrefix+utils.ConcatenatedKey(tenant, id)); err != nil {
+	if err = rs.readCmd(&values, redis_GET, utils.StatQueueProfilePrefix+utils.ConcatenatedKey(tenant, id)); err != nil {
 		return
 	} else if len(values) == 0 {
 		err = utils.ErrNotFound
@@ -931,7 +1056,7 @@ func (rs *RedisStorage) RemStatQueueDrv(tenant, id string) (err error) {
 // GetThresholdProfileDrv retrieves a ThresholdProfile from dataDB
 func (rs *RedisStorage) GetThresholdProfileDrv(tenant, ID string) (tp *ThresholdProfile, err error) {
 	var values []byte
-	if err = rs.Cmd(&values, redis_GET, utils.ThresholdProfilePrefix+utils.ConcatenatedKey(tenant, ID)); err != nil {
+	if err = rs.readCmd(&values, redis_GET, utils.ThresholdProfilePrefix+utils.ConcatenatedKey(tenant, ID)); err != nil {
 		return
 	} else if len(values) == 0 {
 		err = utils.ErrNotFound
@@ -981,7 +1106,7 @@ func (rs *RedisStorage) RemoveThresholdDrv(tenant, id string) (err error) {
 
 func (rs *RedisStorage) GetFilterDrv(tenant, id string) (r *Filter, err error) {
 	var values []byte
-	if err = rs.Cmd(&values, redis_GET, utils.FilterPrefix+utils.ConcatenatedKey(tenant, id)); err != nil {
+	if err = rs.readCmd(&values, redis_GET, utils.FilterPrefix+utils.ConcatenatedKey(tenant, id)); err != nil {
 		return
 	} else if len(values) == 0 {
 		err = utils.ErrNotFound
@@ -1005,7 +1130,7 @@ func (rs *RedisStorage) RemoveFilterDrv(tenant, id string) (err error) {
 
 func (rs *RedisStorage) GetRouteProfileDrv(tenant, id string) (r *RouteProfile, err error) {
 	var values []byte
-	if err = rs.Cmd(&values, redis_GET, utils.RouteProfilePrefix+utils.ConcatenatedKey(tenant, id)); err != nil {
+	if err = rs.readCmd(&values, redis_GET, utils.RouteProfilePrefix+utils.ConcatenatedKey(tenant, id)); err != nil {
 		return
 	} else if len(values) == 0 {
 		err = utils.ErrNotFound
@@ -1029,7 +1154,7 @@ func (rs *RedisStorage) RemoveRouteProfileDrv(tenant, id string) (err error) {
 
 func (rs *RedisStorage) GetAttributeProfileDrv(tenant, id string) (r *AttributeProfile, err error) {
 	var values []byte
-	if err = rs.Cmd(&values, redis_GET, utils.AttributeProfilePrefix+utils.ConcatenatedKey(tenant, id)); err != nil {
+	if err = rs.readCmd(&values, redis_GET, utils.AttributeProfilePrefix+utils.ConcatenatedKey(tenant, id)); err != nil {
 		return
 	} else if len(values) == 0 {
 		err = utils.ErrNotFound
@@ -1053,7 +1178,7 @@ func (rs *RedisStorage) RemoveAttributeProfileDrv(tenant, id string) (err error)
 
 func (rs *RedisStorage) GetChargerProfileDrv(tenant, id string) (r *ChargerProfile, err error) {
 	var values []byte
-	if err = rs.Cmd(&values, redis_GET, utils.ChargerProfilePrefix+utils.ConcatenatedKey(tenant, id)); err != nil {
+	if err = rs.readCmd(&values, redis_GET, utils.ChargerProfilePrefix+utils.ConcatenatedKey(tenant, id)); err != nil {
 		return
 	} else if len(values) == 0 {
 		err = utils.ErrNotFound
@@ -1075,9 +1200,33 @@ func (rs *RedisStorage) RemoveChargerProfileDrv(tenant, id string) (err error) {
 	return rs.Cmd(nil, redis_DEL, utils.ChargerProfilePrefix+utils.ConcatenatedKey(tenant, id))
 }
 
+func (rs *RedisStorage) GetTaxProfileDrv(tenant, id string) (tp *TaxProfile, err error) {
+	var values []byte
+	if err = rs.readCmd(&values, redis_GET, utils.TaxProfilePrefix+utils.ConcatenatedKey(tenant, id)); err != nil {
+		return
+	} else if len(values) == 0 {
+		err = utils.ErrNotFound
+		return
+	}
+	err = rs.ms.Unmarshal(values, &tp)
+	return
+}
+
+func (rs *RedisStorage) SetTaxProfileDrv(tp *TaxProfile) (err error) {
+	var result []byte
+	if result, err = rs.ms.Marshal(tp); err != nil {
+		return
+	}
+	return rs.Cmd(nil, redis_SET, utils.TaxProfilePrefix+tp.TenantID(), string(result))
+}
+
+func (rs *RedisStorage) RemoveTaxProfileDrv(tenant, id string) (err error) {
+	return rs.Cmd(nil, redis_DEL, utils.TaxProfilePrefix+utils.ConcatenatedKey(tenant, id))
+}
+
 func (rs *RedisStorage) GetDispatcherProfileDrv(tenant, id string) (r *DispatcherProfile, err error) {
 	var values []byte
-	if err = rs.Cmd(&values, redis_GET, utils.DispatcherProfilePrefix+utils.ConcatenatedKey(tenant, id)); err != nil {
+	if err = rs.readCmd(&values, redis_GET, utils.DispatcherProfilePrefix+utils.ConcatenatedKey(tenant, id)); err != nil {
 		return
 	} else if len(values) == 0 {
 		err = utils.ErrDSPProfileNotFound
@@ -1101,7 +1250,7 @@ func (rs *RedisStorage) RemoveDispatcherProfileDrv(tenant, id string) (err error
 
 func (rs *RedisStorage) GetDispatcherHostDrv(tenant, id string) (r *DispatcherHost, err error) {
 	var values []byte
-	if err = rs.Cmd(&values, redis_GET, utils.DispatcherHostPrefix+utils.ConcatenatedKey(tenant, id)); err != nil {
+	if err = rs.readCmd(&values, redis_GET, utils.DispatcherHostPrefix+utils.ConcatenatedKey(tenant, id)); err != nil {
 		return
 	} else if len(values) == 0 {
 		err = utils.ErrDSPHostNotFound
@@ -1123,6 +1272,90 @@ func (rs *RedisStorage) RemoveDispatcherHostDrv(tenant, id string) (err error) {
 	return rs.Cmd(nil, redis_DEL, utils.DispatcherHostPrefix+utils.ConcatenatedKey(tenant, id))
 }
 
+func (rs *RedisStorage) GetExchangeRateDrv(tenant, id string) (er *ExchangeRate, err error) {
+	var values []byte
+	if err = rs.readCmd(&values, redis_GET, utils.ExchangeRatePrefix+utils.ConcatenatedKey(tenant, id)); err != nil {
+		return
+	} else if len(values) == 0 {
+		err = utils.ErrNotFound
+		return
+	}
+	err = rs.ms.Unmarshal(values, &er)
+	return
+}
+
+func (rs *RedisStorage) SetExchangeRateDrv(er *ExchangeRate) (err error) {
+	var result []byte
+	if result, err = rs.ms.Marshal(er); err != nil {
+		return
+	}
+	return rs.Cmd(nil, redis_SET, utils.ExchangeRatePrefix+er.TenantID(), string(result))
+}
+
+func (rs *RedisStorage) RemoveExchangeRateDrv(tenant, id string) (err error) {
+	return rs.Cmd(nil, redis_DEL, utils.ExchangeRatePrefix+utils.ConcatenatedKey(tenant, id))
+}
+
+func (rs *RedisStorage) GetCalendarDrv(tenant, id string) (cal *Calendar, err error) {
+	var values []byte
+	if err = rs.readCmd(&values, redis_GET, utils.CalendarPrefix+utils.ConcatenatedKey(tenant, id)); err != nil {
+		return
+	} else if len(values) == 0 {
+		err = utils.ErrNotFound
+		return
+	}
+	err = rs.ms.Unmarshal(values, &cal)
+	return
+}
+
+func (rs *RedisStorage) SetCalendarDrv(cal *Calendar) (err error) {
+	var result []byte
+	if result, err = rs.ms.Marshal(cal); err != nil {
+		return
+	}
+	return rs.Cmd(nil, redis_SET, utils.CalendarPrefix+cal.TenantID(), string(result))
+}
+
+func (rs *RedisStorage) RemoveCalendarDrv(tenant, id string) (err error) {
+	return rs.Cmd(nil, redis_DEL, utils.CalendarPrefix+utils.ConcatenatedKey(tenant, id))
+}
+
+func (rs *RedisStorage) GetSessionsBackupDrv(nodeID string) (sss []*StoredSession, err error) {
+	var keys []string
+	if keys, err = rs.GetKeysForPrefix(utils.SessionsBackupPrefix + nodeID + utils.ConcatenatedKeySep); err != nil {
+		return
+	}
+	for _, key := range keys {
+		var values []byte
+		if err = rs.readCmd(&values, redis_GET, key); err != nil {
+			return
+		} else if len(values) == 0 { // removed in the meantime
+			continue
+		}
+		var ss *StoredSession
+		if err = rs.ms.Unmarshal(values, &ss); err != nil {
+			return
+		}
+		sss = append(sss, ss)
+	}
+	if len(sss) == 0 {
+		return nil, utils.ErrNotFound
+	}
+	return
+}
+
+func (rs *RedisStorage) SetSessionBackupDrv(ss *StoredSession) (err error) {
+	var result []byte
+	if result, err = rs.ms.Marshal(ss); err != nil {
+		return
+	}
+	return rs.Cmd(nil, redis_SET, utils.SessionsBackupPrefix+ss.BackupID(), string(result))
+}
+
+func (rs *RedisStorage) RemoveSessionBackupDrv(nodeID, cgrID string) (err error) {
+	return rs.Cmd(nil, redis_DEL, utils.SessionsBackupPrefix+utils.ConcatenatedKey(nodeID, cgrID))
+}
+
 func (rs *RedisStorage) GetStorageType() string {
 	return utils.MetaRedis
 }
@@ -1131,7 +1364,7 @@ func (rs *RedisStorage) GetItemLoadIDsDrv(itemIDPrefix string) (loadIDs map[stri
 	if itemIDPrefix != "" {
 		var fldVal int64
 		mn := radix.MaybeNil{Rcv: &fldVal}
-		if err = rs.Cmd(&mn, redis_HGET, utils.LoadIDs, itemIDPrefix); err != nil {
+		if err = rs.readCmd(&mn, redis_HGET, utils.LoadIDs, itemIDPrefix); err != nil {
 			return
 		} else if mn.Nil {
 			err = utils.ErrNotFound
@@ -1140,7 +1373,7 @@ func (rs *RedisStorage) GetItemLoadIDsDrv(itemIDPrefix string) (loadIDs map[stri
 		return map[string]int64{itemIDPrefix: fldVal}, nil
 	}
 	mpLoadIDs := make(map[string]string)
-	if err = rs.Cmd(&mpLoadIDs, redis_HGETALL, utils.LoadIDs); err != nil {
+	if err = rs.readCmd(&mpLoadIDs, redis_HGETALL, utils.LoadIDs); err != nil {
 		return
 	}
 	if len(mpLoadIDs) == 0 {
@@ -1164,6 +1397,7 @@ func (rs *RedisStorage) RemoveLoadIDsDrv() (err error) {
 }
 
 // GetIndexesDrv retrieves Indexes from dataDB
+// always from primary since the indexes are updated based on their previous value
 func (rs *RedisStorage) GetIndexesDrv(idxItmType, tntCtx, idxKey string) (indexes map[string]utils.StringSet, err error) {
 	mp := make(map[string]string)
 	dbKey := utils.CacheInstanceToPrefix[idxItmType] + tntCtx
@@ -0,0 +1,143 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+package engine
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cgrates/cgrates/utils"
+	"github.com/mediocregopher/radix/v3"
+)
+
+type mockRedisClient struct {
+	calls int
+	err   error
+}
+
+func (c *mockRedisClient) Do(radix.Action) error {
+	c.calls++
+	return c.err
+}
+
+func (c *mockRedisClient) Close() error { return nil }
+
+func TestRedisStorageReadCmd(t *testing.T) {
+	primary := new(mockRedisClient)
+	replica := new(mockRedisClient)
+	rs := &RedisStorage{
+		client:   primary,
+		replicas: &redisReplicas{clients: []radix.Client{replica}},
+	}
+	var values []byte
+
+	rs.readPolicy = utils.MetaPrimary
+	if err := rs.readCmd(&values, redis_GET, "key"); err != nil {
+		t.Error(err)
+	} else if primary.calls != 1 || replica.calls != 0 {
+		t.Errorf("unexpected calls: primary %d, replica %d", primary.calls, replica.calls)
+	}
+
+	rs.readPolicy = utils.MetaReplica
+	if err := rs.readCmd(&values, redis_GET, "key"); err != nil {
+		t.Error(err)
+	} else if primary.calls != 1 || replica.calls != 1 {
+		t.Errorf("unexpected calls: primary %d, replica %d", primary.calls, replica.calls)
+	}
+	replica.err = errors.New("replica down")
+	if err := rs.readCmd(&values, redis_GET, "key"); err == nil || err.Error() != "replica down" {
+		t.Errorf("Expected error <replica down>, received <%v>", err)
+	}
+
+	// the replica error and the missing data are both retried on primary
+	rs.readPolicy = utils.MetaReplicaPreferred
+	if err := rs.readCmd(&values, redis_GET, "key"); err != nil {
+		t.Error(err)
+	} else if primary.calls != 2 || replica.calls != 3 {
+		t.Errorf("unexpected calls: primary %d, replica %d", primary.calls, replica.calls)
+	}
+	replica.err = nil
+	if err := rs.readCmd(&values, redis_GET, "key"); err != nil {
+		t.Error(err)
+	} else if primary.calls != 3 || replica.calls != 4 {
+		t.Errorf("unexpected calls: primary %d, replica %d", primary.calls, replica.calls)
+	}
+	values = []byte("value")
+	if err := rs.readCmd(&values, redis_GET, "key"); err != nil {
+		t.Error(err)
+	} else if primary.calls != 3 || replica.calls != 5 {
+		t.Errorf("unexpected calls: primary %d, replica %d", primary.calls, replica.calls)
+	}
+}
+
+func TestRedisStorageGetIndexesDrvPrimary(t *testing.T) {
+	primary := new(mockRedisClient)
+	replica := new(mockRedisClient)
+	rs := &RedisStorage{
+		client:     primary,
+		replicas:   &redisReplicas{clients: []radix.Client{replica}},
+		readPolicy: utils.MetaReplica,
+	}
+	// the indexes are updated based on the read value so a stale replica would drop the new entries
+	if _, err := rs.GetIndexesDrv(utils.CacheAttributeFilterIndexes, "cgrates.org:*any", utils.EmptyString); err != utils.ErrNotFound {
+		t.Errorf("Expected error <%v>, received <%v>", utils.ErrNotFound, err)
+	} else if primary.calls != 1 || replica.calls != 0 {
+		t.Errorf("unexpected calls: primary %d, replica %d", primary.calls, replica.calls)
+	}
+}
+
+func TestRedisReplicasDo(t *testing.T) {
+	rpl1 := &mockRedisClient{err: errors.New("replica down")}
+	rpl2 := new(mockRedisClient)
+	r := &redisReplicas{clients: []radix.Client{rpl1, rpl2}}
+	for i := 0; i < 4; i++ {
+		if err := r.Do(radix.Cmd(nil, redis_GET, "key")); err != nil {
+			t.Error(err)
+		}
+	}
+	if rpl1.calls != 2 || rpl2.calls != 4 {
+		t.Errorf("unexpected calls: replica1 %d, replica2 %d", rpl1.calls, rpl2.calls)
+	}
+	rpl2.err = errors.New("replica down")
+	if err := r.Do(radix.Cmd(nil, redis_GET, "key")); err == nil || err.Error() != "replica down" {
+		t.Errorf("Expected error <replica down>, received <%v>", err)
+	}
+}
+
+func TestIsEmptyRedisReply(t *testing.T) {
+	var values []byte
+	var mp map[string]string
+	var str string
+	var nr int
+	if isEmptyRedisReply(nil) {
+		t.Error("Expected nil receiver to not be considered empty")
+	}
+	for _, rcv := range []any{&values, &mp, &str, &nr,
+		&[]string{utils.EmptyString}} {
+		if !isEmptyRedisReply(rcv) {
+			t.Errorf("Expected %T to be empty", rcv)
+		}
+	}
+	values, mp, str, nr = []byte("value"), map[string]string{"key": "value"}, "value", 1
+	for _, rcv := range []any{&values, &mp, &str, &nr,
+		&[]string{utils.EmptyString, "value"}} {
+		if isEmptyRedisReply(rcv) {
+			t.Errorf("Expected %T to not be empty", rcv)
+		}
+	}
+}
@@ -104,8 +104,8 @@ func (sqls *SQLStorage) IsDBEmpty() (resp bool, err error) {
 		utils.TBLTPSharedGroups, utils.TBLTPActions, utils.TBLTPActionTriggers,
 		utils.TBLTPAccountActions, utils.TBLTPResources, utils.TBLTPStats, utils.TBLTPThresholds,
 		utils.TBLTPFilters, utils.SessionCostsTBL, utils.CDRsTBL, utils.TBLTPActionPlans,
-		utils.TBLVersions, utils.TBLTPRoutes, utils.TBLTPAttributes, utils.TBLTPChargers,
-		utils.TBLTPDispatchers, utils.TBLTPDispatcherHosts,
+		utils.TBLVersions, utils.TBLTPRoutes, utils.TBLTPAttributes, utils.TBLTPChargers, utils.TBLTPTaxProfiles,
+		utils.TBLTPDispatchers, utils.TBLTPDispatcherHosts, utils.BalanceLedgerTBL, utils.InvoicesTBL,
 	}
 	for _, tbl := range tbls {
 		if sqls.db.Migrator().HasTable(tbl) {
@@ -124,7 +124,7 @@ func (sqls *SQLStorage) GetTpIds(colName string) ([]string, error) {
 	qryStr := fmt.Sprintf(" (SELECT tpid FROM %s)", colName)
 	if colName == "" {
 		qryStr = fmt.Sprintf(
-			"(SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s)",
+			"(SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s)",
 			utils.TBLTPTimings,
 			utils.TBLTPDestinations,
 			utils.TBLTPRates,
@@ -143,6 +143,7 @@ func (sqls *SQLStorage) GetTpIds(colName string) ([]string, error) {
 			utils.TBLTPRoutes,
 			utils.TBLTPAttributes,
 			utils.TBLTPChargers,
+			utils.TBLTPTaxProfiles,
 			utils.TBLTPDispatchers,
 			utils.TBLTPDispatcherHosts,
 		)
@@ -241,7 +242,7 @@ func (sqls *SQLStorage) RemTpData(table, tpid string, args map[string]string) er
 			utils.TBLTPSharedGroups, utils.TBLTPActions, utils.TBLTPActionTriggers,
 			utils.TBLTPAccountActions, utils.TBLTPResources, utils.TBLTPStats, utils.TBLTPThresholds,
 			utils.TBLTPFilters, utils.TBLTPActionPlans, utils.TBLTPRoutes, utils.TBLTPAttributes,
-			utils.TBLTPChargers, utils.TBLTPDispatchers, utils.TBLTPDispatcherHosts} {
+			utils.TBLTPChargers, utils.TBLTPTaxProfiles, utils.TBLTPDispatchers, utils.TBLTPDispatcherHosts} {
 			if err := tx.Table(tblName).Where("tpid = ?", tpid).Delete(nil).Error; err != nil {
 				tx.Rollback()
 				return err
@@ -686,6 +687,28 @@ func (sqls *SQLStorage) SetTPChargers(tpCPPs []*utils.TPChargerProfile) error {
 	return nil
 }
 
+func (sqls *SQLStorage) SetTPTaxProfiles(tpTxps []*utils.TPTaxProfile) error {
+	if len(tpTxps) == 0 {
+		return nil
+	}
+	tx := sqls.db.Begin()
+	for _, txp := range tpTxps {
+		// Remove previous
+		if err := tx.Where(&TaxProfileMdl{Tpid: txp.TPid, ID: txp.ID}).Delete(TaxProfileMdl{}).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+		for _, mst := range APItoModelTPTaxProfile(txp) {
+			if err := tx.Create(&mst).Error; err != nil {
+				tx.Rollback()
+				return err
+			}
+		}
+	}
+	tx.Commit()
+	return nil
+}
+
 func (sqls *SQLStorage) SetTPDispatcherProfiles(tpDPPs []*utils.TPDispatcherProfile) error {
 	if len(tpDPPs) == 0 {
 		return nil
@@ -872,6 +895,177 @@ func (sqls *SQLStorage) GetSMCosts(cgrid, runid, originHost, originIDPrefix stri
 	return smCosts, nil
 }
 
+// SetBalanceLedgerEntries appends the entries to the ledger
+func (sqls *SQLStorage) SetBalanceLedgerEntries(entries []*BalanceLedgerEntry) error {
+	tx := sqls.db.Begin()
+	for _, le := range entries {
+		if err := tx.Create(&BalanceLedgerSQL{
+			Tenant:      le.Tenant,
+			Account:     le.Account,
+			BalanceType: le.BalanceType,
+			BalanceID:   le.BalanceID,
+			BalanceUUID: le.BalanceUUID,
+			OldValue:    le.OldValue,
+			NewValue:    le.NewValue,
+			Delta:       le.Delta,
+			Cause:       le.Cause,
+			CauseID:     le.CauseID,
+			Time:        le.Time,
+		}).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	tx.Commit()
+	return nil
+}
+
+// GetBalanceLedgerEntries returns the entries matching the filter, ordered by time
+func (sqls *SQLStorage) GetBalanceLedgerEntries(fltr *BalanceLedgerFilter) (entries []*BalanceLedgerEntry, err error) {
+	q := sqls.db.Table(utils.BalanceLedgerTBL).Select("*")
+	for _, cond := range []struct {
+		col  string
+		vals []string
+	}{
+		{"tenant", fltr.Tenants},
+		{"account", fltr.Accounts},
+		{"balance_type", fltr.BalanceTypes},
+		{"balance_id", fltr.BalanceIDs},
+		{"cause", fltr.Causes},
+		{"cause_id", fltr.CauseIDs},
+	} {
+		if len(cond.vals) != 0 {
+			q = q.Where(cond.col+" in (?)", cond.vals)
+		}
+	}
+	if fltr.TimeStart != nil {
+		q = q.Where("time >= ?", fltr.TimeStart)
+	}
+	if fltr.TimeEnd != nil {
+		q = q.Where("time < ?", fltr.TimeEnd)
+	}
+	q = q.Order("time, id")
+	if fltr.Limit != nil {
+		q = q.Limit(*fltr.Limit)
+	}
+	if fltr.Offset != nil {
+		q = q.Offset(*fltr.Offset)
+	}
+	var results []*BalanceLedgerSQL
+	if err = q.Find(&results).Error; err != nil {
+		return
+	}
+	if len(results) == 0 {
+		return nil, utils.ErrNotFound
+	}
+	entries = make([]*BalanceLedgerEntry, len(results))
+	for i, result := range results {
+		entries[i] = &BalanceLedgerEntry{
+			Tenant:      result.Tenant,
+			Account:     result.Account,
+			BalanceType: result.BalanceType,
+			BalanceID:   result.BalanceID,
+			BalanceUUID: result.BalanceUUID,
+			OldValue:    result.OldValue,
+			NewValue:    result.NewValue,
+			Delta:       result.Delta,
+			Cause:       result.Cause,
+			CauseID:     result.CauseID,
+			Time:        result.Time,
+		}
+	}
+	return
+}
+
+// SetInvoice stores the invoice, replacing the one with the same ID
+func (sqls *SQLStorage) SetInvoice(inv *Invoice) error {
+	lis, err := json.Marshal(inv.LineItems)
+	if err != nil {
+		return err
+	}
+	tx := sqls.db.Begin()
+	if err = tx.Where("invoice_id = ?", inv.ID).Delete(InvoiceSQL{}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err = tx.Create(&InvoiceSQL{
+		InvoiceID:   inv.ID,
+		Tenant:      inv.Tenant,
+		Account:     inv.Account,
+		PeriodStart: inv.PeriodStart,
+		PeriodEnd:   inv.PeriodEnd,
+		Currency:    inv.Currency,
+		LineItems:   string(lis),
+		Subtotal:    inv.Subtotal,
+		Discount:    inv.Discount,
+		Tax:         inv.Tax,
+		Total:       inv.Total,
+		CreatedAt:   inv.CreatedAt,
+	}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+	tx.Commit()
+	return nil
+}
+
+// GetInvoices returns the invoices matching the filter, ordered by the start of the period
+func (sqls *SQLStorage) GetInvoices(fltr *InvoiceFilter) (invs []*Invoice, err error) {
+	q := sqls.db.Table(utils.InvoicesTBL).Select("*")
+	for _, cond := range []struct {
+		col  string
+		vals []string
+	}{
+		{"invoice_id", fltr.IDs},
+		{"tenant", fltr.Tenants},
+		{"account", fltr.Accounts},
+	} {
+		if len(cond.vals) != 0 {
+			q = q.Where(cond.col+" in (?)", cond.vals)
+		}
+	}
+	if fltr.PeriodStart != nil {
+		q = q.Where("period_start >= ?", fltr.PeriodStart)
+	}
+	if fltr.PeriodEnd != nil {
+		q = q.Where("period_end <= ?", fltr.PeriodEnd)
+	}
+	q = q.Order("period_start, invoice_id")
+	if fltr.Limit != nil {
+		q = q.Limit(*fltr.Limit)
+	}
+	if fltr.Offset != nil {
+		q = q.Offset(*fltr.Offset)
+	}
+	var results []*InvoiceSQL
+	if err = q.Find(&results).Error; err != nil {
+		return
+	}
+	if len(results) == 0 {
+		return nil, utils.ErrNotFound
+	}
+	invs = make([]*Invoice, len(results))
+	for i, result := range results {
+		invs[i] = &Invoice{
+			ID:          result.InvoiceID,
+			Tenant:      result.Tenant,
+			Account:     result.Account,
+			PeriodStart: result.PeriodStart,
+			PeriodEnd:   result.PeriodEnd,
+			Currency:    result.Currency,
+			Subtotal:    result.Subtotal,
+			Discount:    result.Discount,
+			Tax:         result.Tax,
+			Total:       result.Total,
+			CreatedAt:   result.CreatedAt,
+		}
+		if err = json.Unmarshal([]byte(result.LineItems), &invs[i].LineItems); err != nil {
+			return nil, err
+		}
+	}
+	return
+}
+
 func (sqls *SQLStorage) SetCDR(cdr *CDR, allowUpdate bool) error {
 	tx := sqls.db.Begin()
 	cdrSQL := cdr.AsCDRsql()
@@ -1513,6 +1707,25 @@ func (sqls *SQLStorage) GetTPChargers(tpid, tenant, id string) ([]*utils.TPCharg
 	return arls, nil
 }
 
+func (sqls *SQLStorage) GetTPTaxProfiles(tpid, tenant, id string) ([]*utils.TPTaxProfile, error) {
+	var txps TaxProfileMdls
+	q := sqls.db.Where("tpid = ?", tpid).Order("pk") // keep the order of the components
+	if len(id) != 0 {
+		q = q.Where("id = ?", id)
+	}
+	if len(tenant) != 0 {
+		q = q.Where("tenant = ?", tenant)
+	}
+	if err := q.Find(&txps).Error; err != nil {
+		return nil, err
+	}
+	arls := txps.AsTPTaxProfiles()
+	if len(arls) == 0 {
+		return arls, utils.ErrNotFound
+	}
+	return arls, nil
+}
+
 func (sqls *SQLStorage) GetTPDispatcherProfiles(tpid, tenant, id string) ([]*utils.TPDispatcherProfile, error) {
 	var dpps DispatcherProfileMdls
 	q := sqls.db.Where("tpid = ?", tpid)
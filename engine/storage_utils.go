@@ -51,11 +51,19 @@ func NewDataDBConn(dbType, host, port, name, user,
 		d, err = NewRedisStorage(host, dbNo, user, pass, marshaler, opts.RedisMaxConns, opts.RedisConnectAttempts,
 			opts.RedisSentinel, opts.RedisCluster, opts.RedisClusterSync, opts.RedisClusterOndownDelay,
 			opts.RedisConnectTimeout, opts.RedisReadTimeout, opts.RedisWriteTimeout, opts.RedisTLS,
-			opts.RedisClientCertificate, opts.RedisClientKey, opts.RedisCACertificate)
+			opts.RedisClientCertificate, opts.RedisClientKey, opts.RedisCACertificate,
+			opts.RedisReadPolicy, opts.RedisReadReplicas)
 	case utils.MetaMongo:
 		d, err = NewMongoStorage(host, port, name, user, pass, marshaler, utils.DataDB, nil, opts.MongoQueryTimeout)
 	case utils.MetaInternal:
-		d = NewInternalDB(nil, nil, true, itmsCfg)
+		iDB := NewInternalDB(nil, nil, true, itmsCfg)
+		if opts.InternalDBDumpPath != utils.EmptyString {
+			if err = iDB.StartDumper(opts.InternalDBDumpPath,
+				opts.InternalDBDumpInterval, opts.InternalDBWriteLog); err != nil {
+				return
+			}
+		}
+		d = iDB
 	default:
 		err = fmt.Errorf("unsupported db_type <%s>", dbType)
 	}
@@ -76,7 +84,14 @@ func NewStorDBConn(dbType, host, port, name, user, pass, marshaler string,
 		db, err = NewMySQLStorage(host, port, name, user, pass, opts.SQLMaxOpenConns, opts.SQLMaxIdleConns,
 			opts.SQLConnMaxLifetime, opts.MySQLLocation, opts.MySQLDSNParams)
 	case utils.MetaInternal:
-		db = NewInternalDB(stringIndexedFields, prefixIndexedFields, false, itmsCfg)
+		iDB := NewInternalDB(stringIndexedFields, prefixIndexedFields, false, itmsCfg)
+		if opts.InternalDBDumpPath != utils.EmptyString {
+			if err = iDB.StartDumper(opts.InternalDBDumpPath,
+				opts.InternalDBDumpInterval, opts.InternalDBWriteLog); err != nil {
+				return
+			}
+		}
+		db = iDB
 	default:
 		err = fmt.Errorf("unknown db '%s' valid options are [%s, %s, %s, %s]",
 			dbType, utils.MetaMySQL, utils.MetaMongo, utils.MetaPostgres, utils.MetaInternal)
@@ -0,0 +1,212 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// TaxProfile defines the taxes applied on the cost of the matching events
+type TaxProfile struct {
+	Tenant             string
+	ID                 string
+	FilterIDs          []string
+	ActivationInterval *utils.ActivationInterval // Activation interval
+	Weight             float64
+	Components         []*TaxComponent // applied in order
+}
+
+// TaxComponent is one tax of the TaxProfile
+type TaxComponent struct {
+	ID           string
+	Jurisdiction string  // the authority collecting the tax, ie: country or state
+	Type         string  // <*percent|*flat>
+	Value        float64 // the percentage out of the cost or the flat amount
+	Compound     bool    // the percentage is computed on the cost including the taxes applied before
+}
+
+// TaxProfileWithAPIOpts is used in the APIs and replicatorV1 for the tax profiles
+type TaxProfileWithAPIOpts struct {
+	*TaxProfile
+	APIOpts map[string]any
+}
+
+// TenantID returns the concatenated key beteen tenant and ID
+func (tp *TaxProfile) TenantID() string {
+	return utils.ConcatenatedKey(tp.Tenant, tp.ID)
+}
+
+// Validate checks the tax components before storing the profile
+func (tp *TaxProfile) Validate() error {
+	for _, tc := range tp.Components {
+		if tc.ID == utils.EmptyString {
+			return utils.NewErrMandatoryIeMissing(utils.ComponentID)
+		}
+		if tc.Type != utils.MetaPercent && tc.Type != utils.MetaFlat {
+			return fmt.Errorf("unsupported type <%s> for tax component <%s>", tc.Type, tc.ID)
+		}
+	}
+	return nil
+}
+
+// TaxProfiles is a sortable list of TaxProfiles
+type TaxProfiles []*TaxProfile
+
+// Sort is part of sort interface, sort based on Weight and on ID for the
+// equal weights so the compounding components are applied in a stable order
+func (tps TaxProfiles) Sort() {
+	sort.SliceStable(tps, func(i, j int) bool {
+		if tps[i].Weight == tps[j].Weight {
+			return tps[i].ID < tps[j].ID
+		}
+		return tps[i].Weight > tps[j].Weight
+	})
+}
+
+// computeTaxes applies the components of the profiles, in order, on the cost
+func (tps TaxProfiles) computeTaxes(cost float64) (taxes ECTaxes) {
+	var taxed float64 // the taxes applied so far, used by the compounding components
+	for _, tp := range tps {
+		for _, tc := range tp.Components {
+			amount := tc.Value
+			if tc.Type == utils.MetaPercent {
+				base := cost
+				if tc.Compound {
+					base += taxed
+				}
+				amount = base * tc.Value / 100
+			}
+			amount = utils.Round(amount, globalRoundingDecimals, utils.MetaRoundingMiddle)
+			taxed += amount
+			taxes = append(taxes, &ECTax{
+				TaxProfileID: tp.ID,
+				ComponentID:  tc.ID,
+				Jurisdiction: tc.Jurisdiction,
+				Amount:       amount,
+			})
+		}
+	}
+	return
+}
+
+// matchingTaxProfilesForEvent returns the ordered list of TaxProfiles matching the event, active at the given time
+func matchingTaxProfilesForEvent(dm *DataManager, filterS *FilterS, tCfg *config.TaxSCfg,
+	tnt string, evNm utils.MapStorage, atTime time.Time) (tps TaxProfiles, err error) {
+	var tpIDs utils.StringSet
+	if tpIDs, err = MatchingItemIDsForEvent(evNm,
+		tCfg.StringIndexedFields,
+		tCfg.PrefixIndexedFields,
+		tCfg.SuffixIndexedFields,
+		dm, utils.CacheTaxFilterIndexes, tnt,
+		tCfg.IndexedSelects,
+		tCfg.NestedFields,
+	); err != nil {
+		return
+	}
+	for tpID := range tpIDs {
+		var tp *TaxProfile
+		if tp, err = dm.GetTaxProfile(tnt, tpID, true, true, utils.NonTransactional); err != nil {
+			if err == utils.ErrNotFound {
+				err = nil
+				continue
+			}
+			return nil, err
+		}
+		if tp.ActivationInterval != nil && !atTime.IsZero() &&
+			!tp.ActivationInterval.IsActiveAtTime(atTime) { // not active
+			continue
+		}
+		var pass bool
+		if pass, err = filterS.Pass(tnt, tp.FilterIDs, evNm); err != nil {
+			return nil, err
+		} else if !pass {
+			continue
+		}
+		tps = append(tps, tp)
+	}
+	if len(tps) == 0 {
+		return nil, utils.ErrNotFound
+	}
+	tps.Sort()
+	return
+}
+
+// ECTax is a tax applied on the cost of the event
+type ECTax struct {
+	TaxProfileID string
+	ComponentID  string
+	Jurisdiction string
+	Amount       float64
+}
+
+// Clone returns a copy of the ECTax
+func (ect *ECTax) Clone() *ECTax {
+	if ect == nil {
+		return nil
+	}
+	cln := *ect
+	return &cln
+}
+
+// FieldAsInterface func to help EventCost FieldAsInterface
+func (ect *ECTax) FieldAsInterface(fldPath []string) (val any, err error) {
+	if ect == nil || len(fldPath) != 1 {
+		return nil, utils.ErrNotFound
+	}
+	switch fldPath[0] {
+	default:
+		return nil, fmt.Errorf("unsupported field prefix: <%s>", fldPath[0])
+	case utils.TaxProfileID:
+		return ect.TaxProfileID, nil
+	case utils.ComponentID:
+		return ect.ComponentID, nil
+	case utils.Jurisdiction:
+		return ect.Jurisdiction, nil
+	case utils.Amount:
+		return ect.Amount, nil
+	}
+}
+
+// ECTaxes are the taxes applied on the cost of the event
+type ECTaxes []*ECTax
+
+// Clone returns a copy of the ECTaxes
+func (ects ECTaxes) Clone() (cln ECTaxes) {
+	if ects == nil {
+		return
+	}
+	cln = make(ECTaxes, len(ects))
+	for i, ect := range ects {
+		cln[i] = ect.Clone()
+	}
+	return
+}
+
+// Total returns the sum of the taxes
+func (ects ECTaxes) Total() (total float64) {
+	for _, ect := range ects {
+		total += ect.Amount
+	}
+	return utils.Round(total, globalRoundingDecimals, utils.MetaRoundingMiddle)
+}
@@ -0,0 +1,205 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestTaxProfileValidate(t *testing.T) {
+	tp := &TaxProfile{
+		Tenant: "cgrates.org",
+		ID:     "TAX_DE",
+		Components: []*TaxComponent{
+			{ID: "VAT", Type: utils.MetaPercent, Value: 19},
+			{ID: "FEE", Type: utils.MetaFlat, Value: 0.1},
+		},
+	}
+	if err := tp.Validate(); err != nil {
+		t.Error(err)
+	}
+	if tp.TenantID() != "cgrates.org:TAX_DE" {
+		t.Errorf("Unexpected TenantID: %s", tp.TenantID())
+	}
+	tp.Components[1].Type = "*unknown"
+	if err := tp.Validate(); err == nil {
+		t.Error("Expected error for the unsupported type")
+	}
+	tp.Components[1].ID = utils.EmptyString
+	if err := tp.Validate(); err == nil || err.Error() != utils.NewErrMandatoryIeMissing(utils.ComponentID).Error() {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestTaxProfilesComputeTaxes(t *testing.T) {
+	tps := TaxProfiles{
+		{ID: "TAX_STATE", Weight: 10, Components: []*TaxComponent{
+			{ID: "STATE", Jurisdiction: "US-NY", Type: utils.MetaPercent, Value: 10, Compound: true},
+		}},
+		{ID: "TAX_FED", Weight: 20, Components: []*TaxComponent{
+			{ID: "FED", Jurisdiction: "US", Type: utils.MetaPercent, Value: 5},
+			{ID: "FEE", Jurisdiction: "US", Type: utils.MetaFlat, Value: 0.5},
+		}},
+	}
+	tps.Sort()
+	exp := ECTaxes{
+		{TaxProfileID: "TAX_FED", ComponentID: "FED", Jurisdiction: "US", Amount: 0.5},
+		{TaxProfileID: "TAX_FED", ComponentID: "FEE", Jurisdiction: "US", Amount: 0.5},
+		{TaxProfileID: "TAX_STATE", ComponentID: "STATE", Jurisdiction: "US-NY", Amount: 1.1}, // 10% out of 10+0.5+0.5
+	}
+	taxes := tps.computeTaxes(10)
+	if !reflect.DeepEqual(exp, taxes) {
+		t.Errorf("Expected %s, received %s", utils.ToJSON(exp), utils.ToJSON(taxes))
+	}
+	if total := taxes.Total(); total != 2.1 {
+		t.Errorf("Expected total 2.1, received %v", total)
+	}
+	if cln := taxes.Clone(); !reflect.DeepEqual(taxes, cln) {
+		t.Errorf("Expected %s, received %s", utils.ToJSON(taxes), utils.ToJSON(cln))
+	}
+	// equal weights are ordered on ID
+	tps = TaxProfiles{{ID: "TAX_B", Weight: 10}, {ID: "TAX_C", Weight: 10}, {ID: "TAX_A", Weight: 10}, {ID: "TAX_D", Weight: 20}}
+	tps.Sort()
+	for i, id := range []string{"TAX_D", "TAX_A", "TAX_B", "TAX_C"} {
+		if tps[i].ID != id {
+			t.Errorf("Expected %s on position %d, received %s", id, i, tps[i].ID)
+		}
+	}
+}
+
+func TestCDRsApplyTaxes(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	dataDB := NewInternalDB(nil, nil, true, cfg.DataDbCfg().Items)
+	dmTx := NewDataManager(dataDB, cfg.CacheCfg(), nil)
+	cdrS := &CDRServer{
+		cgrCfg:  cfg,
+		dm:      dmTx,
+		filterS: NewFilterS(cfg, nil, dmTx),
+	}
+	if err := dmTx.SetTaxProfile(&TaxProfile{
+		Tenant:    "cgrates.org",
+		ID:        "TAX_DE",
+		FilterIDs: []string{"*string:~*req.Account:1001"},
+		ActivationInterval: &utils.ActivationInterval{
+			ActivationTime: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		Components: []*TaxComponent{
+			{ID: "VAT", Jurisdiction: "DE", Type: utils.MetaPercent, Value: 19},
+		},
+	}, true); err != nil {
+		t.Fatal(err)
+	}
+	cdr := &CDR{
+		Tenant:      "cgrates.org",
+		Account:     "1001",
+		AnswerTime:  time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		Cost:        2,
+		CostDetails: &EventCost{},
+	}
+	if err := cdrS.applyTaxes(cdr, nil); err != nil {
+		t.Fatal(err)
+	}
+	exp := ECTaxes{{TaxProfileID: "TAX_DE", ComponentID: "VAT", Jurisdiction: "DE", Amount: 0.38}}
+	if !reflect.DeepEqual(exp, cdr.CostDetails.Taxes) {
+		t.Errorf("Expected %s, received %s", utils.ToJSON(exp), utils.ToJSON(cdr.CostDetails.Taxes))
+	}
+	if tax, err := cdr.CostDetails.FieldAsInterface([]string{utils.Tax}); err != nil {
+		t.Error(err)
+	} else if tax != 0.38 {
+		t.Errorf("Expected 0.38, received %v", tax)
+	}
+	if jur, err := cdr.CostDetails.FieldAsInterface([]string{"Taxes[0]", utils.Jurisdiction}); err != nil {
+		t.Error(err)
+	} else if jur != "DE" {
+		t.Errorf("Expected DE, received %v", jur)
+	}
+
+	cdr.Account = "1002" // not matching anymore, the taxes are removed
+	if err := cdrS.applyTaxes(cdr, nil); err != nil {
+		t.Fatal(err)
+	}
+	if cdr.CostDetails.Taxes != nil {
+		t.Errorf("Expected no taxes, received %s", utils.ToJSON(cdr.CostDetails.Taxes))
+	}
+
+	cdr.Account = "1001"
+	cdr.AnswerTime = time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC) // before the activation
+	if err := cdrS.applyTaxes(cdr, nil); err != nil {
+		t.Fatal(err)
+	}
+	if cdr.CostDetails.Taxes != nil {
+		t.Errorf("Expected no taxes, received %s", utils.ToJSON(cdr.CostDetails.Taxes))
+	}
+
+	if err := dmTx.RemoveTaxProfile("cgrates.org", "TAX_DE", true); err != nil {
+		t.Error(err)
+	}
+	if _, err := dmTx.GetTaxProfile("cgrates.org", "TAX_DE", false, false, utils.NonTransactional); err != utils.ErrNotFound {
+		t.Errorf("Expected %v, received %v", utils.ErrNotFound, err)
+	}
+}
+
+func TestCDRsApplyTaxesIndexCfg(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	cfg.TaxSCfg().StringIndexedFields = &[]string{"*req.Subject"}
+	dataDB := NewInternalDB(nil, nil, true, cfg.DataDbCfg().Items)
+	dmTx := NewDataManager(dataDB, cfg.CacheCfg(), nil)
+	cdrS := &CDRServer{
+		cgrCfg:  cfg,
+		dm:      dmTx,
+		filterS: NewFilterS(cfg, nil, dmTx),
+	}
+	if err := dmTx.SetTaxProfile(&TaxProfile{
+		Tenant:    "cgrates.org",
+		ID:        "TAX_DE",
+		FilterIDs: []string{"*string:~*req.Account:1001"},
+		Components: []*TaxComponent{
+			{ID: "VAT", Jurisdiction: "DE", Type: utils.MetaPercent, Value: 19},
+		},
+	}, true); err != nil {
+		t.Fatal(err)
+	}
+	cdr := &CDR{
+		Tenant:      "cgrates.org",
+		Account:     "1001",
+		Cost:        2,
+		CostDetails: &EventCost{},
+	}
+	// the Account is not queried on indexes
+	if err := cdrS.applyTaxes(cdr, nil); err != nil {
+		t.Fatal(err)
+	}
+	if cdr.CostDetails.Taxes != nil {
+		t.Errorf("Expected no taxes, received %s", utils.ToJSON(cdr.CostDetails.Taxes))
+	}
+
+	cfg.TaxSCfg().IndexedSelects = false
+	if err := cdrS.applyTaxes(cdr, nil); err != nil {
+		t.Fatal(err)
+	}
+	exp := ECTaxes{{TaxProfileID: "TAX_DE", ComponentID: "VAT", Jurisdiction: "DE", Amount: 0.38}}
+	if !reflect.DeepEqual(exp, cdr.CostDetails.Taxes) {
+		t.Errorf("Expected %s, received %s", utils.ToJSON(exp), utils.ToJSON(cdr.CostDetails.Taxes))
+	}
+}
@@ -84,6 +84,8 @@ type MonetaryInfo struct {
 	ID           string
 	Value        float64
 	RateInterval *RateInterval
+	Currency     string  `json:",omitempty"` // the currency of the balance if other than the rating one
+	ExchangeRate float64 `json:",omitempty"` // converting the cost into the currency of the balance
 }
 
 func (mi *MonetaryInfo) Clone() *MonetaryInfo {
@@ -99,6 +101,8 @@ func (mi *MonetaryInfo) Equal(other *MonetaryInfo) bool {
 		return false
 	}
 	return mi.UUID == other.UUID &&
+		mi.Currency == other.Currency &&
+		mi.ExchangeRate == other.ExchangeRate &&
 		reflect.DeepEqual(mi.RateInterval, other.RateInterval)
 }
 
@@ -2186,6 +2186,33 @@ func TestMIUIEqualFalse(t *testing.T) {
 
 }
 
+func TestMonetaryInfoEqualExchangeRate(t *testing.T) {
+	mi := &MonetaryInfo{
+		UUID:         "uuid",
+		ID:           "id",
+		Value:        23.1,
+		Currency:     "EUR",
+		ExchangeRate: 0.9,
+	}
+	other := mi.Clone()
+	if !mi.Equal(other) {
+		t.Errorf("Expected %s to equal %s", utils.ToJSON(mi), utils.ToJSON(other))
+	}
+	other.ExchangeRate = 0.95
+	if mi.Equal(other) {
+		t.Errorf("Expected %s to differ from %s", utils.ToJSON(mi), utils.ToJSON(other))
+	}
+	other = mi.Clone()
+	other.Currency = "USD"
+	if mi.Equal(other) {
+		t.Errorf("Expected %s to differ from %s", utils.ToJSON(mi), utils.ToJSON(other))
+	}
+	di := &DebitInfo{Monetary: mi, AccountID: "acc"}
+	if oDi := (&DebitInfo{Monetary: other, AccountID: "acc"}); di.Equal(oDi) {
+		t.Errorf("Expected %s to differ from %s", utils.ToJSON(di), utils.ToJSON(oDi))
+	}
+}
+
 func TestTimespanCreateSecondsSliceNegativeIncrementErr(t *testing.T) {
 
 	setLogger := func(buf *bytes.Buffer) {
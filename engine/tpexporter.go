@@ -359,6 +359,22 @@ func (tpExp *TPExporter) Run() error {
 		}
 	}
 
+	storDataTaxProfiles, err := tpExp.storDb.GetTPTaxProfiles(tpExp.tpID, "", "")
+	if err != nil && err.Error() != utils.ErrNotFound.Error() {
+		utils.Logger.Warning(fmt.Sprintf("<%s> error: %s, when getting %s from stordb for export", utils.ApierS, err, utils.TpTaxProfiles))
+		withError = true
+	}
+
+	if len(storDataTaxProfiles) != 0 {
+		toExportMap[utils.TaxProfilesCsv] = make([]any, 0, len(storDataTaxProfiles))
+		for _, sd := range storDataTaxProfiles {
+			sdModels := APItoModelTPTaxProfile(sd)
+			for _, sdModel := range sdModels {
+				toExportMap[utils.TaxProfilesCsv] = append(toExportMap[utils.TaxProfilesCsv], sdModel)
+			}
+		}
+	}
+
 	storDataDispatcherProfiles, err := tpExp.storDb.GetTPDispatcherProfiles(tpExp.tpID, "", "")
 	if err != nil && err.Error() != utils.ErrNotFound.Error() {
 		utils.Logger.Warning(fmt.Sprintf("<%s> error: %s, when getting %s from stordb for export", utils.ApierS, err, utils.TpDispatcherProfiles))
@@ -58,6 +58,7 @@ var fileHandlers = map[string]func(*TPCSVImporter, string) error{
 	utils.RoutesCsv:             (*TPCSVImporter).importRoutes,
 	utils.AttributesCsv:         (*TPCSVImporter).importAttributeProfiles,
 	utils.ChargersCsv:           (*TPCSVImporter).importChargerProfiles,
+	utils.TaxProfilesCsv:        (*TPCSVImporter).importTaxProfiles,
 	utils.DispatcherProfilesCsv: (*TPCSVImporter).importDispatcherProfiles,
 	utils.DispatcherHostsCsv:    (*TPCSVImporter).importDispatcherHosts,
 }
@@ -340,6 +341,17 @@ func (tpImp *TPCSVImporter) importChargerProfiles(fn string) error {
 	return tpImp.StorDb.SetTPChargers(rls)
 }
 
+func (tpImp *TPCSVImporter) importTaxProfiles(fn string) error {
+	if tpImp.Verbose {
+		log.Printf("Processing file: <%s> ", fn)
+	}
+	rls, err := tpImp.csvr.GetTPTaxProfiles(tpImp.TPid, "", "")
+	if err != nil {
+		return err
+	}
+	return tpImp.StorDb.SetTPTaxProfiles(rls)
+}
+
 func (tpImp *TPCSVImporter) importDispatcherProfiles(fn string) error {
 	if tpImp.Verbose {
 		log.Printf("Processing file: <%s> ", fn)
@@ -54,6 +54,7 @@ type TpReader struct {
 	routeProfiles      map[utils.TenantID]*utils.TPRouteProfile
 	attributeProfiles  map[utils.TenantID]*utils.TPAttributeProfile
 	chargerProfiles    map[utils.TenantID]*utils.TPChargerProfile
+	taxProfiles        map[utils.TenantID]*utils.TPTaxProfile
 	dispatcherProfiles map[utils.TenantID]*utils.TPDispatcherProfile
 	dispatcherHosts    map[utils.TenantID]*utils.TPDispatcherHost
 	acntActionPlans    map[string][]string
@@ -99,6 +100,7 @@ func (tpr *TpReader) Init() {
 	tpr.routeProfiles = make(map[utils.TenantID]*utils.TPRouteProfile)
 	tpr.attributeProfiles = make(map[utils.TenantID]*utils.TPAttributeProfile)
 	tpr.chargerProfiles = make(map[utils.TenantID]*utils.TPChargerProfile)
+	tpr.taxProfiles = make(map[utils.TenantID]*utils.TPTaxProfile)
 	tpr.dispatcherProfiles = make(map[utils.TenantID]*utils.TPDispatcherProfile)
 	tpr.dispatcherHosts = make(map[utils.TenantID]*utils.TPDispatcherHost)
 	tpr.filters = make(map[utils.TenantID]*utils.TPFilterProfile)
@@ -287,26 +289,26 @@ func (tpr *TpReader) LoadRatingPlans() (err error) {
 		return err
 	}
 	bindings := MapTPRatingPlanBindings(tps)
+	getTiming := func(timingID string) (*utils.TPTiming, error) {
+		t, exists := tpr.timings[timingID]
+		if !exists {
+			return nil, fmt.Errorf("could not get timing for tag %q", timingID)
+		}
+		return t, nil
+	}
+	getDestRate := func(drID string) (*utils.TPDestinationRate, error) {
+		drs, exists := tpr.destinationRates[drID]
+		if !exists {
+			return nil, fmt.Errorf("could not find destination rate for tag %q", drID)
+		}
+		return drs, nil
+	}
 	for tag, rplBnds := range bindings {
-		for _, rplBnd := range rplBnds {
-			t, exists := tpr.timings[rplBnd.TimingId]
-			if !exists {
-				return fmt.Errorf("could not get timing for tag %q", rplBnd.TimingId)
-			}
-			rplBnd.SetTiming(t)
-			drs, exists := tpr.destinationRates[rplBnd.DestinationRatesId]
-			if !exists {
-				return fmt.Errorf("could not find destination rate for tag %q", rplBnd.DestinationRatesId)
-			}
-			plan, exists := tpr.ratingPlans[tag]
-			if !exists {
-				plan = &RatingPlan{Id: tag}
-				tpr.ratingPlans[plan.Id] = plan
-			}
-			for _, dr := range drs.DestinationRates {
-				plan.AddRateInterval(dr.DestinationId, GetRateInterval(rplBnd, dr))
-			}
+		plan, err := APItoRatingPlan(tag, rplBnds, getTiming, getDestRate)
+		if err != nil {
+			return err
 		}
+		tpr.ratingPlans[tag] = plan
 	}
 	return nil
 }
@@ -364,28 +366,20 @@ func (tpr *TpReader) LoadRatingProfiles() (err error) {
 		return err
 	}
 	for _, tpRpf := range mpTpRpfs {
-		rpf := &RatingProfile{Id: tpRpf.KeyId()}
-		for _, tpRa := range tpRpf.RatingPlanActivations {
-			at, err := utils.ParseTimeDetectLayout(tpRa.ActivationTime, tpr.timezone)
-			if err != nil {
-				return fmt.Errorf("cannot parse activation time from %v", tpRa.ActivationTime)
-			}
-			_, exists := tpr.ratingPlans[tpRa.RatingPlanId]
+		rpf, err := APItoRatingProfile(tpRpf, tpr.timezone)
+		if err != nil {
+			return err
+		}
+		for _, rpa := range rpf.RatingPlanActivations {
+			_, exists := tpr.ratingPlans[rpa.RatingPlanId]
 			if !exists && tpr.dm.dataDB != nil { // Only query if there is a connection, eg on dry run there is none
-				if exists, err = tpr.dm.HasData(utils.RatingPlanPrefix, tpRa.RatingPlanId, ""); err != nil {
+				if exists, err = tpr.dm.HasData(utils.RatingPlanPrefix, rpa.RatingPlanId, ""); err != nil {
 					return err
 				}
 			}
 			if !exists {
-				return fmt.Errorf("could not load rating plans for tag: %q", tpRa.RatingPlanId)
+				return fmt.Errorf("could not load rating plans for tag: %q", rpa.RatingPlanId)
 			}
-			rpf.RatingPlanActivations = append(rpf.RatingPlanActivations,
-				&RatingPlanActivation{
-					ActivationTime: at,
-					RatingPlanId:   tpRa.RatingPlanId,
-					FallbackKeys: utils.FallbackSubjKeys(tpRpf.Tenant,
-						tpRpf.Category, tpRa.FallbackSubjects),
-				})
 		}
 		tpr.ratingProfiles[tpRpf.KeyId()] = rpf
 	}
@@ -434,104 +428,17 @@ func (tpr *TpReader) LoadActions() (err error) {
 		return err
 	}
 	storActs := MapTPActions(tps)
+	getTiming := func(timingID string) (*utils.TPTiming, error) {
+		if timing, found := tpr.timings[timingID]; found {
+			return timing, nil
+		}
+		return tpr.dm.GetTiming(timingID, false, utils.NonTransactional)
+	}
 	// map[string][]*Action
 	for tag, tpacts := range storActs {
-		acts := make([]*Action, len(tpacts))
-		for idx, tpact := range tpacts {
-			// check filter field
-			var fltrs []string
-			if len(tpact.Filters) > 0 {
-				fltrs = strings.Split(tpact.Filters, utils.InfieldSep)
-			}
-			if err = verifyInlineFilterS(fltrs); err != nil {
-				return fmt.Errorf("error parsing action %s filter field: %v", tag, err)
-			}
-			acts[idx] = &Action{
-				Id:               tag,
-				ActionType:       tpact.Identifier,
-				Weight:           tpact.Weight,
-				ExtraParameters:  tpact.ExtraParameters,
-				ExpirationString: tpact.ExpiryTime,
-				Filters:          fltrs,
-				Balance:          &BalanceFilter{},
-			}
-			if tpact.BalanceId != "" && tpact.BalanceId != utils.MetaAny {
-				acts[idx].Balance.ID = utils.StringPointer(tpact.BalanceId)
-			}
-			if tpact.BalanceType != "" && tpact.BalanceType != utils.MetaAny {
-				acts[idx].Balance.Type = utils.StringPointer(tpact.BalanceType)
-			}
-
-			if tpact.Units != "" && tpact.Units != utils.MetaAny {
-				vf, err := utils.ParseBalanceFilterValue(tpact.BalanceType, tpact.Units)
-				if err != nil {
-					return err
-				}
-				acts[idx].Balance.Value = vf
-			}
-
-			if tpact.BalanceWeight != "" && tpact.BalanceWeight != utils.MetaAny {
-				u, err := strconv.ParseFloat(tpact.BalanceWeight, 64)
-				if err != nil {
-					return err
-				}
-				acts[idx].Balance.Weight = utils.Float64Pointer(u)
-			}
-
-			if tpact.RatingSubject != "" && tpact.RatingSubject != utils.MetaAny {
-				acts[idx].Balance.RatingSubject = utils.StringPointer(tpact.RatingSubject)
-			}
-
-			if tpact.Categories != "" && tpact.Categories != utils.MetaAny {
-				acts[idx].Balance.Categories = utils.StringMapPointer(utils.ParseStringMap(tpact.Categories))
-			}
-			if tpact.DestinationIds != "" && tpact.DestinationIds != utils.MetaAny {
-				acts[idx].Balance.DestinationIDs = utils.StringMapPointer(utils.ParseStringMap(tpact.DestinationIds))
-			}
-			if tpact.SharedGroups != "" && tpact.SharedGroups != utils.MetaAny {
-				acts[idx].Balance.SharedGroups = utils.StringMapPointer(utils.ParseStringMap(tpact.SharedGroups))
-			}
-			if tpact.TimingTags != "" && tpact.TimingTags != utils.MetaAny {
-				acts[idx].Balance.TimingIDs = utils.StringMapPointer(utils.ParseStringMap(tpact.TimingTags))
-			}
-			if tpact.BalanceBlocker != "" && tpact.BalanceBlocker != utils.MetaAny {
-				u, err := strconv.ParseBool(tpact.BalanceBlocker)
-				if err != nil {
-					return err
-				}
-				acts[idx].Balance.Blocker = utils.BoolPointer(u)
-			}
-			if tpact.BalanceDisabled != "" && tpact.BalanceDisabled != utils.MetaAny {
-				u, err := strconv.ParseBool(tpact.BalanceDisabled)
-				if err != nil {
-					return err
-				}
-				acts[idx].Balance.Disabled = utils.BoolPointer(u)
-			}
-
-			// load action timings from tags
-			if tpact.TimingTags != "" {
-				timingIds := strings.Split(tpact.TimingTags, utils.InfieldSep)
-				for _, timingID := range timingIds {
-					timing, found := tpr.timings[timingID]
-					if !found {
-						if timing, err = tpr.dm.GetTiming(timingID, false,
-							utils.NonTransactional); err != nil {
-							return fmt.Errorf("error: %v querying timing with id: %q",
-								err.Error(), timingID)
-						}
-					}
-					acts[idx].Balance.Timings = append(acts[idx].Balance.Timings, &RITiming{
-						ID:        timingID,
-						Years:     timing.Years,
-						Months:    timing.Months,
-						MonthDays: timing.MonthDays,
-						WeekDays:  timing.WeekDays,
-						StartTime: timing.StartTime,
-						EndTime:   timing.EndTime,
-					})
-				}
-			}
+		acts, err := APItoActions(tag, tpacts, getTiming)
+		if err != nil {
+			return err
 		}
 		tpr.actions[tag] = acts
 	}
@@ -544,9 +451,15 @@ func (tpr *TpReader) LoadActionPlans() (err error) {
 		return err
 	}
 	storAps := MapTPActionTimings(tps)
+	getTiming := func(timingID string) (*utils.TPTiming, error) {
+		t, exists := tpr.timings[timingID]
+		if !exists {
+			return nil, fmt.Errorf("[ActionPlans] Could not load the timing for tag: %q", timingID)
+		}
+		return t, nil
+	}
 	for atID, ats := range storAps {
 		for _, at := range ats {
-
 			_, exists := tpr.actions[at.ActionsId]
 			if !exists && tpr.dm.dataDB != nil {
 				if exists, err = tpr.dm.HasData(utils.ActionPrefix, at.ActionsId, ""); err != nil {
@@ -556,34 +469,15 @@ func (tpr *TpReader) LoadActionPlans() (err error) {
 			if !exists {
 				return fmt.Errorf("[ActionPlans] Could not load the action for tag: %q", at.ActionsId)
 			}
-			t, exists := tpr.timings[at.TimingId]
-			if !exists {
-				return fmt.Errorf("[ActionPlans] Could not load the timing for tag: %q", at.TimingId)
-			}
-			var actPln *ActionPlan
-			if actPln, exists = tpr.actionPlans[atID]; !exists {
-				actPln = &ActionPlan{
-					Id: atID,
-				}
-			}
-			actPln.ActionTimings = append(actPln.ActionTimings, &ActionTiming{
-				Uuid:   utils.GenUUID(),
-				Weight: at.Weight,
-				Timing: &RateInterval{
-					Timing: &RITiming{
-						ID:        at.TimingId,
-						Years:     t.Years,
-						Months:    t.Months,
-						MonthDays: t.MonthDays,
-						WeekDays:  t.WeekDays,
-						StartTime: t.StartTime,
-					},
-				},
-				ActionsID: at.ActionsId,
-			})
-
-			tpr.actionPlans[atID] = actPln
 		}
+		actPln, err := APItoActionPlan(atID, ats, getTiming)
+		if err != nil {
+			return err
+		}
+		if prevPln, exists := tpr.actionPlans[atID]; exists {
+			actPln.ActionTimings = append(prevPln.ActionTimings, actPln.ActionTimings...)
+		}
+		tpr.actionPlans[atID] = actPln
 	}
 	return nil
 }
@@ -595,88 +489,9 @@ func (tpr *TpReader) LoadActionTriggers() (err error) {
 	}
 	storAts := MapTPActionTriggers(tps)
 	for key, atrsLst := range storAts {
-		atrs := make([]*ActionTrigger, len(atrsLst))
-		for idx, atr := range atrsLst {
-			expirationDate, err := utils.ParseTimeDetectLayout(atr.ExpirationDate, tpr.timezone)
-			if err != nil {
-				return err
-			}
-			activationDate, err := utils.ParseTimeDetectLayout(atr.ActivationDate, tpr.timezone)
-			if err != nil {
-				return err
-			}
-			minSleep, err := utils.ParseDurationWithNanosecs(atr.MinSleep)
-			if err != nil {
-				return err
-			}
-			if atr.UniqueID == "" {
-				atr.UniqueID = utils.GenUUID()
-			}
-			atrs[idx] = &ActionTrigger{
-				ID:             key,
-				UniqueID:       atr.UniqueID,
-				ThresholdType:  atr.ThresholdType,
-				ThresholdValue: atr.ThresholdValue,
-				Recurrent:      atr.Recurrent,
-				MinSleep:       minSleep,
-				ExpirationDate: expirationDate,
-				ActivationDate: activationDate,
-				Balance:        &BalanceFilter{},
-				Weight:         atr.Weight,
-				ActionsID:      atr.ActionsId,
-			}
-			if atr.BalanceId != "" && atr.BalanceId != utils.MetaAny {
-				atrs[idx].Balance.ID = utils.StringPointer(atr.BalanceId)
-			}
-
-			if atr.BalanceType != "" && atr.BalanceType != utils.MetaAny {
-				atrs[idx].Balance.Type = utils.StringPointer(atr.BalanceType)
-			}
-
-			if atr.BalanceWeight != "" && atr.BalanceWeight != utils.MetaAny {
-				u, err := strconv.ParseFloat(atr.BalanceWeight, 64)
-				if err != nil {
-					return err
-				}
-				atrs[idx].Balance.Weight = utils.Float64Pointer(u)
-			}
-			if atr.BalanceExpirationDate != "" && atr.BalanceExpirationDate != utils.MetaAny && atr.ExpirationDate != utils.MetaUnlimited {
-				u, err := utils.ParseTimeDetectLayout(atr.BalanceExpirationDate, tpr.timezone)
-				if err != nil {
-					return err
-				}
-				atrs[idx].Balance.ExpirationDate = utils.TimePointer(u)
-			}
-			if atr.BalanceRatingSubject != "" && atr.BalanceRatingSubject != utils.MetaAny {
-				atrs[idx].Balance.RatingSubject = utils.StringPointer(atr.BalanceRatingSubject)
-			}
-
-			if atr.BalanceCategories != "" && atr.BalanceCategories != utils.MetaAny {
-				atrs[idx].Balance.Categories = utils.StringMapPointer(utils.ParseStringMap(atr.BalanceCategories))
-			}
-			if atr.BalanceDestinationIds != "" && atr.BalanceDestinationIds != utils.MetaAny {
-				atrs[idx].Balance.DestinationIDs = utils.StringMapPointer(utils.ParseStringMap(atr.BalanceDestinationIds))
-			}
-			if atr.BalanceSharedGroups != "" && atr.BalanceSharedGroups != utils.MetaAny {
-				atrs[idx].Balance.SharedGroups = utils.StringMapPointer(utils.ParseStringMap(atr.BalanceSharedGroups))
-			}
-			if atr.BalanceTimingTags != "" && atr.BalanceTimingTags != utils.MetaAny {
-				atrs[idx].Balance.TimingIDs = utils.StringMapPointer(utils.ParseStringMap(atr.BalanceTimingTags))
-			}
-			if atr.BalanceBlocker != "" && atr.BalanceBlocker != utils.MetaAny {
-				u, err := strconv.ParseBool(atr.BalanceBlocker)
-				if err != nil {
-					return err
-				}
-				atrs[idx].Balance.Blocker = utils.BoolPointer(u)
-			}
-			if atr.BalanceDisabled != "" && atr.BalanceDisabled != utils.MetaAny {
-				u, err := strconv.ParseBool(atr.BalanceDisabled)
-				if err != nil {
-					return err
-				}
-				atrs[idx].Balance.Disabled = utils.BoolPointer(u)
-			}
+		atrs, err := APItoActionTriggers(key, atrsLst, tpr.timezone)
+		if err != nil {
+			return err
 		}
 		tpr.actionsTriggers[key] = atrs
 	}
@@ -1237,6 +1052,26 @@ func (tpr *TpReader) LoadChargerProfiles() error {
 	return tpr.LoadChargerProfilesFiltered("")
 }
 
+func (tpr *TpReader) LoadTaxProfilesFiltered(tag string) (err error) {
+	rls, err := tpr.lr.GetTPTaxProfiles(tpr.tpid, "", tag)
+	if err != nil {
+		return err
+	}
+	mapTaxProfile := make(map[utils.TenantID]*utils.TPTaxProfile)
+	for _, rl := range rls {
+		if err = verifyInlineFilterS(rl.FilterIDs); err != nil {
+			return
+		}
+		mapTaxProfile[utils.TenantID{Tenant: rl.Tenant, ID: rl.ID}] = rl
+	}
+	tpr.taxProfiles = mapTaxProfile
+	return nil
+}
+
+func (tpr *TpReader) LoadTaxProfiles() error {
+	return tpr.LoadTaxProfilesFiltered("")
+}
+
 func (tpr *TpReader) LoadDispatcherProfilesFiltered(tag string) (err error) {
 	rls, err := tpr.lr.GetTPDispatcherProfiles(tpr.tpid, "", tag)
 	if err != nil {
@@ -1329,6 +1164,9 @@ func (tpr *TpReader) LoadAll() (err error) {
 	if err = tpr.LoadChargerProfiles(); err != nil && err.Error() != utils.NotFoundCaps {
 		return
 	}
+	if err = tpr.LoadTaxProfiles(); err != nil && err.Error() != utils.NotFoundCaps {
+		return
+	}
 	if err = tpr.LoadDispatcherProfiles(); err != nil && err.Error() != utils.NotFoundCaps {
 		return
 	}
@@ -1645,6 +1483,24 @@ func (tpr *TpReader) WriteToDatabase(verbose, disableReverse bool) (err error) {
 	if len(tpr.chargerProfiles) != 0 {
 		loadIDs[utils.CacheChargerProfiles] = loadID
 	}
+	if verbose {
+		log.Print("TaxProfiles:")
+	}
+	for _, tpTxp := range tpr.taxProfiles {
+		var txp *TaxProfile
+		if txp, err = APItoTaxProfile(tpTxp, tpr.timezone); err != nil {
+			return
+		}
+		if err = tpr.dm.SetTaxProfile(txp, true); err != nil {
+			return
+		}
+		if verbose {
+			log.Print("\t", txp.TenantID())
+		}
+	}
+	if len(tpr.taxProfiles) != 0 {
+		loadIDs[utils.CacheTaxProfiles] = loadID
+	}
 	if verbose {
 		log.Print("DispatcherProfiles:")
 	}
@@ -1771,6 +1627,8 @@ func (tpr *TpReader) ShowStatistics() {
 	log.Print("AttributeProfiles: ", len(tpr.attributeProfiles))
 	// Charger profiles
 	log.Print("ChargerProfiles: ", len(tpr.chargerProfiles))
+	// Tax profiles
+	log.Print("TaxProfiles: ", len(tpr.taxProfiles))
 	// Dispatcher profiles
 	log.Print("DispatcherProfiles: ", len(tpr.dispatcherProfiles))
 	// Dispatcher Hosts
@@ -1916,6 +1774,14 @@ func (tpr *TpReader) GetLoadedIds(categ string) ([]string, error) {
 			i++
 		}
 		return keys, nil
+	case utils.TaxProfilePrefix:
+		keys := make([]string, len(tpr.taxProfiles))
+		i := 0
+		for k := range tpr.taxProfiles {
+			keys[i] = k.TenantID()
+			i++
+		}
+		return keys, nil
 	case utils.DispatcherProfilePrefix:
 		keys := make([]string, len(tpr.dispatcherProfiles))
 		i := 0
@@ -2095,6 +1961,18 @@ func (tpr *TpReader) RemoveFromDatabase(verbose, disableReverse bool) (err error
 		}
 	}
 
+	if verbose {
+		log.Print("TaxProfiles:")
+	}
+	for _, tpTxp := range tpr.taxProfiles {
+		if err = tpr.dm.RemoveTaxProfile(tpTxp.Tenant, tpTxp.ID, true); err != nil {
+			return
+		}
+		if verbose {
+			log.Print("\t", utils.ConcatenatedKey(tpTxp.Tenant, tpTxp.ID))
+		}
+	}
+
 	if verbose {
 		log.Print("DispatcherProfiles:")
 	}
@@ -2209,6 +2087,9 @@ func (tpr *TpReader) RemoveFromDatabase(verbose, disableReverse bool) (err error
 	if len(tpr.chargerProfiles) != 0 {
 		loadIDs[utils.CacheChargerProfiles] = loadID
 	}
+	if len(tpr.taxProfiles) != 0 {
+		loadIDs[utils.CacheTaxProfiles] = loadID
+	}
 	if len(tpr.dispatcherProfiles) != 0 {
 		loadIDs[utils.CacheDispatcherProfiles] = loadID
 	}
@@ -2246,6 +2127,7 @@ func (tpr *TpReader) ReloadCache(caching string, verbose bool, opts map[string]a
 	routeIDs, _ := tpr.GetLoadedIds(utils.RouteProfilePrefix)
 	apfIDs, _ := tpr.GetLoadedIds(utils.AttributeProfilePrefix)
 	chargerIDs, _ := tpr.GetLoadedIds(utils.ChargerProfilePrefix)
+	taxIDs, _ := tpr.GetLoadedIds(utils.TaxProfilePrefix)
 	dppIDs, _ := tpr.GetLoadedIds(utils.DispatcherProfilePrefix)
 	dphIDs, _ := tpr.GetLoadedIds(utils.DispatcherHostPrefix)
 	aps, _ := tpr.GetLoadedIds(utils.ActionPlanPrefix)
@@ -2272,6 +2154,7 @@ func (tpr *TpReader) ReloadCache(caching string, verbose bool, opts map[string]a
 		utils.CacheRouteProfiles:       routeIDs,
 		utils.CacheAttributeProfiles:   apfIDs,
 		utils.CacheChargerProfiles:     chargerIDs,
+		utils.CacheTaxProfiles:         taxIDs,
 		utils.CacheDispatcherProfiles:  dppIDs,
 		utils.CacheDispatcherHosts:     dphIDs,
 	}
@@ -2296,6 +2179,9 @@ func (tpr *TpReader) ReloadCache(caching string, verbose bool, opts map[string]a
 	if len(chargerIDs) != 0 {
 		cacheIDs = append(cacheIDs, utils.CacheChargerFilterIndexes)
 	}
+	if len(taxIDs) != 0 {
+		cacheIDs = append(cacheIDs, utils.CacheTaxFilterIndexes)
+	}
 	if len(dppIDs) != 0 {
 		cacheIDs = append(cacheIDs, utils.CacheDispatcherFilterIndexes)
 	}
@@ -2380,98 +2266,106 @@ func (tpr *TpReader) ReloadScheduler(verbose bool) (err error) {
 }
 
 func (tpr *TpReader) addDefaultTimings() {
-	tpr.timings[utils.MetaAny] = &utils.TPTiming{
-		ID:        utils.MetaAny,
-		Years:     utils.Years{},
-		Months:    utils.Months{},
-		MonthDays: utils.MonthDays{},
-		WeekDays:  utils.WeekDays{},
-		StartTime: "00:00:00",
-		EndTime:   "",
-	}
-	tpr.timings[utils.MetaASAP] = &utils.TPTiming{
-		ID:        utils.MetaASAP,
-		Years:     utils.Years{},
-		Months:    utils.Months{},
-		MonthDays: utils.MonthDays{},
-		WeekDays:  utils.WeekDays{},
-		StartTime: utils.MetaASAP,
-		EndTime:   "",
-	}
-	tpr.timings[utils.MetaEveryMinute] = &utils.TPTiming{
-		ID:        utils.MetaEveryMinute,
-		Years:     utils.Years{},
-		Months:    utils.Months{},
-		MonthDays: utils.MonthDays{},
-		WeekDays:  utils.WeekDays{},
-		StartTime: utils.ConcatenatedKey(utils.Meta, utils.Meta, strconv.Itoa(time.Now().Second())),
-		EndTime:   "",
-	}
-	tpr.timings[utils.MetaHourly] = &utils.TPTiming{
-		ID:        utils.MetaHourly,
-		Years:     utils.Years{},
-		Months:    utils.Months{},
-		MonthDays: utils.MonthDays{},
-		WeekDays:  utils.WeekDays{},
-		StartTime: utils.ConcatenatedKey(utils.Meta, strconv.Itoa(time.Now().Minute()), strconv.Itoa(time.Now().Second())),
-		EndTime:   "",
+	for timingID, timing := range DefaultTimings() {
+		tpr.timings[timingID] = timing
 	}
+}
+
+// DefaultTimings returns the timings available without being defined by the user
+func DefaultTimings() map[string]*utils.TPTiming {
 	startTime := time.Now().Format("15:04:05")
-	tpr.timings[utils.MetaDaily] = &utils.TPTiming{
-		ID:        utils.MetaDaily,
-		Years:     utils.Years{},
-		Months:    utils.Months{},
-		MonthDays: utils.MonthDays{},
-		WeekDays:  utils.WeekDays{},
-		StartTime: startTime,
-		EndTime:   "",
-	}
-	tpr.timings[utils.MetaWeekly] = &utils.TPTiming{
-		ID:        utils.MetaWeekly,
-		Years:     utils.Years{},
-		Months:    utils.Months{},
-		MonthDays: utils.MonthDays{},
-		WeekDays:  utils.WeekDays{time.Now().Weekday()},
-		StartTime: startTime,
-		EndTime:   "",
-	}
-	tpr.timings[utils.MetaMonthly] = &utils.TPTiming{
-		ID:        utils.MetaMonthly,
-		Years:     utils.Years{},
-		Months:    utils.Months{},
-		MonthDays: utils.MonthDays{time.Now().Day()},
-		WeekDays:  utils.WeekDays{},
-		StartTime: startTime,
-		EndTime:   "",
-	}
-	tpr.timings[utils.MetaMonthlyEstimated] = &utils.TPTiming{
-		ID:        utils.MetaMonthlyEstimated,
-		Years:     utils.Years{},
-		Months:    utils.Months{},
-		MonthDays: utils.MonthDays{time.Now().Day()},
-		WeekDays:  utils.WeekDays{},
-		StartTime: startTime,
-		EndTime:   "",
-	}
-	tpr.timings[utils.MetaMonthEnd] = &utils.TPTiming{
-		ID:        utils.MetaMonthEnd,
-		Years:     utils.Years{},
-		Months:    utils.Months{},
-		MonthDays: utils.MonthDays{-1},
-		WeekDays:  utils.WeekDays{},
-		StartTime: startTime,
-		EndTime:   "",
-	}
-	tpr.timings[utils.MetaYearly] = &utils.TPTiming{
-		ID:        utils.MetaYearly,
-		Years:     utils.Years{},
-		Months:    utils.Months{time.Now().Month()},
-		MonthDays: utils.MonthDays{time.Now().Day()},
-		WeekDays:  utils.WeekDays{},
-		StartTime: startTime,
-		EndTime:   "",
+	return map[string]*utils.TPTiming{
+		utils.MetaAny: {
+			ID:        utils.MetaAny,
+			Years:     utils.Years{},
+			Months:    utils.Months{},
+			MonthDays: utils.MonthDays{},
+			WeekDays:  utils.WeekDays{},
+			StartTime: "00:00:00",
+			EndTime:   "",
+		},
+		utils.MetaASAP: {
+			ID:        utils.MetaASAP,
+			Years:     utils.Years{},
+			Months:    utils.Months{},
+			MonthDays: utils.MonthDays{},
+			WeekDays:  utils.WeekDays{},
+			StartTime: utils.MetaASAP,
+			EndTime:   "",
+		},
+		utils.MetaEveryMinute: {
+			ID:        utils.MetaEveryMinute,
+			Years:     utils.Years{},
+			Months:    utils.Months{},
+			MonthDays: utils.MonthDays{},
+			WeekDays:  utils.WeekDays{},
+			StartTime: utils.ConcatenatedKey(utils.Meta, utils.Meta, strconv.Itoa(time.Now().Second())),
+			EndTime:   "",
+		},
+		utils.MetaHourly: {
+			ID:        utils.MetaHourly,
+			Years:     utils.Years{},
+			Months:    utils.Months{},
+			MonthDays: utils.MonthDays{},
+			WeekDays:  utils.WeekDays{},
+			StartTime: utils.ConcatenatedKey(utils.Meta, strconv.Itoa(time.Now().Minute()), strconv.Itoa(time.Now().Second())),
+			EndTime:   "",
+		},
+		utils.MetaDaily: {
+			ID:        utils.MetaDaily,
+			Years:     utils.Years{},
+			Months:    utils.Months{},
+			MonthDays: utils.MonthDays{},
+			WeekDays:  utils.WeekDays{},
+			StartTime: startTime,
+			EndTime:   "",
+		},
+		utils.MetaWeekly: {
+			ID:        utils.MetaWeekly,
+			Years:     utils.Years{},
+			Months:    utils.Months{},
+			MonthDays: utils.MonthDays{},
+			WeekDays:  utils.WeekDays{time.Now().Weekday()},
+			StartTime: startTime,
+			EndTime:   "",
+		},
+		utils.MetaMonthly: {
+			ID:        utils.MetaMonthly,
+			Years:     utils.Years{},
+			Months:    utils.Months{},
+			MonthDays: utils.MonthDays{time.Now().Day()},
+			WeekDays:  utils.WeekDays{},
+			StartTime: startTime,
+			EndTime:   "",
+		},
+		utils.MetaMonthlyEstimated: {
+			ID:        utils.MetaMonthlyEstimated,
+			Years:     utils.Years{},
+			Months:    utils.Months{},
+			MonthDays: utils.MonthDays{time.Now().Day()},
+			WeekDays:  utils.WeekDays{},
+			StartTime: startTime,
+			EndTime:   "",
+		},
+		utils.MetaMonthEnd: {
+			ID:        utils.MetaMonthEnd,
+			Years:     utils.Years{},
+			Months:    utils.Months{},
+			MonthDays: utils.MonthDays{-1},
+			WeekDays:  utils.WeekDays{},
+			StartTime: startTime,
+			EndTime:   "",
+		},
+		utils.MetaYearly: {
+			ID:        utils.MetaYearly,
+			Years:     utils.Years{},
+			Months:    utils.Months{time.Now().Month()},
+			MonthDays: utils.MonthDays{time.Now().Day()},
+			WeekDays:  utils.WeekDays{},
+			StartTime: startTime,
+			EndTime:   "",
+		},
 	}
-
 }
 
 func (tpr *TpReader) setDestination(dest *Destination, disableReverse bool, transID string) (err error) {
@@ -943,6 +943,7 @@ func TestTPReaderReloadCache(t *testing.T) {
 		RouteProfileIDs:       []string{"cgrates.org:routeProfilesID"},
 		AttributeProfileIDs:   []string{"cgrates.org:attributeProfilesID"},
 		ChargerProfileIDs:     []string{"cgrates.org:chargerProfilesID"},
+		TaxProfileIDs:         []string{"cgrates.org:taxProfilesID"},
 		DispatcherProfileIDs:  []string{"cgrates.org:dispatcherProfilesID"},
 		DispatcherHostIDs:     []string{"cgrates.org:dispatcherHostsID"},
 		ResourceIDs:           []string{"cgrates.org:resourceProfilesID"},
@@ -1016,6 +1017,9 @@ func TestTPReaderReloadCache(t *testing.T) {
 		chargerProfiles: map[utils.TenantID]*utils.TPChargerProfile{
 			{Tenant: "cgrates.org", ID: "chargerProfilesID"}: {},
 		},
+		taxProfiles: map[utils.TenantID]*utils.TPTaxProfile{
+			{Tenant: "cgrates.org", ID: "taxProfilesID"}: {},
+		},
 		dispatcherProfiles: map[utils.TenantID]*utils.TPDispatcherProfile{
 			{Tenant: "cgrates.org", ID: "dispatcherProfilesID"}: {},
 		},
@@ -1051,7 +1055,7 @@ func TestTPReaderLoadDestinationsFiltered(t *testing.T) {
 		TPid: "tpID",
 		ID:   "prefixes",
 	}, []string{"groupId"}, true, "tId")
-	db.db = tscache
+	db.db = &internalDBCache{TransCache: tscache}
 
 	tpr, err := NewTpReader(db, db, "itemId", "local", nil, nil, true)
 	if err != nil {
@@ -1204,7 +1208,7 @@ func TestTpReaderLoadAccountActions(t *testing.T) {
 		ActionPlanId: "actionplans",
 	}, []string{"groupId"}, true, "tId")
 	db := NewInternalDB(nil, nil, true, cfg.DataDbCfg().Items)
-	db.db = tscache
+	db.db = &internalDBCache{TransCache: tscache}
 	tpr, err := NewTpReader(db, db, "*prf", "local", nil, nil, true)
 	if err != nil {
 		t.Error(err)
@@ -1403,7 +1407,7 @@ func TestTpReaderLoadTimingsErr(t *testing.T) {
 		ID:   duplicateId,
 	}, []string{"groupId"}, true, "tId")
 	db := NewInternalDB(nil, nil, true, cfg.DataDbCfg().Items)
-	db.db = tscache
+	db.db = &internalDBCache{TransCache: tscache}
 	tpr, err := NewTpReader(db, db, "*prf", "local", nil, nil, true)
 	if err != nil {
 		t.Error(err)
@@ -1436,7 +1440,7 @@ func TestLoadDestinationRatesErr(t *testing.T) {
 		ID:   duplicateId,
 	}, []string{"groupId"}, true, "tId")
 	db := NewInternalDB(nil, nil, true, cfg.DataDbCfg().Items)
-	db.db = tscache
+	db.db = &internalDBCache{TransCache: tscache}
 	tpr, err := NewTpReader(db, db, "*prf", "local", nil, nil, true)
 	if err != nil {
 		t.Error(err)
@@ -1519,7 +1523,7 @@ func TestLoadRatingProfilesFiltered(t *testing.T) {
 		},
 	)
 	db := NewInternalDB(nil, nil, true, cfg.DataDbCfg().Items)
-	db.db = tscache
+	db.db = &internalDBCache{TransCache: tscache}
 	tpr, err := NewTpReader(db, db, "*prf", "local", nil, nil, true)
 	if err != nil {
 		t.Error(err)
@@ -1576,7 +1580,7 @@ func TestTpReaderLoadActionTriggers(t *testing.T) {
 		},
 	)
 	db := NewInternalDB(nil, nil, true, cfg.DataDbCfg().Items)
-	db.db = tscache
+	db.db = &internalDBCache{TransCache: tscache}
 	tpr, err := NewTpReader(db, db, "*prf", "UTC", nil, nil, true)
 	if err != nil {
 		t.Error(err)
@@ -1661,7 +1665,7 @@ func TestTpReaderSetDestination(t *testing.T) {
 		},
 	)
 	db := NewInternalDB(nil, nil, true, cfg.DataDbCfg().Items)
-	db.db = tscache
+	db.db = &internalDBCache{TransCache: tscache}
 	tpr, err := NewTpReader(db, db, "*prf", "UTC", nil, nil, true)
 	if err != nil {
 		t.Error(err)
@@ -53,7 +53,8 @@ func TestDMitinitDB(t *testing.T) {
 		dataDB, err = NewRedisStorage(
 			fmt.Sprintf("%s:%s", cfg.DataDbCfg().Host, cfg.DataDbCfg().Port),
 			4, cfg.DataDbCfg().User, cfg.DataDbCfg().Password, cfg.GeneralCfg().DBDataEncoding,
-			10, 20, "", false, 0, 0, 0, 0, 0, false, utils.EmptyString, utils.EmptyString, utils.EmptyString)
+			10, 20, "", false, 0, 0, 0, 0, 0, false, utils.EmptyString, utils.EmptyString, utils.EmptyString,
+			utils.MetaPrimary, nil)
 		if err != nil {
 			t.Fatal("Could not connect to Redis", err.Error())
 		}
@@ -22,6 +22,7 @@ import (
 	"encoding/csv"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
 	"path"
 	"slices"
@@ -42,6 +43,14 @@ import (
 type erEvent struct {
 	cgrEvent *utils.CGREvent
 	rdrCfg   *config.EventReaderCfg
+	done     chan error // optional, receives the processing result
+}
+
+// reply sends the processing result to the reader if it waits for it
+func (erEv *erEvent) reply(err error) {
+	if erEv.done != nil {
+		erEv.done <- err
+	}
 }
 
 // NewERService instantiates the ERService
@@ -56,6 +65,8 @@ func NewERService(cfg *config.CGRConfig, filterS *engine.FilterS, connMgr *engin
 		rdrErr:        make(chan error),
 		filterS:       filterS,
 		connMgr:       connMgr,
+		processed:     engine.NewMetricCounters(),
+		failed:        engine.NewMetricCounters(),
 	}
 	ers.partialCache = ltcache.NewCache(ltcache.UnlimitedCaching, cfg.ERsCfg().PartialCacheTTL, false, ers.onEvicted)
 	return
@@ -76,21 +87,27 @@ type ERService struct {
 	connMgr *engine.ConnManager
 
 	partialCache *ltcache.Cache
+
+	processed *engine.MetricCounters // events processed per reader
+	failed    *engine.MetricCounters // events failed per reader
 }
 
 // ListenAndServe keeps the service alive
 func (erS *ERService) ListenAndServe(stopChan, cfgRldChan chan struct{}) (err error) {
+	erS.Lock()
 	for cfgIdx, rdrCfg := range erS.cfg.ERsCfg().Readers {
 		if rdrCfg.Type == utils.MetaNone { // ignore *default reader
 			continue
 		}
 		if err = erS.addReader(rdrCfg.ID, cfgIdx); err != nil {
+			erS.Unlock()
 			utils.Logger.Crit(
 				fmt.Sprintf("<%s> adding reader <%s> got error: <%s>",
 					utils.ERs, rdrCfg.ID, err.Error()))
 			return
 		}
 	}
+	erS.Unlock()
 	for {
 		select {
 		case err = <-erS.rdrErr: // got application error
@@ -103,17 +120,25 @@ func (erS *ERService) ListenAndServe(stopChan, cfgRldChan chan struct{}) (err er
 			erS.closeAllRdrs()
 			return
 		case erEv := <-erS.rdrEvents:
-			if err := erS.processEvent(erEv.cgrEvent, erEv.rdrCfg); err != nil {
+			erS.processed.Inc(erEv.rdrCfg.ID)
+			evErr := erS.processEvent(erEv.cgrEvent, erEv.rdrCfg)
+			if evErr != nil {
+				erS.failed.Inc(erEv.rdrCfg.ID)
 				utils.Logger.Warning(
 					fmt.Sprintf("<%s> reading event: <%s> from reader: <%s> got error: <%s>",
-						utils.ERs, utils.ToJSON(erEv.cgrEvent), erEv.rdrCfg.ID, err.Error()))
+						utils.ERs, utils.ToJSON(erEv.cgrEvent), erEv.rdrCfg.ID, evErr.Error()))
 			}
+			erEv.reply(evErr)
 		case pEv := <-erS.partialEvents:
-			if err := erS.processPartialEvent(pEv.cgrEvent, pEv.rdrCfg); err != nil {
+			erS.processed.Inc(pEv.rdrCfg.ID)
+			evErr := erS.processPartialEvent(pEv.cgrEvent, pEv.rdrCfg)
+			if evErr != nil {
+				erS.failed.Inc(pEv.rdrCfg.ID)
 				utils.Logger.Warning(
 					fmt.Sprintf("<%s> reading partial event: <%s> from reader: <%s> got error: <%s>",
-						utils.ERs, utils.ToJSON(pEv.cgrEvent), pEv.rdrCfg.ID, err.Error()))
+						utils.ERs, utils.ToJSON(pEv.cgrEvent), pEv.rdrCfg.ID, evErr.Error()))
 			}
+			pEv.reply(evErr)
 		case <-cfgRldChan: // handle reload
 			cfgIDs := make(map[string]int)
 			pathReloaded := make(utils.StringSet)
@@ -159,6 +184,37 @@ func (erS *ERService) ListenAndServe(stopChan, cfgRldChan chan struct{}) (err er
 	}
 }
 
+// CollectMetrics implements engine.MetricsCollector
+func (erS *ERService) CollectMetrics(mw *engine.MetricsWriter) {
+	mw.Family("ers_events_processed_total", engine.MetricTypeCounter, "Number of events received from the reader.")
+	for _, rdrID := range erS.processed.Keys() {
+		mw.Sample("ers_events_processed_total", float64(erS.processed.Get(rdrID)), "reader", rdrID)
+	}
+	mw.Family("ers_events_failed_total", engine.MetricTypeCounter, "Number of events from the reader failed to be processed.")
+	for _, rdrID := range erS.processed.Keys() {
+		mw.Sample("ers_events_failed_total", float64(erS.failed.Get(rdrID)), "reader", rdrID)
+	}
+}
+
+// ServeHTTP passes the request to the HTTP reader listening on its path
+func (erS *ERService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var httpRdr *HTTPER
+	erS.RLock()
+	for _, rdr := range erS.rdrs {
+		if hRdr, canCast := rdr.(*HTTPER); canCast &&
+			hRdr.Config().SourcePath == r.URL.Path {
+			httpRdr = hRdr
+			break
+		}
+	}
+	erS.RUnlock()
+	if httpRdr == nil {
+		http.NotFound(w, r)
+		return
+	}
+	httpRdr.ServeHTTP(w, r)
+}
+
 // addReader will add a new reader to the service
 func (erS *ERService) addReader(rdrID string, cfgIdx int) (err error) {
 	erS.stopLsn[rdrID] = make(chan struct{})
@@ -0,0 +1,270 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package ers
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cgrates/cgrates/agents"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+	"github.com/linkedin/goavro/v2"
+)
+
+func NewAvroFileER(cfg *config.CGRConfig, cfgIdx int,
+	rdrEvents, partialEvents chan *erEvent, rdrErr chan error,
+	fltrS *engine.FilterS, rdrExit chan struct{}) (er EventReader, err error) {
+	srcPath := cfg.ERsCfg().Readers[cfgIdx].SourcePath
+	if strings.HasSuffix(srcPath, utils.Slash) {
+		srcPath = srcPath[:len(srcPath)-1]
+	}
+	avroEr := &AvroFileER{
+		cgrCfg:        cfg,
+		cfgIdx:        cfgIdx,
+		fltrS:         fltrS,
+		rdrDir:        srcPath,
+		rdrEvents:     rdrEvents,
+		partialEvents: partialEvents,
+		rdrError:      rdrErr,
+		rdrExit:       rdrExit,
+		conReqs:       make(chan struct{}, cfg.ERsCfg().Readers[cfgIdx].ConcurrentReqs)}
+	var processFile struct{}
+	for i := 0; i < cfg.ERsCfg().Readers[cfgIdx].ConcurrentReqs; i++ {
+		avroEr.conReqs <- processFile // Empty initiate so we do not need to wait later when we pop
+	}
+	return avroEr, nil
+}
+
+// AvroFileER implements EventReader interface for .avro object container files
+type AvroFileER struct {
+	sync.RWMutex
+	cgrCfg        *config.CGRConfig
+	cfgIdx        int // index of config instance within ERsCfg.Readers
+	fltrS         *engine.FilterS
+	rdrDir        string
+	rdrEvents     chan *erEvent // channel to dispatch the events created to
+	partialEvents chan *erEvent // channel to dispatch the partial events created to
+	rdrError      chan error
+	rdrExit       chan struct{}
+	conReqs       chan struct{} // limit number of opened files
+}
+
+func (rdr *AvroFileER) Config() *config.EventReaderCfg {
+	return rdr.cgrCfg.ERsCfg().Readers[rdr.cfgIdx]
+}
+
+func (rdr *AvroFileER) serveDefault() {
+	tm := time.NewTimer(0)
+	for {
+		// Not automated, process and sleep approach
+		select {
+		case <-rdr.rdrExit:
+			tm.Stop()
+			utils.Logger.Info(
+				fmt.Sprintf("<%s> stop monitoring path <%s>",
+					utils.ERs, rdr.rdrDir))
+			return
+		case <-tm.C:
+		}
+		filesInDir, _ := os.ReadDir(rdr.rdrDir)
+		for _, file := range filesInDir {
+			if !strings.HasSuffix(file.Name(), utils.AvroSuffix) { // hardcoded file extension for avro event reader
+				continue // used in order to filter the files from directory
+			}
+			go func(fileName string) {
+				if err := rdr.processFile(rdr.rdrDir, fileName); err != nil {
+					utils.Logger.Warning(
+						fmt.Sprintf("<%s> processing file %s, error: %s",
+							utils.ERs, fileName, err.Error()))
+				}
+			}(file.Name())
+		}
+		tm.Reset(rdr.Config().RunDelay)
+	}
+}
+
+func (rdr *AvroFileER) Serve() (err error) {
+	switch rdr.Config().RunDelay {
+	case time.Duration(0): // 0 disables the automatic read, maybe done per API
+		return
+	case time.Duration(-1):
+		return utils.WatchDir(rdr.rdrDir, rdr.processFile,
+			utils.ERs, rdr.rdrExit)
+	default:
+		go rdr.serveDefault()
+	}
+	return
+}
+
+// processFile is called for each file in a directory and dispatches erEvents from it
+func (rdr *AvroFileER) processFile(fPath, fName string) (err error) {
+	if cap(rdr.conReqs) != 0 { // 0 goes for no limit
+		processFile := <-rdr.conReqs // Queue here for maxOpenFiles
+		defer func() { rdr.conReqs <- processFile }()
+	}
+	absPath := path.Join(fPath, fName)
+	utils.Logger.Info(
+		fmt.Sprintf("<%s> parsing <%s>", utils.ERs, absPath))
+	var file *os.File
+	if file, err = os.Open(absPath); err != nil {
+		return
+	}
+	defer file.Close()
+	var ocfRdr *goavro.OCFReader
+	if ocfRdr, err = goavro.NewOCFReader(file); err != nil {
+		return
+	}
+	decScales := avroDecimalScales(ocfRdr.Codec().Schema())
+	rowNr := 0 // This counts the rows in the file, not really number of CDRs
+	evsPosted := 0
+	timeStart := time.Now()
+	reqVars := &utils.DataNode{Type: utils.NMMapType, Map: map[string]*utils.DataNode{utils.MetaFileName: utils.NewLeafNode(fName)}}
+	for ocfRdr.Scan() {
+		var datum any
+		if datum, err = ocfRdr.Read(); err != nil {
+			return
+		}
+		rowNr++ // increment the rowNr after checking if it's not the end of file
+		avroRec, canCast := datum.(map[string]any)
+		if !canCast {
+			return fmt.Errorf("unsupported record <%v> at row <%d>, expecting an Avro record", datum, rowNr)
+		}
+		record := make(map[string]any, len(avroRec))
+		for fld, val := range avroRec {
+			scale, has := decScales[fld]
+			if !has {
+				scale = -1
+			}
+			if val = avroGoValue(val, scale); val != nil {
+				record[fld] = val
+			}
+		}
+
+		agReq := agents.NewAgentRequest(
+			utils.MapStorage(record), reqVars,
+			nil, nil, nil, rdr.Config().Tenant,
+			rdr.cgrCfg.GeneralCfg().DefaultTenant,
+			utils.FirstNonEmpty(rdr.Config().Timezone,
+				rdr.cgrCfg.GeneralCfg().DefaultTimezone),
+			rdr.fltrS, nil) // create an AgentRequest
+		if pass, err := rdr.fltrS.Pass(agReq.Tenant, rdr.Config().Filters,
+			agReq); err != nil {
+			utils.Logger.Warning(
+				fmt.Sprintf("<%s> reading file: <%s> row <%d>, ignoring due to filter error: <%s>",
+					utils.ERs, absPath, rowNr, err.Error()))
+			return err
+		} else if !pass {
+			continue
+		}
+		if err = agReq.SetFields(rdr.Config().Fields); err != nil {
+			utils.Logger.Warning(
+				fmt.Sprintf("<%s> reading file: <%s> row <%d>, ignoring due to error: <%s>",
+					utils.ERs, absPath, rowNr, err.Error()))
+			return
+		}
+		cgrEv := utils.NMAsCGREvent(agReq.CGRRequest, agReq.Tenant, utils.NestingSep, agReq.Opts)
+		rdrEv := rdr.rdrEvents
+		if _, isPartial := cgrEv.APIOpts[utils.PartialOpt]; isPartial {
+			rdrEv = rdr.partialEvents
+		}
+		rdrEv <- &erEvent{
+			cgrEvent: cgrEv,
+			rdrCfg:   rdr.Config(),
+		}
+		evsPosted++
+	}
+	if err = ocfRdr.Err(); err != nil {
+		return
+	}
+	if rdr.Config().ProcessedPath != "" {
+		// Finished with file, move it to processed folder
+		outPath := path.Join(rdr.Config().ProcessedPath, fName)
+		if err = os.Rename(absPath, outPath); err != nil {
+			return
+		}
+	}
+
+	utils.Logger.Info(
+		fmt.Sprintf("%s finished processing file <%s>. Events posted: %d, run duration: %s",
+			utils.ERs, absPath, evsPosted, time.Since(timeStart)))
+	return
+}
+
+// avroDecimalScales returns the scale of the decimal fields of the record schema
+func avroDecimalScales(schema string) (scales map[string]int) {
+	scales = make(map[string]int)
+	var recSchema struct {
+		Fields []struct {
+			Name string
+			Type json.RawMessage
+		}
+	}
+	if json.Unmarshal([]byte(schema), &recSchema) != nil {
+		return
+	}
+	type decimalType struct {
+		LogicalType string
+		Scale       int
+	}
+	for _, fld := range recSchema.Fields {
+		fldTypes := []json.RawMessage{fld.Type}
+		if len(fld.Type) != 0 && fld.Type[0] == '[' { // union of types
+			if json.Unmarshal(fld.Type, &fldTypes) != nil {
+				continue
+			}
+		}
+		for _, rawType := range fldTypes {
+			var fldType decimalType
+			if json.Unmarshal(rawType, &fldType) == nil &&
+				fldType.LogicalType == "decimal" {
+				scales[fld.Name] = fldType.Scale
+			}
+		}
+	}
+	return
+}
+
+// avroGoValue unwraps the union values, decimals being returned as strings
+// with the scale from schema or the digits needed to represent them if the scale is negative
+func avroGoValue(val any, scale int) any {
+	if union, isUnion := val.(map[string]any); isUnion && len(union) == 1 {
+		for _, uVal := range union {
+			val = uVal
+		}
+	}
+	if rat, isRat := val.(*big.Rat); isRat {
+		if scale < 0 {
+			scale = 0
+			for scaled := new(big.Rat).Set(rat); !scaled.IsInt() &&
+				scale < utils.MaxDecimalPrecision; scale++ {
+				scaled.Mul(scaled, big.NewRat(10, 1))
+			}
+		}
+		return rat.FloatString(scale)
+	}
+	return val
+}
@@ -0,0 +1,64 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package ers
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestAvroFileERProcessFile(t *testing.T) {
+	testTypedFileER(t, utils.MetaFileAvro, NewAvroFileER,
+		func(rdr EventReader, fPath, fName string) error {
+			return rdr.(*AvroFileER).processFile(fPath, fName)
+		})
+}
+
+func TestAvroGoValue(t *testing.T) {
+	for _, tc := range []struct {
+		val   any
+		scale int
+		exp   any
+	}{
+		{val: nil, scale: -1, exp: nil},
+		{val: map[string]any{"string": "1001"}, scale: -1, exp: "1001"},
+		{val: map[string]any{"long": int64(60)}, scale: -1, exp: int64(60)},
+		{val: map[string]any{"bytes.decimal": big.NewRat(1, 8)}, scale: -1, exp: "0.125"},
+		{val: map[string]any{"bytes.decimal": big.NewRat(-12, 1)}, scale: -1, exp: "-12"},
+		{val: map[string]any{"bytes.decimal": big.NewRat(3, 25)}, scale: 4, exp: "0.1200"},
+	} {
+		if rcv := avroGoValue(tc.val, tc.scale); rcv != tc.exp {
+			t.Errorf("expected %v, received %v", tc.exp, rcv)
+		}
+	}
+}
+
+func TestAvroDecimalScales(t *testing.T) {
+	schema := `{"type":"record","name":"cdr","fields":[
+		{"name":"Cost","type":["null",{"type":"bytes","logicalType":"decimal","precision":18,"scale":2}]},
+		{"name":"Rate","type":{"type":"bytes","logicalType":"decimal","precision":10,"scale":6}},
+		{"name":"Account","type":"string"}]}`
+	exp := map[string]int{"Cost": 2, "Rate": 6}
+	if rcv := avroDecimalScales(schema); !reflect.DeepEqual(exp, rcv) {
+		t.Errorf("expected %v, received %v", exp, rcv)
+	}
+}
@@ -0,0 +1,288 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package ers
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cgrates/cgrates/agents"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+	"github.com/parquet-go/parquet-go"
+)
+
+func NewParquetFileER(cfg *config.CGRConfig, cfgIdx int,
+	rdrEvents, partialEvents chan *erEvent, rdrErr chan error,
+	fltrS *engine.FilterS, rdrExit chan struct{}) (er EventReader, err error) {
+	srcPath := cfg.ERsCfg().Readers[cfgIdx].SourcePath
+	if strings.HasSuffix(srcPath, utils.Slash) {
+		srcPath = srcPath[:len(srcPath)-1]
+	}
+	pqEr := &ParquetFileER{
+		cgrCfg:        cfg,
+		cfgIdx:        cfgIdx,
+		fltrS:         fltrS,
+		rdrDir:        srcPath,
+		rdrEvents:     rdrEvents,
+		partialEvents: partialEvents,
+		rdrError:      rdrErr,
+		rdrExit:       rdrExit,
+		conReqs:       make(chan struct{}, cfg.ERsCfg().Readers[cfgIdx].ConcurrentReqs)}
+	var processFile struct{}
+	for i := 0; i < cfg.ERsCfg().Readers[cfgIdx].ConcurrentReqs; i++ {
+		pqEr.conReqs <- processFile // Empty initiate so we do not need to wait later when we pop
+	}
+	return pqEr, nil
+}
+
+// ParquetFileER implements EventReader interface for .parquet files
+type ParquetFileER struct {
+	sync.RWMutex
+	cgrCfg        *config.CGRConfig
+	cfgIdx        int // index of config instance within ERsCfg.Readers
+	fltrS         *engine.FilterS
+	rdrDir        string
+	rdrEvents     chan *erEvent // channel to dispatch the events created to
+	partialEvents chan *erEvent // channel to dispatch the partial events created to
+	rdrError      chan error
+	rdrExit       chan struct{}
+	conReqs       chan struct{} // limit number of opened files
+}
+
+func (rdr *ParquetFileER) Config() *config.EventReaderCfg {
+	return rdr.cgrCfg.ERsCfg().Readers[rdr.cfgIdx]
+}
+
+func (rdr *ParquetFileER) serveDefault() {
+	tm := time.NewTimer(0)
+	for {
+		// Not automated, process and sleep approach
+		select {
+		case <-rdr.rdrExit:
+			tm.Stop()
+			utils.Logger.Info(
+				fmt.Sprintf("<%s> stop monitoring path <%s>",
+					utils.ERs, rdr.rdrDir))
+			return
+		case <-tm.C:
+		}
+		filesInDir, _ := os.ReadDir(rdr.rdrDir)
+		for _, file := range filesInDir {
+			if !strings.HasSuffix(file.Name(), utils.ParquetSuffix) { // hardcoded file extension for parquet event reader
+				continue // used in order to filter the files from directory
+			}
+			go func(fileName string) {
+				if err := rdr.processFile(rdr.rdrDir, fileName); err != nil {
+					utils.Logger.Warning(
+						fmt.Sprintf("<%s> processing file %s, error: %s",
+							utils.ERs, fileName, err.Error()))
+				}
+			}(file.Name())
+		}
+		tm.Reset(rdr.Config().RunDelay)
+	}
+}
+
+func (rdr *ParquetFileER) Serve() (err error) {
+	switch rdr.Config().RunDelay {
+	case time.Duration(0): // 0 disables the automatic read, maybe done per API
+		return
+	case time.Duration(-1):
+		return utils.WatchDir(rdr.rdrDir, rdr.processFile,
+			utils.ERs, rdr.rdrExit)
+	default:
+		go rdr.serveDefault()
+	}
+	return
+}
+
+// processFile is called for each file in a directory and dispatches erEvents from it
+func (rdr *ParquetFileER) processFile(fPath, fName string) (err error) {
+	if cap(rdr.conReqs) != 0 { // 0 goes for no limit
+		processFile := <-rdr.conReqs // Queue here for maxOpenFiles
+		defer func() { rdr.conReqs <- processFile }()
+	}
+	absPath := path.Join(fPath, fName)
+	utils.Logger.Info(
+		fmt.Sprintf("<%s> parsing <%s>", utils.ERs, absPath))
+	var file *os.File
+	if file, err = os.Open(absPath); err != nil {
+		return
+	}
+	defer file.Close()
+	var fInfo os.FileInfo
+	if fInfo, err = file.Stat(); err != nil {
+		return
+	}
+	var pqFile *parquet.File
+	if pqFile, err = parquet.OpenFile(file, fInfo.Size()); err != nil {
+		return
+	}
+	schema := pqFile.Schema()
+	leafs := make([]parquet.LeafColumn, len(schema.Columns()))
+	for i, colPath := range schema.Columns() {
+		leafs[i], _ = schema.Lookup(colPath...)
+	}
+	pqRdr := parquet.NewReader(pqFile)
+	defer pqRdr.Close()
+	rowNr := 0 // This counts the rows in the file, not really number of CDRs
+	evsPosted := 0
+	timeStart := time.Now()
+	reqVars := &utils.DataNode{Type: utils.NMMapType, Map: map[string]*utils.DataNode{utils.MetaFileName: utils.NewLeafNode(fName)}}
+	rows := make([]parquet.Row, 1)
+	for {
+		n, rdErr := pqRdr.ReadRows(rows)
+		if rdErr != nil && rdErr != io.EOF { // the rows read together with the error are not processed
+			return rdErr
+		}
+		if n == 0 {
+			if rdErr == io.EOF { // if it reaches the end of the file, return nil
+				break
+			}
+			continue
+		}
+		rowNr++ // increment the rowNr after checking if it's not the end of file
+		record := make(map[string]any)
+		for _, val := range rows[0] {
+			if val.IsNull() {
+				continue
+			}
+			leaf := leafs[val.Column()]
+			record[strings.Join(leaf.Path, utils.NestingSep)] = parquetGoValue(leaf.Node, val)
+		}
+
+		agReq := agents.NewAgentRequest(
+			utils.MapStorage(record), reqVars,
+			nil, nil, nil, rdr.Config().Tenant,
+			rdr.cgrCfg.GeneralCfg().DefaultTenant,
+			utils.FirstNonEmpty(rdr.Config().Timezone,
+				rdr.cgrCfg.GeneralCfg().DefaultTimezone),
+			rdr.fltrS, nil) // create an AgentRequest
+		if pass, err := rdr.fltrS.Pass(agReq.Tenant, rdr.Config().Filters,
+			agReq); err != nil {
+			utils.Logger.Warning(
+				fmt.Sprintf("<%s> reading file: <%s> row <%d>, ignoring due to filter error: <%s>",
+					utils.ERs, absPath, rowNr, err.Error()))
+			return err
+		} else if !pass {
+			continue
+		}
+		if err = agReq.SetFields(rdr.Config().Fields); err != nil {
+			utils.Logger.Warning(
+				fmt.Sprintf("<%s> reading file: <%s> row <%d>, ignoring due to error: <%s>",
+					utils.ERs, absPath, rowNr, err.Error()))
+			return
+		}
+		cgrEv := utils.NMAsCGREvent(agReq.CGRRequest, agReq.Tenant, utils.NestingSep, agReq.Opts)
+		rdrEv := rdr.rdrEvents
+		if _, isPartial := cgrEv.APIOpts[utils.PartialOpt]; isPartial {
+			rdrEv = rdr.partialEvents
+		}
+		rdrEv <- &erEvent{
+			cgrEvent: cgrEv,
+			rdrCfg:   rdr.Config(),
+		}
+		evsPosted++
+	}
+	if rdr.Config().ProcessedPath != "" {
+		// Finished with file, move it to processed folder
+		outPath := path.Join(rdr.Config().ProcessedPath, fName)
+		if err = os.Rename(absPath, outPath); err != nil {
+			return
+		}
+	}
+
+	utils.Logger.Info(
+		fmt.Sprintf("%s finished processing file <%s>. Events posted: %d, run duration: %s",
+			utils.ERs, absPath, evsPosted, time.Since(timeStart)))
+	return
+}
+
+// parquetGoValue converts the parquet value to the Go one based on the type of the column:
+// timestamps are returned as time.Time, decimals as strings
+func parquetGoValue(node parquet.Node, val parquet.Value) any {
+	if lt := node.Type().LogicalType(); lt != nil {
+		switch {
+		case lt.Timestamp != nil:
+			var ts int64
+			if val.Kind() == parquet.Int64 {
+				ts = val.Int64()
+			}
+			switch {
+			case lt.Timestamp.Unit.Millis != nil:
+				return time.UnixMilli(ts).UTC()
+			case lt.Timestamp.Unit.Nanos != nil:
+				return time.Unix(0, ts).UTC()
+			default:
+				return time.UnixMicro(ts).UTC()
+			}
+		case lt.Decimal != nil:
+			return decimalString(parquetUnscaled(val), int(lt.Decimal.Scale))
+		case lt.UTF8 != nil, lt.Enum != nil, lt.Json != nil:
+			return string(val.ByteArray())
+		}
+	}
+	switch val.Kind() {
+	case parquet.Boolean:
+		return val.Boolean()
+	case parquet.Int32:
+		return int64(val.Int32())
+	case parquet.Int64:
+		return val.Int64()
+	case parquet.Float:
+		return float64(val.Float())
+	case parquet.Double:
+		return val.Double()
+	case parquet.ByteArray, parquet.FixedLenByteArray:
+		return string(val.ByteArray())
+	default:
+		return val.String()
+	}
+}
+
+// parquetUnscaled returns the unscaled value of a decimal
+func parquetUnscaled(val parquet.Value) *big.Int {
+	switch val.Kind() {
+	case parquet.Int32:
+		return big.NewInt(int64(val.Int32()))
+	case parquet.Int64:
+		return big.NewInt(val.Int64())
+	}
+	// big-endian two's complement
+	b := val.ByteArray()
+	unscaled := new(big.Int).SetBytes(b)
+	if len(b) != 0 && b[0]&0x80 != 0 {
+		unscaled.Sub(unscaled, new(big.Int).Lsh(big.NewInt(1), uint(len(b)*8)))
+	}
+	return unscaled
+}
+
+// decimalString formats the unscaled value with the given digits after the decimal point
+func decimalString(unscaled *big.Int, scale int) string {
+	return new(big.Rat).SetFrac(unscaled,
+		new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale)), nil)).FloatString(scale)
+}
@@ -0,0 +1,147 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package ers
+
+import (
+	"os"
+	"path"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/ees"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// exportTypedFile exports a CDR with typed columns in the dir, returning the file name
+func exportTypedFile(t *testing.T, eeType, dir string) string {
+	t.Helper()
+	cfg := config.NewDefaultCGRConfig()
+	eeCfg := cfg.EEsCfg().GetDefaultExporter()
+	eeCfg.ID = "archive"
+	eeCfg.Type = eeType
+	eeCfg.ExportPath = dir
+	eeCfg.Fields = []*config.FCTemplate{
+		{Path: "*exp.OriginID", Type: utils.MetaVariable,
+			Value: config.NewRSRParsersMustCompile("~*req.OriginID", utils.InfieldSep)},
+		{Path: "*exp.AnswerTime", Type: utils.MetaVariable,
+			Value: config.NewRSRParsersMustCompile("~*req.AnswerTime", utils.InfieldSep)},
+		{Path: "*exp.Usage", Type: utils.MetaVariable,
+			Value: config.NewRSRParsersMustCompile("~*req.Usage", utils.InfieldSep)},
+		{Path: "*exp.Cost", Type: utils.MetaVariable,
+			Value: config.NewRSRParsersMustCompile("~*req.Cost", utils.InfieldSep)},
+	}
+	for _, fld := range eeCfg.Fields {
+		fld.ComputePath()
+	}
+	eeCfg.ComputeFields()
+	eeCfg.Opts.FileColumnTypes = map[string]string{
+		"AnswerTime": utils.MetaTimestamp,
+		"Usage":      utils.MetaDuration,
+		"Cost":       utils.MetaDecimal,
+	}
+	ee, err := ees.NewEventExporter(eeCfg, cfg, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec, err := ee.PrepareMap(&utils.CGREvent{
+		Tenant: "cgrates.org",
+		Event: map[string]any{
+			"OriginID":   "origin1",
+			"AnswerTime": time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			"Usage":      90 * time.Second,
+			"Cost":       0.12,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = ee.ExportEvent(rec, utils.EmptyString); err != nil {
+		t.Fatal(err)
+	}
+	if err = ee.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return path.Base(utils.IfaceAsString(ee.GetMetrics().MapStorage[utils.ExportPath]))
+}
+
+// testTypedFileER re-ingests the file exported by exportTypedFile with the reader of the given type
+func testTypedFileER(t *testing.T, rdrType string,
+	newER func(*config.CGRConfig, int, chan *erEvent, chan *erEvent, chan error,
+		*engine.FilterS, chan struct{}) (EventReader, error),
+	processFile func(EventReader, string, string) error) {
+	srcDir, procDir := t.TempDir(), t.TempDir()
+	fName := exportTypedFile(t, rdrType, srcDir)
+	cfg := config.NewDefaultCGRConfig()
+	cfg.ERsCfg().Readers[0].Type = rdrType
+	cfg.ERsCfg().Readers[0].SourcePath = srcDir
+	cfg.ERsCfg().Readers[0].ProcessedPath = procDir
+	cfg.ERsCfg().Readers[0].Tenant = config.NewRSRParsersMustCompile("cgrates.org", utils.InfieldSep)
+	cfg.ERsCfg().Readers[0].Fields = []*config.FCTemplate{
+		{Path: "*cgreq.OriginID", Type: utils.MetaVariable,
+			Value: config.NewRSRParsersMustCompile("~*req.OriginID", utils.InfieldSep)},
+		{Path: "*cgreq.AnswerTime", Type: utils.MetaVariable,
+			Value: config.NewRSRParsersMustCompile("~*req.AnswerTime", utils.InfieldSep)},
+		{Path: "*cgreq.Usage", Type: utils.MetaVariable,
+			Value: config.NewRSRParsersMustCompile("~*req.Usage", utils.InfieldSep)},
+		{Path: "*cgreq.Cost", Type: utils.MetaVariable,
+			Value: config.NewRSRParsersMustCompile("~*req.Cost", utils.InfieldSep)},
+		{Path: "*cgreq.FileName", Type: utils.MetaVariable,
+			Value: config.NewRSRParsersMustCompile("~*vars.*fileName", utils.InfieldSep)},
+	}
+	for _, fld := range cfg.ERsCfg().Readers[0].Fields {
+		fld.ComputePath()
+	}
+	dm := engine.NewDataManager(engine.NewInternalDB(nil, nil, true, cfg.DataDbCfg().Items), cfg.CacheCfg(), nil)
+	rdrEvents := make(chan *erEvent, 1)
+	rdr, err := newER(cfg, 0, rdrEvents, nil, nil, engine.NewFilterS(cfg, nil, dm), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = processFile(rdr, srcDir, fName); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case ev := <-rdrEvents:
+		exp := map[string]any{
+			"OriginID":   "origin1",
+			"AnswerTime": "2024-01-02T03:04:05Z",
+			"Usage":      "90000000000",
+			"Cost":       "0.1200",
+			"FileName":   fName,
+		}
+		if !reflect.DeepEqual(exp, ev.cgrEvent.Event) {
+			t.Errorf("expected %s, received %s", utils.ToJSON(exp), utils.ToJSON(ev.cgrEvent.Event))
+		}
+	default:
+		t.Fatal("no event read from file")
+	}
+	if _, err = os.Stat(path.Join(procDir, fName)); err != nil {
+		t.Errorf("expected file moved to the processed path, received %v", err)
+	}
+}
+
+func TestParquetFileERProcessFile(t *testing.T) {
+	testTypedFileER(t, utils.MetaFileParquet, NewParquetFileER,
+		func(rdr EventReader, fPath, fName string) error {
+			return rdr.(*ParquetFileER).processFile(fPath, fName)
+		})
+}
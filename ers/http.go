@@ -0,0 +1,248 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package ers
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/cgrates/cgrates/agents"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// processing status of the events received by the HTTPER
+const (
+	httpStatusPartial  = "PARTIAL"
+	httpStatusFiltered = "FILTERED"
+	httpStatusError    = "ERROR"
+)
+
+var errHTTPReaderStopped = errors.New("reader stopped")
+
+// NewHTTPER return a new HTTP event reader
+func NewHTTPER(cfg *config.CGRConfig, cfgIdx int,
+	rdrEvents, partialEvents chan *erEvent, rdrErr chan error,
+	fltrS *engine.FilterS, rdrExit chan struct{}) (EventReader, error) {
+	rdr := &HTTPER{
+		cgrCfg:        cfg,
+		cfgIdx:        cfgIdx,
+		fltrS:         fltrS,
+		rdrEvents:     rdrEvents,
+		partialEvents: partialEvents,
+		rdrExit:       rdrExit,
+		rdrErr:        rdrErr,
+	}
+	if concReq := rdr.Config().ConcurrentReqs; concReq != -1 {
+		rdr.cap = make(chan struct{}, concReq)
+		for i := 0; i < concReq; i++ {
+			rdr.cap <- struct{}{}
+		}
+	}
+	return rdr, nil
+}
+
+// HTTPER implements EventReader interface for events posted over HTTP
+// on the SourcePath of the engine's HTTP server
+type HTTPER struct {
+	cgrCfg *config.CGRConfig
+	cfgIdx int // index of config instance within ERsCfg.Readers
+	fltrS  *engine.FilterS
+
+	rdrEvents     chan *erEvent // channel to dispatch the events created to
+	partialEvents chan *erEvent // channel to dispatch the partial events created to
+	rdrExit       chan struct{}
+	rdrErr        chan error
+	cap           chan struct{}
+}
+
+// httpEventReply is the processing status of one event from the request
+type httpEventReply struct {
+	Status string
+	Error  string `json:",omitempty"`
+}
+
+// Config returns the curent configuration
+func (rdr *HTTPER) Config() *config.EventReaderCfg {
+	return rdr.cgrCfg.ERsCfg().Readers[rdr.cfgIdx]
+}
+
+// Serve is a no-op since the requests are received by the HTTP server
+// and passed to the reader through ServeHTTP
+func (rdr *HTTPER) Serve() (err error) {
+	return
+}
+
+// ServeHTTP processes the events from the request body and replies with their status
+func (rdr *HTTPER) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	if !rdr.authorize(r) {
+		if httpOpts := rdr.Config().Opts.HTTP; httpOpts != nil &&
+			httpOpts.Username != nil && *httpOpts.Username != utils.EmptyString {
+			w.Header().Set("WWW-Authenticate", `Basic realm="`+utils.CGRateS+`"`)
+		}
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+	select {
+	case <-rdr.rdrExit:
+		http.Error(w, errHTTPReaderStopped.Error(), http.StatusServiceUnavailable)
+		return
+	default:
+	}
+	if rdr.cap != nil {
+		select {
+		case <-rdr.cap:
+			defer func() { rdr.cap <- struct{}{} }()
+		case <-rdr.rdrExit:
+			http.Error(w, errHTTPReaderStopped.Error(), http.StatusServiceUnavailable)
+			return
+		case <-r.Context().Done():
+			return
+		}
+	}
+	evs, err := rdr.decodeRequest(r)
+	if err != nil {
+		utils.Logger.Warning(
+			fmt.Sprintf("<%s> decoding request from <%s> for reader <%s>, error: <%s>",
+				utils.ERs, r.RemoteAddr, rdr.Config().ID, err.Error()))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	replies := make([]*httpEventReply, len(evs))
+	for i, ev := range evs {
+		replies[i] = rdr.processEvent(ev)
+	}
+	w.Header().Set(utils.ContentType, utils.JsonBody)
+	if err = json.NewEncoder(w).Encode(replies); err != nil {
+		utils.Logger.Warning(
+			fmt.Sprintf("<%s> writing reply to <%s> for reader <%s>, error: <%s>",
+				utils.ERs, r.RemoteAddr, rdr.Config().ID, err.Error()))
+	}
+}
+
+// authorize checks the shared secret and the basic authentication credentials if configured
+func (rdr *HTTPER) authorize(r *http.Request) bool {
+	httpOpts := rdr.Config().Opts.HTTP
+	if httpOpts == nil {
+		return true
+	}
+	if httpOpts.Secret != nil && *httpOpts.Secret != utils.EmptyString {
+		hdr := utils.HTTPDefaultSecretHeader
+		if httpOpts.SecretHeader != nil && *httpOpts.SecretHeader != utils.EmptyString {
+			hdr = *httpOpts.SecretHeader
+		}
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get(hdr)), []byte(*httpOpts.Secret)) != 1 {
+			return false
+		}
+	}
+	if httpOpts.Username != nil && *httpOpts.Username != utils.EmptyString {
+		var passwd string
+		if httpOpts.Password != nil {
+			passwd = *httpOpts.Password
+		}
+		usr, pass, has := r.BasicAuth()
+		if !has ||
+			subtle.ConstantTimeCompare([]byte(usr), []byte(*httpOpts.Username)) != 1 ||
+			subtle.ConstantTimeCompare([]byte(pass), []byte(passwd)) != 1 {
+			return false
+		}
+	}
+	return true
+}
+
+// decodeRequest returns the events from the request, one for form data
+// and one or a batch of them for JSON bodies
+func (rdr *HTTPER) decodeRequest(r *http.Request) (evs []map[string]any, err error) {
+	if rdr.Config().Type == utils.MetaHTTPPost {
+		if err = r.ParseForm(); err != nil {
+			return
+		}
+		ev := make(map[string]any, len(r.PostForm))
+		for fld, vals := range r.PostForm {
+			if len(vals) != 0 {
+				ev[fld] = vals[0]
+			}
+		}
+		return []map[string]any{ev}, nil
+	}
+	var body []byte
+	if body, err = io.ReadAll(r.Body); err != nil {
+		return
+	}
+	if body = bytes.TrimSpace(body); len(body) != 0 && body[0] == '[' {
+		err = json.Unmarshal(body, &evs)
+		return
+	}
+	var ev map[string]any
+	if err = json.Unmarshal(body, &ev); err != nil {
+		return
+	}
+	return []map[string]any{ev}, nil
+}
+
+// processEvent passes the event through the filters and fields of the reader
+// and waits for ERs to process it
+func (rdr *HTTPER) processEvent(ev map[string]any) *httpEventReply {
+	agReq := agents.NewAgentRequest(
+		utils.MapStorage(ev), nil,
+		nil, nil, nil, rdr.Config().Tenant,
+		rdr.cgrCfg.GeneralCfg().DefaultTenant,
+		utils.FirstNonEmpty(rdr.Config().Timezone,
+			rdr.cgrCfg.GeneralCfg().DefaultTimezone),
+		rdr.fltrS, nil) // create an AgentRequest
+	if pass, err := rdr.fltrS.Pass(agReq.Tenant, rdr.Config().Filters,
+		agReq); err != nil {
+		return &httpEventReply{Status: httpStatusError, Error: err.Error()}
+	} else if !pass {
+		return &httpEventReply{Status: httpStatusFiltered}
+	}
+	if err := agReq.SetFields(rdr.Config().Fields); err != nil {
+		return &httpEventReply{Status: httpStatusError, Error: err.Error()}
+	}
+	cgrEv := utils.NMAsCGREvent(agReq.CGRRequest, agReq.Tenant, utils.NestingSep, agReq.Opts)
+	rdrEv, status := rdr.rdrEvents, utils.OK
+	if _, isPartial := cgrEv.APIOpts[utils.PartialOpt]; isPartial {
+		rdrEv, status = rdr.partialEvents, httpStatusPartial
+	}
+	done := make(chan error, 1)
+	select {
+	case rdrEv <- &erEvent{
+		cgrEvent: cgrEv,
+		rdrCfg:   rdr.Config(),
+		done:     done,
+	}:
+	case <-rdr.rdrExit:
+		return &httpEventReply{Status: httpStatusError, Error: errHTTPReaderStopped.Error()}
+	}
+	if err := <-done; err != nil {
+		return &httpEventReply{Status: httpStatusError, Error: err.Error()}
+	}
+	return &httpEventReply{Status: status}
+}
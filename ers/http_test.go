@@ -0,0 +1,159 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package ers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestHTTPERServeHTTP(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	fields := []*config.FCTemplate{
+		{
+			Tag:   utils.AccountField,
+			Path:  utils.MetaCgreq + utils.NestingSep + utils.AccountField,
+			Type:  utils.MetaVariable,
+			Value: config.NewRSRParsersMustCompile("~*req.Account", utils.InfieldSep),
+		},
+	}
+	for _, field := range fields {
+		field.ComputePath()
+	}
+	cfg.ERsCfg().Readers = append(cfg.ERsCfg().Readers,
+		&config.EventReaderCfg{
+			ID:             "http_json",
+			Type:           utils.MetaHTTPjson,
+			SourcePath:     "/webhook",
+			ConcurrentReqs: 2,
+			Tenant:         config.NewRSRParsersMustCompile("cgrates.org", utils.InfieldSep),
+			Filters:        []string{"*string:~*req.Account:1001|1002"},
+			Flags:          utils.FlagsWithParamsFromSlice([]string{utils.MetaNone}),
+			Fields:         fields,
+			Opts: &config.EventReaderOpts{
+				HTTP: &config.HTTPROpts{
+					Secret: utils.StringPointer("s3cr3t"),
+				},
+			},
+		},
+		&config.EventReaderCfg{
+			ID:             "http_post",
+			Type:           utils.MetaHTTPPost,
+			SourcePath:     "/form",
+			ConcurrentReqs: -1,
+			Tenant:         config.NewRSRParsersMustCompile("cgrates.org", utils.InfieldSep),
+			Fields:         fields,
+			Opts: &config.EventReaderOpts{
+				HTTP: &config.HTTPROpts{
+					Username: utils.StringPointer("cgrates"),
+					Password: utils.StringPointer("pass"),
+				},
+			},
+		})
+	dm := engine.NewDataManager(engine.NewInternalDB(nil, nil, true, cfg.DataDbCfg().Items), cfg.CacheCfg(), nil)
+	erS := NewERService(cfg, engine.NewFilterS(cfg, nil, dm), nil)
+	stopChan := make(chan struct{})
+	errChan := make(chan error, 1)
+	go func() { errChan <- erS.ListenAndServe(stopChan, make(chan struct{})) }()
+	srv := httptest.NewServer(erS)
+	defer srv.Close()
+
+	post := func(path, ctType, body string, setAuth func(*http.Request)) (*http.Response, []*httpEventReply) {
+		t.Helper()
+		req, err := http.NewRequest(http.MethodPost, srv.URL+path, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set(utils.ContentType, ctType)
+		if setAuth != nil {
+			setAuth(req)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		var replies []*httpEventReply
+		if resp.StatusCode == http.StatusOK {
+			if err = json.NewDecoder(resp.Body).Decode(&replies); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return resp, replies
+	}
+	withSecret := func(req *http.Request) { req.Header.Set(utils.HTTPDefaultSecretHeader, "s3cr3t") }
+
+	if resp, err := http.Get(srv.URL + "/webhook"); err != nil {
+		t.Fatal(err)
+	} else if resp.Body.Close(); resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("expected status %d, received %d", http.StatusMethodNotAllowed, resp.StatusCode)
+	}
+	if resp, _ := post("/webhook", utils.JsonBody, `{"Account":"1001"}`, nil); resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("expected status %d, received %d", http.StatusUnauthorized, resp.StatusCode)
+	}
+	if resp, _ := post("/unknown", utils.JsonBody, `{"Account":"1001"}`, withSecret); resp.StatusCode != http.StatusNotFound {
+		t.Errorf("expected status %d, received %d", http.StatusNotFound, resp.StatusCode)
+	}
+	if resp, _ := post("/webhook", utils.JsonBody, `{"Account":`, withSecret); resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected status %d, received %d", http.StatusBadRequest, resp.StatusCode)
+	}
+
+	exp := []*httpEventReply{{Status: utils.OK}}
+	if _, rply := post("/webhook", utils.JsonBody, `{"Account":"1001"}`, withSecret); !reflect.DeepEqual(exp, rply) {
+		t.Errorf("expected %s, received %s", utils.ToJSON(exp), utils.ToJSON(rply))
+	}
+	exp = []*httpEventReply{{Status: utils.OK}, {Status: httpStatusFiltered}, {Status: utils.OK}}
+	if _, rply := post("/webhook", utils.JsonBody,
+		`[{"Account":"1001"},{"Account":"1003"},{"Account":"1002"}]`, withSecret); !reflect.DeepEqual(exp, rply) {
+		t.Errorf("expected %s, received %s", utils.ToJSON(exp), utils.ToJSON(rply))
+	}
+
+	form := url.Values{utils.AccountField: {"1001"}}.Encode()
+	if resp, _ := post("/form", "application/x-www-form-urlencoded", form, func(req *http.Request) {
+		req.SetBasicAuth("cgrates", "wrong")
+	}); resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("expected status %d, received %d", http.StatusUnauthorized, resp.StatusCode)
+	}
+	exp = []*httpEventReply{{Status: httpStatusError, Error: "unsupported reqType: <>"}}
+	if _, rply := post("/form", "application/x-www-form-urlencoded", form, func(req *http.Request) {
+		req.SetBasicAuth("cgrates", "pass")
+	}); !reflect.DeepEqual(exp, rply) {
+		t.Errorf("expected %s, received %s", utils.ToJSON(exp), utils.ToJSON(rply))
+	}
+
+	close(stopChan)
+	if err := <-errChan; err != nil {
+		t.Error(err)
+	}
+	if resp, _ := post("/webhook", utils.JsonBody, `{"Account":"1001"}`, withSecret); resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("expected status %d, received %d", http.StatusServiceUnavailable, resp.StatusCode)
+	}
+	if processed, failed := erS.processed.Get("http_json"), erS.failed.Get("http_json"); processed != 3 || failed != 0 {
+		t.Errorf("expected 3 processed and 0 failed events, received %d and %d", processed, failed)
+	}
+}
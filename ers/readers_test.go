@@ -224,6 +224,7 @@ func TestNewAMQPReader(t *testing.T) {
 		SQL:   &config.SQLROpts{},
 		AWS:   &config.AWSROpts{},
 		NATS:  &config.NATSROpts{},
+		HTTP:  &config.HTTPROpts{},
 		Kafka: &config.KafkaROpts{},
 	})
 	exp.createPoster()
@@ -34,7 +34,8 @@ func TestIndexesRedis(t *testing.T) {
 	cfg := config.NewDefaultCGRConfig()
 	db, err := engine.NewRedisStorage(cfg.DataDbCfg().Host+":"+cfg.DataDbCfg().Port, 10, cfg.DataDbCfg().User,
 		cfg.DataDbCfg().Password, cfg.GeneralCfg().DBDataEncoding, 10, 20,
-		utils.EmptyString, false, 0, 0, 0, 0, 0, false, utils.EmptyString, utils.EmptyString, utils.EmptyString)
+		utils.EmptyString, false, 0, 0, 0, 0, 0, false, utils.EmptyString, utils.EmptyString, utils.EmptyString,
+		utils.MetaPrimary, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -0,0 +1,388 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package loaders
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// NewLoaderDiff returns an empty LoaderDiff
+func NewLoaderDiff() *LoaderDiff {
+	return &LoaderDiff{
+		Created:  make(map[string][]string),
+		Modified: make(map[string]map[string][]string),
+		Removed:  make(map[string][]string),
+		Errors:   make(map[string][]string),
+	}
+}
+
+// LoaderDiff lists the changes a load would apply on DataDB, indexed on loader type
+type LoaderDiff struct {
+	Created  map[string][]string            // IDs of the items not in DataDB
+	Modified map[string]map[string][]string // fields changed, indexed on the ID of the item
+	Removed  map[string][]string            // IDs of the items removed from DataDB
+	Errors   map[string][]string            // items or lines failing the validation
+}
+
+// has returns true if the item is created or modified by the load
+func (diff *LoaderDiff) has(ldrType, id string) (has bool) {
+	if _, has = diff.Modified[ldrType][id]; has {
+		return
+	}
+	return slices.Contains(diff.Created[ldrType], id)
+}
+
+func (diff *LoaderDiff) addCreated(ldrType, id string) {
+	if !slices.Contains(diff.Created[ldrType], id) {
+		diff.Created[ldrType] = append(diff.Created[ldrType], id)
+	}
+}
+
+func (diff *LoaderDiff) addModified(ldrType, id string, flds []string) {
+	if _, has := diff.Modified[ldrType]; !has {
+		diff.Modified[ldrType] = make(map[string][]string)
+	}
+	diff.Modified[ldrType][id] = flds
+}
+
+func (diff *LoaderDiff) addRemoved(ldrType, id string) {
+	if !slices.Contains(diff.Removed[ldrType], id) {
+		diff.Removed[ldrType] = append(diff.Removed[ldrType], id)
+	}
+}
+
+func (diff *LoaderDiff) addError(ldrType, id string, err error) {
+	diff.Errors[ldrType] = append(diff.Errors[ldrType], fmt.Sprintf("%s: %s", id, err.Error()))
+}
+
+// sort orders the IDs so the diff does not depend on the order of the records
+func (diff *LoaderDiff) sort() {
+	for _, ids := range diff.Created {
+		slices.Sort(ids)
+	}
+	for _, ids := range diff.Removed {
+		slices.Sort(ids)
+	}
+}
+
+// DiffFolder processes the content of the folder without writing it in DataDB or caches,
+// returning the changes the processing would apply
+func (ldr *Loader) DiffFolder(loadOption string) (diff *LoaderDiff, err error) {
+	if err = ldr.lockFolder(); err != nil {
+		return
+	}
+	defer ldr.unlockFolder()
+	ldr.rtData = newRatingData()
+	defer func() { // the rating data of the diff is not used by the loads
+		ldr.rtData = newRatingData()
+	}()
+	pulled := ldr.pullRemoteFiles()
+	defer ldr.remoteFilesDone(pulled, nil, nil) // none loaded, fetch the remote files again for the load
+	ldr.diff = NewLoaderDiff()
+	defer func() {
+		ldr.diff = nil
+	}()
+	for _, ldrType := range ldr.loaderTypes(loadOption) {
+		if err = ldr.processFiles(ldrType, utils.MetaNone, loadOption); err != nil {
+			if !os.IsNotExist(err) { // the types without files are not part of the load
+				ldr.diff.addError(ldrType, utils.MetaFile, err)
+			}
+			err = nil
+		}
+	}
+	diff = ldr.diff
+	diff.sort()
+	return
+}
+
+// diffItem adds the item to the diff, after validating it
+func (ldr *Loader) diffItem(ldrType, id string, itm any) {
+	if err := ldr.validateItem(ldrType, itm); err != nil {
+		ldr.diff.addError(ldrType, id, err)
+		return
+	}
+	old, err := ldr.getItem(ldrType, id)
+	if err == utils.ErrNotFound {
+		ldr.diff.addCreated(ldrType, id)
+		return
+	}
+	if err != nil {
+		ldr.diff.addError(ldrType, id, err)
+		return
+	}
+	if ldrType == utils.MetaAccountActions { // the account is updated with the actions, not replaced
+		ldr.diff.addModified(ldrType, id, nil)
+		return
+	}
+	if ap, canCast := itm.(*engine.ActionPlan); canCast {
+		old, itm = comparableActionPlan(old.(*engine.ActionPlan)), comparableActionPlan(ap)
+	}
+	flds, err := changedFields(old, itm)
+	if err != nil {
+		ldr.diff.addError(ldrType, id, err)
+		return
+	}
+	if len(flds) != 0 {
+		ldr.diff.addModified(ldrType, id, flds)
+	}
+}
+
+// diffRemovedItem adds the item to the diff if it is in DataDB
+func (ldr *Loader) diffRemovedItem(ldrType, id string) {
+	if _, err := ldr.getItem(ldrType, id); err == nil {
+		ldr.diff.addRemoved(ldrType, id)
+	} else if err != utils.ErrNotFound {
+		ldr.diff.addError(ldrType, id, err)
+	}
+}
+
+// getItem queries DataDB directly for the item, bypassing the caches
+func (ldr *Loader) getItem(ldrType, id string) (itm any, err error) {
+	dataDB := ldr.dm.DataDB()
+	tntID := utils.NewTenantID(id)
+	switch ldrType {
+	case utils.MetaAttributes:
+		return dataDB.GetAttributeProfileDrv(tntID.Tenant, tntID.ID)
+	case utils.MetaResources:
+		return dataDB.GetResourceProfileDrv(tntID.Tenant, tntID.ID)
+	case utils.MetaFilters:
+		return dataDB.GetFilterDrv(tntID.Tenant, tntID.ID)
+	case utils.MetaStats:
+		return dataDB.GetStatQueueProfileDrv(tntID.Tenant, tntID.ID)
+	case utils.MetaThresholds:
+		return dataDB.GetThresholdProfileDrv(tntID.Tenant, tntID.ID)
+	case utils.MetaRoutes:
+		return dataDB.GetRouteProfileDrv(tntID.Tenant, tntID.ID)
+	case utils.MetaChargers:
+		return dataDB.GetChargerProfileDrv(tntID.Tenant, tntID.ID)
+	case utils.MetaTaxProfiles:
+		return dataDB.GetTaxProfileDrv(tntID.Tenant, tntID.ID)
+	case utils.MetaDispatchers:
+		return dataDB.GetDispatcherProfileDrv(tntID.Tenant, tntID.ID)
+	case utils.MetaDispatcherHosts:
+		return dataDB.GetDispatcherHostDrv(tntID.Tenant, tntID.ID)
+	case utils.MetaExchangeRates:
+		return dataDB.GetExchangeRateDrv(tntID.Tenant, tntID.ID)
+	case utils.MetaCalendars:
+		return dataDB.GetCalendarDrv(tntID.Tenant, tntID.ID)
+	case utils.MetaTimings:
+		return dataDB.GetTimingDrv(id)
+	case utils.MetaDestinations:
+		return dataDB.GetDestinationDrv(id, utils.NonTransactional)
+	case utils.MetaRatingPlans:
+		return dataDB.GetRatingPlanDrv(id)
+	case utils.MetaRatingProfiles:
+		return dataDB.GetRatingProfileDrv(id)
+	case utils.MetaSharedGroups:
+		return dataDB.GetSharedGroupDrv(id)
+	case utils.MetaActions:
+		return dataDB.GetActionsDrv(id)
+	case utils.MetaActionPlans:
+		return dataDB.GetActionPlanDrv(id)
+	case utils.MetaActionTriggers:
+		return dataDB.GetActionTriggersDrv(id)
+	case utils.MetaAccountActions:
+		return dataDB.GetAccountDrv(id)
+	}
+	// the rates and destination rates are kept by the loader, not stored in DataDB
+	return nil, utils.ErrNotFound
+}
+
+// hasItem returns true if the item is part of the load or already in DataDB
+func (ldr *Loader) hasItem(ldrType, id string) (has bool, err error) {
+	if ldr.diff.has(ldrType, id) {
+		return true, nil
+	}
+	if _, err = ldr.getItem(ldrType, id); err == utils.ErrNotFound {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// checkFilterIDs verifies that the inline filters compile and the referenced filters exist
+func (ldr *Loader) checkFilterIDs(tnt string, fltrIDs []string) (err error) {
+	for _, fltrID := range fltrIDs {
+		if strings.HasPrefix(fltrID, utils.Meta) {
+			var fltr *engine.Filter
+			if fltr, err = engine.NewFilterFromInline(tnt, fltrID); err != nil {
+				return fmt.Errorf("broken reference to filter: <%s>", fltrID)
+			}
+			if err = engine.CheckFilter(fltr); err != nil {
+				return
+			}
+			continue
+		}
+		var has bool
+		if has, err = ldr.hasItem(utils.MetaFilters, utils.ConcatenatedKey(tnt, fltrID)); err != nil {
+			return
+		} else if !has {
+			return fmt.Errorf("broken reference to filter: <%s>", fltrID)
+		}
+	}
+	return
+}
+
+// checkActivationInterval verifies that the interval does not expire before being active
+func checkActivationInterval(aI *utils.ActivationInterval) error {
+	if aI != nil && !aI.ActivationTime.IsZero() && !aI.ExpiryTime.IsZero() &&
+		aI.ExpiryTime.Before(aI.ActivationTime) {
+		return fmt.Errorf("invalid ActivationInterval: expiry time before activation time")
+	}
+	return nil
+}
+
+// checkWeight verifies that the weight can be used for sorting
+func checkWeight(weight float64) error {
+	if math.IsNaN(weight) || math.IsInf(weight, 0) {
+		return fmt.Errorf("invalid Weight: %v", weight)
+	}
+	return nil
+}
+
+// validateItem checks the references and the values the engine would only reject at runtime
+func (ldr *Loader) validateItem(ldrType string, itm any) (err error) {
+	var tnt string
+	var fltrIDs []string
+	var aI *utils.ActivationInterval
+	var weights []float64
+	switch prf := itm.(type) {
+	case *engine.AttributeProfile:
+		tnt, fltrIDs, aI, weights = prf.Tenant, prf.FilterIDs, prf.ActivationInterval, []float64{prf.Weight}
+		for _, attr := range prf.Attributes {
+			fltrIDs = append(fltrIDs, attr.FilterIDs...)
+		}
+	case *engine.ResourceProfile:
+		tnt, fltrIDs, aI, weights = prf.Tenant, prf.FilterIDs, prf.ActivationInterval, []float64{prf.Weight}
+	case *engine.Filter:
+		return checkActivationInterval(prf.ActivationInterval)
+	case *engine.StatQueueProfile:
+		tnt, fltrIDs, aI, weights = prf.Tenant, prf.FilterIDs, prf.ActivationInterval, []float64{prf.Weight}
+	case *engine.ThresholdProfile:
+		tnt, fltrIDs, aI, weights = prf.Tenant, prf.FilterIDs, prf.ActivationInterval, []float64{prf.Weight}
+	case *engine.RouteProfile:
+		tnt, fltrIDs, aI, weights = prf.Tenant, prf.FilterIDs, prf.ActivationInterval, []float64{prf.Weight}
+		for _, rt := range prf.Routes {
+			fltrIDs = append(fltrIDs, rt.FilterIDs...)
+			weights = append(weights, rt.Weight)
+		}
+	case *engine.ChargerProfile:
+		tnt, fltrIDs, aI, weights = prf.Tenant, prf.FilterIDs, prf.ActivationInterval, []float64{prf.Weight}
+	case *engine.DispatcherProfile:
+		tnt, fltrIDs, aI, weights = prf.Tenant, prf.FilterIDs, prf.ActivationInterval, []float64{prf.Weight}
+		for _, conn := range prf.Hosts {
+			fltrIDs = append(fltrIDs, conn.FilterIDs...)
+			weights = append(weights, conn.Weight)
+		}
+	case *engine.RatingProfile:
+		for _, rpa := range prf.RatingPlanActivations {
+			if has, err := ldr.hasItem(utils.MetaRatingPlans, rpa.RatingPlanId); err != nil {
+				return err
+			} else if !has {
+				return fmt.Errorf("could not load rating plans for tag: %q", rpa.RatingPlanId)
+			}
+		}
+		return
+	case *engine.ActionPlan:
+		for _, at := range prf.ActionTimings {
+			if has, err := ldr.hasItem(utils.MetaActions, at.ActionsID); err != nil {
+				return err
+			} else if !has {
+				return fmt.Errorf("could not load the action for tag: %q", at.ActionsID)
+			}
+		}
+		return
+	default:
+		return
+	}
+	for _, weight := range weights {
+		if err = checkWeight(weight); err != nil {
+			return
+		}
+	}
+	if err = checkActivationInterval(aI); err != nil {
+		return
+	}
+	return ldr.checkFilterIDs(tnt, fltrIDs)
+}
+
+// comparableActionPlan returns the action plan without the data generated on each load
+// (the ActionTiming UUIDs) or kept from the one in DataDB (the accounts)
+func comparableActionPlan(ap *engine.ActionPlan) *engine.ActionPlan {
+	cmpAp := &engine.ActionPlan{
+		Id:            ap.Id,
+		ActionTimings: make([]*engine.ActionTiming, len(ap.ActionTimings)),
+	}
+	for i, at := range ap.ActionTimings {
+		cmpAp.ActionTimings[i] = &engine.ActionTiming{
+			Timing:    at.Timing,
+			ActionsID: at.ActionsID,
+			ExtraData: at.ExtraData,
+			Weight:    at.Weight,
+		}
+	}
+	return cmpAp
+}
+
+// changedFields returns the names of the fields which differ between the two items
+func changedFields(old, itm any) (flds []string, err error) {
+	var oldMp, itmMp map[string]any
+	if oldMp, err = asFieldsMap(old); err != nil {
+		return
+	}
+	if itmMp, err = asFieldsMap(itm); err != nil {
+		return
+	}
+	for fld, val := range itmMp {
+		if !reflect.DeepEqual(oldMp[fld], val) {
+			flds = append(flds, fld)
+		}
+	}
+	for fld := range oldMp {
+		if _, has := itmMp[fld]; !has {
+			flds = append(flds, fld)
+		}
+	}
+	slices.Sort(flds)
+	return
+}
+
+// asFieldsMap returns the exported fields of the item as they would be encoded
+func asFieldsMap(itm any) (mp map[string]any, err error) {
+	var b []byte
+	if b, err = json.Marshal(itm); err != nil {
+		return
+	}
+	if err = json.Unmarshal(b, &mp); err != nil { // the lists (ie: Actions) are compared as a whole
+		var val any
+		if err = json.Unmarshal(b, &val); err != nil {
+			return
+		}
+		mp = map[string]any{utils.MetaAny: val}
+	}
+	return
+}
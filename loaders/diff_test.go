@@ -0,0 +1,206 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package loaders
+
+import (
+	"math"
+	"os"
+	"path"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func newDiffLoader(t *testing.T) (ldr *Loader, dm *engine.DataManager) {
+	tpInDir := t.TempDir()
+	for fName, content := range map[string]string{
+		utils.FiltersCsv: `cgrates.org,FLTR_OLD,*string,~*req.Account,1002
+cgrates.org,FLTR_NEW,*prefix,~*req.Destination,+49
+`,
+		utils.AttributesCsv: `cgrates.org,ATTR_1,*any,FLTR_OLD,*req.Subject,*constant,1001,20
+cgrates.org,ATTR_2,*any,FLTR_OLD,*req.Subject,*constant,1002,10
+cgrates.org,ATTR_3,*any,FLTR_MISSING,*req.Subject,*constant,1003,10
+cgrates.org,ATTR_4,*any,*string:~*req.Account:1001,*req.Subject,*constant,1004,10
+`,
+	} {
+		if err := os.WriteFile(path.Join(tpInDir, fName), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	dm = engine.NewDataManager(engine.NewInternalDB(nil, nil, false, config.CgrConfig().DataDbCfg().Items), config.CgrConfig().CacheCfg(), nil)
+	if err := dm.SetFilter(&engine.Filter{
+		Tenant: "cgrates.org",
+		ID:     "FLTR_OLD",
+		Rules: []*engine.FilterRule{{
+			Type:    utils.MetaString,
+			Element: "~*req.Account",
+			Values:  []string{"1001"},
+		}},
+	}, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := dm.SetAttributeProfile(&engine.AttributeProfile{
+		Tenant:    "cgrates.org",
+		ID:        "ATTR_1",
+		Contexts:  []string{utils.MetaAny},
+		FilterIDs: []string{"FLTR_OLD"},
+		Attributes: []*engine.Attribute{{
+			FilterIDs: []string{},
+			Path:      "*req.Subject",
+			Type:      utils.MetaConstant,
+			Value:     config.NewRSRParsersMustCompile("1001", utils.InfieldSep),
+		}},
+		Weight: 10,
+	}, false); err != nil {
+		t.Fatal(err)
+	}
+	ldr = NewLoader(dm, &config.LoaderSCfg{
+		ID:             "TestLoaderDiff",
+		Enabled:        true,
+		FieldSeparator: utils.FieldsSep,
+		TpInDir:        tpInDir,
+		Data: []*config.LoaderDataType{
+			{
+				Type:     utils.MetaFilters,
+				Filename: utils.FiltersCsv,
+				Fields:   ratingTpl("Tenant", "ID", "Type", "Element", "Values"),
+			},
+			{
+				Type:     utils.MetaAttributes,
+				Filename: utils.AttributesCsv,
+				Fields:   ratingTpl("Tenant", "ID", "Contexts", "FilterIDs", "Path", "Type", "Value", "Weight"),
+			},
+		},
+	}, "UTC", nil, nil, nil)
+	return
+}
+
+func TestLoaderDiffFolderStore(t *testing.T) {
+	ldr, dm := newDiffLoader(t)
+	diff, err := ldr.DiffFolder(utils.MetaStore)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := &LoaderDiff{
+		Created: map[string][]string{
+			utils.MetaFilters:    {"cgrates.org:FLTR_NEW"},
+			utils.MetaAttributes: {"cgrates.org:ATTR_2", "cgrates.org:ATTR_4"},
+		},
+		Modified: map[string]map[string][]string{
+			utils.MetaFilters:    {"cgrates.org:FLTR_OLD": {"Rules"}},
+			utils.MetaAttributes: {"cgrates.org:ATTR_1": {"Weight"}},
+		},
+		Removed: map[string][]string{},
+		Errors: map[string][]string{
+			utils.MetaAttributes: {"cgrates.org:ATTR_3: broken reference to filter: <FLTR_MISSING>"},
+		},
+	}
+	if !reflect.DeepEqual(exp, diff) {
+		t.Errorf("Expected: %s, received: %s", utils.ToJSON(exp), utils.ToJSON(diff))
+	}
+	// nothing written
+	if attr, err := dm.DataDB().GetAttributeProfileDrv("cgrates.org", "ATTR_1"); err != nil {
+		t.Error(err)
+	} else if attr.Weight != 10 {
+		t.Errorf("Expected the AttributeProfile to not be updated, received: %s", utils.ToJSON(attr))
+	}
+	if _, err := dm.DataDB().GetFilterDrv("cgrates.org", "FLTR_NEW"); err != utils.ErrNotFound {
+		t.Errorf("Expected error: %v, received: %v", utils.ErrNotFound, err)
+	}
+	if _, has := engine.Cache.Get(utils.CacheFilters, "cgrates.org:FLTR_NEW"); has {
+		t.Error("Expected the Filter to not be cached")
+	}
+	// the files are kept for the load
+	if _, err := os.Stat(path.Join(ldr.tpInDir, utils.AttributesCsv)); err != nil {
+		t.Error(err)
+	}
+	if ldr.diff != nil {
+		t.Error("Expected the diff to be reset")
+	}
+}
+
+func TestLoaderDiffFolderRemove(t *testing.T) {
+	ldr, _ := newDiffLoader(t)
+	diff, err := ldr.DiffFolder(utils.MetaRemove)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := &LoaderDiff{
+		Created:  map[string][]string{},
+		Modified: map[string]map[string][]string{},
+		Removed: map[string][]string{
+			utils.MetaFilters:    {"cgrates.org:FLTR_OLD"},
+			utils.MetaAttributes: {"cgrates.org:ATTR_1"},
+		},
+		Errors: map[string][]string{},
+	}
+	if !reflect.DeepEqual(exp, diff) {
+		t.Errorf("Expected: %s, received: %s", utils.ToJSON(exp), utils.ToJSON(diff))
+	}
+}
+
+func TestLoaderValidateItem(t *testing.T) {
+	ldr := &Loader{
+		dm:   engine.NewDataManager(engine.NewInternalDB(nil, nil, false, config.CgrConfig().DataDbCfg().Items), config.CgrConfig().CacheCfg(), nil),
+		diff: NewLoaderDiff(),
+	}
+	ldr.diff.addCreated(utils.MetaActions, "ACT_1")
+	for _, tc := range []struct {
+		itm    any
+		expErr string
+	}{
+		{
+			itm: &engine.ChargerProfile{Tenant: "cgrates.org", ID: "CHRG_1",
+				ActivationInterval: &utils.ActivationInterval{
+					ActivationTime: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+					ExpiryTime:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				}},
+			expErr: "invalid ActivationInterval: expiry time before activation time",
+		},
+		{
+			itm:    &engine.ThresholdProfile{Tenant: "cgrates.org", ID: "TH_1", Weight: math.NaN()},
+			expErr: "invalid Weight: NaN",
+		},
+		{
+			itm:    &engine.StatQueueProfile{Tenant: "cgrates.org", ID: "SQ_1", FilterIDs: []string{"*string:~*req.Account"}},
+			expErr: "broken reference to filter: <*string:~*req.Account>",
+		},
+		{
+			itm: &engine.ActionPlan{Id: "AP_1", ActionTimings: []*engine.ActionTiming{
+				{ActionsID: "ACT_1"}, {ActionsID: "ACT_2"}}},
+			expErr: `could not load the action for tag: "ACT_2"`,
+		},
+		{
+			itm: &engine.ResourceProfile{Tenant: "cgrates.org", ID: "RES_1", Weight: 10,
+				FilterIDs: []string{"*string:~*req.Account:1001"}},
+		},
+	} {
+		if err := ldr.validateItem(utils.EmptyString, tc.itm); tc.expErr == utils.EmptyString {
+			if err != nil {
+				t.Error(err)
+			}
+		} else if err == nil || err.Error() != tc.expErr {
+			t.Errorf("Expected error: %s, received: %v", tc.expErr, err)
+		}
+	}
+}
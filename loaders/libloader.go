@@ -35,6 +35,26 @@ func (ld LoaderData) TenantID() string {
 		utils.IfaceAsString(ld[utils.ID]))
 }
 
+// GroupID returns the key grouping the rows of the same item for the loaderType
+func (ld LoaderData) GroupID(loaderType string) string {
+	switch loaderType {
+	case utils.MetaTimings, utils.MetaDestinations, utils.MetaRates,
+		utils.MetaDestinationRates, utils.MetaRatingPlans, utils.MetaActions,
+		utils.MetaActionPlans, utils.MetaActionTriggers, utils.MetaSharedGroups:
+		return utils.IfaceAsString(ld[utils.Tag])
+	case utils.MetaRatingProfiles:
+		return utils.ConcatenatedKey(utils.MetaOut, utils.IfaceAsString(ld[utils.Tenant]),
+			utils.IfaceAsString(ld[utils.Category]), utils.IfaceAsString(ld[utils.Subject]))
+	case utils.MetaAccountActions:
+		return utils.ConcatenatedKey(utils.IfaceAsString(ld[utils.Tenant]),
+			utils.IfaceAsString(ld[utils.AccountField]))
+	case utils.MetaExchangeRates:
+		return utils.ConcatenatedKey(utils.IfaceAsString(ld[utils.Tenant]),
+			utils.IfaceAsString(ld[utils.FromCurrency]), utils.IfaceAsString(ld[utils.ToCurrency]))
+	}
+	return ld.TenantID()
+}
+
 func (ld LoaderData) GetRateIDs() ([]string, error) {
 	if _, has := ld[utils.RateIDs]; !has {
 		return nil, fmt.Errorf("cannot find RateIDs in <%+v>", ld)
@@ -45,6 +65,34 @@ func (ld LoaderData) GetRateIDs() ([]string, error) {
 	return []string{}, nil
 }
 
+// calendarFromLoaderData merges the rows of one calendar, the Hours and
+// Holidays of each row being appended to the ones before
+func calendarFromLoaderData(lDataSet []LoaderData) (cal *engine.Calendar) {
+	cal = new(engine.Calendar)
+	for _, ld := range lDataSet {
+		if tnt := utils.IfaceAsString(ld[utils.Tenant]); tnt != utils.EmptyString {
+			cal.Tenant = tnt
+		}
+		if id := utils.IfaceAsString(ld[utils.ID]); id != utils.EmptyString {
+			cal.ID = id
+		}
+		if tz := utils.IfaceAsString(ld[utils.Timezone]); tz != utils.EmptyString {
+			cal.Timezone = tz
+		}
+		for _, hrs := range strings.Split(utils.IfaceAsString(ld[utils.Hours]), utils.InfieldSep) {
+			if hrs = strings.TrimSpace(hrs); hrs != utils.EmptyString {
+				cal.Hours = append(cal.Hours, hrs)
+			}
+		}
+		for _, day := range strings.Split(utils.IfaceAsString(ld[utils.Holidays]), utils.InfieldSep) {
+			if day = strings.TrimSpace(day); day != utils.EmptyString {
+				cal.Holidays = append(cal.Holidays, day)
+			}
+		}
+	}
+	return
+}
+
 // UpdateFromCSV will update LoaderData with data received from fileName,
 // contained in record and processed with cfgTpl
 func (ld LoaderData) UpdateFromCSV(fileName string, record []string,
@@ -282,6 +282,27 @@ func TestGetRateIDsLoaderData(t *testing.T) {
 	}
 }
 
+func TestLoaderDataGroupID(t *testing.T) {
+	ldrData := LoaderData{
+		utils.Tenant:       "cgrates.org",
+		utils.ID:           "ATTR_1",
+		utils.Tag:          "RP_1001",
+		utils.Category:     "call",
+		utils.Subject:      "1001",
+		utils.AccountField: "1002",
+	}
+	for ldrType, exp := range map[string]string{
+		utils.MetaAttributes:     "cgrates.org:ATTR_1",
+		utils.MetaRatingPlans:    "RP_1001",
+		utils.MetaRatingProfiles: "*out:cgrates.org:call:1001",
+		utils.MetaAccountActions: "cgrates.org:1002",
+	} {
+		if rcv := ldrData.GroupID(ldrType); rcv != exp {
+			t.Errorf("Expected %+v for %s, received %+v", exp, ldrType, rcv)
+		}
+	}
+}
+
 // func TestUpdateFromCsvParseValueError(t *testing.T) {
 // 	ldrData := LoaderData{
 // 		"File1.csv": []string{"Subject", "*any", "1001"},
@@ -387,3 +408,33 @@ func TestLoadersFieldAsInterfaceError(t *testing.T) {
 		t.Errorf("Expected %+v, received %+q", expected, err)
 	}
 }
+
+func TestCalendarFromLoaderData(t *testing.T) {
+	lDataSet := []LoaderData{
+		{
+			utils.Tenant:   "cgrates.org",
+			utils.ID:       "BUSINESS_HOURS",
+			utils.Timezone: "Europe/Berlin",
+			utils.Hours:    "* 9-16 * * 1-5;0-29 17 * * 1-5",
+			utils.Holidays: "12-25",
+		},
+		{
+			utils.Tenant:   "cgrates.org",
+			utils.ID:       "BUSINESS_HOURS",
+			utils.Holidays: "2026-04-06;12-26",
+		},
+	}
+	exp := &engine.Calendar{
+		Tenant:   "cgrates.org",
+		ID:       "BUSINESS_HOURS",
+		Timezone: "Europe/Berlin",
+		Hours:    []string{"* 9-16 * * 1-5", "0-29 17 * * 1-5"},
+		Holidays: []string{"12-25", "2026-04-06", "12-26"},
+	}
+	if rcv := calendarFromLoaderData(lDataSet); !reflect.DeepEqual(exp, rcv) {
+		t.Errorf("Expected %s, received %s", utils.ToJSON(exp), utils.ToJSON(rcv))
+	}
+	if grpID := lDataSet[1].GroupID(utils.MetaCalendars); grpID != "cgrates.org:BUSINESS_HOURS" {
+		t.Errorf("Unexpected GroupID: %s", grpID)
+	}
+}
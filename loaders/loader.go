@@ -55,12 +55,22 @@ func NewLoader(dm *engine.DataManager, cfg *config.LoaderSCfg,
 		flagsTpls:     make(map[string]utils.FlagsWithParams),
 		rdrs:          make(map[string]map[string]*openedCSVFile),
 		bufLoaderData: make(map[string][]LoaderData),
+		rtData:        newRatingData(),
 		dm:            dm,
 		timezone:      timezone,
 		filterS:       filterS,
 		connMgr:       connMgr,
 		cacheConns:    cacheConns,
 	}
+	for _, srcCfg := range cfg.RemoteSources {
+		src, err := newRemoteSource(srcCfg)
+		if err != nil {
+			utils.Logger.Warning(fmt.Sprintf("<%s-%s> cannot create remote source <%s>, err: %s",
+				utils.LoaderS, ldr.ldrID, srcCfg.URL, err.Error()))
+			continue
+		}
+		ldr.remoteSrcs = append(ldr.remoteSrcs, src)
+	}
 	for _, ldrData := range cfg.Data {
 		ldr.dataTpls[ldrData.Type] = ldrData.Fields
 		ldr.flagsTpls[ldrData.Type] = ldrData.Flags
@@ -98,6 +108,9 @@ type Loader struct {
 	flagsTpls     map[string]utils.FlagsWithParams     //map[loaderType]utils.FlagsWithParams
 	rdrs          map[string]map[string]*openedCSVFile // map[loaderType]map[fileName]*openedCSVFile for common incremental read
 	bufLoaderData map[string][]LoaderData              // cache of data read, indexed on tenantID
+	rtData        *ratingData                          // rating data of the current run, referenced by the items loaded after it
+	remoteSrcs    []remoteSource                       // remote locations the files are pulled from
+	diff          *LoaderDiff                          // changes collected instead of being written by DiffFolder
 	dm            *engine.DataManager
 	timezone      string
 	filterS       *engine.FilterS
@@ -116,8 +129,18 @@ func (ldr *Loader) ProcessFolder(caching, loadOption string, stopOnError bool) (
 		return
 	}
 	defer ldr.unlockFolder()
-	for ldrType := range ldr.rdrs {
+	ldr.rtData = newRatingData()
+	pulled := ldr.pullRemoteFiles()
+	loaded := utils.NewStringSet(nil) // files of the loader types processed without error
+	failed := utils.NewStringSet(nil) // files of the loader types not processed
+	defer func() {
+		ldr.remoteFilesDone(pulled, loaded, failed)
+	}()
+	for _, ldrType := range ldr.loaderTypes(loadOption) {
 		if err = ldr.processFiles(ldrType, caching, loadOption); err != nil {
+			for fName := range ldr.rdrs[ldrType] {
+				failed.Add(fName)
+			}
 			if stopOnError {
 				return
 			}
@@ -125,6 +148,9 @@ func (ldr *Loader) ProcessFolder(caching, loadOption string, stopOnError bool) (
 				utils.LoaderS, ldr.ldrID, ldrType, err.Error()))
 			continue
 		}
+		for fName := range ldr.rdrs[ldrType] {
+			loaded.Add(fName)
+		}
 	}
 	return ldr.moveFiles()
 }
@@ -234,6 +260,9 @@ func (ldr *Loader) processContent(loaderType, caching string) (err error) {
 				utils.Logger.Warning(
 					fmt.Sprintf("<%s> <%s> reading line: %d, error: %s",
 						utils.LoaderS, ldr.ldrID, lineNr, err.Error()))
+				if ldr.diff != nil {
+					ldr.diff.addError(loaderType, fmt.Sprintf("line %d", lineNr), err)
+				}
 			}
 			if hasErrors { // if any of the readers will give errors, we ignore the line
 				continue
@@ -244,6 +273,9 @@ func (ldr *Loader) processContent(loaderType, caching string) (err error) {
 				utils.Logger.Warning(
 					fmt.Sprintf("<%s> <%s> line: %d, error: %s",
 						utils.LoaderS, ldr.ldrID, lineNr, err.Error()))
+				if ldr.diff != nil {
+					ldr.diff.addError(loaderType, fmt.Sprintf("line %d", lineNr), err)
+				}
 				hasErrors = true
 				continue
 			}
@@ -253,7 +285,7 @@ func (ldr *Loader) processContent(loaderType, caching string) (err error) {
 		if len(lData) == 0 { // no data, could be the last line in file
 			continue
 		}
-		tntID := lData.TenantID()
+		tntID := lData.GroupID(loaderType)
 		if _, has := ldr.bufLoaderData[tntID]; !has &&
 			len(ldr.bufLoaderData) == 1 { // process previous records before going futher
 			var prevTntID string
@@ -283,6 +315,16 @@ func (ldr *Loader) processContent(loaderType, caching string) (err error) {
 
 func (ldr *Loader) storeLoadedData(loaderType string,
 	lds map[string][]LoaderData, caching string) (err error) {
+	if ldr.diff != nil {
+		defer func() { // the invalid items are part of the diff, continue with the next ones
+			if err != nil {
+				for tntID := range lds {
+					ldr.diff.addError(loaderType, tntID, err)
+				}
+				err = nil
+			}
+		}()
+	}
 	var ids []string
 	cacheArgs := make(map[string][]string)
 	var cacheIDs []string // verify if we need to clear indexe
@@ -302,6 +344,10 @@ func (ldr *Loader) storeLoadedData(loaderType string,
 				if err != nil {
 					return err
 				}
+				if ldr.diff != nil {
+					ldr.diffItem(utils.MetaAttributes, apf.TenantID(), apf)
+					continue
+				}
 				if ldr.dryRun {
 					utils.Logger.Info(
 						fmt.Sprintf("<%s-%s> DRY_RUN: AttributeProfile: %s",
@@ -332,6 +378,10 @@ func (ldr *Loader) storeLoadedData(loaderType string,
 				if err != nil {
 					return err
 				}
+				if ldr.diff != nil {
+					ldr.diffItem(utils.MetaResources, res.TenantID(), res)
+					continue
+				}
 				if ldr.dryRun {
 					utils.Logger.Info(
 						fmt.Sprintf("<%s-%s> DRY_RUN: ResourceProfile: %s",
@@ -362,6 +412,10 @@ func (ldr *Loader) storeLoadedData(loaderType string,
 				if err != nil {
 					return err
 				}
+				if ldr.diff != nil {
+					ldr.diffItem(utils.MetaFilters, fltrPrf.TenantID(), fltrPrf)
+					continue
+				}
 				if ldr.dryRun {
 					utils.Logger.Info(
 						fmt.Sprintf("<%s-%s> DRY_RUN: Filter: %s",
@@ -391,6 +445,10 @@ func (ldr *Loader) storeLoadedData(loaderType string,
 				if err != nil {
 					return err
 				}
+				if ldr.diff != nil {
+					ldr.diffItem(utils.MetaStats, stsPrf.TenantID(), stsPrf)
+					continue
+				}
 				if ldr.dryRun {
 					utils.Logger.Info(
 						fmt.Sprintf("<%s-%s> DRY_RUN: StatsQueueProfile: %s",
@@ -421,6 +479,10 @@ func (ldr *Loader) storeLoadedData(loaderType string,
 				if err != nil {
 					return err
 				}
+				if ldr.diff != nil {
+					ldr.diffItem(utils.MetaThresholds, thPrf.TenantID(), thPrf)
+					continue
+				}
 				if ldr.dryRun {
 					utils.Logger.Info(
 						fmt.Sprintf("<%s-%s> DRY_RUN: ThresholdProfile: %s",
@@ -452,6 +514,10 @@ func (ldr *Loader) storeLoadedData(loaderType string,
 				if err != nil {
 					return err
 				}
+				if ldr.diff != nil {
+					ldr.diffItem(utils.MetaRoutes, spPrf.TenantID(), spPrf)
+					continue
+				}
 				if ldr.dryRun {
 					utils.Logger.Info(
 						fmt.Sprintf("<%s-%s> DRY_RUN: RouteProfile: %s",
@@ -482,6 +548,10 @@ func (ldr *Loader) storeLoadedData(loaderType string,
 				if err != nil {
 					return err
 				}
+				if ldr.diff != nil {
+					ldr.diffItem(utils.MetaChargers, cpp.TenantID(), cpp)
+					continue
+				}
 				if ldr.dryRun {
 					utils.Logger.Info(
 						fmt.Sprintf("<%s-%s> DRY_RUN: ChargerProfile: %s",
@@ -496,6 +566,43 @@ func (ldr *Loader) storeLoadedData(loaderType string,
 				cacheArgs[utils.CacheChargerProfiles] = ids
 			}
 		}
+	case utils.MetaTaxProfiles:
+		cacheIDs = []string{utils.CacheTaxFilterIndexes}
+		for _, lDataSet := range lds {
+			txpModels := make(engine.TaxProfileMdls, len(lDataSet))
+			for i, ld := range lDataSet {
+				txpModels[i] = new(engine.TaxProfileMdl)
+				if err = utils.UpdateStructWithIfaceMap(txpModels[i], ld); err != nil {
+					return
+				}
+			}
+
+			for _, tpTxp := range txpModels.AsTPTaxProfiles() {
+				txp, err := engine.APItoTaxProfile(tpTxp, ldr.timezone)
+				if err != nil {
+					return err
+				}
+				if err = txp.Validate(); err != nil {
+					return err
+				}
+				if ldr.diff != nil {
+					ldr.diffItem(utils.MetaTaxProfiles, txp.TenantID(), txp)
+					continue
+				}
+				if ldr.dryRun {
+					utils.Logger.Info(
+						fmt.Sprintf("<%s-%s> DRY_RUN: TaxProfile: %s",
+							utils.LoaderS, ldr.ldrID, utils.ToJSON(txp)))
+					continue
+				}
+				// get IDs so we can reload in cache
+				ids = append(ids, txp.TenantID())
+				if err := ldr.dm.SetTaxProfile(txp, true); err != nil {
+					return err
+				}
+				cacheArgs[utils.CacheTaxProfiles] = ids
+			}
+		}
 	case utils.MetaDispatchers:
 		cacheIDs = []string{utils.CacheDispatcherFilterIndexes}
 		for _, lDataSet := range lds {
@@ -511,6 +618,10 @@ func (ldr *Loader) storeLoadedData(loaderType string,
 				if err != nil {
 					return err
 				}
+				if ldr.diff != nil {
+					ldr.diffItem(utils.MetaDispatchers, dsp.TenantID(), dsp)
+					continue
+				}
 				if ldr.dryRun {
 					utils.Logger.Info(
 						fmt.Sprintf("<%s-%s> DRY_RUN: DispatcherProfile: %s",
@@ -540,6 +651,10 @@ func (ldr *Loader) storeLoadedData(loaderType string,
 			}
 			for _, tpDsp := range tpDsps {
 				dsp := engine.APItoDispatcherHost(tpDsp)
+				if ldr.diff != nil {
+					ldr.diffItem(utils.MetaDispatcherHosts, dsp.TenantID(), dsp)
+					continue
+				}
 				if ldr.dryRun {
 					utils.Logger.Info(
 						fmt.Sprintf("<%s-%s> DRY_RUN: DispatcherHost: %s",
@@ -554,9 +669,403 @@ func (ldr *Loader) storeLoadedData(loaderType string,
 				cacheArgs[utils.CacheDispatcherHosts] = ids
 			}
 		}
+	case utils.MetaExchangeRates:
+		for _, lDataSet := range lds {
+			for _, ld := range lDataSet {
+				er := new(engine.ExchangeRate)
+				if err = utils.UpdateStructWithIfaceMap(er, ld); err != nil {
+					return
+				}
+				if err = er.Validate(); err != nil {
+					return
+				}
+				if ldr.diff != nil {
+					ldr.diffItem(utils.MetaExchangeRates, er.TenantID(), er)
+					continue
+				}
+				if ldr.dryRun {
+					utils.Logger.Info(
+						fmt.Sprintf("<%s-%s> DRY_RUN: ExchangeRate: %s",
+							utils.LoaderS, ldr.ldrID, utils.ToJSON(er)))
+					continue
+				}
+				// get IDs so we can reload in cache
+				ids = append(ids, er.TenantID())
+				if err := ldr.dm.SetExchangeRate(er); err != nil {
+					return err
+				}
+				cacheArgs[utils.CacheExchangeRates] = ids
+			}
+		}
+	case utils.MetaCalendars:
+		for _, lDataSet := range lds {
+			cal := calendarFromLoaderData(lDataSet)
+			if err = cal.Validate(); err != nil {
+				return
+			}
+			if ldr.diff != nil {
+				ldr.diffItem(utils.MetaCalendars, cal.TenantID(), cal)
+				continue
+			}
+			if ldr.dryRun {
+				utils.Logger.Info(
+					fmt.Sprintf("<%s-%s> DRY_RUN: Calendar: %s",
+						utils.LoaderS, ldr.ldrID, utils.ToJSON(cal)))
+				continue
+			}
+			// get IDs so we can reload in cache
+			ids = append(ids, cal.TenantID())
+			if err := ldr.dm.SetCalendar(cal); err != nil {
+				return err
+			}
+			cacheArgs[utils.CacheCalendars] = ids
+		}
+	case utils.MetaTimings:
+		for _, lDataSet := range lds {
+			tmMdls := make(engine.TimingMdls, len(lDataSet))
+			for i, ld := range lDataSet {
+				if err = utils.UpdateStructWithIfaceMap(&tmMdls[i], ld); err != nil {
+					return
+				}
+			}
+			tms, err := engine.MapTPTimings(tmMdls.AsTPTimings())
+			if err != nil {
+				return err
+			}
+			for _, tm := range tms {
+				ldr.rtData.timings[tm.ID] = tm
+				if ldr.diff != nil {
+					ldr.diffItem(utils.MetaTimings, tm.ID, tm)
+					continue
+				}
+				if ldr.dryRun {
+					utils.Logger.Info(
+						fmt.Sprintf("<%s-%s> DRY_RUN: Timing: %s",
+							utils.LoaderS, ldr.ldrID, utils.ToJSON(tm)))
+					continue
+				}
+				// get IDs so we can reload in cache
+				ids = append(ids, tm.ID)
+				if err := ldr.dm.SetTiming(tm); err != nil {
+					return err
+				}
+				cacheArgs[utils.CacheTimings] = ids
+			}
+		}
+	case utils.MetaDestinations:
+		for _, lDataSet := range lds {
+			dstMdls := make(engine.DestinationMdls, len(lDataSet))
+			for i, ld := range lDataSet {
+				if err = utils.UpdateStructWithIfaceMap(&dstMdls[i], ld); err != nil {
+					return
+				}
+			}
+			for _, tpDst := range dstMdls.AsTPDestinations() {
+				dst := engine.NewDestinationFromTPDestination(tpDst)
+				ldr.rtData.dstIDs.Add(dst.Id)
+				if ldr.diff != nil {
+					ldr.diffItem(utils.MetaDestinations, dst.Id, dst)
+					continue
+				}
+				if ldr.dryRun {
+					utils.Logger.Info(
+						fmt.Sprintf("<%s-%s> DRY_RUN: Destination: %s",
+							utils.LoaderS, ldr.ldrID, utils.ToJSON(dst)))
+					continue
+				}
+				// get IDs so we can reload in cache
+				ids = append(ids, dst.Id)
+				prfxs, err := ldr.setDestination(dst)
+				if err != nil {
+					return err
+				}
+				cacheArgs[utils.CacheDestinations] = ids
+				cacheArgs[utils.CacheReverseDestinations] = append(cacheArgs[utils.CacheReverseDestinations], prfxs...)
+			}
+		}
+	case utils.MetaRates:
+		for _, lDataSet := range lds {
+			rtMdls := make(engine.RateMdls, len(lDataSet))
+			for i, ld := range lDataSet {
+				if err = utils.UpdateStructWithIfaceMap(&rtMdls[i], ld); err != nil {
+					return
+				}
+			}
+			tpRts, err := rtMdls.AsTPRates()
+			if err != nil {
+				return err
+			}
+			for _, tpRt := range tpRts {
+				if ldr.dryRun {
+					utils.Logger.Info(
+						fmt.Sprintf("<%s-%s> DRY_RUN: Rate: %s",
+							utils.LoaderS, ldr.ldrID, utils.ToJSON(tpRt)))
+				}
+				// rates are not stored in DataDB, keep them for the destination rates
+				ldr.rtData.rates[tpRt.ID] = tpRt
+			}
+		}
+	case utils.MetaDestinationRates:
+		for _, lDataSet := range lds {
+			drMdls := make(engine.DestinationRateMdls, len(lDataSet))
+			for i, ld := range lDataSet {
+				if err = utils.UpdateStructWithIfaceMap(&drMdls[i], ld); err != nil {
+					return
+				}
+			}
+			for _, tpDr := range drMdls.AsTPDestinationRates() {
+				if err = ldr.checkDestinationRate(tpDr); err != nil {
+					return
+				}
+				if ldr.dryRun {
+					utils.Logger.Info(
+						fmt.Sprintf("<%s-%s> DRY_RUN: DestinationRate: %s",
+							utils.LoaderS, ldr.ldrID, utils.ToJSON(tpDr)))
+				}
+				// destination rates are not stored in DataDB, keep them for the rating plans
+				ldr.rtData.destRates[tpDr.ID] = tpDr
+			}
+		}
+	case utils.MetaRatingPlans:
+		for _, lDataSet := range lds {
+			rplMdls := make(engine.RatingPlanMdls, len(lDataSet))
+			for i, ld := range lDataSet {
+				if err = utils.UpdateStructWithIfaceMap(&rplMdls[i], ld); err != nil {
+					return
+				}
+			}
+			for tag, rplBnds := range engine.MapTPRatingPlanBindings(rplMdls.AsTPRatingPlans()) {
+				rpl, err := engine.APItoRatingPlan(tag, rplBnds, ldr.getTiming, ldr.getDestRate)
+				if err != nil {
+					return err
+				}
+				if ldr.diff != nil {
+					ldr.diffItem(utils.MetaRatingPlans, rpl.Id, rpl)
+					continue
+				}
+				if ldr.dryRun {
+					utils.Logger.Info(
+						fmt.Sprintf("<%s-%s> DRY_RUN: RatingPlan: %s",
+							utils.LoaderS, ldr.ldrID, utils.ToJSON(rpl)))
+					continue
+				}
+				// get IDs so we can reload in cache
+				ids = append(ids, rpl.Id)
+				if err := ldr.dm.SetRatingPlan(rpl); err != nil {
+					return err
+				}
+				cacheArgs[utils.CacheRatingPlans] = ids
+			}
+		}
+	case utils.MetaRatingProfiles:
+		for _, lDataSet := range lds {
+			rpfMdls := make(engine.RatingProfileMdls, len(lDataSet))
+			for i, ld := range lDataSet {
+				if err = utils.UpdateStructWithIfaceMap(&rpfMdls[i], ld); err != nil {
+					return
+				}
+			}
+			for _, tpRpf := range rpfMdls.AsTPRatingProfiles() {
+				rpf, err := engine.APItoRatingProfile(tpRpf, ldr.timezone)
+				if err != nil {
+					return err
+				}
+				if ldr.diff != nil {
+					ldr.diffItem(utils.MetaRatingProfiles, rpf.Id, rpf)
+					continue
+				}
+				if ldr.dryRun {
+					utils.Logger.Info(
+						fmt.Sprintf("<%s-%s> DRY_RUN: RatingProfile: %s",
+							utils.LoaderS, ldr.ldrID, utils.ToJSON(rpf)))
+					continue
+				}
+				for _, rpa := range rpf.RatingPlanActivations {
+					if has, err := ldr.dm.HasData(utils.RatingPlanPrefix, rpa.RatingPlanId, utils.EmptyString); err != nil {
+						return err
+					} else if !has {
+						return fmt.Errorf("could not load rating plans for tag: %q", rpa.RatingPlanId)
+					}
+				}
+				// get IDs so we can reload in cache
+				ids = append(ids, rpf.Id)
+				if err := ldr.dm.SetRatingProfile(rpf); err != nil {
+					return err
+				}
+				cacheArgs[utils.CacheRatingProfiles] = ids
+			}
+		}
+	case utils.MetaSharedGroups:
+		for _, lDataSet := range lds {
+			sgMdls := make(engine.SharedGroupMdls, len(lDataSet))
+			for i, ld := range lDataSet {
+				if err = utils.UpdateStructWithIfaceMap(&sgMdls[i], ld); err != nil {
+					return
+				}
+			}
+			for tag, tpSgs := range engine.MapTPSharedGroup(sgMdls.AsTPSharedGroups()) {
+				sg := &engine.SharedGroup{
+					Id:                tag,
+					AccountParameters: make(map[string]*engine.SharingParameters, len(tpSgs)),
+				}
+				for _, tpSg := range tpSgs {
+					sg.AccountParameters[tpSg.Account] = &engine.SharingParameters{
+						Strategy:      tpSg.Strategy,
+						RatingSubject: tpSg.RatingSubject,
+					}
+				}
+				if ldr.diff != nil {
+					ldr.diffItem(utils.MetaSharedGroups, sg.Id, sg)
+					continue
+				}
+				if ldr.dryRun {
+					utils.Logger.Info(
+						fmt.Sprintf("<%s-%s> DRY_RUN: SharedGroup: %s",
+							utils.LoaderS, ldr.ldrID, utils.ToJSON(sg)))
+					continue
+				}
+				// get IDs so we can reload in cache
+				ids = append(ids, sg.Id)
+				if err := ldr.dm.SetSharedGroup(sg); err != nil {
+					return err
+				}
+				cacheArgs[utils.CacheSharedGroups] = ids
+			}
+		}
+	case utils.MetaActions:
+		for _, lDataSet := range lds {
+			actMdls := make(engine.ActionMdls, len(lDataSet))
+			for i, ld := range lDataSet {
+				if err = utils.UpdateStructWithIfaceMap(&actMdls[i], ld); err != nil {
+					return
+				}
+			}
+			for tag, tpActs := range engine.MapTPActions(actMdls.AsTPActions()) {
+				acts, err := engine.APItoActions(tag, tpActs, ldr.getTiming)
+				if err != nil {
+					return err
+				}
+				if ldr.diff != nil {
+					ldr.diffItem(utils.MetaActions, tag, acts)
+					continue
+				}
+				if ldr.dryRun {
+					utils.Logger.Info(
+						fmt.Sprintf("<%s-%s> DRY_RUN: Actions: %s",
+							utils.LoaderS, ldr.ldrID, utils.ToJSON(acts)))
+					continue
+				}
+				// get IDs so we can reload in cache
+				ids = append(ids, tag)
+				if err := ldr.dm.SetActions(tag, acts); err != nil {
+					return err
+				}
+				cacheArgs[utils.CacheActions] = ids
+			}
+		}
+	case utils.MetaActionPlans:
+		for _, lDataSet := range lds {
+			apMdls := make(engine.ActionPlanMdls, len(lDataSet))
+			for i, ld := range lDataSet {
+				if err = utils.UpdateStructWithIfaceMap(&apMdls[i], ld); err != nil {
+					return
+				}
+			}
+			for tag, tpAts := range engine.MapTPActionTimings(apMdls.AsTPActionPlans()) {
+				ap, err := engine.APItoActionPlan(tag, tpAts, ldr.getTiming)
+				if err != nil {
+					return err
+				}
+				if ldr.diff != nil {
+					ldr.diffItem(utils.MetaActionPlans, ap.Id, ap)
+					continue
+				}
+				if ldr.dryRun {
+					utils.Logger.Info(
+						fmt.Sprintf("<%s-%s> DRY_RUN: ActionPlan: %s",
+							utils.LoaderS, ldr.ldrID, utils.ToJSON(ap)))
+					continue
+				}
+				for _, at := range ap.ActionTimings {
+					if has, err := ldr.dm.HasData(utils.ActionPrefix, at.ActionsID, utils.EmptyString); err != nil {
+						return err
+					} else if !has {
+						return fmt.Errorf("could not load the action for tag: %q", at.ActionsID)
+					}
+				}
+				// get IDs so we can reload in cache
+				ids = append(ids, ap.Id)
+				if err := ldr.setActionPlan(ap); err != nil {
+					return err
+				}
+				cacheArgs[utils.CacheActionPlans] = ids
+			}
+		}
+	case utils.MetaActionTriggers:
+		for _, lDataSet := range lds {
+			atrMdls := make(engine.ActionTriggerMdls, len(lDataSet))
+			for i, ld := range lDataSet {
+				if err = utils.UpdateStructWithIfaceMap(&atrMdls[i], ld); err != nil {
+					return
+				}
+			}
+			for tag, tpAtrs := range engine.MapTPActionTriggers(atrMdls.AsTPActionTriggers()) {
+				atrs, err := engine.APItoActionTriggers(tag, tpAtrs, ldr.timezone)
+				if err != nil {
+					return err
+				}
+				if ldr.diff != nil {
+					ldr.diffItem(utils.MetaActionTriggers, tag, atrs)
+					continue
+				}
+				if ldr.dryRun {
+					utils.Logger.Info(
+						fmt.Sprintf("<%s-%s> DRY_RUN: ActionTriggers: %s",
+							utils.LoaderS, ldr.ldrID, utils.ToJSON(atrs)))
+					continue
+				}
+				// get IDs so we can reload in cache
+				ids = append(ids, tag)
+				if err := ldr.dm.SetActionTriggers(tag, atrs); err != nil {
+					return err
+				}
+				cacheArgs[utils.CacheActionTriggers] = ids
+			}
+		}
+	case utils.MetaAccountActions:
+		for _, lDataSet := range lds {
+			aaMdls := make(engine.AccountActionMdls, len(lDataSet))
+			for i, ld := range lDataSet {
+				if err = utils.UpdateStructWithIfaceMap(&aaMdls[i], ld); err != nil {
+					return
+				}
+			}
+			for _, tpAa := range aaMdls.AsTPAccountActions() {
+				if ldr.diff != nil {
+					ldr.diffItem(utils.MetaAccountActions, tpAa.KeyId(), tpAa)
+					continue
+				}
+				if ldr.dryRun {
+					utils.Logger.Info(
+						fmt.Sprintf("<%s-%s> DRY_RUN: AccountActions: %s",
+							utils.LoaderS, ldr.ldrID, utils.ToJSON(tpAa)))
+					continue
+				}
+				// get IDs so we can reload in cache
+				ids = append(ids, tpAa.KeyId())
+				apIDs, err := ldr.setAccountActions(tpAa)
+				if err != nil {
+					return err
+				}
+				cacheArgs[utils.CacheAccountActionPlans] = ids
+				if len(apIDs) != 0 {
+					cacheArgs[utils.CacheActionPlans] = append(cacheArgs[utils.CacheActionPlans], apIDs...)
+				}
+			}
+		}
 	}
 
-	if len(ldr.cacheConns) != 0 {
+	if ldr.diff == nil && len(ldr.cacheConns) != 0 {
 		return engine.CallCache(ldr.connMgr, ldr.cacheConns, caching, cacheArgs, cacheIDs, nil, false, ldr.tenant)
 	}
 	return
@@ -582,6 +1091,9 @@ func (ldr *Loader) removeContent(loaderType, caching string) (err error) {
 				utils.Logger.Warning(
 					fmt.Sprintf("<%s> <%s> reading line: %d, error: %s",
 						utils.LoaderS, ldr.ldrID, lineNr, err.Error()))
+				if ldr.diff != nil {
+					ldr.diff.addError(loaderType, fmt.Sprintf("line %d", lineNr), err)
+				}
 			}
 			if hasErrors { // if any of the readers will give errors, we ignore the line
 				continue
@@ -592,6 +1104,9 @@ func (ldr *Loader) removeContent(loaderType, caching string) (err error) {
 				utils.Logger.Warning(
 					fmt.Sprintf("<%s> <%s> line: %d, error: %s",
 						utils.LoaderS, ldr.ldrID, lineNr, err.Error()))
+				if ldr.diff != nil {
+					ldr.diff.addError(loaderType, fmt.Sprintf("line %d", lineNr), err)
+				}
 				hasErrors = true
 				continue
 			}
@@ -601,7 +1116,7 @@ func (ldr *Loader) removeContent(loaderType, caching string) (err error) {
 		if len(lData) == 0 { // no data, could be the last line in file
 			continue
 		}
-		tntID := lData.TenantID()
+		tntID := lData.GroupID(loaderType)
 		if _, has := ldr.bufLoaderData[tntID]; !has &&
 			len(ldr.bufLoaderData) == 1 { // process previous records before going futher
 			var prevTntID string
@@ -632,6 +1147,14 @@ func (ldr *Loader) removeContent(loaderType, caching string) (err error) {
 // removeLoadedData will remove the data from database
 // since we remove we don't need to compose the struct we only need the Tenant and the ID of the profile
 func (ldr *Loader) removeLoadedData(loaderType string, lds map[string][]LoaderData, caching string) (err error) {
+	if ldr.diff != nil {
+		for tntID := range lds {
+			if tntID != utils.EmptyString {
+				ldr.diffRemovedItem(loaderType, tntID)
+			}
+		}
+		return
+	}
 	var ids []string
 	cacheArgs := make(map[string][]string)
 	var cacheIDs []string // verify if we need to clear indexe
@@ -766,6 +1289,24 @@ func (ldr *Loader) removeLoadedData(loaderType string, lds map[string][]LoaderDa
 				cacheArgs[utils.CacheChargerProfiles] = ids
 			}
 		}
+	case utils.MetaTaxProfiles:
+		cacheIDs = []string{utils.CacheTaxFilterIndexes}
+		for tntID := range lds {
+			if ldr.dryRun {
+				utils.Logger.Info(
+					fmt.Sprintf("<%s-%s> DRY_RUN: TaxProfileID: %s",
+						utils.LoaderS, ldr.ldrID, tntID))
+			} else {
+				tntIDStruct := utils.NewTenantID(tntID)
+				// get IDs so we can reload in cache
+				ids = append(ids, tntID)
+				if err := ldr.dm.RemoveTaxProfile(tntIDStruct.Tenant,
+					tntIDStruct.ID, true); err != nil {
+					return err
+				}
+				cacheArgs[utils.CacheTaxProfiles] = ids
+			}
+		}
 	case utils.MetaDispatchers:
 		cacheIDs = []string{utils.CacheDispatcherFilterIndexes}
 		for tntID := range lds {
@@ -801,6 +1342,208 @@ func (ldr *Loader) removeLoadedData(loaderType string, lds map[string][]LoaderDa
 				cacheArgs[utils.CacheDispatcherHosts] = ids
 			}
 		}
+	case utils.MetaExchangeRates:
+		for tntID := range lds {
+			if ldr.dryRun {
+				utils.Logger.Info(
+					fmt.Sprintf("<%s-%s> DRY_RUN: ExchangeRateID: %s",
+						utils.LoaderS, ldr.ldrID, tntID))
+			} else {
+				tntIDStruct := utils.NewTenantID(tntID)
+				// get IDs so we can reload in cache
+				ids = append(ids, tntID)
+				if err := ldr.dm.RemoveExchangeRate(tntIDStruct.Tenant,
+					tntIDStruct.ID); err != nil {
+					return err
+				}
+				cacheArgs[utils.CacheExchangeRates] = ids
+			}
+		}
+	case utils.MetaCalendars:
+		for tntID := range lds {
+			if ldr.dryRun {
+				utils.Logger.Info(
+					fmt.Sprintf("<%s-%s> DRY_RUN: CalendarID: %s",
+						utils.LoaderS, ldr.ldrID, tntID))
+			} else {
+				tntIDStruct := utils.NewTenantID(tntID)
+				// get IDs so we can reload in cache
+				ids = append(ids, tntID)
+				if err := ldr.dm.RemoveCalendar(tntIDStruct.Tenant,
+					tntIDStruct.ID); err != nil {
+					return err
+				}
+				cacheArgs[utils.CacheCalendars] = ids
+			}
+		}
+	case utils.MetaTimings:
+		for tag := range lds {
+			if ldr.dryRun {
+				utils.Logger.Info(
+					fmt.Sprintf("<%s-%s> DRY_RUN: TimingID: %s",
+						utils.LoaderS, ldr.ldrID, tag))
+			} else {
+				// get IDs so we can reload in cache
+				ids = append(ids, tag)
+				if err := ldr.dm.RemoveTiming(tag, utils.NonTransactional); err != nil {
+					return err
+				}
+				cacheArgs[utils.CacheTimings] = ids
+			}
+		}
+	case utils.MetaDestinations:
+		for tag := range lds {
+			if ldr.dryRun {
+				utils.Logger.Info(
+					fmt.Sprintf("<%s-%s> DRY_RUN: DestinationID: %s",
+						utils.LoaderS, ldr.ldrID, tag))
+			} else {
+				dst, err := ldr.dm.GetDestination(tag, false, false, utils.NonTransactional)
+				if err != nil {
+					return err
+				}
+				// get IDs so we can reload in cache
+				ids = append(ids, tag)
+				if err := ldr.dm.RemoveDestination(tag, utils.NonTransactional); err != nil {
+					return err
+				}
+				cacheArgs[utils.CacheDestinations] = ids
+				cacheArgs[utils.CacheReverseDestinations] = append(cacheArgs[utils.CacheReverseDestinations], dst.Prefixes...)
+			}
+		}
+	case utils.MetaRates:
+		for tag := range lds {
+			if ldr.dryRun {
+				utils.Logger.Info(
+					fmt.Sprintf("<%s-%s> DRY_RUN: RateID: %s",
+						utils.LoaderS, ldr.ldrID, tag))
+				continue
+			}
+			delete(ldr.rtData.rates, tag)
+		}
+	case utils.MetaDestinationRates:
+		for tag := range lds {
+			if ldr.dryRun {
+				utils.Logger.Info(
+					fmt.Sprintf("<%s-%s> DRY_RUN: DestinationRateID: %s",
+						utils.LoaderS, ldr.ldrID, tag))
+				continue
+			}
+			delete(ldr.rtData.destRates, tag)
+		}
+	case utils.MetaRatingPlans:
+		for tag := range lds {
+			if ldr.dryRun {
+				utils.Logger.Info(
+					fmt.Sprintf("<%s-%s> DRY_RUN: RatingPlanID: %s",
+						utils.LoaderS, ldr.ldrID, tag))
+			} else {
+				// get IDs so we can reload in cache
+				ids = append(ids, tag)
+				if err := ldr.dm.RemoveRatingPlan(tag, utils.NonTransactional); err != nil {
+					return err
+				}
+				cacheArgs[utils.CacheRatingPlans] = ids
+			}
+		}
+	case utils.MetaRatingProfiles:
+		for rpfID := range lds {
+			if ldr.dryRun {
+				utils.Logger.Info(
+					fmt.Sprintf("<%s-%s> DRY_RUN: RatingProfileID: %s",
+						utils.LoaderS, ldr.ldrID, rpfID))
+			} else {
+				// get IDs so we can reload in cache
+				ids = append(ids, rpfID)
+				if err := ldr.dm.RemoveRatingProfile(rpfID); err != nil {
+					return err
+				}
+				cacheArgs[utils.CacheRatingProfiles] = ids
+			}
+		}
+	case utils.MetaSharedGroups:
+		for tag := range lds {
+			if ldr.dryRun {
+				utils.Logger.Info(
+					fmt.Sprintf("<%s-%s> DRY_RUN: SharedGroupID: %s",
+						utils.LoaderS, ldr.ldrID, tag))
+			} else {
+				// get IDs so we can reload in cache
+				ids = append(ids, tag)
+				if err := ldr.dm.RemoveSharedGroup(tag, utils.NonTransactional); err != nil {
+					return err
+				}
+				cacheArgs[utils.CacheSharedGroups] = ids
+			}
+		}
+	case utils.MetaActions:
+		for tag := range lds {
+			if ldr.dryRun {
+				utils.Logger.Info(
+					fmt.Sprintf("<%s-%s> DRY_RUN: ActionsID: %s",
+						utils.LoaderS, ldr.ldrID, tag))
+			} else {
+				// get IDs so we can reload in cache
+				ids = append(ids, tag)
+				if err := ldr.dm.RemoveActions(tag); err != nil {
+					return err
+				}
+				cacheArgs[utils.CacheActions] = ids
+			}
+		}
+	case utils.MetaActionPlans:
+		for tag := range lds {
+			if ldr.dryRun {
+				utils.Logger.Info(
+					fmt.Sprintf("<%s-%s> DRY_RUN: ActionPlanID: %s",
+						utils.LoaderS, ldr.ldrID, tag))
+			} else {
+				// get IDs so we can reload in cache
+				ids = append(ids, tag)
+				acntIDs, err := ldr.removeActionPlan(tag)
+				if err != nil {
+					return err
+				}
+				cacheArgs[utils.CacheActionPlans] = ids
+				if len(acntIDs) != 0 {
+					cacheArgs[utils.CacheAccountActionPlans] = append(cacheArgs[utils.CacheAccountActionPlans], acntIDs...)
+				}
+			}
+		}
+	case utils.MetaActionTriggers:
+		for tag := range lds {
+			if ldr.dryRun {
+				utils.Logger.Info(
+					fmt.Sprintf("<%s-%s> DRY_RUN: ActionTriggersID: %s",
+						utils.LoaderS, ldr.ldrID, tag))
+			} else {
+				// get IDs so we can reload in cache
+				ids = append(ids, tag)
+				if err := ldr.dm.RemoveActionTriggers(tag, utils.NonTransactional); err != nil {
+					return err
+				}
+				cacheArgs[utils.CacheActionTriggers] = ids
+			}
+		}
+	case utils.MetaAccountActions:
+		for accID := range lds {
+			if ldr.dryRun {
+				utils.Logger.Info(
+					fmt.Sprintf("<%s-%s> DRY_RUN: AccountID: %s",
+						utils.LoaderS, ldr.ldrID, accID))
+			} else {
+				// get IDs so we can reload in cache
+				ids = append(ids, accID)
+				apIDs, err := ldr.removeAccount(accID)
+				if err != nil {
+					return err
+				}
+				cacheArgs[utils.CacheAccountActionPlans] = ids
+				if len(apIDs) != 0 {
+					cacheArgs[utils.CacheActionPlans] = append(cacheArgs[utils.CacheActionPlans], apIDs...)
+				}
+			}
+		}
 	}
 
 	if len(ldr.cacheConns) != 0 {
@@ -867,6 +1610,9 @@ func (ldr *Loader) processFile(_, itmID string) (err error) {
 	}
 
 	err = ldr.processContent(loaderType, config.CgrConfig().GeneralCfg().DefaultCaching)
+	if loaderType == utils.MetaRatingPlans { // the rating data is not referenced after the rating plans
+		ldr.rtData = newRatingData()
+	}
 
 	if ldr.tpOutDir == utils.EmptyString {
 		return
@@ -135,6 +135,47 @@ func (ldrS *LoaderService) V1Remove(ctx *context.Context, args *ArgsProcessFolde
 	return
 }
 
+// ArgsDryRun are the arguments of LoaderSv1.DryRun
+type ArgsDryRun struct {
+	LoaderID   string
+	ForceLock  bool
+	LoadOption string // *store(default) to diff LoaderSv1.Load or *remove to diff LoaderSv1.Remove
+}
+
+// V1DryRun processes the files of the loader without writing them, returning the changes on DataDB
+func (ldrS *LoaderService) V1DryRun(ctx *context.Context, args *ArgsDryRun,
+	rply *LoaderDiff) (err error) {
+	ldrS.RLock()
+	defer ldrS.RUnlock()
+	if args.LoaderID == "" {
+		args.LoaderID = utils.MetaDefault
+	}
+	ldr, has := ldrS.ldrs[args.LoaderID]
+	if !has {
+		return fmt.Errorf("UNKNOWN_LOADER: %s", args.LoaderID)
+	}
+	loadOption := utils.FirstNonEmpty(args.LoadOption, utils.MetaStore)
+	if loadOption != utils.MetaStore && loadOption != utils.MetaRemove {
+		return fmt.Errorf("unsupported load option: <%s>", loadOption)
+	}
+	if locked, err := ldr.isFolderLocked(); err != nil {
+		return utils.NewErrServerError(err)
+	} else if locked {
+		if !args.ForceLock {
+			return errors.New("ANOTHER_LOADER_RUNNING")
+		}
+		if err := ldr.unlockFolder(); err != nil {
+			return utils.NewErrServerError(err)
+		}
+	}
+	diff, err := ldr.DiffFolder(loadOption)
+	if err != nil {
+		return utils.NewErrServerError(err)
+	}
+	*rply = *diff
+	return
+}
+
 // Reload recreates the loaders map thread safe
 func (ldrS *LoaderService) Reload(dm *engine.DataManager, ldrsCfg []*config.LoaderSCfg,
 	timezone string, filterS *engine.FilterS, connMgr *engine.ConnManager) {
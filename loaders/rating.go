@@ -0,0 +1,305 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package loaders
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/guardian"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// ratingLoaderTypes are the rating and accounting loader types in the order of their dependencies
+var ratingLoaderTypes = []string{utils.MetaTimings, utils.MetaDestinations,
+	utils.MetaRates, utils.MetaDestinationRates, utils.MetaRatingPlans,
+	utils.MetaRatingProfiles, utils.MetaSharedGroups, utils.MetaActions,
+	utils.MetaActionPlans, utils.MetaActionTriggers, utils.MetaAccountActions}
+
+// loaderTypes returns the configured loader types in the order they need to be processed:
+// the rating and accounting ones after their dependencies on store and before them on remove
+func (ldr *Loader) loaderTypes(loadOption string) (ldrTypes []string) {
+	ldrTypes = make([]string, 0, len(ldr.rdrs))
+	for _, ldrType := range ratingLoaderTypes {
+		if _, has := ldr.rdrs[ldrType]; has {
+			ldrTypes = append(ldrTypes, ldrType)
+		}
+	}
+	if loadOption == utils.MetaRemove {
+		slices.Reverse(ldrTypes)
+	}
+	otherTypes := make([]string, 0, len(ldr.rdrs)-len(ldrTypes))
+	for ldrType := range ldr.rdrs {
+		if !slices.Contains(ratingLoaderTypes, ldrType) {
+			otherTypes = append(otherTypes, ldrType)
+		}
+	}
+	sort.Strings(otherTypes)
+	return append(ldrTypes, otherTypes...)
+}
+
+// ratingData are the rating items of one run of the loader, the rates and destination rates
+// not being stored in DataDB but referenced by the items processed after them in the same run.
+// The timings and destinations are staged as well, resolving the references of the diffs and dry runs
+type ratingData struct {
+	rates     map[string]*utils.TPRateRALs
+	destRates map[string]*utils.TPDestinationRate
+	timings   map[string]*utils.TPTiming
+	dstIDs    utils.StringSet
+}
+
+func newRatingData() *ratingData {
+	return &ratingData{
+		rates:     make(map[string]*utils.TPRateRALs),
+		destRates: make(map[string]*utils.TPDestinationRate),
+		timings:   make(map[string]*utils.TPTiming),
+		dstIDs:    make(utils.StringSet),
+	}
+}
+
+// getTiming returns the timing staged in the run or the one from DataDB, falling back on the default ones
+func (ldr *Loader) getTiming(timingID string) (tm *utils.TPTiming, err error) {
+	var has bool
+	if tm, has = ldr.rtData.timings[timingID]; has {
+		return
+	}
+	if tm, err = ldr.dm.GetTiming(timingID, false, utils.NonTransactional); err != utils.ErrNotFound {
+		return
+	}
+	if tm, has = engine.DefaultTimings()[timingID]; !has {
+		return nil, fmt.Errorf("could not get timing for tag %q", timingID)
+	}
+	return tm, nil
+}
+
+// getDestRate returns the loaded destination rate with the rates attached
+func (ldr *Loader) getDestRate(drID string) (*utils.TPDestinationRate, error) {
+	tpDr, has := ldr.rtData.destRates[drID]
+	if !has {
+		return nil, fmt.Errorf("could not find destination rate for tag %q", drID)
+	}
+	for _, dr := range tpDr.DestinationRates {
+		if dr.Rate, has = ldr.rtData.rates[dr.RateId]; !has {
+			return nil, fmt.Errorf("could not find rate for tag %q", dr.RateId)
+		}
+	}
+	return tpDr, nil
+}
+
+// checkDestinationRate verifies that the rates and destinations referenced by the destination rate exist
+func (ldr *Loader) checkDestinationRate(tpDr *utils.TPDestinationRate) (err error) {
+	for _, dr := range tpDr.DestinationRates {
+		if _, has := ldr.rtData.rates[dr.RateId]; !has {
+			return fmt.Errorf("could not find rate for tag %q", dr.RateId)
+		}
+		if dr.DestinationId == utils.MetaAny ||
+			ldr.rtData.dstIDs.Has(dr.DestinationId) { // staged in the same run
+			continue
+		}
+		var has bool
+		if has, err = ldr.dm.HasData(utils.DestinationPrefix, dr.DestinationId, utils.EmptyString); err != nil {
+			return
+		}
+		if !has {
+			return fmt.Errorf("could not get destination for tag %q", dr.DestinationId)
+		}
+	}
+	return
+}
+
+// setDestination stores the destination updating the reverse destinations,
+// returning the prefixes affected
+func (ldr *Loader) setDestination(dst *engine.Destination) (prfxs []string, err error) {
+	var oldDst *engine.Destination
+	if oldDst, err = ldr.dm.GetDestination(dst.Id, false, false,
+		utils.NonTransactional); err != nil && err != utils.ErrNotFound {
+		return
+	}
+	if err = ldr.dm.SetDestination(dst, utils.NonTransactional); err != nil {
+		return
+	}
+	if err = ldr.dm.UpdateReverseDestination(oldDst, dst, utils.NonTransactional); err != nil {
+		return
+	}
+	prfxs = dst.Prefixes
+	if oldDst != nil {
+		prfxs = append(slices.Clone(oldDst.Prefixes), prfxs...)
+	}
+	return
+}
+
+// setActionPlan stores the action plan keeping the accounts already attached to it
+func (ldr *Loader) setActionPlan(ap *engine.ActionPlan) error {
+	return guardian.Guardian.Guard(func() error {
+		return ldr.dm.SetActionPlan(ap.Id, ap, false, utils.NonTransactional)
+	}, config.CgrConfig().GeneralCfg().LockingTimeout, utils.ActionPlanPrefix)
+}
+
+// removeActionPlan removes the action plan detaching the accounts from it,
+// returning the IDs of the accounts affected
+func (ldr *Loader) removeActionPlan(apID string) (acntIDs []string, err error) {
+	err = guardian.Guardian.Guard(func() error {
+		ap, err := ldr.dm.GetActionPlan(apID, false, false, utils.NonTransactional)
+		if err != nil && err != utils.ErrNotFound {
+			return err
+		}
+		if err = ldr.dm.RemoveActionPlan(apID, utils.NonTransactional); err != nil {
+			return err
+		}
+		if ap == nil {
+			return nil
+		}
+		for acntID := range ap.AccountIDs {
+			if err = ldr.dm.RemAccountActionPlans(acntID, []string{apID}); err != nil &&
+				err != utils.ErrNotFound {
+				return err
+			}
+			acntIDs = append(acntIDs, acntID)
+		}
+		return nil
+	}, config.CgrConfig().GeneralCfg().LockingTimeout, utils.ActionPlanPrefix)
+	return
+}
+
+// setAccountActions creates or updates the account keeping its balances, attaches it to the
+// action plan (executing the *asap actions) and sets its action triggers,
+// returning the IDs of the action plans modified
+func (ldr *Loader) setAccountActions(aa *utils.TPAccountActions) (apIDs []string, err error) {
+	accID := aa.KeyId()
+	err = guardian.Guardian.Guard(func() error {
+		acc, err := ldr.dm.GetAccount(accID)
+		if err != nil {
+			if err != utils.ErrNotFound {
+				return err
+			}
+			acc = &engine.Account{ID: accID}
+		}
+		if aa.ActionPlanId != utils.EmptyString {
+			if apIDs, err = ldr.attachActionPlan(accID, aa.ActionPlanId); err != nil {
+				return err
+			}
+		}
+		if aa.ActionTriggersId != utils.EmptyString {
+			if acc.ActionTriggers, err = ldr.dm.GetActionTriggers(aa.ActionTriggersId,
+				false, utils.NonTransactional); err != nil {
+				return fmt.Errorf("could not get action triggers for tag %q: %v",
+					aa.ActionTriggersId, err)
+			}
+			acc.InitCounters()
+		}
+		acc.AllowNegative = aa.AllowNegative
+		acc.Disabled = aa.Disabled
+		return ldr.dm.SetAccount(acc)
+	}, config.CgrConfig().GeneralCfg().LockingTimeout, utils.AccountPrefix+accID)
+	return
+}
+
+// attachActionPlan moves the account on the action plan, returning the IDs of the action plans modified
+func (ldr *Loader) attachActionPlan(accID, apID string) (apIDs []string, err error) {
+	err = guardian.Guardian.Guard(func() error {
+		acntAPids, err := ldr.dm.GetAccountActionPlans(accID, false, false, utils.NonTransactional)
+		if err != nil && err != utils.ErrNotFound {
+			return err
+		}
+		dirtyActionPlans := make(map[string]*engine.ActionPlan)
+		// detach the account from the previous action plans
+		for _, prevAPID := range acntAPids {
+			if prevAPID == apID {
+				continue
+			}
+			ap, err := ldr.dm.GetActionPlan(prevAPID, false, false, utils.NonTransactional)
+			if err != nil {
+				return err
+			}
+			delete(ap.AccountIDs, accID)
+			dirtyActionPlans[prevAPID] = ap
+		}
+		if !slices.Contains(acntAPids, apID) {
+			ap, err := ldr.dm.GetActionPlan(apID, false, false, utils.NonTransactional)
+			if err != nil {
+				return fmt.Errorf("could not get action plan for tag %q: %v", apID, err)
+			}
+			if ap.AccountIDs == nil {
+				ap.AccountIDs = make(utils.StringMap)
+			}
+			ap.AccountIDs[accID] = true
+			dirtyActionPlans[apID] = ap
+			for _, at := range ap.ActionTimings {
+				if !at.IsASAP() {
+					continue
+				}
+				if err = ldr.dm.DataDB().PushTask(&engine.Task{
+					Uuid:      utils.GenUUID(),
+					AccountID: accID,
+					ActionsID: at.ActionsID,
+				}); err != nil {
+					return err
+				}
+			}
+		}
+		for dirtyAPID, ap := range dirtyActionPlans {
+			if err = ldr.dm.SetActionPlan(dirtyAPID, ap, true, utils.NonTransactional); err != nil {
+				return err
+			}
+			apIDs = append(apIDs, dirtyAPID)
+		}
+		return ldr.dm.SetAccountActionPlans(accID, []string{apID}, true)
+	}, config.CgrConfig().GeneralCfg().LockingTimeout, utils.ActionPlanPrefix)
+	return
+}
+
+// removeAccount removes the account detaching it from its action plans,
+// returning the IDs of the action plans modified
+func (ldr *Loader) removeAccount(accID string) (apIDs []string, err error) {
+	if err = guardian.Guardian.Guard(func() error {
+		if err := guardian.Guardian.Guard(func() error {
+			acntAPids, err := ldr.dm.GetAccountActionPlans(accID, false, false, utils.NonTransactional)
+			if err != nil && err != utils.ErrNotFound {
+				return err
+			}
+			for _, apID := range acntAPids {
+				ap, err := ldr.dm.GetActionPlan(apID, false, false, utils.NonTransactional)
+				if err != nil {
+					if err == utils.ErrNotFound {
+						continue
+					}
+					return err
+				}
+				delete(ap.AccountIDs, accID)
+				if err = ldr.dm.SetActionPlan(apID, ap, true, utils.NonTransactional); err != nil {
+					return err
+				}
+				apIDs = append(apIDs, apID)
+			}
+			return nil
+		}, config.CgrConfig().GeneralCfg().LockingTimeout, utils.ActionPlanPrefix); err != nil {
+			return err
+		}
+		return ldr.dm.RemoveAccount(accID)
+	}, config.CgrConfig().GeneralCfg().LockingTimeout, utils.AccountPrefix+accID); err != nil {
+		return
+	}
+	if err = ldr.dm.RemAccountActionPlans(accID, nil); err == utils.ErrNotFound {
+		err = nil
+	}
+	return
+}
@@ -0,0 +1,323 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package loaders
+
+import (
+	"encoding/csv"
+	"io"
+	"os"
+	"path"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// ratingTpl returns the template populating the paths from the columns in order
+func ratingTpl(paths ...string) (tpl []*config.FCTemplate) {
+	tpl = make([]*config.FCTemplate, len(paths))
+	for i, path := range paths {
+		tpl[i] = &config.FCTemplate{Path: path, Type: utils.MetaVariable,
+			Value: config.NewRSRParsersMustCompile("~*req."+strconv.Itoa(i), utils.InfieldSep)}
+	}
+	return
+}
+
+// ratingCSVs are the rating and accounting data in the order of their dependencies
+var ratingCSVs = []struct {
+	ldrType string
+	tpl     []*config.FCTemplate
+	content string
+}{
+	{utils.MetaTimings, ratingTpl("Tag", "Years", "Months", "MonthDays", "WeekDays", "Time"),
+		"WORKDAYS,*any,*any,*any,1;2;3;4;5,08:00:00\n"},
+	{utils.MetaDestinations, ratingTpl("Tag", "Prefix"),
+		"DST_1002,1002\nDST_1002,+491002\n"},
+	{utils.MetaRates, ratingTpl("Tag", "ConnectFee", "Rate", "RateUnit", "RateIncrement", "GroupIntervalStart"),
+		"RT_1CNT,0,0.01,60s,60s,0s\n"},
+	{utils.MetaDestinationRates, ratingTpl("Tag", "DestinationsTag", "RatesTag", "RoundingMethod",
+		"RoundingDecimals", "MaxCost", "MaxCostStrategy"),
+		"DR_1002,DST_1002,RT_1CNT,*up,4,0,\n"},
+	{utils.MetaRatingPlans, ratingTpl("Tag", "DestratesTag", "TimingTag", "Weight"),
+		"RP_1001,DR_1002,WORKDAYS,10\n"},
+	{utils.MetaRatingProfiles, ratingTpl("Tenant", "Category", "Subject", "ActivationTime",
+		"RatingPlanTag", "FallbackSubjects"),
+		"cgrates.org,call,1001,2014-01-14T00:00:00Z,RP_1001,\n"},
+	{utils.MetaSharedGroups, ratingTpl("Tag", "Account", "Strategy", "RatingSubject"),
+		"SG_1,*any,*lowest,\n"},
+	{utils.MetaActions, ratingTpl("Tag", "Action", "ExtraParameters", "Filters", "BalanceTag",
+		"BalanceType", "Categories", "DestinationTags", "RatingSubject", "SharedGroups", "ExpiryTime",
+		"TimingTags", "Units", "BalanceWeight", "BalanceBlocker", "BalanceDisabled", "Weight"),
+		"ACT_TOPUP,*topup_reset,,,MONETARY,*monetary,,*any,,,*unlimited,,10,10,false,false,10\n"},
+	{utils.MetaActionPlans, ratingTpl("Tag", "ActionsTag", "TimingTag", "Weight"),
+		"AP_PACKAGE,ACT_TOPUP,*asap,10\n"},
+	{utils.MetaActionTriggers, ratingTpl("Tag", "UniqueId", "ThresholdType", "ThresholdValue",
+		"Recurrent", "MinSleep", "ExpiryTime", "ActivationTime", "BalanceTag", "BalanceType",
+		"BalanceCategories", "BalanceDestinationTags", "BalanceRatingSubject", "BalanceSharedGroups",
+		"BalanceExpiryTime", "BalanceTimingTags", "BalanceWeight", "BalanceBlocker", "BalanceDisabled",
+		"ActionsTag", "Weight"),
+		"STANDARD_TRIGGERS,TRG1,*min_balance,2,false,0,,,,*monetary,,,,,,,,,,ACT_TOPUP,10\n"},
+	{utils.MetaAccountActions, ratingTpl("Tenant", "Account", "ActionPlanTag", "ActionTriggersTag",
+		"AllowNegative", "Disabled"),
+		"cgrates.org,1001,AP_PACKAGE,STANDARD_TRIGGERS,false,false\n"},
+}
+
+// newRatingLoader returns a loader with the templates for all the rating and accounting data
+func newRatingLoader() *Loader {
+	ldr := &Loader{
+		ldrID:         "TestLoaderRating",
+		dm:            engine.NewDataManager(engine.NewInternalDB(nil, nil, false, config.CgrConfig().DataDbCfg().Items), config.CgrConfig().CacheCfg(), nil),
+		bufLoaderData: make(map[string][]LoaderData),
+		rtData:        newRatingData(),
+		timezone:      "UTC",
+		dataTpls:      make(map[string][]*config.FCTemplate),
+		rdrs:          make(map[string]map[string]*openedCSVFile),
+	}
+	for _, rCSV := range ratingCSVs {
+		ldr.dataTpls[rCSV.ldrType] = rCSV.tpl
+	}
+	return ldr
+}
+
+// setRatingReaders opens the CSV content for each of the rating and accounting data
+func setRatingReaders(ldr *Loader) {
+	for _, rCSV := range ratingCSVs {
+		rdr := io.NopCloser(strings.NewReader(rCSV.content))
+		csvRdr := csv.NewReader(rdr)
+		csvRdr.Comment = '#'
+		ldr.rdrs[rCSV.ldrType] = map[string]*openedCSVFile{
+			rCSV.ldrType + utils.CSVSuffix: {fileName: rCSV.ldrType + utils.CSVSuffix,
+				rdr: rdr, csvRdr: csvRdr}}
+	}
+}
+
+func TestLoaderProcessRatingData(t *testing.T) {
+	ldr := newRatingLoader()
+	setRatingReaders(ldr)
+	for _, ldrType := range ldr.loaderTypes(utils.MetaStore) {
+		if err := ldr.processContent(ldrType, utils.EmptyString); err != nil {
+			t.Fatalf("loaderType: %s, error: %v", ldrType, err)
+		}
+	}
+
+	if rcv, err := ldr.dm.GetReverseDestination("+491002", false, false, utils.NonTransactional); err != nil {
+		t.Error(err)
+	} else if !reflect.DeepEqual([]string{"DST_1002"}, rcv) {
+		t.Errorf("expecting: %+v, received: %+v", []string{"DST_1002"}, rcv)
+	}
+	rpl, err := ldr.dm.GetRatingPlan("RP_1001", true, utils.NonTransactional)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ris := rpl.RateIntervalList("DST_1002"); len(ris) != 1 {
+		t.Errorf("expecting one rate interval, received: %s", utils.ToJSON(ris))
+	} else if ris[0].Timing.StartTime != "08:00:00" ||
+		ris[0].Rating.Rates[0].Value != 0.01 ||
+		ris[0].Rating.RoundingMethod != utils.MetaRoundingUp {
+		t.Errorf("unexpected rate interval: %s", utils.ToJSON(ris[0]))
+	}
+	if rpf, err := ldr.dm.GetRatingProfile("*out:cgrates.org:call:1001", true, utils.NonTransactional); err != nil {
+		t.Error(err)
+	} else if len(rpf.RatingPlanActivations) != 1 ||
+		rpf.RatingPlanActivations[0].RatingPlanId != "RP_1001" {
+		t.Errorf("unexpected rating profile: %s", utils.ToJSON(rpf))
+	}
+	if sg, err := ldr.dm.GetSharedGroup("SG_1", true, utils.NonTransactional); err != nil {
+		t.Error(err)
+	} else if sg.AccountParameters[utils.MetaAny].Strategy != "*lowest" {
+		t.Errorf("unexpected shared group: %s", utils.ToJSON(sg))
+	}
+	if acts, err := ldr.dm.GetActions("ACT_TOPUP", true, utils.NonTransactional); err != nil {
+		t.Error(err)
+	} else if len(acts) != 1 || acts[0].ActionType != utils.MetaTopUpReset ||
+		acts[0].Balance.GetValue() != 10 {
+		t.Errorf("unexpected actions: %s", utils.ToJSON(acts))
+	}
+
+	acc, err := ldr.dm.GetAccount("cgrates.org:1001")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(acc.ActionTriggers) != 1 || acc.ActionTriggers[0].UniqueID != "TRG1" {
+		t.Errorf("unexpected action triggers: %s", utils.ToJSON(acc.ActionTriggers))
+	}
+	if ap, err := ldr.dm.GetActionPlan("AP_PACKAGE", false, false, utils.NonTransactional); err != nil {
+		t.Error(err)
+	} else if !ap.AccountIDs.HasKey("cgrates.org:1001") {
+		t.Errorf("account not attached to the action plan: %s", utils.ToJSON(ap))
+	}
+	if apIDs, err := ldr.dm.GetAccountActionPlans("cgrates.org:1001", false, false, utils.NonTransactional); err != nil {
+		t.Error(err)
+	} else if !reflect.DeepEqual([]string{"AP_PACKAGE"}, apIDs) {
+		t.Errorf("expecting: %+v, received: %+v", []string{"AP_PACKAGE"}, apIDs)
+	}
+	if task, err := ldr.dm.DataDB().PopTask(); err != nil {
+		t.Error(err)
+	} else if task.AccountID != "cgrates.org:1001" || task.ActionsID != "ACT_TOPUP" {
+		t.Errorf("unexpected task: %s", utils.ToJSON(task))
+	}
+
+	// remove everything in the reverse order
+	setRatingReaders(ldr)
+	for _, ldrType := range ldr.loaderTypes(utils.MetaRemove) {
+		if err := ldr.removeContent(ldrType, utils.EmptyString); err != nil {
+			t.Fatalf("loaderType: %s, error: %v", ldrType, err)
+		}
+	}
+	if _, err := ldr.dm.GetAccount("cgrates.org:1001"); err != utils.ErrNotFound {
+		t.Errorf("expected error: %v, received: %v", utils.ErrNotFound, err)
+	}
+	if _, err := ldr.dm.GetActionPlan("AP_PACKAGE", false, false, utils.NonTransactional); err != utils.ErrNotFound {
+		t.Errorf("expected error: %v, received: %v", utils.ErrNotFound, err)
+	}
+	if _, err := ldr.dm.GetRatingPlan("RP_1001", true, utils.NonTransactional); err != utils.ErrNotFound {
+		t.Errorf("expected error: %v, received: %v", utils.ErrNotFound, err)
+	}
+	if _, err := ldr.dm.GetReverseDestination("1002", false, false, utils.NonTransactional); err != utils.ErrNotFound {
+		t.Errorf("expected error: %v, received: %v", utils.ErrNotFound, err)
+	}
+	if len(ldr.rtData.rates) != 0 || len(ldr.rtData.destRates) != 0 {
+		t.Errorf("expected empty rates, received: %s and %s", utils.ToJSON(ldr.rtData.rates), utils.ToJSON(ldr.rtData.destRates))
+	}
+}
+
+func TestLoaderProcessRatingPlansMissingRates(t *testing.T) {
+	ldr := newRatingLoader()
+	setRatingReaders(ldr)
+	expErr := `could not find rate for tag "RT_1CNT"`
+	if err := ldr.processContent(utils.MetaDestinationRates, utils.EmptyString); err == nil ||
+		err.Error() != expErr {
+		t.Errorf("expected error: %s, received: %v", expErr, err)
+	}
+}
+
+// newRatingFolderLoader returns a loader processing the rating data written in its folder
+func newRatingFolderLoader(t *testing.T) (ldr *Loader, dm *engine.DataManager) {
+	tpInDir := t.TempDir()
+	var data []*config.LoaderDataType
+	for _, rCSV := range ratingCSVs[:5] { // up to the rating plans
+		fName := rCSV.ldrType + utils.CSVSuffix
+		if err := os.WriteFile(path.Join(tpInDir, fName), []byte(rCSV.content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		data = append(data, &config.LoaderDataType{
+			Type:     rCSV.ldrType,
+			Filename: fName,
+			Fields:   rCSV.tpl,
+		})
+	}
+	dm = engine.NewDataManager(engine.NewInternalDB(nil, nil, false, config.CgrConfig().DataDbCfg().Items), config.CgrConfig().CacheCfg(), nil)
+	ldr = NewLoader(dm, &config.LoaderSCfg{
+		ID:             "TestLoaderRatingFolder",
+		Enabled:        true,
+		FieldSeparator: utils.FieldsSep,
+		TpInDir:        tpInDir,
+		Data:           data,
+	}, "UTC", nil, nil, nil)
+	return
+}
+
+func TestLoaderProcessFolderRatingDataPerRun(t *testing.T) {
+	ldr, dm := newRatingFolderLoader(t)
+	if err := ldr.ProcessFolder(utils.MetaNone, utils.MetaStore, true); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := dm.GetRatingPlan("RP_1001", true, utils.NonTransactional); err != nil {
+		t.Fatal(err)
+	}
+	// the rates of the previous run are not used by the next one
+	for _, ldrType := range []string{utils.MetaRates, utils.MetaDestinationRates} {
+		if err := os.WriteFile(path.Join(ldr.tpInDir, ldrType+utils.CSVSuffix), nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	expErr := `could not find destination rate for tag "DR_1002"`
+	if err := ldr.ProcessFolder(utils.MetaNone, utils.MetaStore, true); err == nil ||
+		err.Error() != expErr {
+		t.Errorf("expected error: %s, received: %v", expErr, err)
+	}
+}
+
+func TestLoaderDiffFolderRatingData(t *testing.T) {
+	ldr, dm := newRatingFolderLoader(t)
+	// the timings and destinations are referenced out of the same run
+	diff, err := ldr.DiffFolder(utils.MetaStore)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := &LoaderDiff{
+		Created: map[string][]string{
+			utils.MetaTimings:      {"WORKDAYS"},
+			utils.MetaDestinations: {"DST_1002"},
+			utils.MetaRatingPlans:  {"RP_1001"},
+		},
+		Modified: map[string]map[string][]string{},
+		Removed:  map[string][]string{},
+		Errors:   map[string][]string{},
+	}
+	if !reflect.DeepEqual(exp, diff) {
+		t.Errorf("Expected: %s, received: %s", utils.ToJSON(exp), utils.ToJSON(diff))
+	}
+	ldr.dryRun = true
+	if err := ldr.ProcessFolder(utils.MetaNone, utils.MetaStore, true); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := dm.GetRatingPlan("RP_1001", true, utils.NonTransactional); err != utils.ErrNotFound {
+		t.Errorf("expected error: %v, received: %v", utils.ErrNotFound, err)
+	}
+	// the rates of the diff and dry runs are not used by the loads
+	ldr.dryRun = false
+	for _, ldrType := range []string{utils.MetaRates, utils.MetaDestinationRates} {
+		if err := os.WriteFile(path.Join(ldr.tpInDir, ldrType+utils.CSVSuffix), nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	expErr := `could not find destination rate for tag "DR_1002"`
+	if err := ldr.ProcessFolder(utils.MetaNone, utils.MetaStore, true); err == nil ||
+		err.Error() != expErr {
+		t.Errorf("expected error: %s, received: %v", expErr, err)
+	}
+}
+
+func TestLoaderLoaderTypes(t *testing.T) {
+	ldr := &Loader{
+		rdrs: map[string]map[string]*openedCSVFile{
+			utils.MetaAccountActions: nil,
+			utils.MetaAttributes:     nil,
+			utils.MetaRates:          nil,
+			utils.MetaFilters:        nil,
+			utils.MetaActionPlans:    nil,
+			utils.MetaTimings:        nil,
+		},
+	}
+	exp := []string{utils.MetaTimings, utils.MetaRates, utils.MetaActionPlans,
+		utils.MetaAccountActions, utils.MetaAttributes, utils.MetaFilters}
+	if rcv := ldr.loaderTypes(utils.MetaStore); !reflect.DeepEqual(exp, rcv) {
+		t.Errorf("expecting: %+v, received: %+v", exp, rcv)
+	}
+	exp = []string{utils.MetaAccountActions, utils.MetaActionPlans, utils.MetaRates,
+		utils.MetaTimings, utils.MetaAttributes, utils.MetaFilters}
+	if rcv := ldr.loaderTypes(utils.MetaRemove); !reflect.DeepEqual(exp, rcv) {
+		t.Errorf("expecting: %+v, received: %+v", exp, rcv)
+	}
+}
@@ -0,0 +1,316 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package loaders
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// remoteSource pulls the loader files from a remote location
+type remoteSource interface {
+	// fetch writes the remote file at fPath if it changed since the last fetch, returning true if it did
+	fetch(fName, fPath string) (bool, error)
+	// done applies the processed action on the remote file once it was loaded,
+	// otherwise forgets it so it is fetched again
+	done(fName string, loaded bool) error
+}
+
+// remoteFile is a file pulled from one of the remote sources
+type remoteFile struct {
+	src   remoteSource
+	fName string
+}
+
+func newRemoteSource(cfg *config.LoaderSourceCfg) (remoteSource, error) {
+	switch cfg.Type {
+	case utils.MetaS3:
+		return newS3Source(cfg)
+	case utils.MetaHTTP:
+		return newHTTPSource(cfg), nil
+	}
+	return nil, fmt.Errorf("unsupported remote source type: <%s>", cfg.Type)
+}
+
+// writeFile writes the content of the reader at fPath
+func writeFile(fPath string, rdr io.Reader) (err error) {
+	var f *os.File
+	if f, err = os.Create(fPath); err != nil {
+		return
+	}
+	if _, err = io.Copy(f, rdr); err != nil {
+		f.Close()
+		return
+	}
+	return f.Close()
+}
+
+type s3Client interface {
+	GetObject(*s3.GetObjectInput) (*s3.GetObjectOutput, error)
+	CopyObject(*s3.CopyObjectInput) (*s3.CopyObjectOutput, error)
+	DeleteObject(*s3.DeleteObjectInput) (*s3.DeleteObjectOutput, error)
+}
+
+func newS3Source(cfg *config.LoaderSourceCfg) (src *s3Source, err error) {
+	awsCfg := aws.Config{
+		Endpoint:         aws.String(cfg.URL),
+		S3ForcePathStyle: aws.Bool(true), // the S3-compatible storages do not always resolve the bucket subdomains
+	}
+	if len(cfg.AWSRegion) != 0 {
+		awsCfg.Region = aws.String(cfg.AWSRegion)
+	}
+	if len(cfg.AWSKey) != 0 &&
+		len(cfg.AWSSecret) != 0 {
+		awsCfg.Credentials = credentials.NewStaticCredentials(cfg.AWSKey, cfg.AWSSecret, cfg.AWSToken)
+	}
+	var sess *session.Session
+	if sess, err = session.NewSessionWithOptions(session.Options{Config: awsCfg}); err != nil {
+		return
+	}
+	return &s3Source{
+		cfg:    cfg,
+		client: s3.New(sess),
+		etags:  make(map[string]string),
+	}, nil
+}
+
+// s3Source pulls the files from a bucket of an S3-compatible object storage
+type s3Source struct {
+	cfg    *config.LoaderSourceCfg
+	client s3Client
+	mx     sync.Mutex
+	etags  map[string]string // ETags of the objects fetched, indexed on file name
+}
+
+func (src *s3Source) key(fName string) string {
+	return path.Join(src.cfg.S3Prefix, fName)
+}
+
+func (src *s3Source) fetch(fName, fPath string) (fetched bool, err error) {
+	src.mx.Lock()
+	defer src.mx.Unlock()
+	input := &s3.GetObjectInput{
+		Bucket: aws.String(src.cfg.S3Bucket),
+		Key:    aws.String(src.key(fName)),
+	}
+	if etag, has := src.etags[fName]; has {
+		input.IfNoneMatch = aws.String(etag)
+	}
+	var obj *s3.GetObjectOutput
+	if obj, err = src.client.GetObject(input); err != nil {
+		if reqErr, canCast := err.(awserr.RequestFailure); canCast &&
+			(reqErr.StatusCode() == http.StatusNotModified ||
+				reqErr.StatusCode() == http.StatusNotFound) {
+			return false, nil
+		}
+		return
+	}
+	defer obj.Body.Close()
+	if err = writeFile(fPath, obj.Body); err != nil {
+		return
+	}
+	if obj.ETag != nil {
+		src.etags[fName] = *obj.ETag
+	}
+	return true, nil
+}
+
+func (src *s3Source) done(fName string, loaded bool) (err error) {
+	src.mx.Lock()
+	defer src.mx.Unlock()
+	if !loaded {
+		delete(src.etags, fName)
+		return
+	}
+	switch src.cfg.ProcessedAction {
+	case utils.MetaArchive:
+		if _, err = src.client.CopyObject(&s3.CopyObjectInput{
+			Bucket:     aws.String(src.cfg.S3Bucket),
+			CopySource: aws.String(url.PathEscape(path.Join(src.cfg.S3Bucket, src.key(fName)))),
+			Key:        aws.String(path.Join(src.cfg.S3ArchivePrefix, fName)),
+		}); err != nil {
+			return
+		}
+		fallthrough
+	case utils.MetaDelete:
+		if _, err = src.client.DeleteObject(&s3.DeleteObjectInput{
+			Bucket: aws.String(src.cfg.S3Bucket),
+			Key:    aws.String(src.key(fName)),
+		}); err != nil {
+			return
+		}
+		delete(src.etags, fName) // an object uploaded again with the same content needs to be loaded
+	}
+	return
+}
+
+func newHTTPSource(cfg *config.LoaderSourceCfg) *httpSource {
+	return &httpSource{
+		cfg:      cfg,
+		client:   new(http.Client),
+		etags:    make(map[string]string),
+		modified: make(map[string]string),
+	}
+}
+
+// httpSource pulls the files from an HTTP(S) location using conditional requests
+type httpSource struct {
+	cfg      *config.LoaderSourceCfg
+	client   *http.Client
+	mx       sync.Mutex
+	etags    map[string]string // ETag headers of the files fetched, indexed on file name
+	modified map[string]string // Last-Modified headers of the files fetched, indexed on file name
+}
+
+func (src *httpSource) url(fName string) string {
+	return strings.TrimSuffix(src.cfg.URL, utils.Slash) + utils.Slash + url.PathEscape(fName)
+}
+
+func (src *httpSource) fetch(fName, fPath string) (fetched bool, err error) {
+	src.mx.Lock()
+	defer src.mx.Unlock()
+	var req *http.Request
+	if req, err = http.NewRequest(http.MethodGet, src.url(fName), nil); err != nil {
+		return
+	}
+	if etag, has := src.etags[fName]; has {
+		req.Header.Set("If-None-Match", etag)
+	}
+	if modified, has := src.modified[fName]; has {
+		req.Header.Set("If-Modified-Since", modified)
+	}
+	var resp *http.Response
+	if resp, err = src.client.Do(req); err != nil {
+		return
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotModified, http.StatusNotFound:
+		return false, nil
+	default:
+		return false, fmt.Errorf("unexpected status code received: %d", resp.StatusCode)
+	}
+	if err = writeFile(fPath, resp.Body); err != nil {
+		return
+	}
+	if etag := resp.Header.Get("ETag"); etag != utils.EmptyString {
+		src.etags[fName] = etag
+	}
+	if modified := resp.Header.Get("Last-Modified"); modified != utils.EmptyString {
+		src.modified[fName] = modified
+	}
+	return true, nil
+}
+
+func (src *httpSource) done(fName string, loaded bool) (err error) {
+	src.mx.Lock()
+	defer src.mx.Unlock()
+	if !loaded {
+		delete(src.etags, fName)
+		delete(src.modified, fName)
+		return
+	}
+	if src.cfg.ProcessedAction != utils.MetaDelete {
+		return
+	}
+	var req *http.Request
+	if req, err = http.NewRequest(http.MethodDelete, src.url(fName), nil); err != nil {
+		return
+	}
+	var resp *http.Response
+	if resp, err = src.client.Do(req); err != nil {
+		return
+	}
+	resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected status code received: %d", resp.StatusCode)
+	}
+	delete(src.etags, fName)
+	delete(src.modified, fName)
+	return
+}
+
+// pullRemoteFiles fetches the changed files from the remote sources into the tp_in_dir,
+// a file name being pulled from one source per run so the sources do not overwrite each other's files
+func (ldr *Loader) pullRemoteFiles() (pulled []*remoteFile) {
+	fNames := utils.NewStringSet(nil)
+	for _, rdrs := range ldr.rdrs {
+		for fName := range rdrs {
+			fNames.Add(fName)
+		}
+	}
+	pulledNames := utils.NewStringSet(nil)
+	for _, src := range ldr.remoteSrcs {
+		for _, fName := range fNames.AsOrderedSlice() {
+			if pulledNames.Has(fName) { // fetched again on the next run, once the current file was processed
+				utils.Logger.Warning(fmt.Sprintf("<%s-%s> remote file <%s> already pulled from another source, postponing it",
+					utils.LoaderS, ldr.ldrID, fName))
+				continue
+			}
+			fetched, err := src.fetch(fName, path.Join(ldr.tpInDir, fName))
+			if err != nil {
+				utils.Logger.Warning(fmt.Sprintf("<%s-%s> cannot fetch remote file <%s>, err: %s",
+					utils.LoaderS, ldr.ldrID, fName, err.Error()))
+				continue
+			}
+			if fetched {
+				pulledNames.Add(fName)
+				pulled = append(pulled, &remoteFile{src: src, fName: fName})
+			}
+		}
+	}
+	return
+}
+
+// remoteFilesDone applies the processed action on the remote files loaded successfully,
+// the ones not processed (e.g. after stopping on error) being fetched again on the next run,
+// and removes their local copy if it is not moved to the tp_out_dir
+func (ldr *Loader) remoteFilesDone(pulled []*remoteFile, loaded, failed utils.StringSet) {
+	for _, rmtFile := range pulled {
+		if ldr.tpOutDir == utils.EmptyString {
+			if err := os.Remove(path.Join(ldr.tpInDir, rmtFile.fName)); err != nil && !os.IsNotExist(err) {
+				utils.Logger.Warning(fmt.Sprintf("<%s-%s> cannot remove local copy of remote file <%s>, err: %s",
+					utils.LoaderS, ldr.ldrID, rmtFile.fName, err.Error()))
+			}
+		}
+		if ldr.dryRun {
+			continue
+		}
+		if err := rmtFile.src.done(rmtFile.fName,
+			loaded.Has(rmtFile.fName) && !failed.Has(rmtFile.fName)); err != nil {
+			utils.Logger.Warning(fmt.Sprintf("<%s-%s> cannot process remote file <%s>, err: %s",
+				utils.LoaderS, ldr.ldrID, rmtFile.fName, err.Error()))
+		}
+	}
+}
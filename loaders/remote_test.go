@@ -0,0 +1,337 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package loaders
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// remoteStandIn is a minimal object storage answering to both the plain HTTP
+// and the path-style S3 requests
+type remoteStandIn struct {
+	mx   sync.Mutex
+	objs map[string]string // content indexed on the path
+}
+
+func newRemoteStandIn(objs map[string]string) (*remoteStandIn, *httptest.Server) {
+	rs := &remoteStandIn{objs: objs}
+	return rs, httptest.NewServer(rs)
+}
+
+func (rs *remoteStandIn) get(p string) (content string, has bool) {
+	rs.mx.Lock()
+	defer rs.mx.Unlock()
+	content, has = rs.objs[p]
+	return
+}
+
+func (rs *remoteStandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rs.mx.Lock()
+	defer rs.mx.Unlock()
+	switch r.Method {
+	case http.MethodGet:
+		content, has := rs.objs[r.URL.Path]
+		if !has {
+			w.WriteHeader(http.StatusNotFound)
+			io.WriteString(w, "<Error><Code>NoSuchKey</Code><Message>not found</Message></Error>")
+			return
+		}
+		sum := md5.Sum([]byte(content))
+		etag := `"` + hex.EncodeToString(sum[:]) + `"`
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		io.WriteString(w, content)
+	case http.MethodPut: // only the S3 CopyObject is used
+		src, err := url.PathUnescape(r.Header.Get("X-Amz-Copy-Source"))
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		content, has := rs.objs[utils.Slash+strings.TrimPrefix(src, utils.Slash)]
+		if !has {
+			w.WriteHeader(http.StatusNotFound)
+			io.WriteString(w, "<Error><Code>NoSuchKey</Code><Message>not found</Message></Error>")
+			return
+		}
+		rs.objs[r.URL.Path] = content
+		io.WriteString(w, "<CopyObjectResult><ETag>\"copied\"</ETag></CopyObjectResult>")
+	case http.MethodDelete:
+		delete(rs.objs, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func TestLoaderHTTPSource(t *testing.T) {
+	rs, srv := newRemoteStandIn(map[string]string{
+		"/tariffs/Filters.csv": "cgrates.org,FLTR_1,*string,~*req.Account,1001\n",
+	})
+	defer srv.Close()
+	src, err := newRemoteSource(&config.LoaderSourceCfg{
+		Type:            utils.MetaHTTP,
+		URL:             srv.URL + "/tariffs/",
+		ProcessedAction: utils.MetaDelete,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fPath := path.Join(t.TempDir(), "Filters.csv")
+	if fetched, err := src.fetch("Filters.csv", fPath); err != nil {
+		t.Fatal(err)
+	} else if !fetched {
+		t.Error("expected the file to be fetched")
+	}
+	if content, err := os.ReadFile(fPath); err != nil {
+		t.Error(err)
+	} else if string(content) != "cgrates.org,FLTR_1,*string,~*req.Account,1001\n" {
+		t.Errorf("unexpected content: %q", content)
+	}
+	// not modified since the last fetch
+	if fetched, err := src.fetch("Filters.csv", fPath); err != nil {
+		t.Fatal(err)
+	} else if fetched {
+		t.Error("expected the file to not be fetched again")
+	}
+	// not loaded, fetched again on the next run
+	if err = src.done("Filters.csv", false); err != nil {
+		t.Fatal(err)
+	}
+	if fetched, err := src.fetch("Filters.csv", fPath); err != nil {
+		t.Fatal(err)
+	} else if !fetched {
+		t.Error("expected the file to be fetched")
+	}
+	if err = src.done("Filters.csv", true); err != nil {
+		t.Fatal(err)
+	}
+	if _, has := rs.get("/tariffs/Filters.csv"); has {
+		t.Error("expected the remote file to be deleted")
+	}
+	if fetched, err := src.fetch("Filters.csv", fPath); err != nil {
+		t.Fatal(err)
+	} else if fetched {
+		t.Error("expected the missing file to not be fetched")
+	}
+}
+
+func TestLoaderS3Source(t *testing.T) {
+	rs, srv := newRemoteStandIn(map[string]string{
+		"/tariffs/in/Filters.csv": "cgrates.org,FLTR_1,*string,~*req.Account,1001\n",
+	})
+	defer srv.Close()
+	src, err := newRemoteSource(&config.LoaderSourceCfg{
+		Type:            utils.MetaS3,
+		URL:             srv.URL,
+		ProcessedAction: utils.MetaArchive,
+		S3Bucket:        "tariffs",
+		S3Prefix:        "in",
+		S3ArchivePrefix: "archive",
+		AWSRegion:       "us-east-1",
+		AWSKey:          "testKey",
+		AWSSecret:       "testSecret",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fPath := path.Join(t.TempDir(), "Filters.csv")
+	if fetched, err := src.fetch("Filters.csv", fPath); err != nil {
+		t.Fatal(err)
+	} else if !fetched {
+		t.Error("expected the file to be fetched")
+	}
+	if content, err := os.ReadFile(fPath); err != nil {
+		t.Error(err)
+	} else if string(content) != "cgrates.org,FLTR_1,*string,~*req.Account,1001\n" {
+		t.Errorf("unexpected content: %q", content)
+	}
+	if fetched, err := src.fetch("Filters.csv", fPath); err != nil {
+		t.Fatal(err)
+	} else if fetched {
+		t.Error("expected the file to not be fetched again")
+	}
+	if fetched, err := src.fetch("Attributes.csv", path.Join(t.TempDir(), "Attributes.csv")); err != nil {
+		t.Fatal(err)
+	} else if fetched {
+		t.Error("expected the missing file to not be fetched")
+	}
+	if err = src.done("Filters.csv", true); err != nil {
+		t.Fatal(err)
+	}
+	if _, has := rs.get("/tariffs/in/Filters.csv"); has {
+		t.Error("expected the remote file to be moved")
+	}
+	if content, has := rs.get("/tariffs/archive/Filters.csv"); !has {
+		t.Error("expected the remote file to be archived")
+	} else if content != "cgrates.org,FLTR_1,*string,~*req.Account,1001\n" {
+		t.Errorf("unexpected content: %q", content)
+	}
+}
+
+func TestLoaderProcessFolderRemoteSources(t *testing.T) {
+	rs, srv := newRemoteStandIn(map[string]string{
+		"/tariffs/Filters.csv": "cgrates.org,FLTR_1,*string,~*req.Account,1001\n",
+	})
+	defer srv.Close()
+	tpInDir := t.TempDir()
+	dm := engine.NewDataManager(engine.NewInternalDB(nil, nil, false, config.CgrConfig().DataDbCfg().Items), config.CgrConfig().CacheCfg(), nil)
+	ldr := NewLoader(dm, &config.LoaderSCfg{
+		ID:             "TestLoaderRemote",
+		Enabled:        true,
+		FieldSeparator: utils.FieldsSep,
+		TpInDir:        tpInDir,
+		RemoteSources: []*config.LoaderSourceCfg{{
+			Type:            utils.MetaHTTP,
+			URL:             srv.URL + "/tariffs",
+			ProcessedAction: utils.MetaDelete,
+		}},
+		Data: []*config.LoaderDataType{{
+			Type:     utils.MetaFilters,
+			Filename: utils.FiltersCsv,
+			Fields:   ratingTpl("Tenant", "ID", "Type", "Element", "Values"),
+		}},
+	}, "UTC", nil, nil, nil)
+	if err := ldr.ProcessFolder(utils.EmptyString, utils.MetaStore, true); err != nil {
+		t.Fatal(err)
+	}
+	if fltr, err := dm.GetFilter("cgrates.org", "FLTR_1", false, false, utils.NonTransactional); err != nil {
+		t.Error(err)
+	} else if len(fltr.Rules) != 1 || fltr.Rules[0].Element != "~*req.Account" {
+		t.Errorf("unexpected filter: %s", utils.ToJSON(fltr))
+	}
+	if _, err := os.Stat(path.Join(tpInDir, utils.FiltersCsv)); !os.IsNotExist(err) {
+		t.Errorf("expected the local copy to be removed, received: %v", err)
+	}
+	if _, has := rs.get("/tariffs/Filters.csv"); has {
+		t.Error("expected the remote file to be deleted")
+	}
+}
+
+func TestLoaderProcessFolderRemoteSourcesStopOnError(t *testing.T) {
+	rs, srv := newRemoteStandIn(map[string]string{
+		"/tariffs/Filters.csv": "cgrates.org,FLTR_1,*string,~*req.Account,1001\n",
+	})
+	defer srv.Close()
+	dm := engine.NewDataManager(engine.NewInternalDB(nil, nil, false, config.CgrConfig().DataDbCfg().Items), config.CgrConfig().CacheCfg(), nil)
+	ldr := NewLoader(dm, &config.LoaderSCfg{
+		ID:             "TestLoaderRemote",
+		Enabled:        true,
+		FieldSeparator: utils.FieldsSep,
+		TpInDir:        t.TempDir(),
+		RemoteSources: []*config.LoaderSourceCfg{{
+			Type:            utils.MetaHTTP,
+			URL:             srv.URL + "/tariffs",
+			ProcessedAction: utils.MetaDelete,
+		}},
+		Data: []*config.LoaderDataType{
+			{
+				Type:     utils.MetaAttributes, // processed first, its file is missing
+				Filename: utils.AttributesCsv,
+				Fields:   ratingTpl("Tenant", "ID"),
+			},
+			{
+				Type:     utils.MetaFilters,
+				Filename: utils.FiltersCsv,
+				Fields:   ratingTpl("Tenant", "ID", "Type", "Element", "Values"),
+			},
+		},
+	}, "UTC", nil, nil, nil)
+	if err := ldr.ProcessFolder(utils.EmptyString, utils.MetaStore, true); !os.IsNotExist(err) {
+		t.Fatalf("expected missing file error, received: %v", err)
+	}
+	if _, err := dm.GetFilter("cgrates.org", "FLTR_1", false, false, utils.NonTransactional); err != utils.ErrNotFound {
+		t.Errorf("expected the filter not to be loaded, received: %v", err)
+	}
+	if _, has := rs.get("/tariffs/Filters.csv"); !has {
+		t.Error("expected the remote file not processed to be kept")
+	}
+}
+
+func TestLoaderProcessFolderRemoteSourcesSameFile(t *testing.T) {
+	rs, srv := newRemoteStandIn(map[string]string{
+		"/tariffs1/Filters.csv": "cgrates.org,FLTR_1,*string,~*req.Account,1001\n",
+		"/tariffs2/Filters.csv": "cgrates.org,FLTR_2,*string,~*req.Account,1002\n",
+	})
+	defer srv.Close()
+	dm := engine.NewDataManager(engine.NewInternalDB(nil, nil, false, config.CgrConfig().DataDbCfg().Items), config.CgrConfig().CacheCfg(), nil)
+	ldr := NewLoader(dm, &config.LoaderSCfg{
+		ID:             "TestLoaderRemote",
+		Enabled:        true,
+		FieldSeparator: utils.FieldsSep,
+		TpInDir:        t.TempDir(),
+		RemoteSources: []*config.LoaderSourceCfg{
+			{
+				Type:            utils.MetaHTTP,
+				URL:             srv.URL + "/tariffs1",
+				ProcessedAction: utils.MetaDelete,
+			},
+			{
+				Type:            utils.MetaHTTP,
+				URL:             srv.URL + "/tariffs2",
+				ProcessedAction: utils.MetaDelete,
+			},
+		},
+		Data: []*config.LoaderDataType{{
+			Type:     utils.MetaFilters,
+			Filename: utils.FiltersCsv,
+			Fields:   ratingTpl("Tenant", "ID", "Type", "Element", "Values"),
+		}},
+	}, "UTC", nil, nil, nil)
+	if err := ldr.ProcessFolder(utils.EmptyString, utils.MetaStore, true); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := dm.GetFilter("cgrates.org", "FLTR_1", false, false, utils.NonTransactional); err != nil {
+		t.Error(err)
+	}
+	if _, err := dm.GetFilter("cgrates.org", "FLTR_2", false, false, utils.NonTransactional); err != utils.ErrNotFound {
+		t.Errorf("expected the second file to be postponed, received: %v", err)
+	}
+	if _, has := rs.get("/tariffs1/Filters.csv"); has {
+		t.Error("expected the first remote file to be deleted")
+	}
+	if _, has := rs.get("/tariffs2/Filters.csv"); !has {
+		t.Error("expected the second remote file to be kept")
+	}
+	if err := ldr.ProcessFolder(utils.EmptyString, utils.MetaStore, true); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := dm.GetFilter("cgrates.org", "FLTR_2", false, false, utils.NonTransactional); err != nil {
+		t.Error(err)
+	}
+	if _, has := rs.get("/tariffs2/Filters.csv"); has {
+		t.Error("expected the second remote file to be deleted")
+	}
+}
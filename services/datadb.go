@@ -20,6 +20,7 @@ package services
 
 import (
 	"fmt"
+	"slices"
 	"sync"
 
 	"github.com/cgrates/cgrates/config"
@@ -163,6 +164,11 @@ func (db *DataDBService) needsConnectionReload() bool {
 				return true
 			}
 		}
+		if db.oldDBCfg.Opts.InternalDBDumpPath != db.cfg.DataDbCfg().Opts.InternalDBDumpPath ||
+			db.oldDBCfg.Opts.InternalDBDumpInterval != db.cfg.DataDbCfg().Opts.InternalDBDumpInterval ||
+			db.oldDBCfg.Opts.InternalDBWriteLog != db.cfg.DataDbCfg().Opts.InternalDBWriteLog {
+			return true
+		}
 	}
 	return db.oldDBCfg.Type == utils.MetaRedis &&
 		(db.oldDBCfg.Opts.RedisMaxConns != db.cfg.DataDbCfg().Opts.RedisMaxConns ||
@@ -173,7 +179,9 @@ func (db *DataDBService) needsConnectionReload() bool {
 			db.oldDBCfg.Opts.RedisClusterOndownDelay != db.cfg.DataDbCfg().Opts.RedisClusterOndownDelay ||
 			db.oldDBCfg.Opts.RedisConnectTimeout != db.cfg.DataDbCfg().Opts.RedisConnectTimeout ||
 			db.oldDBCfg.Opts.RedisReadTimeout != db.cfg.DataDbCfg().Opts.RedisReadTimeout ||
-			db.oldDBCfg.Opts.RedisWriteTimeout != db.cfg.DataDbCfg().Opts.RedisWriteTimeout)
+			db.oldDBCfg.Opts.RedisWriteTimeout != db.cfg.DataDbCfg().Opts.RedisWriteTimeout ||
+			db.oldDBCfg.Opts.RedisReadPolicy != db.cfg.DataDbCfg().Opts.RedisReadPolicy ||
+			!slices.Equal(db.oldDBCfg.Opts.RedisReadReplicas, db.cfg.DataDbCfg().Opts.RedisReadReplicas))
 }
 
 // GetDMChan returns the DataManager chanel
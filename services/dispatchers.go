@@ -87,6 +87,7 @@ func (dspS *DispatcherService) Start() error {
 	defer dspS.Unlock()
 
 	dspS.dspS = dispatchers.NewDispatcherService(datadb, dspS.cfg, fltrS, dspS.connMgr)
+	engine.Metrics.Register(utils.DispatcherS, dspS.dspS)
 
 	dspS.server.RpcUnregisterName(utils.AttributeSv1)
 
@@ -111,6 +112,7 @@ func (dspS *DispatcherService) Reload() (err error) {
 func (dspS *DispatcherService) Shutdown() (err error) {
 	dspS.Lock()
 	defer dspS.Unlock()
+	engine.Metrics.Unregister(utils.DispatcherS)
 	dspS.dspS.Shutdown()
 	dspS.dspS = nil
 	dspS.rpc = nil
@@ -93,6 +93,7 @@ func (es *EventExporterService) Shutdown() (err error) {
 	es.Lock()
 	defer es.Unlock()
 	close(es.stopChan)
+	engine.Metrics.Unregister(utils.EEs)
 	es.eeS.Shutdown()
 	es.eeS = nil
 	<-es.intConnChan
@@ -116,6 +117,7 @@ func (es *EventExporterService) Start() error {
 	es.eeS = ees.NewEventExporterS(es.cfg, fltrS, es.connMgr)
 	es.stopChan = make(chan struct{})
 	go es.eeS.ListenAndServe(es.stopChan, es.rldChan)
+	engine.Metrics.Register(utils.EEs, es.eeS)
 
 	srv, err := engine.NewServiceWithName(es.eeS, utils.EeS, true)
 	if err != nil {
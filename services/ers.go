@@ -20,9 +20,11 @@ package services
 
 import (
 	"fmt"
+	"net/http"
 	"sync"
 
 	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/cores"
 	"github.com/cgrates/cgrates/engine"
 	"github.com/cgrates/cgrates/ers"
 	"github.com/cgrates/cgrates/servmanager"
@@ -31,13 +33,15 @@ import (
 
 // NewEventReaderService returns the EventReader Service
 func NewEventReaderService(cfg *config.CGRConfig, filterSChan chan *engine.FilterS,
-	shdChan *utils.SyncedChan, connMgr *engine.ConnManager,
+	shdChan *utils.SyncedChan, server *cores.Server, connMgr *engine.ConnManager,
 	srvDep map[string]*sync.WaitGroup) servmanager.Service {
 	return &EventReaderService{
 		rldChan:     make(chan struct{}, 1),
 		cfg:         cfg,
 		filterSChan: filterSChan,
 		shdChan:     shdChan,
+		server:      server,
+		httpPaths:   make(utils.StringSet),
 		connMgr:     connMgr,
 		srvDep:      srvDep,
 	}
@@ -49,6 +53,8 @@ type EventReaderService struct {
 	cfg         *config.CGRConfig
 	filterSChan chan *engine.FilterS
 	shdChan     *utils.SyncedChan
+	server      *cores.Server
+	httpPaths   utils.StringSet // paths registered on the HTTP server for the HTTP readers
 
 	ers      *ers.ERService
 	rldChan  chan struct{}
@@ -76,10 +82,42 @@ func (erS *EventReaderService) Start() (err error) {
 
 	// build the service
 	erS.ers = ers.NewERService(erS.cfg, filterS, erS.connMgr)
+	engine.Metrics.Register(utils.ERs, erS.ers)
+	erS.registerHTTPPaths()
 	go erS.listenAndServe(erS.ers, erS.stopChan, erS.rldChan)
 	return
 }
 
+// registerHTTPPaths registers on the HTTP server the paths of the HTTP readers;
+// since the paths cannot be unregistered they are served by the service
+// and passed to the reader only while it is running
+func (erS *EventReaderService) registerHTTPPaths() {
+	if erS.server == nil {
+		return
+	}
+	for _, rdrCfg := range erS.cfg.ERsCfg().Readers {
+		if (rdrCfg.Type != utils.MetaHTTPjson &&
+			rdrCfg.Type != utils.MetaHTTPPost) ||
+			erS.httpPaths.Has(rdrCfg.SourcePath) {
+			continue
+		}
+		erS.httpPaths.Add(rdrCfg.SourcePath)
+		erS.server.RegisterHttpFunc(rdrCfg.SourcePath, erS.serveHTTP)
+	}
+}
+
+// serveHTTP passes the requests to the ERService if running
+func (erS *EventReaderService) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	erS.RLock()
+	ers := erS.ers
+	erS.RUnlock()
+	if ers == nil {
+		http.Error(w, http.StatusText(http.StatusServiceUnavailable), http.StatusServiceUnavailable)
+		return
+	}
+	ers.ServeHTTP(w, r)
+}
+
 func (erS *EventReaderService) listenAndServe(ers *ers.ERService, stopChan chan struct{}, rldChan chan struct{}) (err error) {
 	if err = ers.ListenAndServe(stopChan, rldChan); err != nil {
 		utils.Logger.Err(fmt.Sprintf("<%s> error: <%s>", utils.ERs, err.Error()))
@@ -90,9 +128,10 @@ func (erS *EventReaderService) listenAndServe(ers *ers.ERService, stopChan chan
 
 // Reload handles the change of config
 func (erS *EventReaderService) Reload() (err error) {
-	erS.RLock()
+	erS.Lock()
+	erS.registerHTTPPaths()
 	erS.rldChan <- struct{}{}
-	erS.RUnlock()
+	erS.Unlock()
 	return
 }
 
@@ -100,6 +139,7 @@ func (erS *EventReaderService) Reload() (err error) {
 func (erS *EventReaderService) Shutdown() (err error) {
 	erS.Lock()
 	close(erS.stopChan)
+	engine.Metrics.Unregister(utils.ERs)
 	erS.ers = nil
 	erS.Unlock()
 	return
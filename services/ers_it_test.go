@@ -67,7 +67,7 @@ func TestEventReaderSReload(t *testing.T) {
 	anz := NewAnalyzerService(cfg, server, filterSChan, shdChan, make(chan birpc.ClientConnector, 1), srvDep)
 	db := NewDataDBService(cfg, nil, srvDep)
 	sS := NewSessionService(cfg, db, server, make(chan birpc.ClientConnector, 1), shdChan, nil, anz, srvDep)
-	erS := NewEventReaderService(cfg, filterSChan, shdChan, nil, srvDep)
+	erS := NewEventReaderService(cfg, filterSChan, shdChan, nil, nil, srvDep)
 	engine.NewConnManager(cfg, nil)
 	srvMngr.AddServices(erS, sS,
 		NewLoaderService(cfg, db, filterSChan, server, make(chan birpc.ClientConnector, 1), nil, anz, srvDep), db)
@@ -135,7 +135,7 @@ func TestEventReaderSReload2(t *testing.T) {
 	filterSChan <- nil
 	shdChan := utils.NewSyncedChan()
 	srvDep := map[string]*sync.WaitGroup{utils.DataDB: new(sync.WaitGroup)}
-	erS := NewEventReaderService(cfg, filterSChan, shdChan, nil, srvDep)
+	erS := NewEventReaderService(cfg, filterSChan, shdChan, nil, nil, srvDep)
 	ers := ers.NewERService(cfg, nil, nil)
 
 	runtime.Gosched()
@@ -0,0 +1,135 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package services
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/cgrates/birpc"
+	v1 "github.com/cgrates/cgrates/apier/v1"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/cores"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/servmanager"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// NewInvoiceService returns the Invoice Service
+func NewInvoiceService(cfg *config.CGRConfig, dm *DataDBService,
+	storDB *StorDBService, server *cores.Server,
+	internalInvoiceSChan chan birpc.ClientConnector,
+	connMgr *engine.ConnManager, anz *AnalyzerService,
+	srvDep map[string]*sync.WaitGroup) servmanager.Service {
+	return &InvoiceService{
+		connChan: internalInvoiceSChan,
+		cfg:      cfg,
+		dm:       dm,
+		storDB:   storDB,
+		server:   server,
+		connMgr:  connMgr,
+		rldChan:  make(chan struct{}),
+		anz:      anz,
+		srvDep:   srvDep,
+	}
+}
+
+// InvoiceService implements Service interface
+type InvoiceService struct {
+	sync.RWMutex
+	cfg     *config.CGRConfig
+	dm      *DataDBService
+	storDB  *StorDBService
+	server  *cores.Server
+	connMgr *engine.ConnManager
+
+	invS     *engine.InvoiceService
+	connChan chan birpc.ClientConnector
+	rldChan  chan struct{}
+	stopChan chan struct{}
+	anz      *AnalyzerService
+	srvDep   map[string]*sync.WaitGroup
+}
+
+// Start should handle the service start
+func (invS *InvoiceService) Start() error {
+	if invS.IsRunning() {
+		return utils.ErrServiceAlreadyRunning
+	}
+
+	utils.Logger.Info(fmt.Sprintf("<%s> starting <%s> subsystem", utils.CoreS, utils.InvoiceS))
+
+	dbchan := invS.dm.GetDMChan()
+	datadb := <-dbchan
+	dbchan <- datadb
+
+	storDBChan := make(chan engine.StorDB, 1)
+	invS.stopChan = make(chan struct{})
+	invS.storDB.RegisterSyncChan(storDBChan)
+
+	invS.Lock()
+	defer invS.Unlock()
+
+	invS.invS = engine.NewInvoiceService(invS.cfg, datadb, storDBChan, invS.connMgr)
+	go invS.invS.ListenAndServe(invS.stopChan, invS.rldChan)
+	srv, err := engine.NewService(v1.NewInvoiceSv1(invS.invS))
+	if err != nil {
+		return err
+	}
+	if !invS.cfg.DispatcherSCfg().Enabled {
+		for _, s := range srv {
+			invS.server.RpcRegister(s)
+		}
+	}
+	invS.connChan <- invS.anz.GetInternalCodec(srv, utils.InvoiceS)
+	return nil
+}
+
+// Reload handles the change of config
+func (invS *InvoiceService) Reload() (err error) {
+	invS.rldChan <- struct{}{}
+	return
+}
+
+// Shutdown stops the service
+func (invS *InvoiceService) Shutdown() (err error) {
+	invS.Lock()
+	close(invS.stopChan)
+	invS.invS = nil
+	<-invS.connChan
+	invS.Unlock()
+	return
+}
+
+// IsRunning returns if the service is running
+func (invS *InvoiceService) IsRunning() bool {
+	invS.RLock()
+	defer invS.RUnlock()
+	return invS != nil && invS.invS != nil
+}
+
+// ServiceName returns the service name
+func (invS *InvoiceService) ServiceName() string {
+	return utils.InvoiceS
+}
+
+// ShouldRun returns if the service should be running
+func (invS *InvoiceService) ShouldRun() bool {
+	return invS.cfg.InvoiceSCfg().Enabled
+}
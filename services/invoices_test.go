@@ -0,0 +1,87 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+package services
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/cgrates/birpc"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/cores"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// TestInvoiceSCoverage for cover testing
+func TestInvoiceSCoverage(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	chS := engine.NewCacheS(cfg, nil, nil)
+	filterSChan := make(chan *engine.FilterS, 1)
+	filterSChan <- nil
+	shdChan := utils.NewSyncedChan()
+	server := cores.NewServer(nil)
+	srvDep := map[string]*sync.WaitGroup{utils.DataDB: new(sync.WaitGroup)}
+	db := NewDataDBService(cfg, nil, srvDep)
+	cfg.StorDbCfg().Type = utils.MetaInternal
+	stordb := NewStorDBService(cfg, srvDep)
+	anz := NewAnalyzerService(cfg, server, filterSChan, shdChan, make(chan birpc.ClientConnector, 1), srvDep)
+	invS := NewInvoiceService(cfg, db, stordb, server,
+		make(chan birpc.ClientConnector, 1), nil, anz, srvDep)
+	if invS.IsRunning() {
+		t.Errorf("Expected service to be down")
+	}
+	if invS.ShouldRun() {
+		t.Errorf("Expected service to not run with the default config")
+	}
+	cfg.InvoiceSCfg().Enabled = true
+	if !invS.ShouldRun() {
+		t.Errorf("Expected service to run")
+	}
+	if !stordb.ShouldRun() {
+		t.Errorf("Expected StorDB to be required by InvoiceS")
+	}
+	if serviceName := invS.ServiceName(); serviceName != utils.InvoiceS {
+		t.Errorf("\nExpecting <%+v>,\n Received <%+v>", utils.InvoiceS, serviceName)
+	}
+	invS2 := &InvoiceService{
+		cfg:      cfg,
+		dm:       db,
+		storDB:   stordb,
+		server:   server,
+		connChan: make(chan birpc.ClientConnector, 1),
+		stopChan: make(chan struct{}),
+		anz:      anz,
+		srvDep:   srvDep,
+		invS:     &engine.InvoiceService{},
+	}
+	srv, err := engine.NewService(chS)
+	if err != nil {
+		t.Error(err)
+	}
+	invS2.connChan <- srv
+	if !invS2.IsRunning() {
+		t.Errorf("Expected service to be running")
+	}
+	if err := invS2.Shutdown(); err != nil {
+		t.Error(err)
+	}
+	if invS2.IsRunning() {
+		t.Errorf("Expected service to be down")
+	}
+}
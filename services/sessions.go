@@ -89,6 +89,7 @@ func (smg *SessionService) Start() error {
 	//start sync session in a separate gorutine
 	smg.stopChan = make(chan struct{})
 	go smg.sm.ListenAndServe(smg.stopChan)
+	engine.Metrics.Register(utils.SessionS, smg.sm)
 	// Pass internal connection
 	srv, err := engine.NewServiceWithName(v1.NewSessionSv1(smg.sm), "", false)
 	if err != nil {
@@ -146,6 +147,7 @@ func (smg *SessionService) Shutdown() (err error) {
 	smg.Lock()
 	defer smg.Unlock()
 	close(smg.stopChan)
+	engine.Metrics.Unregister(utils.SessionS)
 	if err = smg.sm.Shutdown(); err != nil {
 		return
 	}
@@ -90,6 +90,7 @@ func (sts *StatService) Start() error {
 	utils.Logger.Info(fmt.Sprintf("<%s> starting <%s> subsystem",
 		utils.CoreS, utils.StatS))
 	sts.sts.StartLoop()
+	engine.Metrics.Register(utils.StatS, sts.sts)
 	srv, err := engine.NewService(v1.NewStatSv1(sts.sts))
 	if err != nil {
 		return err
@@ -116,6 +117,7 @@ func (sts *StatService) Shutdown() (err error) {
 	defer sts.srvDep[utils.DataDB].Done()
 	sts.Lock()
 	defer sts.Unlock()
+	engine.Metrics.Unregister(utils.StatS)
 	sts.sts.Shutdown()
 	sts.sts = nil
 	<-sts.connChan
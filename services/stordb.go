@@ -80,6 +80,13 @@ func (db *StorDBService) Reload() (err error) {
 	db.Lock()
 	defer db.Unlock()
 	if db.needsConnectionReload() {
+		idb, isInternal := db.db.(*engine.InternalDB)
+		if isInternal && db.oldDBCfg.Opts.InternalDBDumpPath != utils.EmptyString {
+			// write the last dump before the new connection restores it
+			if err = idb.Dump(); err != nil {
+				return
+			}
+		}
 		var d engine.StorDB
 		if d, err = engine.NewStorDBConn(db.cfg.StorDbCfg().Type, db.cfg.StorDbCfg().Host,
 			db.cfg.StorDbCfg().Port, db.cfg.StorDbCfg().Name, db.cfg.StorDbCfg().User,
@@ -88,6 +95,9 @@ func (db *StorDBService) Reload() (err error) {
 			db.cfg.StorDbCfg().Opts, db.cfg.StorDbCfg().Items); err != nil {
 			return
 		}
+		if isInternal { // the files are taken over by the new connection so no final dump
+			idb.StopDumper()
+		}
 		db.db.Close()
 		db.db = d
 		db.oldDBCfg = db.cfg.StorDbCfg().Clone()
@@ -155,7 +165,8 @@ func (db *StorDBService) ServiceName() string {
 
 // ShouldRun returns if the service should be running
 func (db *StorDBService) ShouldRun() bool {
-	return db.cfg.RalsCfg().Enabled || db.cfg.CdrsCfg().Enabled || db.cfg.ApierCfg().Enabled
+	return db.cfg.RalsCfg().Enabled || db.cfg.CdrsCfg().Enabled || db.cfg.ApierCfg().Enabled ||
+		db.cfg.InvoiceSCfg().Enabled
 }
 
 // RegisterSyncChan used by dependent subsystems to register a chanel to reload only the storDB(thread safe)
@@ -187,6 +198,12 @@ func (db *StorDBService) needsConnectionReload() bool {
 		db.oldDBCfg.Password != db.cfg.StorDbCfg().Password {
 		return true
 	}
+	if db.cfg.StorDbCfg().Type == utils.MetaInternal &&
+		(db.oldDBCfg.Opts.InternalDBDumpPath != db.cfg.StorDbCfg().Opts.InternalDBDumpPath ||
+			db.oldDBCfg.Opts.InternalDBDumpInterval != db.cfg.StorDbCfg().Opts.InternalDBDumpInterval ||
+			db.oldDBCfg.Opts.InternalDBWriteLog != db.cfg.StorDbCfg().Opts.InternalDBWriteLog) {
+		return true
+	}
 	return db.cfg.StorDbCfg().Type == utils.MetaPostgres &&
 		db.oldDBCfg.Opts.PgSSLMode != db.cfg.StorDbCfg().Opts.PgSSLMode
 }
@@ -18,9 +18,12 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>
 package services
 
 import (
+	"os"
+	"path/filepath"
 	"reflect"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/cgrates/cgrates/config"
 	"github.com/cgrates/cgrates/engine"
@@ -62,3 +65,58 @@ func TestStorDBServiceCoverage(t *testing.T) {
 		t.Errorf("Expected service to be down")
 	}
 }
+
+func TestStorDBServiceReloadInternalDump(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	cfg.StorDbCfg().Type = utils.MetaInternal
+	cfg.StorDbCfg().Opts.InternalDBDumpPath = t.TempDir()
+	srv := NewStorDBService(cfg, map[string]*sync.WaitGroup{utils.StorDB: new(sync.WaitGroup)})
+	idb := engine.NewInternalDB(nil, nil, false, cfg.StorDbCfg().Items)
+	cfg.StorDbCfg().Opts.InternalDBWriteLog = true
+	if err := idb.StartDumper(cfg.StorDbCfg().Opts.InternalDBDumpPath, 0, true); err != nil {
+		t.Fatal(err)
+	}
+	srv.db = idb
+	srv.oldDBCfg = cfg.StorDbCfg().Clone()
+	if srv.needsConnectionReload() {
+		t.Fatal("Expected no connection reload")
+	}
+
+	// the old connection is kept if the new one fails
+	cfg.StorDbCfg().Opts.InternalDBDumpInterval = time.Hour
+	cfg.StorDbCfg().Opts.InternalDBDumpPath = filepath.Join(srv.oldDBCfg.Opts.InternalDBDumpPath, "file")
+	if err := os.WriteFile(cfg.StorDbCfg().Opts.InternalDBDumpPath, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if !srv.needsConnectionReload() {
+		t.Fatal("Expected connection reload for the changed dump options")
+	}
+	if err := srv.Reload(); err == nil {
+		t.Error("Expected error for the dump path not being a folder")
+	} else if srv.db != idb {
+		t.Error("Expected the old connection to be kept")
+	}
+	// the writes of the old connection are still persisted
+	cdr := &engine.CDR{CGRID: "CGRID_1", RunID: utils.MetaDefault, Tenant: "cgrates.org"}
+	if err := idb.SetCDR(cdr, false); err != nil {
+		t.Fatal(err)
+	}
+	rdb := engine.NewInternalDB(nil, nil, false, cfg.StorDbCfg().Items)
+	if err := rdb.StartDumper(srv.oldDBCfg.Opts.InternalDBDumpPath, 0, true); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := rdb.GetCDRs(&utils.CDRsFilter{CGRIDs: []string{cdr.CGRID}}, false); err != nil {
+		t.Errorf("Expected the CDR written after the failed reload, received error: %v", err)
+	}
+	rdb.StopDumper()
+
+	cfg.StorDbCfg().Opts.InternalDBDumpPath = t.TempDir()
+	if err := srv.Reload(); err != nil {
+		t.Fatal(err)
+	} else if srv.db == idb {
+		t.Error("Expected a new connection")
+	} else if srv.needsConnectionReload() {
+		t.Error("Expected no connection reload after reconnecting")
+	}
+	srv.Shutdown()
+}
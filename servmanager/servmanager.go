@@ -250,6 +250,8 @@ func (srvMngr *ServiceManager) handleReload() {
 			go srvMngr.reloadService(utils.GlobalVarS)
 		case <-srvMngr.GetConfig().GetReloadChan(config.CoreSCfgJson):
 			go srvMngr.reloadService(utils.CoreS)
+		case <-srvMngr.GetConfig().GetReloadChan(config.InvoiceSJson):
+			go srvMngr.reloadService(utils.InvoiceS)
 		}
 		// handle RPC server
 	}
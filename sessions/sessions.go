@@ -97,6 +97,10 @@ type SessionS struct {
 // ListenAndServe starts the service and binds it to the listen loop
 func (sS *SessionS) ListenAndServe(stopChan chan struct{}) {
 	utils.Logger.Info(fmt.Sprintf("<%s> starting <%s> subsystem", utils.CoreS, utils.SessionS))
+	if sS.cgrCfg.SessionSCfg().BackupInterval > 0 {
+		sS.restoreSessions()
+		go sS.runBackup(stopChan)
+	}
 	if sS.cgrCfg.SessionSCfg().ChannelSyncInterval != 0 {
 		for { // Schedule sync channels to run repeately
 			select {
@@ -111,6 +115,16 @@ func (sS *SessionS) ListenAndServe(stopChan chan struct{}) {
 
 // Shutdown is called by engine to clear states
 func (sS *SessionS) Shutdown() (err error) {
+	if sS.cgrCfg.SessionSCfg().BackupInterval > 0 { // keep the sessions to be restored on start
+		for _, s := range sS.getSessions("", false) {
+			s.Lock()
+			s.stopSTerminator()
+			s.stopDebitLoops()
+			s.Unlock()
+		}
+		_, err = sS.backupSessions()
+		return
+	}
 	if len(sS.cgrCfg.SessionSCfg().ReplicationConns) == 0 {
 		var hasErr bool
 		for _, s := range sS.getSessions("", false) { // Force sessions shutdown
@@ -125,6 +139,20 @@ func (sS *SessionS) Shutdown() (err error) {
 	return
 }
 
+// CollectMetrics implements engine.MetricsCollector
+func (sS *SessionS) CollectMetrics(mw *engine.MetricsWriter) {
+	sS.aSsMux.RLock()
+	aSs := len(sS.aSessions)
+	sS.aSsMux.RUnlock()
+	sS.pSsMux.RLock()
+	pSs := len(sS.pSessions)
+	sS.pSsMux.RUnlock()
+	mw.Family("sessions_active", engine.MetricTypeGauge, "Number of active sessions.")
+	mw.Sample("sessions_active", float64(aSs))
+	mw.Family("sessions_passive", engine.MetricTypeGauge, "Number of passive sessions.")
+	mw.Sample("sessions_passive", float64(pSs))
+}
+
 // OnBiJSONConnect handles new client connections.
 func (sS *SessionS) OnBiJSONConnect(c birpc.ClientConnector) {
 	nodeID := utils.UUIDSha1Prefix() // connection identifier, should be later updated as login procedure
@@ -296,6 +324,13 @@ func (sS *SessionS) setSTerminator(s *Session, opts engine.MapEvent) {
 				utils.SessionS, utils.OptsSessionsTTLUsage, s.CGRID, opts.String(), err.Error()))
 		return
 	}
+	sS.installSTerminator(s, ttl, ttlLastUsed, ttlUsage, ttlLastUsage)
+}
+
+// installSTerminator resets the terminator of the session or starts a new one
+// not thread safe
+func (sS *SessionS) installSTerminator(s *Session, ttl time.Duration,
+	ttlLastUsed, ttlUsage, ttlLastUsage *time.Duration) {
 	// previously defined, reset
 	if s.sTerminator != nil {
 		s.sTerminator.ttl = ttl
@@ -542,7 +577,7 @@ func (sS *SessionS) debitLoopSession(s *Session, sRunIdx int,
 	now := time.Now()
 	if s.SRuns[sRunIdx].NextAutoDebit != nil &&
 		now.Before(*s.SRuns[sRunIdx].NextAutoDebit) {
-		time.Sleep(now.Sub(*s.SRuns[sRunIdx].NextAutoDebit))
+		time.Sleep(s.SRuns[sRunIdx].NextAutoDebit.Sub(now))
 	}
 	for {
 		s.Lock()
@@ -1574,6 +1609,7 @@ func (sS *SessionS) endSession(s *Session, tUsage, lastUsage *time.Duration,
 		sS.unregisterSession(s.CGRID, false)
 		s.stopSTerminator()
 		s.stopDebitLoops()
+		sS.removeSessionBackup(s.CGRID)
 	}
 	for sRunIdx, sr := range s.SRuns {
 		sUsage := sr.TotalUsage
@@ -0,0 +1,254 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package sessions
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// asStoredSession returns the session in the format backed up in DataDB
+// not thread safe
+func (s *Session) asStoredSession(nodeID string) (ss *engine.StoredSession) {
+	ss = &engine.StoredSession{
+		NodeID:        nodeID,
+		CGRID:         s.CGRID,
+		Tenant:        s.Tenant,
+		ResourceID:    s.ResourceID,
+		ClientConnID:  s.ClientConnID,
+		EventStart:    s.EventStart.Clone(),
+		DebitInterval: s.DebitInterval,
+		Chargeable:    s.Chargeable,
+		SRuns:         make([]*engine.StoredSRun, len(s.SRuns)),
+		OptsStart:     s.OptsStart.Clone(),
+		UpdatedAt:     time.Now(),
+	}
+	for i, sr := range s.SRuns {
+		sr = sr.Clone()
+		ss.SRuns[i] = &engine.StoredSRun{
+			Event:         sr.Event,
+			CD:            sr.CD,
+			EventCost:     sr.EventCost,
+			ExtraDuration: sr.ExtraDuration,
+			LastUsage:     sr.LastUsage,
+			TotalUsage:    sr.TotalUsage,
+			NextAutoDebit: sr.NextAutoDebit,
+		}
+	}
+	if s.sTerminator != nil {
+		ss.TTL = s.sTerminator.ttl
+		ss.TTLLastUsed = s.sTerminator.ttlLastUsed
+		ss.TTLUsage = s.sTerminator.ttlUsage
+		ss.TTLLastUsage = s.sTerminator.ttlLastUsage
+	}
+	return
+}
+
+// newSessionFromStored builds the session out of its backup
+func newSessionFromStored(ss *engine.StoredSession) (s *Session) {
+	s = &Session{
+		CGRID:         ss.CGRID,
+		Tenant:        ss.Tenant,
+		ResourceID:    ss.ResourceID,
+		ClientConnID:  ss.ClientConnID,
+		EventStart:    ss.EventStart.Clone(),
+		DebitInterval: ss.DebitInterval,
+		Chargeable:    ss.Chargeable,
+		SRuns:         make([]*SRun, len(ss.SRuns)),
+		OptsStart:     ss.OptsStart.Clone(),
+	}
+	for i, sr := range ss.SRuns {
+		s.SRuns[i] = (&SRun{
+			Event:         sr.Event,
+			CD:            sr.CD,
+			EventCost:     sr.EventCost,
+			ExtraDuration: sr.ExtraDuration,
+			LastUsage:     sr.LastUsage,
+			TotalUsage:    sr.TotalUsage,
+			NextAutoDebit: sr.NextAutoDebit,
+		}).Clone() // decouple from the DataDB object
+	}
+	return
+}
+
+// runBackup will regularly backup the active sessions into DataDB
+func (sS *SessionS) runBackup(stopChan chan struct{}) {
+	for {
+		select {
+		case <-stopChan:
+			return
+		case <-time.After(sS.cgrCfg.SessionSCfg().BackupInterval):
+			if _, err := sS.backupSessions(); err != nil {
+				utils.Logger.Warning(
+					fmt.Sprintf("<%s> failed backing up the active sessions, error: <%s>",
+						utils.SessionS, err.Error()))
+			}
+		}
+	}
+}
+
+// backupSessions stores the active sessions into DataDB and removes the backups of the ones ended
+func (sS *SessionS) backupSessions() (stored int, err error) {
+	nodeID := sS.cgrCfg.GeneralCfg().NodeID
+	active := make(utils.StringSet)
+	for _, s := range sS.getSessions(utils.EmptyString, false) {
+		s.RLock()
+		if !sS.isIndexed(s, false) { // ended in the meantime
+			s.RUnlock()
+			continue
+		}
+		// keep the lock while storing so the backup is not restored after the session ends
+		err = sS.dm.SetSessionBackup(s.asStoredSession(nodeID))
+		s.RUnlock()
+		if err != nil {
+			return
+		}
+		active.Add(s.CGRID)
+		stored++
+	}
+	var sss []*engine.StoredSession
+	if sss, err = sS.dm.GetSessionsBackup(nodeID); err != nil {
+		if err == utils.ErrNotFound {
+			err = nil
+		}
+		return
+	}
+	for _, ss := range sss {
+		if active.Has(ss.CGRID) {
+			continue
+		}
+		if err = sS.dm.RemoveSessionBackup(nodeID, ss.CGRID); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// removeSessionBackup removes the backup of a session ended
+func (sS *SessionS) removeSessionBackup(cgrID string) {
+	if sS.cgrCfg.SessionSCfg().BackupInterval <= 0 {
+		return
+	}
+	if err := sS.dm.RemoveSessionBackup(sS.cgrCfg.GeneralCfg().NodeID, cgrID); err != nil &&
+		err != utils.ErrNotFound {
+		utils.Logger.Warning(
+			fmt.Sprintf("<%s> failed removing the backup of session: <%s>, error: <%s>",
+				utils.SessionS, cgrID, err.Error()))
+	}
+}
+
+// restoreSessions activates the sessions backed up by this node, resuming their debit loops and timeouts.
+// The downtime since the backup counts out of the session_ttl while the debit loops resume from now on,
+// the usage of the downtime being charged once the session is updated or terminated with its usage
+func (sS *SessionS) restoreSessions() {
+	sss, err := sS.dm.GetSessionsBackup(sS.cgrCfg.GeneralCfg().NodeID)
+	if err != nil {
+		if err != utils.ErrNotFound {
+			utils.Logger.Warning(
+				fmt.Sprintf("<%s> failed restoring the sessions, error: <%s>",
+					utils.SessionS, err.Error()))
+		}
+		return
+	}
+	var restored int
+	for _, ss := range sss {
+		s := newSessionFromStored(ss)
+		if sS.isIndexed(s, false) {
+			continue
+		}
+		s.Lock()
+		sS.registerSession(s, false)
+		sS.initSessionDebitLoops(s)
+		if ss.TTL != 0 {
+			sS.installSTerminator(s, ss.TTL, ss.TTLLastUsed, ss.TTLUsage, ss.TTLLastUsage)
+			s.sTerminator.timer.Reset(max(ss.TTL-time.Since(ss.UpdatedAt), 0))
+		}
+		s.Unlock()
+		restored++
+	}
+	utils.Logger.Info(fmt.Sprintf("<%s> restored %d sessions out of the backup",
+		utils.SessionS, restored))
+}
+
+// BiRPCv1BackupActiveSessions stores the active sessions into DataDB, replying with their number
+func (sS *SessionS) BiRPCv1BackupActiveSessions(ctx *context.Context,
+	_ *utils.TenantWithAPIOpts, reply *int) (err error) {
+	var stored int
+	if stored, err = sS.backupSessions(); err != nil {
+		return
+	}
+	*reply = stored
+	return
+}
+
+// BiRPCv1GetBackupSessions returns the sessions backed up by this node
+func (sS *SessionS) BiRPCv1GetBackupSessions(ctx *context.Context,
+	args *utils.SessionFilter, reply *[]*ExternalSession) (err error) {
+	if args == nil { //protection in case on nil
+		args = &utils.SessionFilter{}
+	}
+	var sss []*engine.StoredSession
+	if sss, err = sS.dm.GetSessionsBackup(sS.cgrCfg.GeneralCfg().NodeID); err != nil {
+		return
+	}
+	tenant := utils.FirstNonEmpty(args.Tenant, sS.cgrCfg.GeneralCfg().DefaultTenant)
+	indx, unindx := sS.getIndexedFilters(tenant, args.Filters)
+	bSs := make([]*ExternalSession, 0, len(sss))
+	for _, ss := range sss {
+		s := newSessionFromStored(ss)
+		for _, sr := range s.SRuns {
+			if !passSessionFilters(indx, unindx, sr.Event) {
+				continue
+			}
+			bSs = append(bSs, s.AsExternalSession(sr, sS.cgrCfg.GeneralCfg().DefaultTimezone,
+				sS.cgrCfg.GeneralCfg().NodeID))
+			if args.Limit != nil && *args.Limit > 0 && *args.Limit == len(bSs) {
+				*reply = bSs
+				return
+			}
+		}
+	}
+	if len(bSs) == 0 {
+		return utils.ErrNotFound
+	}
+	*reply = bSs
+	return
+}
+
+// passSessionFilters checks the session run event against the filters, indexed or not
+func passSessionFilters(indx map[string][]string, unindx []*engine.FilterRule, me engine.MapEvent) bool {
+	for fldName, fldVals := range indx {
+		fldVal, err := me.GetString(fldName)
+		if err != nil || !slices.Contains(fldVals, fldVal) {
+			return false
+		}
+	}
+	ev := utils.MapStorage{utils.MetaReq: me.Data()}
+	for _, fltr := range unindx {
+		if pass, err := fltr.Pass(ev); err != nil || !pass {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,180 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>
+*/
+
+package sessions
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func newBackupSession() *Session {
+	return &Session{
+		CGRID:         "CGRID_BACKUP",
+		Tenant:        "cgrates.org",
+		ClientConnID:  utils.MetaInternal,
+		EventStart:    engine.MapEvent{utils.OriginID: "backup1", utils.AccountField: "1001"},
+		DebitInterval: 0,
+		Chargeable:    true,
+		OptsStart:     engine.MapEvent{utils.OptsSessionsTTL: "1h"},
+		SRuns: []*SRun{{
+			Event: engine.MapEvent{utils.RunID: utils.MetaDefault, utils.AccountField: "1001"},
+			CD: &engine.CallDescriptor{
+				CgrID:     "CGRID_BACKUP",
+				RunID:     utils.MetaDefault,
+				Account:   "1001",
+				LoopIndex: 2,
+			},
+			ExtraDuration: 5 * time.Second,
+			LastUsage:     time.Minute,
+			TotalUsage:    2 * time.Minute,
+			NextAutoDebit: utils.TimePointer(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+		}},
+	}
+}
+
+func TestSessionAsStoredSession(t *testing.T) {
+	s := newBackupSession()
+	s.sTerminator = &sTerminator{
+		ttl:      time.Hour,
+		ttlUsage: utils.DurationPointer(time.Minute),
+	}
+	ss := s.asStoredSession("node1")
+	if ss.BackupID() != "node1:CGRID_BACKUP" {
+		t.Errorf("Unexpected backup ID: %s", ss.BackupID())
+	}
+	if ss.TTL != time.Hour || ss.TTLUsage == nil || *ss.TTLUsage != time.Minute {
+		t.Errorf("Unexpected timeout: %s", utils.ToJSON(ss))
+	}
+	rcv := newSessionFromStored(ss)
+	s.sTerminator = nil
+	if !reflect.DeepEqual(s, rcv) {
+		t.Errorf("Expected %s, received %s", utils.ToJSON(s), utils.ToJSON(rcv))
+	}
+	// the restored session is decoupled from the stored one
+	rcv.SRuns[0].CD.LoopIndex = 3
+	if ss.SRuns[0].CD.LoopIndex != 2 {
+		t.Error("Expected the stored session to not be modified")
+	}
+}
+
+func TestSessionSBackupRestore(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	cfg.SessionSCfg().BackupInterval = time.Hour
+	dm := engine.NewDataManager(engine.NewInternalDB(nil, nil, true, cfg.DataDbCfg().Items),
+		cfg.CacheCfg(), nil)
+	sS := NewSessionS(cfg, dm, nil)
+	s := newBackupSession()
+	sS.registerSession(s, false)
+	sS.setSTerminator(s, nil)
+	var stored int
+	if err := sS.BiRPCv1BackupActiveSessions(context.Background(), nil, &stored); err != nil {
+		t.Fatal(err)
+	} else if stored != 1 {
+		t.Errorf("Expected 1 session stored, received %d", stored)
+	}
+	var bSs []*ExternalSession
+	if err := sS.BiRPCv1GetBackupSessions(context.Background(), &utils.SessionFilter{
+		Filters: []string{"*string:~*req.Account:1001"},
+	}, &bSs); err != nil {
+		t.Fatal(err)
+	} else if len(bSs) != 1 || bSs[0].CGRID != "CGRID_BACKUP" || bSs[0].Usage != 2*time.Minute {
+		t.Errorf("Unexpected sessions: %s", utils.ToJSON(bSs))
+	}
+	if err := sS.BiRPCv1GetBackupSessions(context.Background(), &utils.SessionFilter{
+		Filters: []string{"*string:~*req.Account:1002"},
+	}, &bSs); err != utils.ErrNotFound {
+		t.Errorf("Expected %v, received %v", utils.ErrNotFound, err)
+	}
+
+	// the sessions are kept on shutdown and restored on start
+	if err := sS.Shutdown(); err != nil {
+		t.Fatal(err)
+	}
+	sS2 := NewSessionS(cfg, dm, nil)
+	sS2.restoreSessions()
+	rs := sS2.getSessions("CGRID_BACKUP", false)
+	if len(rs) != 1 {
+		t.Fatalf("Expected the session to be restored, received: %s", utils.ToJSON(rs))
+	}
+	if rs[0].sTerminator == nil || rs[0].sTerminator.ttl != time.Hour {
+		t.Errorf("Expected the session timeout to be restored")
+	}
+	if rs[0].SRuns[0].TotalUsage != 2*time.Minute || rs[0].SRuns[0].CD.LoopIndex != 2 {
+		t.Errorf("Unexpected session run: %s", utils.ToJSON(rs[0].SRuns[0]))
+	}
+
+	// ending the session removes its backup
+	if err := sS2.terminateSession(rs[0], nil, nil, nil, false); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := dm.GetSessionsBackup(cfg.GeneralCfg().NodeID); err != utils.ErrNotFound {
+		t.Errorf("Expected %v, received %v", utils.ErrNotFound, err)
+	}
+
+	// the downtime counts out of the timeout, terminating the sessions expired meanwhile
+	ss := newBackupSession().asStoredSession(cfg.GeneralCfg().NodeID)
+	ss.TTL = time.Hour
+	ss.UpdatedAt = time.Now().Add(-2 * time.Hour)
+	if err := dm.SetSessionBackup(ss); err != nil {
+		t.Fatal(err)
+	}
+	sS3 := NewSessionS(cfg, dm, nil)
+	sS3.restoreSessions()
+	for i := 0; i < 100 && len(sS3.getSessions("CGRID_BACKUP", false)) != 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if rs = sS3.getSessions("CGRID_BACKUP", false); len(rs) != 0 {
+		t.Errorf("Expected the expired session to be terminated, received: %s", utils.ToJSON(rs))
+	}
+}
+
+func TestSessionSBackupRemoveEnded(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	cfg.SessionSCfg().BackupInterval = time.Hour
+	dm := engine.NewDataManager(engine.NewInternalDB(nil, nil, true, cfg.DataDbCfg().Items),
+		cfg.CacheCfg(), nil)
+	sS := NewSessionS(cfg, dm, nil)
+	// backed up by a previous run, not active anymore
+	if err := dm.SetSessionBackup(newBackupSession().asStoredSession(cfg.GeneralCfg().NodeID)); err != nil {
+		t.Fatal(err)
+	}
+	// backed up by another node
+	if err := dm.SetSessionBackup(newBackupSession().asStoredSession("node2")); err != nil {
+		t.Fatal(err)
+	}
+	if stored, err := sS.backupSessions(); err != nil {
+		t.Fatal(err)
+	} else if stored != 0 {
+		t.Errorf("Expected no session stored, received %d", stored)
+	}
+	if _, err := dm.GetSessionsBackup(cfg.GeneralCfg().NodeID); err != utils.ErrNotFound {
+		t.Errorf("Expected %v, received %v", utils.ErrNotFound, err)
+	}
+	if sss, err := dm.GetSessionsBackup("node2"); err != nil {
+		t.Error(err)
+	} else if len(sss) != 1 {
+		t.Errorf("Unexpected sessions: %s", utils.ToJSON(sss))
+	}
+}
@@ -911,6 +911,7 @@ type ArgsComputeFilterIndexIDs struct {
 	RouteIDs         []string
 	ThresholdIDs     []string
 	ChargerIDs       []string
+	TaxProfileIDs    []string
 	DispatcherIDs    []string
 	RateProfileIDs   []string
 	AccountIDs       []string
@@ -927,6 +928,7 @@ type ArgsComputeFilterIndexes struct {
 	RouteS      bool
 	ThresholdS  bool
 	ChargerS    bool
+	TaxS        bool
 	DispatcherS bool
 }
 
@@ -1072,6 +1074,26 @@ type TPChargerProfile struct {
 	Weight             float64
 }
 
+// TPTaxProfile is used in APIs to manage remotely offline TaxProfile
+type TPTaxProfile struct {
+	TPid               string
+	Tenant             string
+	ID                 string
+	FilterIDs          []string
+	ActivationInterval *TPActivationInterval // Time when this profile becomes active and expires
+	Weight             float64
+	Components         []*TPTaxComponent
+}
+
+// TPTaxComponent is one tax applied by the TPTaxProfile
+type TPTaxComponent struct {
+	ID           string
+	Jurisdiction string
+	Type         string
+	Value        float64
+	Compound     bool
+}
+
 type TPTntID struct {
 	TPid   string
 	Tenant string
@@ -1295,15 +1317,19 @@ func NewAttrReloadCacheWithOpts() *AttrReloadCacheWithAPIOpts {
 		RouteProfileIDs:          []string{MetaAny},
 		AttributeProfileIDs:      []string{MetaAny},
 		ChargerProfileIDs:        []string{MetaAny},
+		TaxProfileIDs:            []string{MetaAny},
 		DispatcherProfileIDs:     []string{MetaAny},
 		DispatcherHostIDs:        []string{MetaAny},
 		TimingIDs:                []string{MetaAny},
+		ExchangeRateIDs:          []string{MetaAny},
+		CalendarIDs:              []string{MetaAny},
 		AttributeFilterIndexIDs:  []string{MetaAny},
 		ResourceFilterIndexIDs:   []string{MetaAny},
 		StatFilterIndexIDs:       []string{MetaAny},
 		ThresholdFilterIndexIDs:  []string{MetaAny},
 		RouteFilterIndexIDs:      []string{MetaAny},
 		ChargerFilterIndexIDs:    []string{MetaAny},
+		TaxFilterIndexIDs:        []string{MetaAny},
 		DispatcherFilterIndexIDs: []string{MetaAny},
 		FilterIndexIDs:           []string{MetaAny},
 		Dispatchers:              []string{MetaAny},
@@ -1334,17 +1360,21 @@ func NewAttrReloadCacheWithOptsFromMap(arg map[string][]string, tnt string, opts
 		RouteProfileIDs:       arg[CacheRouteProfiles],
 		AttributeProfileIDs:   arg[CacheAttributeProfiles],
 		ChargerProfileIDs:     arg[CacheChargerProfiles],
+		TaxProfileIDs:         arg[CacheTaxProfiles],
 		DispatcherProfileIDs:  arg[CacheDispatcherProfiles],
 		DispatcherHostIDs:     arg[CacheDispatcherHosts],
 		Dispatchers:           arg[CacheDispatchers],
 
 		TimingIDs:                arg[CacheTimings],
+		ExchangeRateIDs:          arg[CacheExchangeRates],
+		CalendarIDs:              arg[CacheCalendars],
 		AttributeFilterIndexIDs:  arg[CacheAttributeFilterIndexes],
 		ResourceFilterIndexIDs:   arg[CacheResourceFilterIndexes],
 		StatFilterIndexIDs:       arg[CacheStatFilterIndexes],
 		ThresholdFilterIndexIDs:  arg[CacheThresholdFilterIndexes],
 		RouteFilterIndexIDs:      arg[CacheRouteFilterIndexes],
 		ChargerFilterIndexIDs:    arg[CacheChargerFilterIndexes],
+		TaxFilterIndexIDs:        arg[CacheTaxFilterIndexes],
 		DispatcherFilterIndexIDs: arg[CacheDispatcherFilterIndexes],
 		FilterIndexIDs:           arg[CacheReverseFilterIndexes],
 	}
@@ -1372,16 +1402,20 @@ type AttrReloadCacheWithAPIOpts struct {
 	RouteProfileIDs          []string       `json:",omitempty"`
 	AttributeProfileIDs      []string       `json:",omitempty"`
 	ChargerProfileIDs        []string       `json:",omitempty"`
+	TaxProfileIDs            []string       `json:",omitempty"`
 	DispatcherProfileIDs     []string       `json:",omitempty"`
 	DispatcherHostIDs        []string       `json:",omitempty"`
 	Dispatchers              []string       `json:",omitempty"`
 	TimingIDs                []string       `json:",omitempty"`
+	ExchangeRateIDs          []string       `json:",omitempty"`
+	CalendarIDs              []string       `json:",omitempty"`
 	AttributeFilterIndexIDs  []string       `json:",omitempty"`
 	ResourceFilterIndexIDs   []string       `json:",omitempty"`
 	StatFilterIndexIDs       []string       `json:",omitempty"`
 	ThresholdFilterIndexIDs  []string       `json:",omitempty"`
 	RouteFilterIndexIDs      []string       `json:",omitempty"`
 	ChargerFilterIndexIDs    []string       `json:",omitempty"`
+	TaxFilterIndexIDs        []string       `json:",omitempty"`
 	DispatcherFilterIndexIDs []string       `json:",omitempty"`
 	FilterIndexIDs           []string       `json:",omitempty"`
 }
@@ -1407,17 +1441,21 @@ func (a *AttrReloadCacheWithAPIOpts) Map() map[string][]string {
 		CacheRouteProfiles:       a.RouteProfileIDs,
 		CacheAttributeProfiles:   a.AttributeProfileIDs,
 		CacheChargerProfiles:     a.ChargerProfileIDs,
+		CacheTaxProfiles:         a.TaxProfileIDs,
 		CacheDispatcherProfiles:  a.DispatcherProfileIDs,
 		CacheDispatcherHosts:     a.DispatcherHostIDs,
 		CacheDispatchers:         a.Dispatchers,
 
 		CacheTimings:                 a.TimingIDs,
+		CacheExchangeRates:           a.ExchangeRateIDs,
+		CacheCalendars:               a.CalendarIDs,
 		CacheAttributeFilterIndexes:  a.AttributeFilterIndexIDs,
 		CacheResourceFilterIndexes:   a.ResourceFilterIndexIDs,
 		CacheStatFilterIndexes:       a.StatFilterIndexIDs,
 		CacheThresholdFilterIndexes:  a.ThresholdFilterIndexIDs,
 		CacheRouteFilterIndexes:      a.RouteFilterIndexIDs,
 		CacheChargerFilterIndexes:    a.ChargerFilterIndexIDs,
+		CacheTaxFilterIndexes:        a.TaxFilterIndexIDs,
 		CacheDispatcherFilterIndexes: a.DispatcherFilterIndexIDs,
 		CacheReverseFilterIndexes:    a.FilterIndexIDs,
 	}
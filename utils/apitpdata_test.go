@@ -985,16 +985,20 @@ func TestNewAttrReloadCacheWithOpts(t *testing.T) {
 		RouteProfileIDs:          []string{MetaAny},
 		AttributeProfileIDs:      []string{MetaAny},
 		ChargerProfileIDs:        []string{MetaAny},
+		TaxProfileIDs:            []string{MetaAny},
 		DispatcherProfileIDs:     []string{MetaAny},
 		DispatcherHostIDs:        []string{MetaAny},
 		Dispatchers:              []string{MetaAny},
 		TimingIDs:                []string{MetaAny},
+		ExchangeRateIDs:          []string{MetaAny},
+		CalendarIDs:              []string{MetaAny},
 		AttributeFilterIndexIDs:  []string{MetaAny},
 		ResourceFilterIndexIDs:   []string{MetaAny},
 		StatFilterIndexIDs:       []string{MetaAny},
 		ThresholdFilterIndexIDs:  []string{MetaAny},
 		RouteFilterIndexIDs:      []string{MetaAny},
 		ChargerFilterIndexIDs:    []string{MetaAny},
+		TaxFilterIndexIDs:        []string{MetaAny},
 		DispatcherFilterIndexIDs: []string{MetaAny},
 		FilterIndexIDs:           []string{MetaAny},
 	}
@@ -31,24 +31,25 @@ var (
 	GitLastLog string // If set, it will be processed as part of versioning
 
 	extraDBPartition = NewStringSet([]string{CacheDispatchers,
-		CacheDispatcherRoutes, CacheDispatcherLoads, CacheDiameterMessages, CacheRPCResponses, CacheClosedSessions,
-		CacheCDRIDs, CacheRPCConnections, CacheUCH, CacheSTIR, CacheEventCharges, MetaAPIBan, MetaSentryPeer,
+		CacheDispatcherRoutes, CacheDispatcherLoads, CacheDiameterMessages, CacheRadiusPackets, CacheRPCResponses, CacheClosedSessions,
+		CacheCDRIDs, CacheRPCConnections, CacheUCH, CacheSTIR, CacheEventCharges, MetaAPIBan, MetaSentryPeer, CacheHTTPLookups,
 		CacheRatingProfilesTmp, CacheCapsEvents, CacheReplicationHosts})
 
 	DataDBPartitions = NewStringSet([]string{CacheDestinations, CacheReverseDestinations, CacheRatingPlans,
-		CacheRatingProfiles, CacheDispatcherProfiles, CacheDispatcherHosts, CacheChargerProfiles, CacheActions, CacheActionTriggers, CacheSharedGroups, CacheTimings,
-		CacheResourceProfiles, CacheResources, CacheEventResources, CacheStatQueueProfiles, CacheStatQueues,
+		CacheRatingProfiles, CacheDispatcherProfiles, CacheDispatcherHosts, CacheChargerProfiles, CacheTaxProfiles, CacheActions, CacheActionTriggers, CacheSharedGroups, CacheTimings,
+		CacheExchangeRates, CacheCalendars, CacheResourceProfiles, CacheResources, CacheEventResources, CacheStatQueueProfiles, CacheStatQueues,
 		CacheThresholdProfiles, CacheThresholds, CacheFilters, CacheRouteProfiles, CacheAttributeProfiles,
 		CacheResourceFilterIndexes, CacheStatFilterIndexes, CacheThresholdFilterIndexes, CacheRouteFilterIndexes,
-		CacheAttributeFilterIndexes, CacheChargerFilterIndexes, CacheDispatcherFilterIndexes, CacheLoadIDs,
-		CacheReverseFilterIndexes, CacheActionPlans, CacheAccountActionPlans, CacheAccounts, CacheVersions})
+		CacheAttributeFilterIndexes, CacheChargerFilterIndexes, CacheTaxFilterIndexes, CacheDispatcherFilterIndexes, CacheLoadIDs,
+		CacheReverseFilterIndexes, CacheActionPlans, CacheAccountActionPlans, CacheAccounts, CacheVersions,
+		CacheSessionsBackup})
 
 	StorDBPartitions = NewStringSet([]string{CacheTBLTPTimings, CacheTBLTPDestinations, CacheTBLTPRates, CacheTBLTPDestinationRates,
 		CacheTBLTPRatingPlans, CacheTBLTPRatingProfiles, CacheTBLTPSharedGroups, CacheTBLTPActions,
 		CacheTBLTPActionPlans, CacheTBLTPActionTriggers, CacheTBLTPAccountActions, CacheTBLTPResources,
 		CacheTBLTPStats, CacheTBLTPThresholds, CacheTBLTPFilters, CacheSessionCostsTBL, CacheCDRsTBL,
-		CacheTBLTPRoutes, CacheTBLTPAttributes, CacheTBLTPChargers, CacheTBLTPDispatchers,
-		CacheTBLTPDispatcherHosts, CacheVersions})
+		CacheBalanceLedgerTBL, CacheInvoicesTBL, CacheTBLTPRoutes, CacheTBLTPAttributes, CacheTBLTPChargers, CacheTBLTPTaxProfiles,
+		CacheTBLTPDispatchers, CacheTBLTPDispatcherHosts, CacheVersions})
 
 	// CachePartitions enables creation of cache partitions
 	CachePartitions = JoinStringSet(extraDBPartition, DataDBPartitions)
@@ -66,6 +67,8 @@ var (
 		CacheResourceProfiles:        ResourceProfilesPrefix,
 		CacheResources:               ResourcesPrefix,
 		CacheTimings:                 TimingsPrefix,
+		CacheExchangeRates:           ExchangeRatePrefix,
+		CacheCalendars:               CalendarPrefix,
 		CacheStatQueueProfiles:       StatQueueProfilePrefix,
 		CacheStatQueues:              StatQueuePrefix,
 		CacheThresholdProfiles:       ThresholdProfilePrefix,
@@ -74,6 +77,7 @@ var (
 		CacheRouteProfiles:           RouteProfilePrefix,
 		CacheAttributeProfiles:       AttributeProfilePrefix,
 		CacheChargerProfiles:         ChargerProfilePrefix,
+		CacheTaxProfiles:             TaxProfilePrefix,
 		CacheDispatcherProfiles:      DispatcherProfilePrefix,
 		CacheDispatcherHosts:         DispatcherHostPrefix,
 		CacheResourceFilterIndexes:   ResourceFilterIndexes,
@@ -82,6 +86,7 @@ var (
 		CacheRouteFilterIndexes:      RouteFilterIndexes,
 		CacheAttributeFilterIndexes:  AttributeFilterIndexes,
 		CacheChargerFilterIndexes:    ChargerFilterIndexes,
+		CacheTaxFilterIndexes:        TaxFilterIndexes,
 		CacheDispatcherFilterIndexes: DispatcherFilterIndexes,
 
 		CacheLoadIDs:              LoadIDPrefix,
@@ -99,6 +104,7 @@ var (
 		CacheRouteFilterIndexes:      RouteProfilePrefix,
 		CacheAttributeFilterIndexes:  AttributeProfilePrefix,
 		CacheChargerFilterIndexes:    ChargerProfilePrefix,
+		CacheTaxFilterIndexes:        TaxProfilePrefix,
 		CacheDispatcherFilterIndexes: DispatcherProfilePrefix,
 		CacheReverseFilterIndexes:    FilterPrefix,
 	}
@@ -110,6 +116,7 @@ var (
 		CacheRouteProfiles:      CacheRouteFilterIndexes,
 		CacheAttributeProfiles:  CacheAttributeFilterIndexes,
 		CacheChargerProfiles:    CacheChargerFilterIndexes,
+		CacheTaxProfiles:        CacheTaxFilterIndexes,
 		CacheDispatcherProfiles: CacheDispatcherFilterIndexes,
 		CacheFilters:            CacheReverseFilterIndexes,
 	}
@@ -141,6 +148,7 @@ var (
 		TBLTPRoutes:           CacheTBLTPRoutes,
 		TBLTPAttributes:       CacheTBLTPAttributes,
 		TBLTPChargers:         CacheTBLTPChargers,
+		TBLTPTaxProfiles:      CacheTBLTPTaxProfiles,
 		TBLTPDispatchers:      CacheTBLTPDispatchers,
 		TBLTPDispatcherHosts:  CacheTBLTPDispatcherHosts,
 	}
@@ -191,6 +199,7 @@ const (
 	MetaPipe                 = "*|"
 	FieldsSep                = ","
 	InInFieldSep             = ":"
+	PercentSep               = "%"
 	StaticHDRValSep          = "::"
 	FilterValStart           = "("
 	FilterValEnd             = ")"
@@ -211,6 +220,7 @@ const (
 	RequestType              = "RequestType"
 	Direction                = "Direction"
 	Tenant                   = "Tenant"
+	Tag                      = "Tag"
 	Category                 = "Category"
 	Contexts                 = "Contexts"
 	AccountField             = "Account"
@@ -300,11 +310,15 @@ const (
 	RatePrefix                = "rep_"
 	AttributeProfilePrefix    = "alp_"
 	ChargerProfilePrefix      = "cpp_"
+	TaxProfilePrefix          = "txp_"
 	DispatcherProfilePrefix   = "dpp_"
 	DispatcherHostPrefix      = "dph_"
 	ThresholdProfilePrefix    = "thp_"
 	StatQueuePrefix           = "stq_"
 	LoadIDPrefix              = "lid_"
+	ExchangeRatePrefix        = "exr_"
+	CalendarPrefix            = "cal_"
+	SessionsBackupPrefix      = "sbk_"
 	LoadInstKey               = "load_history"
 	CreateCDRsTablesSQL       = "create_cdrs_tables.sql"
 	CreateTariffPlanTablesSQL = "create_tariffplan_tables.sql"
@@ -312,6 +326,7 @@ const (
 	MetaConstant              = "*constant"
 	MetaFiller                = "*filler"
 	MetaHTTPPost              = "*http_post"
+	MetaHTTPjson              = "*http_json"
 	MetaHTTPjsonCDR           = "*http_json_cdr"
 	MetaHTTPjsonMap           = "*http_json_map"
 	MetaAMQPjsonCDR           = "*amqp_json_cdr"
@@ -324,6 +339,10 @@ const (
 	MetaSQL                   = "*sql"
 	MetaMySQL                 = "*mysql"
 	MetaS3jsonMap             = "*s3_json_map"
+	MetaS3                    = "*s3"
+	MetaHTTP                  = "*http"
+	MetaDelete                = "*delete"
+	MetaArchive               = "*archive"
 	ConfigPath                = "/etc/cgrates/"
 	DisconnectCause           = "DisconnectCause"
 	MetaRating                = "*rating"
@@ -363,6 +382,7 @@ const (
 	UpdatedAt                = "UpdatedAt"
 	NodeID                   = "NodeID"
 	ActiveGoroutines         = "ActiveGoroutines"
+	CapsLimits               = "CapsLimits"
 	MemoryUsage              = "MemoryUsage"
 	RunningSince             = "RunningSince"
 	GoVersion                = "GoVersion"
@@ -396,18 +416,27 @@ const (
 	MetaSessionsCosts        = "*sessions_costs"
 	MetaRALs                 = "*rals"
 	MetaReplicator           = "*replicator"
+	MetaPrimary              = "*primary"
+	MetaReplica              = "*replica"
+	MetaReplicaPreferred     = "*replica_preferred"
 	MetaRerate               = "*rerate"
 	MetaRefund               = "*refund"
+	MetaAPI                  = "*api"
 	MetaStats                = "*stats"
 	MetaResponder            = "*responder"
 	MetaCore                 = "*core"
 	MetaServiceManager       = "*servicemanager"
 	MetaChargers             = "*chargers"
+	MetaTaxes                = "*taxes"
+	MetaPercent              = "*percent"
+	MetaFlat                 = "*flat"
 	MetaBlockerError         = "*blocker_error"
 	MetaConfig               = "*config"
 	MetaDispatchers          = "*dispatchers"
 	MetaRegistrarC           = "*registrarc"
 	MetaDispatcherHosts      = "*dispatcher_hosts"
+	MetaExchangeRates        = "*exchange_rates"
+	MetaCalendars            = "*calendars"
 	MetaFilters              = "*filters"
 	MetaCDRs                 = "*cdrs"
 	MetaDC                   = "*dc"
@@ -426,12 +455,16 @@ const (
 	XMLSuffix                = ".xml"
 	CSVSuffix                = ".csv"
 	FWVSuffix                = ".fwv"
+	ParquetSuffix            = ".parquet"
+	AvroSuffix               = ".avro"
 	ContentJSON              = "json"
 	ContentForm              = "form"
 	FileLockPrefix           = "file_"
 	ActionsPoster            = "act"
 	CDRPoster                = "cdr"
 	MetaFileCSV              = "*file_csv"
+	MetaFileParquet          = "*file_parquet"
+	MetaFileAvro             = "*file_avro"
 	MetaVirt                 = "*virt"
 	MetaElastic              = "*els"
 	MetaFileFWV              = "*file_fwv"
@@ -480,6 +513,7 @@ const (
 	Routes                = "Routes"
 	Attributes            = "Attributes"
 	Chargers              = "Chargers"
+	TaxProfiles           = "TaxProfiles"
 	Dispatchers           = "Dispatchers"
 	StatS                 = "Stats"
 	LoadIDsVrs            = "LoadIDs"
@@ -518,6 +552,39 @@ const (
 	AllowNegative         = "AllowNegative"
 	Disabled              = "Disabled"
 	Initial               = "Initial"
+	Held                  = "Held"
+	Amount                = "Amount"
+	HoldID                = "HoldID"
+	FromAccount           = "FromAccount"
+	ToAccount             = "ToAccount"
+	ToBalanceID           = "ToBalanceID"
+	OldValue              = "OldValue"
+	NewValue              = "NewValue"
+	Delta                 = "Delta"
+	Cause                 = "Cause"
+	CauseID               = "CauseID"
+	BalanceLedgerEntry    = "BalanceLedgerEntry"
+	Currency              = "Currency"
+	ExchangeRate          = "ExchangeRate"
+	Timezone              = "Timezone"
+	Hours                 = "Hours"
+	Holidays              = "Holidays"
+	FromCurrency          = "FromCurrency"
+	Invoice               = "Invoice"
+	InvoiceID             = "InvoiceID"
+	PeriodStart           = "PeriodStart"
+	PeriodEnd             = "PeriodEnd"
+	LineItems             = "LineItems"
+	Subtotal              = "Subtotal"
+	Discount              = "Discount"
+	Tax                   = "Tax"
+	Taxes                 = "Taxes"
+	TaxProfileID          = "TaxProfileID"
+	ComponentID           = "ComponentID"
+	Jurisdiction          = "Jurisdiction"
+	Compound              = "Compound"
+	Total                 = "Total"
+	ToCurrency            = "ToCurrency"
 	Action                = "Action"
 
 	SessionSCosts            = "SessionSCosts"
@@ -697,6 +764,8 @@ const (
 	UDP                     = "udp"
 	VersionName             = "Version"
 	MetaTenant              = "*tenant"
+	MetaAPIMethod           = "*api_method"
+	MetaConnection          = "*connection"
 	ResourceUsage           = "ResourceUsage"
 	MetaStrip               = "*strip"
 	MetaDuration            = "*duration"
@@ -715,6 +784,10 @@ const (
 	MetaReload              = "*reload"
 	MetaLoad                = "*load"
 	MetaFloat64             = "*float64"
+	MetaInt64               = "*int64"
+	MetaBool                = "*bool"
+	MetaTimestamp           = "*timestamp"
+	MetaDecimal             = "*decimal"
 	MetaRemove              = "*remove"
 	MetaRemoveAll           = "*removeall"
 	MetaStore               = "*store"
@@ -739,6 +812,10 @@ const (
 	ApierV                  = "ApierV"
 	MetaApier               = "*apier"
 	MetaAnalyzer            = "*analyzer"
+	MetaInvoices            = "*invoices"
+	MetaCategory            = "*category"
+	MetaDestination         = "*destination"
+	MetaRecurring           = "*recurring"
 	CGREventString          = "CGREvent"
 	MetaTextPlain           = "*text_plain"
 	MetaIgnoreErrors        = "*ignore_errors"
@@ -787,6 +864,9 @@ const (
 	MetaLoaders             = "*loaders"
 	TmpSuffix               = ".tmp"
 	MetaDiamreq             = "*diamreq"
+	MetaRadDAReq            = "*radDAReq"
+	MetaDMR                 = "*dmr"
+	MetaCoA                 = "*coa"
 	MetaCost                = "*cost"
 	MetaGroup               = "*group"
 	InternalRPCSet          = "InternalRPCSet"
@@ -917,6 +997,10 @@ const (
 	DNSUri                = "Uri"
 	DNSHdr                = "Hdr"
 	DNSA                  = "A"
+	DNSAAAA               = "AAAA"
+	DNSTxt                = "Txt"
+	DNSPtr                = "Ptr"
+	DNSMx                 = "Mx"
 	DNSTarget             = "Target"
 	DNSPriority           = "Priority"
 	DNSPort               = "Port"
@@ -949,12 +1033,16 @@ const (
 	MetaAttributeProfiles   = "*attribute_profiles"
 	MetaDispatcherProfiles  = "*dispatcher_profiles"
 	MetaChargerProfiles     = "*charger_profiles"
+	MetaTaxProfiles         = "*tax_profiles"
 	MetaSharedGroups        = "*shared_groups"
 	MetaThresholds          = "*thresholds"
 	MetaRoutes              = "*routes"
 	MetaAttributes          = "*attributes"
 	MetaLoadIDs             = "*load_ids"
 	MetaNodeID              = "*node_id"
+	MetaRates               = "*rates"
+	MetaDestinationRates    = "*destination_rates"
+	MetaAccountActions      = "*account_actions"
 )
 
 // MetaMetrics
@@ -969,6 +1057,11 @@ const (
 	MetaSum      = "*sum"
 	MetaAverage  = "*average"
 	MetaDistinct = "*distinct"
+	MetaP50      = "*p50"
+	MetaP95      = "*p95"
+	MetaP99      = "*p99"
+	MetaMax      = "*max"
+	MetaMin      = "*min"
 	MetaRAR      = "*rar"
 )
 
@@ -985,6 +1078,7 @@ const (
 	EeS         = "EeS"
 	FilterS     = "FilterS"
 	GuardianS   = "GuardianS"
+	InvoiceS    = "InvoiceS"
 	LoaderS     = "LoaderS"
 	RALs        = "RALs"
 	RegistrarC  = "RegistrarC"
@@ -1014,6 +1108,7 @@ const (
 	ReplicatorLow  = "replicator"
 	ApierSLow      = "apiers"
 	EEsLow         = "ees"
+	InvoicesLow    = "invoices"
 )
 
 // Actions
@@ -1040,6 +1135,7 @@ const (
 	CDRLog                      = "*cdrlog"
 	MetaSetDDestinations        = "*set_ddestinations"
 	MetaTransferMonetaryDefault = "*transfer_monetary_default"
+	MetaTransferBalance         = "*transfer_balance"
 	MetaCgrRpc                  = "*cgr_rpc"
 	TopUpZeroNegative           = "*topup_zero_negative"
 	SetExpiry                   = "*set_expiry"
@@ -1084,6 +1180,7 @@ const (
 	MetaTpTimings           = "*tp_timings"
 	MetaTpDestinations      = "*tp_destinations"
 	MetaTpChargers          = "*tp_chargers"
+	MetaTpTaxProfiles       = "*tp_tax_profiles"
 	MetaTpDispatchers       = "*tp_dispatchers"
 	MetaDurationSeconds     = "*duration_seconds"
 	MetaDurationNanoseconds = "*duration_nanoseconds"
@@ -1123,6 +1220,7 @@ const (
 	TpRatingPlan         = "TpRatingPlan"
 	TpRatingProfile      = "TpRatingProfile"
 	TpChargers           = "TpChargers"
+	TpTaxProfiles        = "TpTaxProfiles"
 	TpDispatchers        = "TpDispatchers"
 	TpDispatcherProfiles = "TpDispatcherProfiles"
 	TpDispatcherHosts    = "TpDispatcherHosts"
@@ -1135,6 +1233,9 @@ const (
 	MetaRoundRobin     = "*round_robin"
 	MetaRatio          = "*ratio"
 	MetaDefaultRatio   = "*default_ratio"
+	MetaHash           = "*hash"
+	MetaHashField      = "*hash_field"
+	MetaLeastLoad      = "*least_load"
 	ThresholdSv1       = "ThresholdSv1"
 	StatSv1            = "StatSv1"
 	ResourceSv1        = "ResourceSv1"
@@ -1169,6 +1270,8 @@ const (
 	MetaIPNet              = "*ipnet"
 	MetaAPIBan             = "*apiban"
 	MetaSentryPeer         = "*sentrypeer"
+	MetaGeoIP              = "*geoip"
+	MetaCalendar           = "*calendar"
 	MetaToken              = "*token"
 	MetaIp                 = "*ip"
 	MetaNumber             = "*number"
@@ -1189,6 +1292,8 @@ const (
 	MetaNotIPNet              = "*notipnet"
 	MetaNotAPIBan             = "*notapiban"
 	MetaNotSentryPeer         = "*notsentrypeer"
+	MetaNotGeoIP              = "*notgeoip"
+	MetaNotCalendar           = "*notcalendar"
 	MetaNotActivationInterval = "*notai"
 	MetaNotRegex              = "*notregex"
 
@@ -1221,8 +1326,11 @@ const (
 	ReplicatorSv1GetRouteProfile         = "ReplicatorSv1.GetRouteProfile"
 	ReplicatorSv1GetAttributeProfile     = "ReplicatorSv1.GetAttributeProfile"
 	ReplicatorSv1GetChargerProfile       = "ReplicatorSv1.GetChargerProfile"
+	ReplicatorSv1GetTaxProfile           = "ReplicatorSv1.GetTaxProfile"
 	ReplicatorSv1GetDispatcherProfile    = "ReplicatorSv1.GetDispatcherProfile"
 	ReplicatorSv1GetDispatcherHost       = "ReplicatorSv1.GetDispatcherHost"
+	ReplicatorSv1GetExchangeRate         = "ReplicatorSv1.GetExchangeRate"
+	ReplicatorSv1GetCalendar             = "ReplicatorSv1.GetCalendar"
 	ReplicatorSv1GetItemLoadIDs          = "ReplicatorSv1.GetItemLoadIDs"
 	ReplicatorSv1SetThresholdProfile     = "ReplicatorSv1.SetThresholdProfile"
 	ReplicatorSv1SetThreshold            = "ReplicatorSv1.SetThreshold"
@@ -1245,8 +1353,11 @@ const (
 	ReplicatorSv1SetRouteProfile         = "ReplicatorSv1.SetRouteProfile"
 	ReplicatorSv1SetAttributeProfile     = "ReplicatorSv1.SetAttributeProfile"
 	ReplicatorSv1SetChargerProfile       = "ReplicatorSv1.SetChargerProfile"
+	ReplicatorSv1SetTaxProfile           = "ReplicatorSv1.SetTaxProfile"
 	ReplicatorSv1SetDispatcherProfile    = "ReplicatorSv1.SetDispatcherProfile"
 	ReplicatorSv1SetDispatcherHost       = "ReplicatorSv1.SetDispatcherHost"
+	ReplicatorSv1SetExchangeRate         = "ReplicatorSv1.SetExchangeRate"
+	ReplicatorSv1SetCalendar             = "ReplicatorSv1.SetCalendar"
 	ReplicatorSv1SetLoadIDs              = "ReplicatorSv1.SetLoadIDs"
 	ReplicatorSv1RemoveThreshold         = "ReplicatorSv1.RemoveThreshold"
 	ReplicatorSv1RemoveDestination       = "ReplicatorSv1.RemoveDestination"
@@ -1268,8 +1379,11 @@ const (
 	ReplicatorSv1RemoveRouteProfile      = "ReplicatorSv1.RemoveRouteProfile"
 	ReplicatorSv1RemoveAttributeProfile  = "ReplicatorSv1.RemoveAttributeProfile"
 	ReplicatorSv1RemoveChargerProfile    = "ReplicatorSv1.RemoveChargerProfile"
+	ReplicatorSv1RemoveTaxProfile        = "ReplicatorSv1.RemoveTaxProfile"
 	ReplicatorSv1RemoveDispatcherProfile = "ReplicatorSv1.RemoveDispatcherProfile"
 	ReplicatorSv1RemoveDispatcherHost    = "ReplicatorSv1.RemoveDispatcherHost"
+	ReplicatorSv1RemoveExchangeRate      = "ReplicatorSv1.RemoveExchangeRate"
+	ReplicatorSv1RemoveCalendar          = "ReplicatorSv1.RemoveCalendar"
 	ReplicatorSv1GetIndexes              = "ReplicatorSv1.GetIndexes"
 	ReplicatorSv1SetIndexes              = "ReplicatorSv1.SetIndexes"
 	ReplicatorSv1RemoveIndexes           = "ReplicatorSv1.RemoveIndexes"
@@ -1302,6 +1416,14 @@ const (
 	APIerSv1GetDispatcherHost                 = "APIerSv1.GetDispatcherHost"
 	APIerSv1GetDispatcherHostIDs              = "APIerSv1.GetDispatcherHostIDs"
 	APIerSv1RemoveDispatcherHost              = "APIerSv1.RemoveDispatcherHost"
+	APIerSv1SetExchangeRate                   = "APIerSv1.SetExchangeRate"
+	APIerSv1GetExchangeRate                   = "APIerSv1.GetExchangeRate"
+	APIerSv1GetExchangeRateIDs                = "APIerSv1.GetExchangeRateIDs"
+	APIerSv1RemoveExchangeRate                = "APIerSv1.RemoveExchangeRate"
+	APIerSv1SetCalendar                       = "APIerSv1.SetCalendar"
+	APIerSv1GetCalendar                       = "APIerSv1.GetCalendar"
+	APIerSv1GetCalendarIDs                    = "APIerSv1.GetCalendarIDs"
+	APIerSv1RemoveCalendar                    = "APIerSv1.RemoveCalendar"
 	APIerSv1GetEventCost                      = "APIerSv1.GetEventCost"
 	APIerSv1LoadTariffPlanFromFolder          = "APIerSv1.LoadTariffPlanFromFolder"
 	APIerSv1ExportToFolder                    = "APIerSv1.ExportToFolder"
@@ -1321,6 +1443,10 @@ const (
 	APIerSv1SetStorDBVersions                 = "APIerSv1.SetStorDBVersions"
 	APIerSv1GetAccountActionPlan              = "APIerSv1.GetAccountActionPlan"
 	APIerSv1ComputeActionPlanIndexes          = "APIerSv1.ComputeActionPlanIndexes"
+	APIerSv1DumpDataDB                        = "APIerSv1.DumpDataDB"
+	APIerSv1RestoreDataDB                     = "APIerSv1.RestoreDataDB"
+	APIerSv1DumpStorDB                        = "APIerSv1.DumpStorDB"
+	APIerSv1RestoreStorDB                     = "APIerSv1.RestoreStorDB"
 	APIerSv1GetActions                        = "APIerSv1.GetActions"
 	APIerSv1GetActionPlan                     = "APIerSv1.GetActionPlan"
 	APIerSv1GetActionPlanIDs                  = "APIerSv1.GetActionPlanIDs"
@@ -1332,6 +1458,12 @@ const (
 	APIerSv1GetReverseDestination             = "APIerSv1.GetReverseDestination"
 	APIerSv1AddBalance                        = "APIerSv1.AddBalance"
 	APIerSv1DebitBalance                      = "APIerSv1.DebitBalance"
+	APIerSv1HoldBalance                       = "APIerSv1.HoldBalance"
+	APIerSv1CaptureBalanceHold                = "APIerSv1.CaptureBalanceHold"
+	APIerSv1ReleaseBalanceHold                = "APIerSv1.ReleaseBalanceHold"
+	APIerSv1TransferBalance                   = "APIerSv1.TransferBalance"
+	APIerSv1GetBalanceLedger                  = "APIerSv1.GetBalanceLedger"
+	APIerSv1ExportBalanceLedger               = "APIerSv1.ExportBalanceLedger"
 	APIerSv1SetAccount                        = "APIerSv1.SetAccount"
 	APIerSv1GetAccountsCount                  = "APIerSv1.GetAccountsCount"
 	APIerSv1GetDataDBVersions                 = "APIerSv1.GetDataDBVersions"
@@ -1359,6 +1491,10 @@ const (
 	APIerSv1GetTPCharger                      = "APIerSv1.GetTPCharger"
 	APIerSv1SetTPCharger                      = "APIerSv1.SetTPCharger"
 	APIerSv1RemoveTPCharger                   = "APIerSv1.RemoveTPCharger"
+	APIerSv1GetTPTaxProfile                   = "APIerSv1.GetTPTaxProfile"
+	APIerSv1SetTPTaxProfile                   = "APIerSv1.SetTPTaxProfile"
+	APIerSv1RemoveTPTaxProfile                = "APIerSv1.RemoveTPTaxProfile"
+	APIerSv1GetTPTaxProfileIDs                = "APIerSv1.GetTPTaxProfileIDs"
 	APIerSv1GetTPChargerIDs                   = "APIerSv1.GetTPChargerIDs"
 	APIerSv1SetTPFilterProfile                = "APIerSv1.SetTPFilterProfile"
 	APIerSv1GetTPFilterProfile                = "APIerSv1.GetTPFilterProfile"
@@ -1570,6 +1706,14 @@ const (
 	APIerSv1GetChargerProfileIDs  = "APIerSv1.GetChargerProfileIDs"
 )
 
+// TaxProfile APIs
+const (
+	APIerSv1GetTaxProfile    = "APIerSv1.GetTaxProfile"
+	APIerSv1RemoveTaxProfile = "APIerSv1.RemoveTaxProfile"
+	APIerSv1SetTaxProfile    = "APIerSv1.SetTaxProfile"
+	APIerSv1GetTaxProfileIDs = "APIerSv1.GetTaxProfileIDs"
+)
+
 // ThresholdS APIs
 const (
 	ThresholdSv1ProcessEvent          = "ThresholdSv1.ProcessEvent"
@@ -1643,6 +1787,8 @@ const (
 	SessionSv1ReplicateSessions          = "SessionSv1.ReplicateSessions"
 	SessionSv1ActivateSessions           = "SessionSv1.ActivateSessions"
 	SessionSv1DeactivateSessions         = "SessionSv1.DeactivateSessions"
+	SessionSv1BackupActiveSessions       = "SessionSv1.BackupActiveSessions"
+	SessionSv1GetBackupSessions          = "SessionSv1.GetBackupSessions"
 	SMGenericV1InitiateSession           = "SMGenericV1.InitiateSession"
 	SessionSv1ReAuthorize                = "SessionSv1.ReAuthorize"
 	SessionSv1DisconnectPeer             = "SessionSv1.DisconnectPeer"
@@ -1696,11 +1842,21 @@ const (
 	AnalyzerSv1StringQuery = "AnalyzerSv1.StringQuery"
 )
 
+// InvoiceS APIs
+const (
+	InvoiceSv1               = "InvoiceSv1"
+	InvoiceSv1Ping           = "InvoiceSv1.Ping"
+	InvoiceSv1BillRun        = "InvoiceSv1.BillRun"
+	InvoiceSv1GetInvoices    = "InvoiceSv1.GetInvoices"
+	InvoiceSv1ExportInvoices = "InvoiceSv1.ExportInvoices"
+)
+
 // LoaderS APIs
 const (
 	LoaderSv1       = "LoaderSv1"
 	LoaderSv1Load   = "LoaderSv1.Load"
 	LoaderSv1Remove = "LoaderSv1.Remove"
+	LoaderSv1DryRun = "LoaderSv1.DryRun"
 	LoaderSv1Ping   = "LoaderSv1.Ping"
 )
 
@@ -1762,9 +1918,12 @@ const (
 
 // EEs
 const (
-	EeSv1             = "EeSv1"
-	EeSv1Ping         = "EeSv1.Ping"
-	EeSv1ProcessEvent = "EeSv1.ProcessEvent"
+	EeSv1                  = "EeSv1"
+	EeSv1Ping              = "EeSv1.Ping"
+	EeSv1ProcessEvent      = "EeSv1.ProcessEvent"
+	EeSv1GetDeadLetters    = "EeSv1.GetDeadLetters"
+	EeSv1ReplayDeadLetters = "EeSv1.ReplayDeadLetters"
+	EeSv1PurgeDeadLetters  = "EeSv1.PurgeDeadLetters"
 )
 
 // cgr_ variables
@@ -1804,6 +1963,7 @@ const (
 	RoutesCsv             = "Routes.csv"
 	AttributesCsv         = "Attributes.csv"
 	ChargersCsv           = "Chargers.csv"
+	TaxProfilesCsv        = "TaxProfiles.csv"
 	DispatcherProfilesCsv = "DispatcherProfiles.csv"
 	DispatcherHostsCsv    = "DispatcherHosts.csv"
 )
@@ -1827,9 +1987,12 @@ const (
 	TBLTPFilters          = "tp_filters"
 	SessionCostsTBL       = "session_costs"
 	CDRsTBL               = "cdrs"
+	BalanceLedgerTBL      = "balance_ledger"
+	InvoicesTBL           = "invoices"
 	TBLTPRoutes           = "tp_routes"
 	TBLTPAttributes       = "tp_attributes"
 	TBLTPChargers         = "tp_chargers"
+	TBLTPTaxProfiles      = "tp_tax_profiles"
 	TBLVersions           = "versions"
 	OldSMCosts            = "sm_costs"
 	TBLTPDispatchers      = "tp_dispatcher_profiles"
@@ -1859,8 +2022,11 @@ const (
 	CacheRouteProfiles           = "*route_profiles"
 	CacheAttributeProfiles       = "*attribute_profiles"
 	CacheChargerProfiles         = "*charger_profiles"
+	CacheTaxProfiles             = "*tax_profiles"
 	CacheDispatcherProfiles      = "*dispatcher_profiles"
 	CacheDispatcherHosts         = "*dispatcher_hosts"
+	CacheExchangeRates           = "*exchange_rates"
+	CacheCalendars               = "*calendars"
 	CacheDispatchers             = "*dispatchers"
 	CacheDispatcherRoutes        = "*dispatcher_routes"
 	CacheDispatcherLoads         = "*dispatcher_loads"
@@ -1870,8 +2036,10 @@ const (
 	CacheRouteFilterIndexes      = "*route_filter_indexes"
 	CacheAttributeFilterIndexes  = "*attribute_filter_indexes"
 	CacheChargerFilterIndexes    = "*charger_filter_indexes"
+	CacheTaxFilterIndexes        = "*tax_filter_indexes"
 	CacheDispatcherFilterIndexes = "*dispatcher_filter_indexes"
 	CacheDiameterMessages        = "*diameter_messages"
+	CacheRadiusPackets           = "*radius_packets"
 	CacheRPCResponses            = "*rpc_responses"
 	CacheClosedSessions          = "*closed_sessions"
 	MetaPrecaching               = "*precaching"
@@ -1883,9 +2051,11 @@ const (
 	CacheUCH                     = "*uch"
 	CacheSTIR                    = "*stir"
 	CacheEventCharges            = "*event_charges"
+	CacheHTTPLookups             = "*http_lookups"
 	CacheReverseFilterIndexes    = "*reverse_filter_indexes"
 	CacheAccounts                = "*accounts"
 	CacheVersions                = "*versions"
+	CacheSessionsBackup          = "*sessions_backup"
 	CacheCapsEvents              = "*caps_events"
 	CacheReplicationHosts        = "*replication_hosts"
 
@@ -1907,9 +2077,12 @@ const (
 	CacheTBLTPFilters          = "*tp_filters"
 	CacheSessionCostsTBL       = "*session_costs"
 	CacheCDRsTBL               = "*cdrs"
+	CacheBalanceLedgerTBL      = "*balance_ledger"
+	CacheInvoicesTBL           = "*invoices"
 	CacheTBLTPRoutes           = "*tp_routes"
 	CacheTBLTPAttributes       = "*tp_attributes"
 	CacheTBLTPChargers         = "*tp_chargers"
+	CacheTBLTPTaxProfiles      = "*tp_tax_profiles"
 	CacheTBLTPDispatchers      = "*tp_dispatcher_profiles"
 	CacheTBLTPDispatcherHosts  = "*tp_dispatcher_hosts"
 )
@@ -1921,6 +2094,7 @@ const (
 	ThresholdFilterIndexes  = "tfi_"
 	AttributeFilterIndexes  = "afi_"
 	ChargerFilterIndexes    = "cfi_"
+	TaxFilterIndexes        = "txi_"
 	DispatcherFilterIndexes = "dfi_"
 	ActionPlanIndexes       = "api_"
 	RouteFilterIndexes      = "rti_"
@@ -2001,6 +2175,10 @@ const (
 	Tenants                = "tenants"
 	MysqlLocation          = "mysqlLocation"
 	SSLMode                = "sslMode"
+
+	InternalDBDumpPathCfg     = "internalDBDumpPath"
+	InternalDBDumpIntervalCfg = "internalDBDumpInterval"
+	InternalDBWriteLogCfg     = "internalDBWriteLog"
 )
 
 // DataDbCfg
@@ -2024,6 +2202,8 @@ const (
 	RedisClientCertificate     = "redisClientCertificate"
 	RedisClientKey             = "redisClientKey"
 	RedisCACertificate         = "redisCACertificate"
+	RedisReadPolicyCfg         = "redisReadPolicy"
+	RedisReadReplicasCfg       = "redisReadReplicas"
 	ReplicationFilteredCfg     = "replication_filtered"
 	ReplicationCache           = "replication_cache"
 	RemoteConnIDCfg            = "remote_conn_id"
@@ -2068,6 +2248,7 @@ const (
 	HTTPWSURLCfg             = "ws_url"
 	HTTPFreeswitchCDRsURLCfg = "freeswitch_cdrs_url"
 	HTTPCDRsURLCfg           = "http_cdrs"
+	HTTPMetricsURLCfg        = "metrics_url"
 	HTTPUseBasicAuthCfg      = "use_basic_auth"
 	HTTPAuthUsersCfg         = "auth_users"
 	HTTPClientOptsCfg        = "client_opts"
@@ -2094,6 +2275,10 @@ const (
 	StatSConnsCfg     = "stats_conns"
 	ResourceSConnsCfg = "resources_conns"
 	ApierSConnsCfg    = "apiers_conns"
+	HTTPProfilesCfg   = "http_profiles"
+	TimeoutCfg        = "timeout"
+	HeadersCfg        = "headers"
+	GeoIPDBPathCfg    = "geoip_db_path"
 )
 
 // RalsCfg
@@ -2106,6 +2291,10 @@ const (
 	MaxComputedUsageCfg        = "max_computed_usage"
 	BalanceRatingSubjectCfg    = "balance_rating_subject"
 	MaxIncrementsCfg           = "max_increments"
+	BalanceLedgerCfg           = "balance_ledger"
+	DefaultCurrencyCfg         = "default_currency"
+	MaxTransferCfg             = "max_transfer"
+	TransferFeeCfg             = "transfer_fee"
 )
 
 // SchedulerCfg
@@ -2125,6 +2314,7 @@ const (
 	OnlineCDRExportsCfg    = "online_cdr_exports"
 	SessionCostRetires     = "session_cost_retries"
 	RateSConnsCfg          = "rates_conns"
+	TaxesCfg               = "taxes"
 )
 
 // SessionSCfg
@@ -2150,6 +2340,7 @@ const (
 	ChannelSyncIntervalCfg    = "channel_sync_interval"
 	StaleChanMaxExtraUsageCfg = "stale_chan_max_extra_usage"
 	TerminateAttemptsCfg      = "terminate_attempts"
+	BackupIntervalCfg         = "backup_interval"
 	AlterableFieldsCfg        = "alterable_fields"
 	MinDurLowBalanceCfg       = "min_dur_low_balance"
 	DefaultUsageCfg           = "default_usage"
@@ -2221,6 +2412,13 @@ const (
 	ListenAcctCfg         = "listen_acct"
 	ClientSecretsCfg      = "client_secrets"
 	ClientDictionariesCfg = "client_dictionaries"
+	ClientDaAddressesCfg  = "client_da_addresses"
+	HostCfg               = "host"
+	PortCfg               = "port"
+	RequestsCacheKeyCfg   = "requests_cache_key"
+	DMRTemplateCfg        = "dmr_template"
+	CoATemplateCfg        = "coa_template"
+	DATimeoutCfg          = "da_timeout"
 
 	// AttributeSCfg
 	IndexedSelectsCfg           = "indexed_selects"
@@ -2246,6 +2444,11 @@ const (
 	AttributeSContextCfg = "attributes_context"
 	SynchronousCfg       = "synchronous"
 	AttemptsCfg          = "attempts"
+	RetryBackoffCfg      = "retry_backoff"
+	RetryMaxBackoffCfg   = "retry_max_backoff"
+	RetryJitterCfg       = "retry_jitter"
+	RetryMaxAgeCfg       = "retry_max_age"
+	DeadLetterDirCfg     = "dead_letter_dir"
 	AttributeContextCfg  = "attribute_context"
 	AttributeIDsCfg      = "attribute_ids"
 
@@ -2256,6 +2459,17 @@ const (
 	TpOutDirCfg     = "tp_out_dir"
 	DataCfg         = "data"
 
+	// LoaderSourceCfg
+	RemoteSourcesCfg   = "remote_sources"
+	ProcessedActionCfg = "processed_action"
+	S3BucketCfg        = "s3_bucket"
+	S3PrefixCfg        = "s3_prefix"
+	S3ArchivePrefixCfg = "s3_archive_prefix"
+	AWSRegionCfg       = "aws_region"
+	AWSKeyCfg          = "aws_key"
+	AWSSecretCfg       = "aws_secret"
+	AWSTokenCfg        = "aws_token"
+
 	DefaultRatioCfg           = "default_ratio"
 	ReadersCfg                = "readers"
 	ExportersCfg              = "exporters"
@@ -2288,6 +2502,13 @@ const (
 	MetaLimitCfg        = "*limit"
 	MetaOffsetCfg       = "*offset"
 
+	// InvoiceSCfg
+	ExporterIDsCfg     = "exporter_ids"
+	RunIntervalCfg     = "run_interval"
+	GroupByCfg         = "group_by"
+	TaxPercentCfg      = "tax_percent"
+	DiscountPercentCfg = "discount_percent"
+
 	// AnalyzerSCfg
 	CleanupIntervalCfg = "cleanup_interval"
 	IndexTypeCfg       = "index_type"
@@ -2297,7 +2518,11 @@ const (
 	CapsCfg              = "caps"
 	CapsStrategyCfg      = "caps_strategy"
 	CapsStatsIntervalCfg = "caps_stats_interval"
+	CapsLimitsCfg        = "caps_limits"
 	ShutdownTimeoutCfg   = "shutdown_timeout"
+	APIMethodsCfg        = "api_methods"
+	RequestsPerSecondCfg = "requests_per_second"
+	BurstCfg             = "burst"
 
 	// AccountSCfg
 	MaxIterations = "max_iterations"
@@ -2479,7 +2704,7 @@ var CGROptionsSet = NewStringSet([]string{OptsSessionsTTL,
 	OptsAttributesProfileIgnoreFilters, OptsStatsProfileIDs, OptsStatsProfileIgnoreFilters,
 	OptsThresholdsProfileIDs, OptsThresholdsProfileIgnoreFilters, OptsResourcesUsageID, OptsResourcesUsageTTL,
 	OptsResourcesUnits, OptsAttributeS, OptsThresholdS, OptsChargerS, OptsStatS, OptsRALs, OptsRerate,
-	OptsRefund})
+	OptsRefund, OptsTaxS})
 
 // EventExporter metrics
 const (
@@ -2546,6 +2771,7 @@ const (
 	OptsStatS      = "*statS"
 	OptsThresholdS = "*thresholdS"
 	OptsRALs       = "*ralS"
+	OptsTaxS       = "*taxS"
 	OptsRerate     = "*rerate"
 	OptsRefund     = "*refund"
 	// Others
@@ -2626,6 +2852,22 @@ const (
 	// fileXML
 	XMLRootPathOpt = "xmlRootPath"
 
+	// fileParquet and fileAvro
+	FileColumnTypesOpt    = "fileColumnTypes"
+	ParquetCompressionOpt = "parquetCompression"
+	AvroCodecOpt          = "avroCodec"
+
+	ParquetUncompressed = "uncompressed"
+	ParquetSnappy       = "snappy"
+	ParquetGzip         = "gzip"
+	ParquetZstd         = "zstd"
+	AvroNull            = "null"
+	AvroDeflate         = "deflate"
+	AvroSnappy          = "snappy"
+
+	DefaultDecimalScale = 4
+	MaxDecimalPrecision = 18 // the digits fitting into int64 decimals
+
 	// amqp
 	AMQPDefaultConsumerTag = "cgrates"
 	DefaultExchangeType    = "direct"
@@ -2685,6 +2927,14 @@ const (
 	NatsJetStream            = "natsJetStream"
 	NatsJetStreamMaxWait     = "natsJetStreamMaxWait"
 
+	// http
+	HTTPSecret       = "httpSecret"
+	HTTPSecretHeader = "httpSecretHeader"
+	HTTPUsername     = "httpUsername"
+	HTTPPassword     = "httpPassword"
+
+	HTTPDefaultSecretHeader = "X-Webhook-Secret"
+
 	// rpc
 	RpcCodec        = "rpcCodec"
 	ServiceMethod   = "serviceMethod"
@@ -2860,4 +3110,5 @@ func init() {
 	buildCacheInstRevPrefixes()
 	CachePartitions.Remove(CacheAccounts)
 	CachePartitions.Remove(CacheVersions)
+	CachePartitions.Remove(CacheSessionsBackup)
 }
@@ -1134,3 +1134,28 @@ type PanicMessageArgs struct {
 	APIOpts map[string]any
 	Message string
 }
+
+// ParseColumnType splits the column type definition <*type[:scale]>
+// used by the typed file formats, the scale being accepted only for *decimal
+func ParseColumnType(colType string) (typ string, scale int, err error) {
+	typ, scaleStr, hasScale := strings.Cut(colType, InInFieldSep)
+	switch typ {
+	case MetaString, MetaInt64, MetaFloat64, MetaBool,
+		MetaTimestamp, MetaDuration:
+		if hasScale {
+			err = fmt.Errorf("unsupported scale for column type: <%s>", colType)
+		}
+		return
+	case MetaDecimal:
+		scale = DefaultDecimalScale
+		if hasScale {
+			if scale, err = strconv.Atoi(scaleStr); err != nil ||
+				scale < 0 || scale > MaxDecimalPrecision {
+				return EmptyString, 0, fmt.Errorf("invalid scale for column type: <%s>", colType)
+			}
+		}
+		return
+	default:
+		return EmptyString, 0, fmt.Errorf("unsupported column type: <%s>", colType)
+	}
+}
@@ -77,6 +77,7 @@ var (
 	ErrServiceAlreadyRunning          = fmt.Errorf("service already running")
 	ErrMaxConcurrentRPCExceededNoCaps = errors.New("max concurrent rpc exceeded") // on internal we return this error for concureq
 	ErrMaxConcurrentRPCExceeded       = errors.New("MAX_CONCURRENT_RPC_EXCEEDED") // but the codec will rewrite it with this one to be sure that we corectly dealocate the request
+	ErrMaxRPCRateExceeded             = errors.New("MAX_RPC_RATE_EXCEEDED")
 	ErrMaxIterationsReached           = errors.New("maximum iterations reached")
 	ErrNegative                       = errors.New("NEGATIVE")
 	ErrCastFailed                     = errors.New("CAST_FAILED")